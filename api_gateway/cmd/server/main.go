@@ -75,7 +75,7 @@ func main() {
 	paymentHandler := handler.NewPaymentHandler(paymentClient)
 
 	scheduleClient := schedulepb.NewScheduleServiceClient(scheduleGrpcClient)
-	scheduleHandler := handler.NewScheduleHandler(scheduleClient)
+	scheduleHandler := handler.NewScheduleHandler(scheduleClient, userClient)
 
 	authMiddleware := middleware.NewAuthMiddleware(userClient)
 	r := chi.NewRouter()
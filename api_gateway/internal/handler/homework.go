@@ -4,9 +4,14 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"net/url"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/go-chi/chi/v5"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	homeworkpb "homework_service/pkg/api"
 )
 
@@ -26,16 +31,42 @@ func (h *HomeworkHandler) RegisterRoutes(r chi.Router, authMiddleware func(http.
 		r.Get("/assignments", h.ListAssignments)
 		r.Patch("/assignments/{id}", h.UpdateAssignment)
 		r.Delete("/assignments/{id}", h.DeleteAssignment)
+		r.Post("/assignments/{id}/restore", h.RestoreAssignment)
+		r.Post("/assignments/{id}/checklist/{position}/mark", h.MarkChecklistItem)
+		r.Post("/assignments/{id}/checklist/{position}/review", h.ReviewChecklistItem)
 		r.Get("/assignments/{assignment_id}/file-url", h.GetAssignmentFile)
+		r.Get("/assignments/{assignment_id}/attachment-urls", h.ListAttachmentFileURLs(homeworkpb.AttachmentOwnerType_ATTACHMENT_OWNER_ASSIGNMENT, "assignment_id"))
 		r.Get("/assignments/{assignment_id}/submissions", h.ListSubmissions)
 		r.Get("/assignments/{assignment_id}/feedbacks", h.ListFeedbacks)
+		r.Post("/assignments/{assignment_id}/comments", h.CreateComment)
+		r.Get("/assignments/{assignment_id}/comments", h.ListComments)
+
+		r.Patch("/comments/{id}", h.UpdateComment)
+		r.Delete("/comments/{id}", h.DeleteComment)
+		r.Get("/comments/{comment_id}/attachment-urls", h.ListAttachmentFileURLs(homeworkpb.AttachmentOwnerType_ATTACHMENT_OWNER_COMMENT, "comment_id"))
+
+		r.Post("/templates", h.CreateAssignmentTemplate)
+		r.Get("/templates", h.ListAssignmentTemplates)
+		r.Patch("/templates/{id}", h.UpdateAssignmentTemplate)
+		r.Delete("/templates/{id}", h.DeleteAssignmentTemplate)
+		r.Post("/templates/{id}/assign", h.AssignFromTemplate)
+		r.Put("/templates/default", h.SetDefaultTemplate)
+		r.Get("/templates/default", h.GetDefaultTemplate)
 
 		r.Post("/submissions", h.CreateSubmission)
 		r.Get("/submissions/{submission_id}/file-url", h.GetSubmissionFile)
+		r.Get("/submissions/{submission_id}/attachment-urls", h.ListAttachmentFileURLs(homeworkpb.AttachmentOwnerType_ATTACHMENT_OWNER_SUBMISSION, "submission_id"))
 
 		r.Post("/feedbacks", h.CreateFeedback)
 		r.Patch("/feedbacks/{id}", h.UpdateFeedback)
 		r.Get("/feedbacks/{feedback_id}/file-url", h.GetFeedbackFile)
+		r.Post("/feedbacks/{id}/flatten", h.FlattenFeedbackAnnotations)
+		r.Get("/gradebook", h.GetGradebook)
+		r.Get("/stats", h.GetHomeworkStats)
+		r.Get("/search", h.SearchHomework)
+		r.Post("/exports", h.CreatePortfolioExport)
+		r.Get("/exports/{id}", h.GetPortfolioExport)
+		r.Get("/feedbacks/{feedback_id}/attachment-urls", h.ListAttachmentFileURLs(homeworkpb.AttachmentOwnerType_ATTACHMENT_OWNER_FEEDBACK, "feedback_id"))
 	})
 }
 
@@ -66,12 +97,129 @@ func parseFeedbackID(ctx context.Context, r *http.Request, req *homeworkpb.GetFe
 	return nil
 }
 
+func parseGetGradebook(ctx context.Context, r *http.Request, req *homeworkpb.GetGradebookRequest) error {
+	q := r.URL.Query()
+	req.TutorId = q.Get("tutor_id")
+	req.StudentId = q.Get("student_id")
+	if req.TutorId == "" || req.StudentId == "" {
+		return fmt.Errorf("tutor_id and student_id are required")
+	}
+
+	if v := q.Get("from"); v != "" {
+		from, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return fmt.Errorf("invalid from: %w", err)
+		}
+		req.From = timestamppb.New(from)
+	}
+	if v := q.Get("to"); v != "" {
+		to, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return fmt.Errorf("invalid to: %w", err)
+		}
+		req.To = timestamppb.New(to)
+	}
+	return nil
+}
+
+func (h *HomeworkHandler) GetHomeworkStats(w http.ResponseWriter, r *http.Request) {
+	handler, _ := Handle[homeworkpb.GetHomeworkStatsRequest, homeworkpb.HomeworkStats](h.c.GetHomeworkStats, parseGetHomeworkStats, false)
+	handler(w, r)
+}
+
+func parseGetHomeworkStats(ctx context.Context, r *http.Request, req *homeworkpb.GetHomeworkStatsRequest) error {
+	q := r.URL.Query()
+	req.TutorId = q.Get("tutor_id")
+	if req.TutorId == "" {
+		return fmt.Errorf("tutor_id is required")
+	}
+
+	if v := q.Get("from"); v != "" {
+		from, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return fmt.Errorf("invalid from: %w", err)
+		}
+		req.From = timestamppb.New(from)
+	}
+	if v := q.Get("to"); v != "" {
+		to, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return fmt.Errorf("invalid to: %w", err)
+		}
+		req.To = timestamppb.New(to)
+	}
+	return nil
+}
+
+func (h *HomeworkHandler) SearchHomework(w http.ResponseWriter, r *http.Request) {
+	handler, _ := Handle[homeworkpb.SearchHomeworkRequest, homeworkpb.SearchHomeworkResponse](h.c.SearchHomework, parseSearchHomework, false)
+	handler(w, r)
+}
+
+func parseSearchHomework(ctx context.Context, r *http.Request, req *homeworkpb.SearchHomeworkRequest) error {
+	q := r.URL.Query()
+	req.Query = q.Get("q")
+	if req.Query == "" {
+		return fmt.Errorf("q is required")
+	}
+	if v := q.Get("tutor_id"); v != "" {
+		req.TutorId = &v
+	}
+	if v := q.Get("student_id"); v != "" {
+		req.StudentId = &v
+	}
+
+	for _, t := range q["type"] {
+		switch strings.ToLower(t) {
+		case "assignment":
+			req.Types = append(req.Types, homeworkpb.SearchHitType_SEARCH_HIT_ASSIGNMENT)
+		case "submission":
+			req.Types = append(req.Types, homeworkpb.SearchHitType_SEARCH_HIT_SUBMISSION)
+		case "feedback":
+			req.Types = append(req.Types, homeworkpb.SearchHitType_SEARCH_HIT_FEEDBACK)
+		default:
+			return fmt.Errorf("invalid type: %s", t)
+		}
+	}
+
+	if v := q.Get("from"); v != "" {
+		from, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return fmt.Errorf("invalid from: %w", err)
+		}
+		req.From = timestamppb.New(from)
+	}
+	if v := q.Get("to"); v != "" {
+		to, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return fmt.Errorf("invalid to: %w", err)
+		}
+		req.To = timestamppb.New(to)
+	}
+
+	if v := q.Get("page_size"); v != "" {
+		pageSize, err := strconv.ParseInt(v, 10, 32)
+		if err != nil {
+			return fmt.Errorf("invalid page_size: %w", err)
+		}
+		req.PageSize = int32(pageSize)
+	}
+	req.PageToken = q.Get("page_token")
+	return nil
+}
+
 func parseAssignmentQuery(ctx context.Context, r *http.Request) (context.Context, any, error) {
 	q := r.URL.Query()
 	tutorID := q.Get("tutor_id")
 	studentID := q.Get("student_id")
+	lessonID := q.Get("lesson_id")
 	statuses := q["status_filter"]
 
+	progress, err := parseChecklistProgress(q)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	parseStatuses := func(raw []string) []homeworkpb.AssignmentStatusFilter {
 		res := make([]homeworkpb.AssignmentStatusFilter, 0)
 		for _, s := range raw {
@@ -84,26 +232,76 @@ func parseAssignmentQuery(ctx context.Context, r *http.Request) (context.Context
 				res = append(res, homeworkpb.AssignmentStatusFilter_REVIEWED)
 			case "OVERDUE":
 				res = append(res, homeworkpb.AssignmentStatusFilter_OVERDUE)
+			case "NEEDS_REVISION":
+				res = append(res, homeworkpb.AssignmentStatusFilter_NEEDS_REVISION)
 			}
 		}
 		return res
 	}
 
 	switch {
+	case lessonID != "":
+		if tutorID != "" || studentID != "" {
+			return nil, nil, fmt.Errorf("lesson_id cannot be combined with tutor_id or student_id")
+		}
+		req := &homeworkpb.ListAssignmentsByLessonRequest{LessonId: lessonID, StatusFilter: parseStatuses(statuses), ChecklistProgress: progress}
+		return ctx, req, nil
 	case tutorID != "" && studentID != "":
-		req := &homeworkpb.ListAssignmentsByPairRequest{TutorId: tutorID, StudentId: studentID, StatusFilter: parseStatuses(statuses)}
+		req := &homeworkpb.ListAssignmentsByPairRequest{TutorId: tutorID, StudentId: studentID, StatusFilter: parseStatuses(statuses), ChecklistProgress: progress}
 		return ctx, req, nil
 	case tutorID != "":
-		req := &homeworkpb.ListAssignmentsByTutorRequest{TutorId: tutorID, StatusFilter: parseStatuses(statuses)}
+		req := &homeworkpb.ListAssignmentsByTutorRequest{TutorId: tutorID, StatusFilter: parseStatuses(statuses), ChecklistProgress: progress}
 		return ctx, req, nil
 	case studentID != "":
-		req := &homeworkpb.ListAssignmentsByStudentRequest{StudentId: studentID, StatusFilter: parseStatuses(statuses)}
+		req := &homeworkpb.ListAssignmentsByStudentRequest{StudentId: studentID, StatusFilter: parseStatuses(statuses), ChecklistProgress: progress}
 		return ctx, req, nil
 	default:
 		return nil, nil, fmt.Errorf("invalid filter combination")
 	}
 }
 
+// parseChecklistProgress reads the min_progress and max_progress bounds, returning
+// nil without them.
+func parseChecklistProgress(q url.Values) (*homeworkpb.ChecklistProgressFilter, error) {
+	filter := &homeworkpb.ChecklistProgressFilter{}
+	if v := q.Get("min_progress"); v != "" {
+		minProgress, err := strconv.ParseInt(v, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid min_progress: %w", err)
+		}
+		filter.Min = proto.Int32(int32(minProgress))
+	}
+	if v := q.Get("max_progress"); v != "" {
+		maxProgress, err := strconv.ParseInt(v, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid max_progress: %w", err)
+		}
+		filter.Max = proto.Int32(int32(maxProgress))
+	}
+
+	if filter.Min == nil && filter.Max == nil {
+		return nil, nil
+	}
+	return filter, nil
+}
+
+// parseChecklistPosition reads the assignment ID and the checklist item position from the path.
+func parseChecklistPosition(r *http.Request) (string, int32, error) {
+	id, err := parsePathParam(r, "id")
+	if err != nil {
+		return "", 0, err
+	}
+	raw, err := parsePathParam(r, "position")
+	if err != nil {
+		return "", 0, err
+	}
+	position, err := strconv.ParseInt(raw, 10, 32)
+	if err != nil {
+		return "", 0, fmt.Errorf("invalid position: %w", err)
+	}
+	return id, int32(position), nil
+}
+
 func (h *HomeworkHandler) CreateAssignment(w http.ResponseWriter, r *http.Request) {
 	handler, err := Handle[homeworkpb.CreateAssignmentRequest, homeworkpb.Assignment](h.c.CreateAssignment, nil, true)
 	if err != nil {
@@ -130,6 +328,9 @@ func (h *HomeworkHandler) ListAssignments(w http.ResponseWriter, r *http.Request
 	case *homeworkpb.ListAssignmentsByPairRequest:
 		handler, _ := Handle[homeworkpb.ListAssignmentsByPairRequest, homeworkpb.ListAssignmentsResponse](h.c.ListAssignmentsByPair, nil, false)
 		handler(w, r.WithContext(context.WithValue(ctx, contextKey("req"), x)))
+	case *homeworkpb.ListAssignmentsByLessonRequest:
+		handler, _ := Handle[homeworkpb.ListAssignmentsByLessonRequest, homeworkpb.ListAssignmentsResponse](h.c.ListAssignmentsByLesson, nil, false)
+		handler(w, r.WithContext(context.WithValue(ctx, contextKey("req"), x)))
 	default:
 		http.Error(w, "invalid query", http.StatusBadRequest)
 	}
@@ -159,6 +360,18 @@ func (h *HomeworkHandler) DeleteAssignment(w http.ResponseWriter, r *http.Reques
 	handler(w, r)
 }
 
+func (h *HomeworkHandler) RestoreAssignment(w http.ResponseWriter, r *http.Request) {
+	handler, _ := Handle[homeworkpb.RestoreAssignmentRequest, homeworkpb.Assignment](h.c.RestoreAssignment, func(ctx context.Context, r *http.Request, req *homeworkpb.RestoreAssignmentRequest) error {
+		id, err := parsePathParam(r, "id")
+		if err != nil {
+			return err
+		}
+		req.AssignmentId = id
+		return nil
+	}, false)
+	handler(w, r)
+}
+
 func (h *HomeworkHandler) GetAssignmentFile(w http.ResponseWriter, r *http.Request) {
 	handler, _ := Handle[homeworkpb.GetAssignmentFileRequest, homeworkpb.HomeworkFileURL](h.c.GetAssignmentFile, parseAssignmentID, false)
 	handler(w, r)
@@ -186,6 +399,32 @@ func (h *HomeworkHandler) GetSubmissionFile(w http.ResponseWriter, r *http.Reque
 	handler(w, r)
 }
 
+func (h *HomeworkHandler) MarkChecklistItem(w http.ResponseWriter, r *http.Request) {
+	handler, _ := Handle[homeworkpb.MarkChecklistItemRequest, homeworkpb.Assignment](h.c.MarkChecklistItem, func(ctx context.Context, r *http.Request, req *homeworkpb.MarkChecklistItemRequest) error {
+		id, position, err := parseChecklistPosition(r)
+		if err != nil {
+			return err
+		}
+		req.AssignmentId = id
+		req.Position = position
+		return nil
+	}, true)
+	handler(w, r)
+}
+
+func (h *HomeworkHandler) ReviewChecklistItem(w http.ResponseWriter, r *http.Request) {
+	handler, _ := Handle[homeworkpb.ReviewChecklistItemRequest, homeworkpb.Assignment](h.c.ReviewChecklistItem, func(ctx context.Context, r *http.Request, req *homeworkpb.ReviewChecklistItemRequest) error {
+		id, position, err := parseChecklistPosition(r)
+		if err != nil {
+			return err
+		}
+		req.AssignmentId = id
+		req.Position = position
+		return nil
+	}, true)
+	handler(w, r)
+}
+
 func (h *HomeworkHandler) CreateFeedback(w http.ResponseWriter, r *http.Request) {
 	handler, _ := Handle[homeworkpb.CreateFeedbackRequest, homeworkpb.Feedback](h.c.CreateFeedback, nil, true)
 	handler(w, r)
@@ -203,6 +442,18 @@ func (h *HomeworkHandler) UpdateFeedback(w http.ResponseWriter, r *http.Request)
 	handler(w, r)
 }
 
+func (h *HomeworkHandler) FlattenFeedbackAnnotations(w http.ResponseWriter, r *http.Request) {
+	handler, _ := Handle[homeworkpb.FlattenFeedbackAnnotationsRequest, homeworkpb.AnnotatedFile](h.c.FlattenFeedbackAnnotations, func(ctx context.Context, r *http.Request, req *homeworkpb.FlattenFeedbackAnnotationsRequest) error {
+		id, err := parsePathParam(r, "id")
+		if err != nil {
+			return err
+		}
+		req.FeedbackId = id
+		return nil
+	}, true)
+	handler(w, r)
+}
+
 func (h *HomeworkHandler) ListFeedbacks(w http.ResponseWriter, r *http.Request) {
 	handler, _ := Handle[homeworkpb.ListFeedbacksByAssignmentRequest, homeworkpb.ListFeedbacksResponse](h.c.ListFeedbacksByAssignment, func(ctx context.Context, r *http.Request, req *homeworkpb.ListFeedbacksByAssignmentRequest) error {
 		id, err := parsePathParam(r, "assignment_id")
@@ -215,7 +466,171 @@ func (h *HomeworkHandler) ListFeedbacks(w http.ResponseWriter, r *http.Request)
 	handler(w, r)
 }
 
+func (h *HomeworkHandler) GetGradebook(w http.ResponseWriter, r *http.Request) {
+	handler, _ := Handle[homeworkpb.GetGradebookRequest, homeworkpb.Gradebook](h.c.GetGradebook, parseGetGradebook, false)
+	handler(w, r)
+}
+
+func (h *HomeworkHandler) CreatePortfolioExport(w http.ResponseWriter, r *http.Request) {
+	handler, _ := Handle[homeworkpb.CreatePortfolioExportRequest, homeworkpb.PortfolioExport](h.c.CreatePortfolioExport, nil, true)
+	handler(w, r)
+}
+
+func (h *HomeworkHandler) GetPortfolioExport(w http.ResponseWriter, r *http.Request) {
+	handler, _ := Handle[homeworkpb.GetPortfolioExportRequest, homeworkpb.PortfolioExport](h.c.GetPortfolioExport, func(ctx context.Context, r *http.Request, req *homeworkpb.GetPortfolioExportRequest) error {
+		id, err := parsePathParam(r, "id")
+		if err != nil {
+			return err
+		}
+		req.Id = id
+		return nil
+	}, false)
+	handler(w, r)
+}
+
 func (h *HomeworkHandler) GetFeedbackFile(w http.ResponseWriter, r *http.Request) {
 	handler, _ := Handle[homeworkpb.GetFeedbackFileRequest, homeworkpb.HomeworkFileURL](h.c.GetFeedbackFile, parseFeedbackID, false)
 	handler(w, r)
 }
+
+// ListAttachmentFileURLs returns a handler resolving download URLs of all attachments
+// of the owner identified by the given path parameter.
+func (h *HomeworkHandler) ListAttachmentFileURLs(ownerType homeworkpb.AttachmentOwnerType, param string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		handler, _ := Handle[homeworkpb.ListAttachmentFileURLsRequest, homeworkpb.ListAttachmentFileURLsResponse](h.c.ListAttachmentFileURLs, func(ctx context.Context, r *http.Request, req *homeworkpb.ListAttachmentFileURLsRequest) error {
+			id, err := parsePathParam(r, param)
+			if err != nil {
+				return err
+			}
+			req.OwnerType = ownerType
+			req.OwnerId = id
+			return nil
+		}, false)
+		handler(w, r)
+	}
+}
+
+func (h *HomeworkHandler) CreateAssignmentTemplate(w http.ResponseWriter, r *http.Request) {
+	handler, _ := Handle[homeworkpb.CreateAssignmentTemplateRequest, homeworkpb.AssignmentTemplate](h.c.CreateAssignmentTemplate, nil, true)
+	handler(w, r)
+}
+
+func (h *HomeworkHandler) ListAssignmentTemplates(w http.ResponseWriter, r *http.Request) {
+	handler, _ := Handle[homeworkpb.ListAssignmentTemplatesRequest, homeworkpb.ListAssignmentTemplatesResponse](h.c.ListAssignmentTemplates, func(ctx context.Context, r *http.Request, req *homeworkpb.ListAssignmentTemplatesRequest) error {
+		req.TutorId = r.URL.Query().Get("tutor_id")
+		if req.TutorId == "" {
+			return fmt.Errorf("tutor_id is required")
+		}
+		return nil
+	}, false)
+	handler(w, r)
+}
+
+func (h *HomeworkHandler) UpdateAssignmentTemplate(w http.ResponseWriter, r *http.Request) {
+	handler, _ := Handle[homeworkpb.UpdateAssignmentTemplateRequest, homeworkpb.AssignmentTemplate](h.c.UpdateAssignmentTemplate, func(ctx context.Context, r *http.Request, req *homeworkpb.UpdateAssignmentTemplateRequest) error {
+		id, err := parsePathParam(r, "id")
+		if err != nil {
+			return err
+		}
+		req.Id = id
+		return nil
+	}, true)
+	handler(w, r)
+}
+
+func (h *HomeworkHandler) DeleteAssignmentTemplate(w http.ResponseWriter, r *http.Request) {
+	handler, _ := Handle[homeworkpb.DeleteAssignmentTemplateRequest, homeworkpb.Empty](h.c.DeleteAssignmentTemplate, func(ctx context.Context, r *http.Request, req *homeworkpb.DeleteAssignmentTemplateRequest) error {
+		id, err := parsePathParam(r, "id")
+		if err != nil {
+			return err
+		}
+		req.TemplateId = id
+		return nil
+	}, false)
+	handler(w, r)
+}
+
+func (h *HomeworkHandler) AssignFromTemplate(w http.ResponseWriter, r *http.Request) {
+	handler, _ := Handle[homeworkpb.AssignFromTemplateRequest, homeworkpb.ListAssignmentsResponse](h.c.AssignFromTemplate, func(ctx context.Context, r *http.Request, req *homeworkpb.AssignFromTemplateRequest) error {
+		id, err := parsePathParam(r, "id")
+		if err != nil {
+			return err
+		}
+		req.TemplateId = id
+		return nil
+	}, true)
+	handler(w, r)
+}
+
+func (h *HomeworkHandler) SetDefaultTemplate(w http.ResponseWriter, r *http.Request) {
+	handler, _ := Handle[homeworkpb.SetDefaultTemplateRequest, homeworkpb.Empty](h.c.SetDefaultTemplate, nil, true)
+	handler(w, r)
+}
+
+func (h *HomeworkHandler) GetDefaultTemplate(w http.ResponseWriter, r *http.Request) {
+	handler, _ := Handle[homeworkpb.GetDefaultTemplateRequest, homeworkpb.AssignmentTemplate](h.c.GetDefaultTemplate, parseGetDefaultTemplate, false)
+	handler(w, r)
+}
+
+func parseGetDefaultTemplate(ctx context.Context, r *http.Request, req *homeworkpb.GetDefaultTemplateRequest) error {
+	q := r.URL.Query()
+	req.TutorId = q.Get("tutor_id")
+	req.StudentId = q.Get("student_id")
+	if req.TutorId == "" || req.StudentId == "" {
+		return fmt.Errorf("tutor_id and student_id are required")
+	}
+	return nil
+}
+
+func (h *HomeworkHandler) CreateComment(w http.ResponseWriter, r *http.Request) {
+	handler, _ := Handle[homeworkpb.CreateCommentRequest, homeworkpb.Comment](h.c.CreateComment, func(ctx context.Context, r *http.Request, req *homeworkpb.CreateCommentRequest) error {
+		id, err := parsePathParam(r, "assignment_id")
+		if err != nil {
+			return err
+		}
+		req.AssignmentId = id
+		return nil
+	}, true)
+	handler(w, r)
+}
+
+func (h *HomeworkHandler) ListComments(w http.ResponseWriter, r *http.Request) {
+	handler, _ := Handle[homeworkpb.ListCommentsRequest, homeworkpb.ListCommentsResponse](h.c.ListComments, parseListComments, false)
+	handler(w, r)
+}
+
+func parseListComments(ctx context.Context, r *http.Request, req *homeworkpb.ListCommentsRequest) error {
+	id, err := parsePathParam(r, "assignment_id")
+	if err != nil {
+		return err
+	}
+	req.AssignmentId = id
+	if submissionID := r.URL.Query().Get("submission_id"); submissionID != "" {
+		req.SubmissionId = &submissionID
+	}
+	return nil
+}
+
+func (h *HomeworkHandler) UpdateComment(w http.ResponseWriter, r *http.Request) {
+	handler, _ := Handle[homeworkpb.UpdateCommentRequest, homeworkpb.Comment](h.c.UpdateComment, func(ctx context.Context, r *http.Request, req *homeworkpb.UpdateCommentRequest) error {
+		id, err := parsePathParam(r, "id")
+		if err != nil {
+			return err
+		}
+		req.Id = id
+		return nil
+	}, true)
+	handler(w, r)
+}
+
+func (h *HomeworkHandler) DeleteComment(w http.ResponseWriter, r *http.Request) {
+	handler, _ := Handle[homeworkpb.DeleteCommentRequest, homeworkpb.Empty](h.c.DeleteComment, func(ctx context.Context, r *http.Request, req *homeworkpb.DeleteCommentRequest) error {
+		id, err := parsePathParam(r, "id")
+		if err != nil {
+			return err
+		}
+		req.CommentId = id
+		return nil
+	}, false)
+	handler(w, r)
+}
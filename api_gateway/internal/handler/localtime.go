@@ -0,0 +1,296 @@
+package handler
+
+import (
+	"common_library/logging"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	schedulepb "schedule_service/pkg/api"
+	"sort"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	userpb "userservice/pkg/api"
+)
+
+const dateLayout = "2006-01-02"
+
+// localTimeOptions controls the optional rendering of schedule times in a time zone.
+// Rendering is enabled by ?tz=<IANA zone>, ?local=true or ?view=week. Without ?tz
+// the requesting user's zone is used, falling back to UTC.
+type localTimeOptions struct {
+	loc  *time.Location
+	week bool
+}
+
+// parseLocalTimeOptions returns nil options if local rendering is not requested.
+func parseLocalTimeOptions(r *http.Request) (*localTimeOptions, error) {
+	q := r.URL.Query()
+	tz := q.Get("tz")
+	view := q.Get("view")
+
+	if view != "" && view != "week" {
+		return nil, fmt.Errorf("%w: unknown view %q", ErrBadRequest, view)
+	}
+	if tz == "" && view == "" && q.Get("local") != "true" {
+		return nil, nil
+	}
+
+	opts := &localTimeOptions{loc: time.UTC, week: view == "week"}
+	if tz != "" {
+		loc, err := time.LoadLocation(tz)
+		if err != nil {
+			return nil, fmt.Errorf("%w: unknown time zone %q", ErrBadRequest, tz)
+		}
+		opts.loc = loc
+	} else if loc := loadLocation(r.Header.Get("X-User-Timezone")); loc != nil {
+		opts.loc = loc
+	}
+
+	return opts, nil
+}
+
+// loadLocation returns nil for an empty or unknown zone name.
+func loadLocation(name string) *time.Location {
+	if name == "" {
+		return nil
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil
+	}
+	return loc
+}
+
+func localTimeFields(loc *time.Location, startsAt, endsAt time.Time) map[string]any {
+	start := startsAt.In(loc)
+	return map[string]any{
+		"timezone": loc.String(),
+		"startsAt": start.Format(time.RFC3339),
+		"endsAt":   endsAt.In(loc).Format(time.RFC3339),
+		"date":     start.Format(dateLayout),
+		"weekday":  start.Weekday().String(),
+	}
+}
+
+func protoToMap(m proto.Message) (map[string]any, error) {
+	data, err := protojson.Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+
+	var out map[string]any
+	if err := json.Unmarshal(data, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// renderedItem is a slot or lesson rendered to JSON, with its start time for grouping.
+type renderedItem struct {
+	startsAt time.Time
+	value    map[string]any
+}
+
+// weekView groups items into ISO weeks (starting on Monday) and days in loc.
+func weekView(loc *time.Location, key string, items []renderedItem) map[string]any {
+	sort.SliceStable(items, func(i, j int) bool { return items[i].startsAt.Before(items[j].startsAt) })
+
+	weeks := make([]map[string]any, 0)
+	var days []map[string]any
+	var weekStart, day string
+	for _, item := range items {
+		start := item.startsAt.In(loc)
+		if ws := startOfWeek(start).Format(dateLayout); ws != weekStart {
+			weekStart = ws
+			days = make([]map[string]any, 0)
+			weeks = append(weeks, map[string]any{"weekStart": weekStart, "days": days})
+			day = ""
+		}
+		if d := start.Format(dateLayout); d != day {
+			day = d
+			days = append(days, map[string]any{
+				"date":    day,
+				"weekday": start.Weekday().String(),
+				key:       make([]map[string]any, 0),
+			})
+			weeks[len(weeks)-1]["days"] = days
+		}
+		current := days[len(days)-1]
+		current[key] = append(current[key].([]map[string]any), item.value)
+	}
+
+	return map[string]any{
+		"timezone": loc.String(),
+		"weeks":    weeks,
+	}
+}
+
+func startOfWeek(t time.Time) time.Time {
+	offset := (int(t.Weekday()) + 6) % 7 // Monday = 0
+	y, m, d := t.AddDate(0, 0, -offset).Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, t.Location())
+}
+
+func renderSlot(opts *localTimeOptions, slot *schedulepb.Slot) (renderedItem, error) {
+	value, err := protoToMap(slot)
+	if err != nil {
+		return renderedItem{}, err
+	}
+
+	startsAt := slot.StartsAt.AsTime()
+	value["local"] = localTimeFields(opts.loc, startsAt, slot.EndsAt.AsTime())
+	return renderedItem{startsAt: startsAt, value: value}, nil
+}
+
+func (h *ScheduleHandler) renderSlot(opts *localTimeOptions) func(context.Context, *http.Request, *schedulepb.Slot) (any, error) {
+	if opts == nil {
+		return nil
+	}
+	return func(_ context.Context, _ *http.Request, slot *schedulepb.Slot) (any, error) {
+		item, err := renderSlot(opts, slot)
+		if err != nil {
+			return nil, err
+		}
+		return item.value, nil
+	}
+}
+
+func (h *ScheduleHandler) renderSlots(opts *localTimeOptions) func(context.Context, *http.Request, *schedulepb.ListSlotsResponse) (any, error) {
+	if opts == nil {
+		return nil
+	}
+	return func(_ context.Context, _ *http.Request, resp *schedulepb.ListSlotsResponse) (any, error) {
+		items := make([]renderedItem, 0, len(resp.Slots))
+		for _, slot := range resp.Slots {
+			item, err := renderSlot(opts, slot)
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, item)
+		}
+
+		if opts.week {
+			return weekView(opts.loc, "slots", items), nil
+		}
+		slots := make([]map[string]any, 0, len(items))
+		for _, item := range items {
+			slots = append(slots, item.value)
+		}
+		return map[string]any{"slots": slots}, nil
+	}
+}
+
+// participantZones resolves the time zones of the lesson participants. The requesting
+// user's zone comes from the auth middleware, the others are fetched from user_service
+// in a single batch. Unknown zones are stored as nil.
+func (h *ScheduleHandler) participantZones(ctx context.Context, r *http.Request, lessons []*schedulepb.Lesson) map[string]*time.Location {
+	zones := make(map[string]*time.Location)
+	if id := r.Header.Get("X-User-Id"); id != "" {
+		zones[id] = loadLocation(r.Header.Get("X-User-Timezone"))
+	}
+
+	var ids []string
+	for _, lesson := range lessons {
+		for _, id := range []string{lesson.GetTutorId(), lesson.StudentId} {
+			if _, ok := zones[id]; ok || id == "" {
+				continue
+			}
+			zones[id] = nil
+			ids = append(ids, id)
+		}
+	}
+	if len(ids) == 0 {
+		return zones
+	}
+
+	resp, err := h.users.GetUserTimezones(ctx, &userpb.GetUserTimezonesRequest{Ids: ids})
+	if err != nil {
+		if logger, ok := logging.GetFromContext(r.Context()); ok {
+			logger.Error(ctx, "Failed to get participant time zones", zap.Int("users", len(ids)), zap.Error(err))
+		}
+		return zones
+	}
+	for id, tz := range resp.GetTimezones() {
+		if _, ok := zones[id]; ok {
+			zones[id] = loadLocation(tz)
+		}
+	}
+
+	return zones
+}
+
+// renderLesson adds local times in the requested zone and, when the tutor's and
+// the student's clocks differ at the lesson time, in both of their zones.
+func renderLesson(opts *localTimeOptions, zones map[string]*time.Location, lesson *schedulepb.Lesson) (renderedItem, error) {
+	value, err := protoToMap(lesson)
+	if err != nil {
+		return renderedItem{}, err
+	}
+
+	if lesson.StartsAt == nil {
+		return renderedItem{startsAt: lesson.CreatedAt.AsTime(), value: value}, nil
+	}
+
+	startsAt := lesson.StartsAt.AsTime()
+	endsAt := lesson.EndsAt.AsTime()
+	value["local"] = localTimeFields(opts.loc, startsAt, endsAt)
+
+	tutorLoc, studentLoc := zones[lesson.GetTutorId()], zones[lesson.StudentId]
+	if tutorLoc != nil && studentLoc != nil && !sameClock(startsAt, tutorLoc, studentLoc) {
+		value["tutorLocal"] = localTimeFields(tutorLoc, startsAt, endsAt)
+		value["studentLocal"] = localTimeFields(studentLoc, startsAt, endsAt)
+	}
+
+	return renderedItem{startsAt: startsAt, value: value}, nil
+}
+
+func sameClock(t time.Time, a, b *time.Location) bool {
+	_, offsetA := t.In(a).Zone()
+	_, offsetB := t.In(b).Zone()
+	return offsetA == offsetB
+}
+
+func (h *ScheduleHandler) renderLesson(opts *localTimeOptions) func(context.Context, *http.Request, *schedulepb.Lesson) (any, error) {
+	if opts == nil {
+		return nil
+	}
+	return func(ctx context.Context, r *http.Request, lesson *schedulepb.Lesson) (any, error) {
+		zones := h.participantZones(ctx, r, []*schedulepb.Lesson{lesson})
+		item, err := renderLesson(opts, zones, lesson)
+		if err != nil {
+			return nil, err
+		}
+		return item.value, nil
+	}
+}
+
+func (h *ScheduleHandler) renderLessons(opts *localTimeOptions) func(context.Context, *http.Request, *schedulepb.ListLessonsResponse) (any, error) {
+	if opts == nil {
+		return nil
+	}
+	return func(ctx context.Context, r *http.Request, resp *schedulepb.ListLessonsResponse) (any, error) {
+		zones := h.participantZones(ctx, r, resp.Lessons)
+
+		items := make([]renderedItem, 0, len(resp.Lessons))
+		for _, lesson := range resp.Lessons {
+			item, err := renderLesson(opts, zones, lesson)
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, item)
+		}
+
+		if opts.week {
+			return weekView(opts.loc, "lessons", items), nil
+		}
+		lessons := make([]map[string]any, 0, len(items))
+		for _, item := range items {
+			lessons = append(lessons, item.value)
+		}
+		return map[string]any{"lessons": lessons}, nil
+	}
+}
@@ -7,17 +7,21 @@ import (
 	"net/http"
 	schedulepb "schedule_service/pkg/api"
 	"strings"
+	"time"
 
 	"github.com/go-chi/chi/v5"
 	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	userpb "userservice/pkg/api"
 )
 
 type ScheduleHandler struct {
-	c schedulepb.ScheduleServiceClient
+	c     schedulepb.ScheduleServiceClient
+	users userpb.UserServiceClient
 }
 
-func NewScheduleHandler(c schedulepb.ScheduleServiceClient) *ScheduleHandler {
-	return &ScheduleHandler{c: c}
+func NewScheduleHandler(c schedulepb.ScheduleServiceClient, users userpb.UserServiceClient) *ScheduleHandler {
+	return &ScheduleHandler{c: c, users: users}
 }
 
 func (h *ScheduleHandler) RegisterRoutes(r chi.Router, authMiddleware func(http.Handler) http.Handler) {
@@ -33,6 +37,19 @@ func (h *ScheduleHandler) RegisterRoutes(r chi.Router, authMiddleware func(http.
 		r.Get("/lessons/{id}", h.GetLesson)
 		r.Patch("/lessons/{id}", h.UpdateLesson)
 		r.Post("/lessons/{id}/cancel", h.CancelLesson)
+		r.Post("/lessons/{id}/attendance", h.UpdateAttendance)
+		r.Put("/lessons/{id}/notes", h.UpsertLessonNotes)
+		r.Get("/lessons/{id}/notes/history", h.ListLessonNotesHistory)
+
+		r.Post("/block-period", h.BlockPeriod)
+
+		r.Post("/lesson-series", h.CreateLessonSeries)
+		r.Post("/lesson-series/{id}/cancel", h.CancelLessonSeries)
+
+		r.Post("/packages", h.CreateLessonPackage)
+		r.Get("/packages/balance", h.GetPackageBalance)
+
+		r.Get("/stats", h.GetScheduleStats)
 	})
 }
 
@@ -114,6 +131,79 @@ func parseCancelLesson(ctx context.Context, r *http.Request, req *schedulepb.Can
 	return nil
 }
 
+func parseUpdateAttendance(ctx context.Context, r *http.Request, req *schedulepb.UpdateAttendanceRequest) error {
+	id, err := parseIDParam(r, "id")
+	if err != nil {
+		return err
+	}
+	req.Id = id
+	return nil
+}
+
+func parseUpsertLessonNotes(ctx context.Context, r *http.Request, req *schedulepb.UpsertLessonNotesRequest) error {
+	id, err := parseIDParam(r, "id")
+	if err != nil {
+		return err
+	}
+	req.LessonId = id
+	return nil
+}
+
+func parseListLessonNotesHistory(ctx context.Context, r *http.Request, req *schedulepb.ListLessonNotesHistoryRequest) error {
+	id, err := parseIDParam(r, "id")
+	if err != nil {
+		return err
+	}
+	req.LessonId = id
+	return nil
+}
+
+func parseCancelLessonSeries(ctx context.Context, r *http.Request, req *schedulepb.CancelLessonSeriesRequest) error {
+	id, err := parseIDParam(r, "id")
+	if err != nil {
+		return err
+	}
+	req.SeriesId = id
+	if reason := r.URL.Query().Get("reason"); reason != "" {
+		req.Reason = &reason
+	}
+	return nil
+}
+
+func parseGetPackageBalance(ctx context.Context, r *http.Request, req *schedulepb.GetPackageBalanceRequest) error {
+	q := r.URL.Query()
+	req.TutorId = q.Get("tutor_id")
+	req.StudentId = q.Get("student_id")
+	if req.TutorId == "" || req.StudentId == "" {
+		return fmt.Errorf("tutor_id and student_id are required")
+	}
+	return nil
+}
+
+func parseGetScheduleStats(ctx context.Context, r *http.Request, req *schedulepb.GetScheduleStatsRequest) error {
+	q := r.URL.Query()
+	req.TutorId = q.Get("tutor_id")
+	if req.TutorId == "" {
+		return fmt.Errorf("tutor_id is required")
+	}
+
+	from, err := time.Parse(time.RFC3339, q.Get("from"))
+	if err != nil {
+		return fmt.Errorf("invalid from: %w", err)
+	}
+	to, err := time.Parse(time.RFC3339, q.Get("to"))
+	if err != nil {
+		return fmt.Errorf("invalid to: %w", err)
+	}
+	req.From = timestamppb.New(from)
+	req.To = timestamppb.New(to)
+
+	if tz := q.Get("tz"); tz != "" {
+		req.Timezone = &tz
+	}
+	return nil
+}
+
 func parseListLessons(ctx context.Context, r *http.Request) (context.Context, any, error) {
 	q := r.URL.Query()
 	tutorID := q.Get("tutor_id")
@@ -152,6 +242,10 @@ func parseStatus(s string) schedulepb.LessonStatusFilter {
 		return schedulepb.LessonStatusFilter_CANCELLED
 	case "COMPLETED":
 		return schedulepb.LessonStatusFilter_COMPLETED
+	case "NO_SHOW_STUDENT":
+		return schedulepb.LessonStatusFilter_NO_SHOW_STUDENT
+	case "NO_SHOW_TUTOR":
+		return schedulepb.LessonStatusFilter_NO_SHOW_TUTOR
 	default:
 		return schedulepb.LessonStatusFilter_BOOKED
 	}
@@ -166,7 +260,13 @@ func (h *ScheduleHandler) CreateSlot(w http.ResponseWriter, r *http.Request) {
 }
 
 func (h *ScheduleHandler) GetSlot(w http.ResponseWriter, r *http.Request) {
-	handler, err := Handle[schedulepb.GetSlotRequest, schedulepb.Slot](h.c.GetSlot, parseGetSlot, false)
+	opts, err := parseLocalTimeOptions(r)
+	if err != nil {
+		writeErrorJSON(w, http.StatusBadRequest, "invalid tz or view")
+		return
+	}
+
+	handler, err := HandleWithRender[schedulepb.GetSlotRequest, schedulepb.Slot](h.c.GetSlot, parseGetSlot, false, h.renderSlot(opts))
 	if err != nil {
 		panic(err)
 	}
@@ -190,7 +290,13 @@ func (h *ScheduleHandler) DeleteSlot(w http.ResponseWriter, r *http.Request) {
 }
 
 func (h *ScheduleHandler) ListSlotsByTutor(w http.ResponseWriter, r *http.Request) {
-	handler, err := Handle[schedulepb.ListSlotsByTutorRequest, schedulepb.ListSlotsResponse](h.c.ListSlotsByTutor, parseListSlotsByTutor, false)
+	opts, err := parseLocalTimeOptions(r)
+	if err != nil {
+		writeErrorJSON(w, http.StatusBadRequest, "invalid tz or view")
+		return
+	}
+
+	handler, err := HandleWithRender[schedulepb.ListSlotsByTutorRequest, schedulepb.ListSlotsResponse](h.c.ListSlotsByTutor, parseListSlotsByTutor, false, h.renderSlots(opts))
 	if err != nil {
 		panic(err)
 	}
@@ -198,7 +304,13 @@ func (h *ScheduleHandler) ListSlotsByTutor(w http.ResponseWriter, r *http.Reques
 }
 
 func (h *ScheduleHandler) GetLesson(w http.ResponseWriter, r *http.Request) {
-	handler, err := Handle[schedulepb.GetLessonRequest, schedulepb.Lesson](h.c.GetLesson, parseGetLesson, false)
+	opts, err := parseLocalTimeOptions(r)
+	if err != nil {
+		writeErrorJSON(w, http.StatusBadRequest, "invalid tz or view")
+		return
+	}
+
+	handler, err := HandleWithRender[schedulepb.GetLessonRequest, schedulepb.Lesson](h.c.GetLesson, parseGetLesson, false, h.renderLesson(opts))
 	if err != nil {
 		panic(err)
 	}
@@ -229,6 +341,78 @@ func (h *ScheduleHandler) CancelLesson(w http.ResponseWriter, r *http.Request) {
 	handler(w, r)
 }
 
+func (h *ScheduleHandler) UpdateAttendance(w http.ResponseWriter, r *http.Request) {
+	handler, err := Handle[schedulepb.UpdateAttendanceRequest, schedulepb.Lesson](h.c.UpdateAttendance, parseUpdateAttendance, true)
+	if err != nil {
+		panic(err)
+	}
+	handler(w, r)
+}
+
+func (h *ScheduleHandler) UpsertLessonNotes(w http.ResponseWriter, r *http.Request) {
+	handler, err := Handle[schedulepb.UpsertLessonNotesRequest, schedulepb.LessonNotes](h.c.UpsertLessonNotes, parseUpsertLessonNotes, true)
+	if err != nil {
+		panic(err)
+	}
+	handler(w, r)
+}
+
+func (h *ScheduleHandler) ListLessonNotesHistory(w http.ResponseWriter, r *http.Request) {
+	handler, err := Handle[schedulepb.ListLessonNotesHistoryRequest, schedulepb.ListLessonNotesHistoryResponse](h.c.ListLessonNotesHistory, parseListLessonNotesHistory, false)
+	if err != nil {
+		panic(err)
+	}
+	handler(w, r)
+}
+
+func (h *ScheduleHandler) BlockPeriod(w http.ResponseWriter, r *http.Request) {
+	handler, err := Handle[schedulepb.BlockPeriodRequest, schedulepb.BlockPeriodResponse](h.c.BlockPeriod, nil, true)
+	if err != nil {
+		panic(err)
+	}
+	handler(w, r)
+}
+
+func (h *ScheduleHandler) CreateLessonSeries(w http.ResponseWriter, r *http.Request) {
+	handler, err := Handle[schedulepb.CreateLessonSeriesRequest, schedulepb.CreateLessonSeriesResponse](h.c.CreateLessonSeries, nil, true)
+	if err != nil {
+		panic(err)
+	}
+	handler(w, r)
+}
+
+func (h *ScheduleHandler) CancelLessonSeries(w http.ResponseWriter, r *http.Request) {
+	handler, err := Handle[schedulepb.CancelLessonSeriesRequest, schedulepb.ListLessonsResponse](h.c.CancelLessonSeries, parseCancelLessonSeries, false)
+	if err != nil {
+		panic(err)
+	}
+	handler(w, r)
+}
+
+func (h *ScheduleHandler) CreateLessonPackage(w http.ResponseWriter, r *http.Request) {
+	handler, err := Handle[schedulepb.CreateLessonPackageRequest, schedulepb.LessonPackage](h.c.CreateLessonPackage, nil, true)
+	if err != nil {
+		panic(err)
+	}
+	handler(w, r)
+}
+
+func (h *ScheduleHandler) GetPackageBalance(w http.ResponseWriter, r *http.Request) {
+	handler, err := Handle[schedulepb.GetPackageBalanceRequest, schedulepb.PackageBalance](h.c.GetPackageBalance, parseGetPackageBalance, false)
+	if err != nil {
+		panic(err)
+	}
+	handler(w, r)
+}
+
+func (h *ScheduleHandler) GetScheduleStats(w http.ResponseWriter, r *http.Request) {
+	handler, err := Handle[schedulepb.GetScheduleStatsRequest, schedulepb.ScheduleStats](h.c.GetScheduleStats, parseGetScheduleStats, false)
+	if err != nil {
+		panic(err)
+	}
+	handler(w, r)
+}
+
 func (h *ScheduleHandler) ListLessons(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	ctx, customReq, err := parseListLessons(ctx, r)
@@ -236,42 +420,47 @@ func (h *ScheduleHandler) ListLessons(w http.ResponseWriter, r *http.Request) {
 		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
 	}
+	opts, err := parseLocalTimeOptions(r)
+	if err != nil {
+		writeErrorJSON(w, http.StatusBadRequest, "invalid tz or view")
+		return
+	}
 
 	switch req := customReq.(type) {
 	case *schedulepb.ListLessonsByTutorRequest:
-		handler, err := Handle[schedulepb.ListLessonsByTutorRequest, schedulepb.ListLessonsResponse](
+		handler, err := HandleWithRender[schedulepb.ListLessonsByTutorRequest, schedulepb.ListLessonsResponse](
 			h.c.ListLessonsByTutor,
 			func(_ context.Context, _ *http.Request, grpcReq *schedulepb.ListLessonsByTutorRequest) error {
 				grpcReq.TutorId = req.TutorId
 				grpcReq.StatusFilter = req.StatusFilter
 				return nil
-			}, false,
+			}, false, h.renderLessons(opts),
 		)
 		if err != nil {
 			panic(err)
 		}
 		handler(w, r.WithContext(context.WithValue(ctx, contextKey("req"), req)))
 	case *schedulepb.ListLessonsByStudentRequest:
-		handler, err := Handle[schedulepb.ListLessonsByStudentRequest, schedulepb.ListLessonsResponse](
+		handler, err := HandleWithRender[schedulepb.ListLessonsByStudentRequest, schedulepb.ListLessonsResponse](
 			h.c.ListLessonsByStudent,
 			func(_ context.Context, _ *http.Request, grpcReq *schedulepb.ListLessonsByStudentRequest) error {
 				grpcReq.StudentId = req.StudentId
 				grpcReq.StatusFilter = req.StatusFilter
 				return nil
-			}, false)
+			}, false, h.renderLessons(opts))
 		if err != nil {
 			panic(err)
 		}
 		handler(w, r.WithContext(context.WithValue(ctx, contextKey("req"), req)))
 	case *schedulepb.ListLessonsByPairRequest:
-		handler, err := Handle[schedulepb.ListLessonsByPairRequest, schedulepb.ListLessonsResponse](
+		handler, err := HandleWithRender[schedulepb.ListLessonsByPairRequest, schedulepb.ListLessonsResponse](
 			h.c.ListLessonsByPair,
 			func(_ context.Context, _ *http.Request, grpcReq *schedulepb.ListLessonsByPairRequest) error {
 				grpcReq.TutorId = req.TutorId
 				grpcReq.StudentId = req.StudentId
 				grpcReq.StatusFilter = req.StatusFilter
 				return nil
-			}, false)
+			}, false, h.renderLessons(opts))
 		if err != nil {
 			panic(err)
 		}
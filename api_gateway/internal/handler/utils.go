@@ -45,6 +45,8 @@ func mapErr(err error) int {
 			return http.StatusForbidden
 		case codes.NotFound:
 			return http.StatusNotFound
+		case codes.FailedPrecondition:
+			return http.StatusPreconditionFailed
 		case codes.Unauthenticated:
 			return http.StatusUnauthorized
 		}
@@ -56,6 +58,17 @@ func Handle[Req any, Resp any](
 	method func(context.Context, *Req, ...grpc.CallOption) (*Resp, error),
 	reqParser func(context.Context, *http.Request, *Req) error,
 	parseBody bool,
+) (http.HandlerFunc, error) {
+	return HandleWithRender(method, reqParser, parseBody, nil)
+}
+
+// HandleWithRender works like Handle, but lets render replace the protojson response
+// with another JSON value. A nil render keeps the protojson response.
+func HandleWithRender[Req any, Resp any](
+	method func(context.Context, *Req, ...grpc.CallOption) (*Resp, error),
+	reqParser func(context.Context, *http.Request, *Req) error,
+	parseBody bool,
+	render func(context.Context, *http.Request, *Resp) (any, error),
 ) (http.HandlerFunc, error) {
 	if method == nil {
 		return nil, ErrNilMethod
@@ -131,7 +144,16 @@ func Handle[Req any, Resp any](
 			logger.Debug(ctx, "Received response", zap.Any("grpcResp", grpcResp))
 		}
 
-		data, err := protojson.Marshal(any(grpcResp).(proto.Message))
+		var data []byte
+		if render != nil {
+			var rendered any
+			rendered, err = render(ctx, r, grpcResp)
+			if err == nil {
+				data, err = json.Marshal(rendered)
+			}
+		} else {
+			data, err = protojson.Marshal(any(grpcResp).(proto.Message))
+		}
 		if err != nil {
 			if logger, ok := logging.GetFromContext(r.Context()); ok {
 				logger.Error(ctx, "Failed to parse response message", zap.Error(err))
@@ -17,6 +17,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	homeworkpb "homework_service/pkg/api"
 	schedulepb "schedule_service/pkg/api"
 	userpb "userservice/pkg/api"
@@ -51,6 +52,7 @@ func TestMapErr(t *testing.T) {
 		{"gRPC_AlreadyExists", status.Error(codes.AlreadyExists, "dup"), http.StatusConflict},
 		{"gRPC_PermissionDenied", status.Error(codes.PermissionDenied, "no"), http.StatusForbidden},
 		{"gRPC_NotFound", status.Error(codes.NotFound, "miss"), http.StatusNotFound},
+		{"gRPC_FailedPrecondition", status.Error(codes.FailedPrecondition, "state"), http.StatusPreconditionFailed},
 		{"gRPC_Unauthenticated", status.Error(codes.Unauthenticated, "auth"), http.StatusUnauthorized},
 		{"gRPC_Internal", status.Error(codes.Internal, "fail"), http.StatusInternalServerError},
 		{"UnknownError", errors.New("unknown"), http.StatusInternalServerError},
@@ -536,11 +538,137 @@ func TestParseAssignmentQuery(t *testing.T) {
 		assert.Equal(t, "s1", pairReq.StudentId)
 	})
 
+	t.Run("Lesson", func(t *testing.T) {
+		r := httptest.NewRequest(http.MethodGet, "/assignments?lesson_id=l1&status_filter=UNSENT", nil)
+		_, req, err := parseAssignmentQuery(context.Background(), r)
+		require.NoError(t, err)
+
+		lessonReq, ok := req.(*homeworkpb.ListAssignmentsByLessonRequest)
+		require.True(t, ok)
+		assert.Equal(t, "l1", lessonReq.LessonId)
+		assert.Len(t, lessonReq.StatusFilter, 1)
+	})
+
+	t.Run("LessonWithTutor_Error", func(t *testing.T) {
+		r := httptest.NewRequest(http.MethodGet, "/assignments?lesson_id=l1&tutor_id=t1", nil)
+		_, _, err := parseAssignmentQuery(context.Background(), r)
+		assert.Error(t, err)
+	})
+
 	t.Run("NoParams_Error", func(t *testing.T) {
 		r := httptest.NewRequest(http.MethodGet, "/assignments", nil)
 		_, _, err := parseAssignmentQuery(context.Background(), r)
 		assert.Error(t, err)
 	})
+
+	t.Run("ChecklistProgress", func(t *testing.T) {
+		r := httptest.NewRequest(http.MethodGet, "/assignments?student_id=s1&min_progress=50", nil)
+		_, req, err := parseAssignmentQuery(context.Background(), r)
+		require.NoError(t, err)
+
+		studentReq, ok := req.(*homeworkpb.ListAssignmentsByStudentRequest)
+		require.True(t, ok)
+		require.NotNil(t, studentReq.ChecklistProgress)
+		assert.Equal(t, int32(50), studentReq.ChecklistProgress.GetMin())
+		assert.Nil(t, studentReq.ChecklistProgress.Max)
+
+		r = httptest.NewRequest(http.MethodGet, "/assignments?tutor_id=t1", nil)
+		_, req, err = parseAssignmentQuery(context.Background(), r)
+		require.NoError(t, err)
+		assert.Nil(t, req.(*homeworkpb.ListAssignmentsByTutorRequest).ChecklistProgress)
+	})
+
+	t.Run("ChecklistProgress_Error", func(t *testing.T) {
+		r := httptest.NewRequest(http.MethodGet, "/assignments?tutor_id=t1&max_progress=half", nil)
+		_, _, err := parseAssignmentQuery(context.Background(), r)
+		assert.Error(t, err)
+	})
+}
+
+func TestParseListComments(t *testing.T) {
+	t.Run("AssignmentThread", func(t *testing.T) {
+		r := httptest.NewRequest(http.MethodGet, "/assignments/a1/comments", nil)
+		r = withChiParam(r, "assignment_id", "a1")
+		req := &homeworkpb.ListCommentsRequest{}
+
+		err := parseListComments(context.Background(), r, req)
+		require.NoError(t, err)
+		assert.Equal(t, "a1", req.AssignmentId)
+		assert.Nil(t, req.SubmissionId)
+	})
+
+	t.Run("SubmissionThread", func(t *testing.T) {
+		r := httptest.NewRequest(http.MethodGet, "/assignments/a1/comments?submission_id=s1", nil)
+		r = withChiParam(r, "assignment_id", "a1")
+		req := &homeworkpb.ListCommentsRequest{}
+
+		err := parseListComments(context.Background(), r, req)
+		require.NoError(t, err)
+		assert.Equal(t, "s1", req.GetSubmissionId())
+	})
+}
+
+func TestParseSearchHomework(t *testing.T) {
+	t.Run("AllParams", func(t *testing.T) {
+		r := httptest.NewRequest(http.MethodGet,
+			"/search?q=geometry&student_id=s1&type=assignment&type=Feedback&from=2026-03-01T00:00:00Z&page_size=10&page_token=MTA", nil)
+		req := &homeworkpb.SearchHomeworkRequest{}
+
+		err := parseSearchHomework(context.Background(), r, req)
+		require.NoError(t, err)
+		assert.Equal(t, "geometry", req.Query)
+		assert.Equal(t, "s1", req.GetStudentId())
+		assert.Nil(t, req.TutorId)
+		assert.Equal(t, []homeworkpb.SearchHitType{
+			homeworkpb.SearchHitType_SEARCH_HIT_ASSIGNMENT,
+			homeworkpb.SearchHitType_SEARCH_HIT_FEEDBACK,
+		}, req.Types)
+		assert.Equal(t, int64(1772323200), req.From.GetSeconds())
+		assert.Nil(t, req.To)
+		assert.Equal(t, int32(10), req.PageSize)
+		assert.Equal(t, "MTA", req.PageToken)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		for _, query := range []string{"", "?q=x&type=comment", "?q=x&page_size=ten", "?q=x&to=yesterday"} {
+			r := httptest.NewRequest(http.MethodGet, "/search"+query, nil)
+			err := parseSearchHomework(context.Background(), r, &homeworkpb.SearchHomeworkRequest{})
+			assert.Error(t, err, query)
+		}
+	})
+}
+
+func TestParseGetHomeworkStats(t *testing.T) {
+	r := httptest.NewRequest(http.MethodGet, "/stats?tutor_id=t1&to=2026-03-01T00:00:00Z", nil)
+	req := &homeworkpb.GetHomeworkStatsRequest{}
+
+	err := parseGetHomeworkStats(context.Background(), r, req)
+	require.NoError(t, err)
+	assert.Equal(t, "t1", req.TutorId)
+	assert.Nil(t, req.From)
+	assert.Equal(t, int64(1772323200), req.To.GetSeconds())
+
+	for _, query := range []string{"", "?from=2026-03-01T00:00:00Z", "?tutor_id=t1&from=yesterday"} {
+		r := httptest.NewRequest(http.MethodGet, "/stats"+query, nil)
+		err := parseGetHomeworkStats(context.Background(), r, &homeworkpb.GetHomeworkStatsRequest{})
+		assert.Error(t, err, query)
+	}
+}
+
+func TestParseGetDefaultTemplate(t *testing.T) {
+	r := httptest.NewRequest(http.MethodGet, "/templates/default?tutor_id=t1&student_id=s1", nil)
+	req := &homeworkpb.GetDefaultTemplateRequest{}
+
+	err := parseGetDefaultTemplate(context.Background(), r, req)
+	require.NoError(t, err)
+	assert.Equal(t, "t1", req.TutorId)
+	assert.Equal(t, "s1", req.StudentId)
+
+	for _, query := range []string{"", "?tutor_id=t1", "?student_id=s1"} {
+		r := httptest.NewRequest(http.MethodGet, "/templates/default"+query, nil)
+		err := parseGetDefaultTemplate(context.Background(), r, &homeworkpb.GetDefaultTemplateRequest{})
+		assert.Error(t, err, query)
+	}
 }
 
 // ── Schedule parse functions with chi params ────────────────────────
@@ -629,6 +757,170 @@ func TestScheduleParsers(t *testing.T) {
 	})
 }
 
+// ── Schedule local time rendering ───────────────────────────────────
+
+type fakeUserClient struct {
+	userpb.UserServiceClient
+	timezones map[string]string
+	calls     int
+}
+
+func (f *fakeUserClient) GetUserTimezones(_ context.Context, req *userpb.GetUserTimezonesRequest, _ ...grpc.CallOption) (*userpb.GetUserTimezonesResponse, error) {
+	f.calls++
+	resp := &userpb.GetUserTimezonesResponse{Timezones: make(map[string]string)}
+	for _, id := range req.Ids {
+		if tz, ok := f.timezones[id]; ok {
+			resp.Timezones[id] = tz
+		}
+	}
+	return resp, nil
+}
+
+func TestParseLocalTimeOptions(t *testing.T) {
+	t.Run("NotRequested", func(t *testing.T) {
+		r := httptest.NewRequest(http.MethodGet, "/slots/abc", nil)
+		r.Header.Set("X-User-Timezone", "Europe/Moscow")
+
+		opts, err := parseLocalTimeOptions(r)
+		assert.NoError(t, err)
+		assert.Nil(t, opts)
+	})
+
+	t.Run("UserTimezone", func(t *testing.T) {
+		r := httptest.NewRequest(http.MethodGet, "/slots/abc?local=true", nil)
+		r.Header.Set("X-User-Timezone", "Europe/Moscow")
+
+		opts, err := parseLocalTimeOptions(r)
+		require.NoError(t, err)
+		assert.Equal(t, "Europe/Moscow", opts.loc.String())
+		assert.False(t, opts.week)
+	})
+
+	t.Run("OverrideAndWeek", func(t *testing.T) {
+		r := httptest.NewRequest(http.MethodGet, "/lessons?view=week&tz=Asia/Tokyo", nil)
+		r.Header.Set("X-User-Timezone", "Europe/Moscow")
+
+		opts, err := parseLocalTimeOptions(r)
+		require.NoError(t, err)
+		assert.Equal(t, "Asia/Tokyo", opts.loc.String())
+		assert.True(t, opts.week)
+	})
+
+	t.Run("FallbackUTC", func(t *testing.T) {
+		r := httptest.NewRequest(http.MethodGet, "/lessons?local=true", nil)
+
+		opts, err := parseLocalTimeOptions(r)
+		require.NoError(t, err)
+		assert.Equal(t, time.UTC, opts.loc)
+	})
+
+	t.Run("InvalidTimezone", func(t *testing.T) {
+		r := httptest.NewRequest(http.MethodGet, "/lessons?tz=Mars/Olympus", nil)
+		_, err := parseLocalTimeOptions(r)
+		assert.ErrorIs(t, err, ErrBadRequest)
+	})
+
+	t.Run("InvalidView", func(t *testing.T) {
+		r := httptest.NewRequest(http.MethodGet, "/lessons?view=month", nil)
+		_, err := parseLocalTimeOptions(r)
+		assert.ErrorIs(t, err, ErrBadRequest)
+	})
+}
+
+func TestRenderLessons(t *testing.T) {
+	tutorID, studentID := "tutor-1", "student-1"
+	// Monday 2025-03-03 21:30 UTC is already Tuesday in Tokyo.
+	startsAt := time.Date(2025, 3, 3, 21, 30, 0, 0, time.UTC)
+	resp := &schedulepb.ListLessonsResponse{Lessons: []*schedulepb.Lesson{
+		{
+			Id:        "l2",
+			StudentId: studentID,
+			TutorId:   proto.String(tutorID),
+			StartsAt:  timestamppb.New(startsAt.AddDate(0, 0, 7)),
+			EndsAt:    timestamppb.New(startsAt.AddDate(0, 0, 7).Add(time.Hour)),
+		},
+		{
+			Id:        "l1",
+			StudentId: studentID,
+			TutorId:   proto.String(tutorID),
+			StartsAt:  timestamppb.New(startsAt),
+			EndsAt:    timestamppb.New(startsAt.Add(time.Hour)),
+		},
+	}}
+
+	users := &fakeUserClient{timezones: map[string]string{studentID: "Asia/Tokyo"}}
+	h := NewScheduleHandler(nil, users)
+	r := httptest.NewRequest(http.MethodGet, "/lessons?view=week", nil)
+	r.Header.Set("X-User-Id", tutorID)
+	r.Header.Set("X-User-Timezone", "Europe/Moscow")
+	opts, err := parseLocalTimeOptions(r)
+	require.NoError(t, err)
+
+	out, err := h.renderLessons(opts)(context.Background(), r, resp)
+	require.NoError(t, err)
+	assert.Equal(t, 1, users.calls)
+
+	data, err := json.Marshal(out)
+	require.NoError(t, err)
+
+	var view struct {
+		Timezone string `json:"timezone"`
+		Weeks    []struct {
+			WeekStart string `json:"weekStart"`
+			Days      []struct {
+				Date    string `json:"date"`
+				Weekday string `json:"weekday"`
+				Lessons []struct {
+					ID    string            `json:"id"`
+					Local map[string]string `json:"local"`
+					Tutor map[string]string `json:"tutorLocal"`
+					Stud  map[string]string `json:"studentLocal"`
+				} `json:"lessons"`
+			} `json:"days"`
+		} `json:"weeks"`
+	}
+	require.NoError(t, json.Unmarshal(data, &view))
+
+	assert.Equal(t, "Europe/Moscow", view.Timezone)
+	require.Len(t, view.Weeks, 2)
+	assert.Equal(t, "2025-03-03", view.Weeks[0].WeekStart)
+	require.Len(t, view.Weeks[0].Days, 1)
+	assert.Equal(t, "2025-03-04", view.Weeks[0].Days[0].Date)
+	assert.Equal(t, "Tuesday", view.Weeks[0].Days[0].Weekday)
+
+	lesson := view.Weeks[0].Days[0].Lessons[0]
+	assert.Equal(t, "l1", lesson.ID)
+	assert.Equal(t, "2025-03-04T00:30:00+03:00", lesson.Local["startsAt"])
+	assert.Equal(t, "2025-03-04T00:30:00+03:00", lesson.Tutor["startsAt"])
+	assert.Equal(t, "2025-03-04T06:30:00+09:00", lesson.Stud["startsAt"])
+}
+
+func TestRenderLessons_SameZone(t *testing.T) {
+	startsAt := time.Date(2025, 3, 3, 15, 0, 0, 0, time.UTC)
+	lesson := &schedulepb.Lesson{
+		Id:        "l1",
+		StudentId: "student-1",
+		TutorId:   proto.String("tutor-1"),
+		StartsAt:  timestamppb.New(startsAt),
+		EndsAt:    timestamppb.New(startsAt.Add(time.Hour)),
+	}
+
+	h := NewScheduleHandler(nil, &fakeUserClient{timezones: map[string]string{"student-1": "Europe/Moscow"}})
+	r := httptest.NewRequest(http.MethodGet, "/lessons/l1?local=true", nil)
+	r.Header.Set("X-User-Id", "tutor-1")
+	r.Header.Set("X-User-Timezone", "Europe/Moscow")
+	opts, err := parseLocalTimeOptions(r)
+	require.NoError(t, err)
+
+	out, err := h.renderLesson(opts)(context.Background(), r, lesson)
+	require.NoError(t, err)
+
+	value := out.(map[string]any)
+	assert.Contains(t, value, "local")
+	assert.NotContains(t, value, "tutorLocal")
+	assert.NotContains(t, value, "studentLocal")
+}
+
 // ── User handler key builders ───────────────────────────────────────
 
 func TestUserKeyBuilders(t *testing.T) {
@@ -45,6 +45,10 @@ func NewAuthMiddleware(userClient userpb.UserServiceClient) func(http.Handler) h
 
 			r.Header.Set("X-User-Id", resp.Id)
 			r.Header.Set("X-User-Role", resp.Role)
+			r.Header.Del("X-User-Timezone")
+			if resp.Timezone != nil {
+				r.Header.Set("X-User-Timezone", *resp.Timezone)
+			}
 			next.ServeHTTP(w, r)
 		})
 	}
@@ -52,6 +52,10 @@ func main() {
 	assignmentRepo := repository.NewAssignmentRepository(pg.DB())
 	submissionRepo := repository.NewSubmissionRepository(pg.DB())
 	feedbackRepo := repository.NewFeedbackRepository(pg.DB())
+	templateRepo := repository.NewTemplateRepository(pg.DB())
+	commentRepo := repository.NewCommentRepository(pg.DB())
+	searchRepo := repository.NewSearchRepository(pg.DB())
+	exportRepo := repository.NewExportRepository(pg.DB())
 
 	userGrpc, err := grpc.NewClient(
 		cfg.Services.UserService.Address,
@@ -69,13 +73,34 @@ func main() {
 		_ = pg.Close()
 		log.Fatalf("Failed to create file service: %v", err)
 	}
+	scheduleGrpc, err := grpc.NewClient(
+		cfg.Services.ScheduleService.Address,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		_ = pg.Close()
+		log.Fatalf("Failed to create schedule service: %v", err)
+	}
 	userClient := app.NewUserClient(userGrpc)
-	fileClient := app.NewFileClient(fileGrpc)
+	fileClient := app.NewFileClient(fileGrpc, cfg.Files.PublicURL, cfg.Files.InternalURL)
+	scheduleClient := app.NewScheduleClient(scheduleGrpc)
+
+	kafkaConfig := kafka.Config{
+		Brokers: cfg.Kafka.Brokers,
+	}
+
+	kafkaProducer, err := kafka.NewProducer(kafkaConfig)
+	if err != nil {
+		_ = pg.Close()
+		log.Fatalf("Failed to create Kafka producer: %v", err)
+	}
 
 	assignmentService := service.NewAssignmentService(
 		*assignmentRepo,
 		userClient,
 		fileClient,
+		scheduleClient,
+		cfg.DeletionRetention(),
 	)
 
 	submissionService := service.NewSubmissionService(
@@ -91,23 +116,40 @@ func main() {
 		fileClient,
 	)
 
+	templateService := service.NewTemplateService(
+		templateRepo,
+		assignmentRepo,
+		userClient,
+	)
+
+	commentService := service.NewCommentService(
+		commentRepo,
+		assignmentRepo,
+		submissionRepo,
+		fileClient,
+		kafkaProducer,
+		log,
+	)
+
+	searchService := service.NewSearchService(searchRepo)
+
+	exportService := service.NewExportService(
+		exportRepo,
+		userClient,
+		fileClient,
+	)
+
 	handler := homework_grpc.NewHomeworkHandler(
 		assignmentService,
 		submissionService,
 		feedbackService,
+		templateService,
+		commentService,
+		searchService,
+		exportService,
 		log,
 	)
 
-	kafkaConfig := kafka.Config{
-		Brokers: cfg.Kafka.Brokers,
-	}
-
-	kafkaProducer, err := kafka.NewProducer(kafkaConfig)
-	if err != nil {
-		_ = pg.Close()
-		log.Fatalf("Failed to create Kafka producer: %v", err)
-	}
-
 	interceptor := grpc_middleware.ChainUnaryServer(
 		metadata.NewMetadataUnaryInterceptor(),
 		logging.NewUnaryLoggingInterceptor(logging.New(log.ZapLogger)),
@@ -142,6 +184,69 @@ func main() {
 		reminderWorker.Start(ctx)
 	}()
 
+	lessonSyncWorker := NewLessonSyncWorker(service.NewDueLessonSyncer(assignmentRepo, scheduleClient), log)
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		lessonSyncWorker.Start(ctx)
+	}()
+
+	overdueWorker := NewOverdueWorker(service.NewOverdueNotifier(assignmentRepo, kafkaProducer), cfg.Overdue, log)
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		overdueWorker.Start(ctx)
+	}()
+
+	publishWorker := NewPublishWorker(service.NewAssignmentPublisher(assignmentRepo, kafkaProducer), log)
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		publishWorker.Start(ctx)
+	}()
+
+	exportWorker := NewExportWorker(
+		service.NewPortfolioExporter(exportRepo, assignmentRepo, submissionRepo, feedbackRepo, fileClient),
+		log,
+	)
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		exportWorker.Start(ctx)
+	}()
+
+	lessonConsumers := make([]*kafka.Consumer, cfg.Kafka.WorkerPoolSize)
+	for i := range lessonConsumers {
+		lessonConsumers[i] = kafka.NewConsumer(kafka.ConsumerConfig{
+			Brokers: cfg.Kafka.Brokers,
+			Topic:   cfg.Kafka.LessonTopic,
+			GroupID: cfg.Kafka.GroupID,
+		})
+	}
+	defer func() {
+		for _, consumer := range lessonConsumers {
+			_ = consumer.Close()
+		}
+	}()
+
+	lessonEventWorker := NewLessonEventWorker(
+		service.NewLessonFollowUpCreator(templateRepo, assignmentRepo, scheduleClient),
+		lessonConsumers,
+		log,
+	)
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		lessonEventWorker.Start(ctx)
+	}()
+
+	purgeWorker := NewPurgeWorker(assignmentRepo, cfg.DeletionRetention(), log)
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		purgeWorker.Start(ctx)
+	}()
+
 	go func() {
 		log.Infof("Starting gRPC server on %s", cfg.GRPC.Address)
 		if err := grpcServer.Serve(listener); err != nil {
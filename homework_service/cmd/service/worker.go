@@ -2,9 +2,14 @@ package main
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"sync"
 	"time"
 
+	configs "homework_service/config"
 	"homework_service/internal/repository"
+	"homework_service/internal/service"
 	"homework_service/pkg/kafka"
 	"homework_service/pkg/logger"
 )
@@ -68,3 +73,313 @@ func (w *ReminderWorker) processReminders(ctx context.Context) {
 		w.logger.Infof("Sent reminder for assignment %s", assignment.ID)
 	}
 }
+
+// LessonSyncWorker moves due dates that follow a lesson when the lesson is cancelled.
+type LessonSyncWorker struct {
+	syncer   *service.DueLessonSyncer
+	logger   *logger.Logger
+	interval time.Duration
+}
+
+func NewLessonSyncWorker(syncer *service.DueLessonSyncer, logger *logger.Logger) *LessonSyncWorker {
+	return &LessonSyncWorker{
+		syncer:   syncer,
+		logger:   logger,
+		interval: 5 * time.Minute,
+	}
+}
+
+func (w *LessonSyncWorker) Start(ctx context.Context) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			w.logger.Info("Lesson sync worker stopped")
+			return
+		case <-ticker.C:
+			moved, err := w.syncer.Sync(ctx)
+			if err != nil {
+				w.logger.Errorf("Failed to sync due lessons: %v", err)
+			}
+			if moved > 0 {
+				w.logger.Infof("Moved due dates of %d assignments", moved)
+			}
+		}
+	}
+}
+
+// OverdueWorker announces assignments whose due date has passed and, if enabled,
+// sends tutors the daily digest of overdue assignments.
+type OverdueWorker struct {
+	notifier      *service.OverdueNotifier
+	logger        *logger.Logger
+	interval      time.Duration
+	digestEnabled bool
+	digestHour    int
+}
+
+func NewOverdueWorker(notifier *service.OverdueNotifier, cfg configs.OverdueConfig, logger *logger.Logger) *OverdueWorker {
+	return &OverdueWorker{
+		notifier:      notifier,
+		logger:        logger,
+		interval:      time.Minute,
+		digestEnabled: cfg.DigestEnabled,
+		digestHour:    cfg.DigestHour,
+	}
+}
+
+func (w *OverdueWorker) Start(ctx context.Context) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			w.logger.Info("Overdue worker stopped")
+			return
+		case <-ticker.C:
+			w.process(ctx, time.Now())
+		}
+	}
+}
+
+func (w *OverdueWorker) process(ctx context.Context, now time.Time) {
+	sent, err := w.notifier.Notify(ctx)
+	if err != nil {
+		w.logger.Errorf("Failed to notify about overdue assignments: %v", err)
+	}
+	if sent > 0 {
+		w.logger.Infof("Sent %d overdue assignment events", sent)
+	}
+
+	// Digests are claimed per tutor and day, so running every minute after the hour sends each one once.
+	if !w.digestEnabled || now.UTC().Hour() < w.digestHour {
+		return
+	}
+	sent, err = w.notifier.SendDigests(ctx, now)
+	if err != nil {
+		w.logger.Errorf("Failed to send overdue digests: %v", err)
+	}
+	if sent > 0 {
+		w.logger.Infof("Sent %d overdue digests", sent)
+	}
+}
+
+// PublishWorker publishes scheduled assignments and announces published ones.
+type PublishWorker struct {
+	publisher *service.AssignmentPublisher
+	logger    *logger.Logger
+	interval  time.Duration
+}
+
+func NewPublishWorker(publisher *service.AssignmentPublisher, logger *logger.Logger) *PublishWorker {
+	return &PublishWorker{
+		publisher: publisher,
+		logger:    logger,
+		interval:  time.Minute,
+	}
+}
+
+func (w *PublishWorker) Start(ctx context.Context) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			w.logger.Info("Publish worker stopped")
+			return
+		case <-ticker.C:
+			sent, err := w.publisher.Publish(ctx)
+			if err != nil {
+				w.logger.Errorf("Failed to publish assignments: %v", err)
+			}
+			if sent > 0 {
+				w.logger.Infof("Sent %d assignment created events", sent)
+			}
+		}
+	}
+}
+
+// ExportWorker runs queued portfolio exports one at a time.
+type ExportWorker struct {
+	exporter *service.PortfolioExporter
+	logger   *logger.Logger
+	interval time.Duration
+}
+
+func NewExportWorker(exporter *service.PortfolioExporter, logger *logger.Logger) *ExportWorker {
+	return &ExportWorker{
+		exporter: exporter,
+		logger:   logger,
+		interval: 10 * time.Second,
+	}
+}
+
+func (w *ExportWorker) Start(ctx context.Context) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			w.logger.Info("Export worker stopped")
+			return
+		case <-ticker.C:
+			w.runQueued(ctx)
+		}
+	}
+}
+
+// runQueued runs exports until the queue is empty or the worker is stopped.
+func (w *ExportWorker) runQueued(ctx context.Context) {
+	for ctx.Err() == nil {
+		ran, err := w.exporter.RunNext(ctx)
+		if err != nil {
+			w.logger.Errorf("Failed to run portfolio export: %v", err)
+		}
+		if !ran {
+			return
+		}
+	}
+}
+
+// PurgeWorker permanently deletes assignments whose restore window has passed.
+type PurgeWorker struct {
+	assignmentRepo *repository.AssignmentRepository
+	retention      time.Duration
+	logger         *logger.Logger
+	interval       time.Duration
+}
+
+func NewPurgeWorker(assignmentRepo *repository.AssignmentRepository, retention time.Duration, logger *logger.Logger) *PurgeWorker {
+	return &PurgeWorker{
+		assignmentRepo: assignmentRepo,
+		retention:      retention,
+		logger:         logger,
+		interval:       time.Hour,
+	}
+}
+
+func (w *PurgeWorker) Start(ctx context.Context) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			w.logger.Info("Purge worker stopped")
+			return
+		case <-ticker.C:
+			purged, err := w.assignmentRepo.Purge(ctx, w.retention)
+			if err != nil {
+				w.logger.Errorf("Failed to purge deleted assignments: %v", err)
+			}
+			if purged > 0 {
+				w.logger.Infof("Purged %d deleted assignments", purged)
+			}
+		}
+	}
+}
+
+const (
+	lessonEventMaxAttempts = 5
+	lessonEventRetryDelay  = 2 * time.Second
+)
+
+// LessonEventWorker consumes schedule_service lesson events and creates follow-up
+// assignments for completed lessons. Every consumer is a separate member of the
+// group, so each partition is processed in order and committed after handling.
+type LessonEventWorker struct {
+	creator   *service.LessonFollowUpCreator
+	consumers []*kafka.Consumer
+	logger    *logger.Logger
+}
+
+func NewLessonEventWorker(creator *service.LessonFollowUpCreator, consumers []*kafka.Consumer, logger *logger.Logger) *LessonEventWorker {
+	return &LessonEventWorker{
+		creator:   creator,
+		consumers: consumers,
+		logger:    logger,
+	}
+}
+
+func (w *LessonEventWorker) Start(ctx context.Context) {
+	var wg sync.WaitGroup
+	for _, consumer := range w.consumers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			w.consume(ctx, consumer)
+		}()
+	}
+	wg.Wait()
+	w.logger.Info("Lesson event worker stopped")
+}
+
+func (w *LessonEventWorker) consume(ctx context.Context, consumer *kafka.Consumer) {
+	for {
+		msg, err := consumer.Fetch(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			w.logger.Errorf("Failed to fetch lesson event: %v", err)
+			if !sleepCtx(ctx, lessonEventRetryDelay) {
+				return
+			}
+			continue
+		}
+
+		if !w.handle(ctx, msg.Value) {
+			// Stopped while handling: leave the message to be redelivered.
+			return
+		}
+		if err := consumer.Commit(ctx, msg); err != nil {
+			w.logger.Errorf("Failed to commit lesson event: %v", err)
+		}
+	}
+}
+
+// handle processes one event, retrying transient failures. Malformed events and
+// events that keep failing are logged and skipped. It returns false if ctx is done.
+func (w *LessonEventWorker) handle(ctx context.Context, payload []byte) bool {
+	var event service.LessonEvent
+	if err := json.Unmarshal(payload, &event); err != nil {
+		w.logger.Errorf("Skipping malformed lesson event: %v", err)
+		return true
+	}
+
+	for attempt := 1; ; attempt++ {
+		created, err := w.creator.HandleLessonEvent(ctx, event)
+		if err == nil {
+			if created {
+				w.logger.Infof("Created follow-up assignment for lesson %s", event.LessonID)
+			}
+			return true
+		}
+		if errors.Is(err, service.ErrInvalidArgument) || attempt == lessonEventMaxAttempts {
+			w.logger.Errorf("Skipping lesson event for lesson %s: %v", event.LessonID, err)
+			return true
+		}
+		w.logger.Errorf("Failed to handle lesson event for lesson %s (attempt %d): %v", event.LessonID, attempt, err)
+		if !sleepCtx(ctx, time.Duration(attempt)*lessonEventRetryDelay) {
+			return false
+		}
+	}
+}
+
+// sleepCtx waits for d and reports whether ctx is still active.
+func sleepCtx(ctx context.Context, d time.Duration) bool {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
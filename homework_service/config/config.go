@@ -11,10 +11,13 @@ import (
 )
 
 type Config struct {
-	GRPC     GRPCConfig  `yaml:"grpc"`
-	DB       DBConfig    `yaml:"db"`
-	Kafka    KafkaConfig `yaml:"kafka"`
-	Services Services    `yaml:"services"`
+	GRPC     GRPCConfig     `yaml:"grpc"`
+	DB       DBConfig       `yaml:"db"`
+	Kafka    KafkaConfig    `yaml:"kafka"`
+	Services Services       `yaml:"services"`
+	Overdue  OverdueConfig  `yaml:"overdue"`
+	Files    FilesConfig    `yaml:"files"`
+	Deletion DeletionConfig `yaml:"deletion"`
 }
 
 type GRPCConfig struct {
@@ -36,11 +39,35 @@ type KafkaConfig struct {
 	Topic          string   `yaml:"topic"`
 	GroupID        string   `yaml:"group_id"`
 	WorkerPoolSize int      `yaml:"worker_pool_size"`
+	// LessonTopic carries schedule_service lesson events; completed lessons get
+	// follow-up assignments from the pair's default template.
+	LessonTopic string `yaml:"lesson_topic"`
 }
 
 type Services struct {
-	UserService ServiceConfig `yaml:"user_service"`
-	FileService ServiceConfig `yaml:"file_service"`
+	UserService     ServiceConfig `yaml:"user_service"`
+	FileService     ServiceConfig `yaml:"file_service"`
+	ScheduleService ServiceConfig `yaml:"schedule_service"`
+}
+
+// OverdueConfig configures the optional daily digest of overdue assignments for tutors.
+// The digest is sent once a day after DigestHour (UTC).
+type OverdueConfig struct {
+	DigestEnabled bool `yaml:"digest_enabled"`
+	DigestHour    int  `yaml:"digest_hour"`
+}
+
+// FilesConfig lets the service download and upload file contents. Presigned URLs
+// issued by file_service start with PublicURL, which is replaced with InternalURL.
+type FilesConfig struct {
+	PublicURL   string `yaml:"public_url"`
+	InternalURL string `yaml:"internal_url"`
+}
+
+// DeletionConfig configures how long deleted assignments can be restored before
+// they are purged with their submissions and feedbacks.
+type DeletionConfig struct {
+	RetentionDays int `yaml:"retention_days"`
 }
 
 type ServiceConfig struct {
@@ -103,6 +130,10 @@ func setDefaults(cfg *Config) {
 		cfg.Services.FileService.Timeout = 10 * time.Second
 	}
 
+	if cfg.Services.ScheduleService.Timeout == 0 {
+		cfg.Services.ScheduleService.Timeout = 10 * time.Second
+	}
+
 	if cfg.Kafka.WorkerPoolSize == 0 {
 		cfg.Kafka.WorkerPoolSize = 5
 	}
@@ -110,6 +141,14 @@ func setDefaults(cfg *Config) {
 	if cfg.Kafka.GroupID == "" {
 		cfg.Kafka.GroupID = "homework-service-group"
 	}
+
+	if cfg.Kafka.LessonTopic == "" {
+		cfg.Kafka.LessonTopic = "lesson-reminders"
+	}
+
+	if cfg.Deletion.RetentionDays == 0 {
+		cfg.Deletion.RetentionDays = 30
+	}
 }
 
 func overrideFromEnv(cfg *Config) {
@@ -157,6 +196,9 @@ func overrideFromEnv(cfg *Config) {
 			cfg.Kafka.WorkerPoolSize = size
 		}
 	}
+	if val := os.Getenv("KAFKA_LESSON_TOPIC"); val != "" {
+		cfg.Kafka.LessonTopic = val
+	}
 
 	if val := os.Getenv("USER_SERVICE_ADDRESS"); val != "" {
 		cfg.Services.UserService.Address = val
@@ -174,6 +216,38 @@ func overrideFromEnv(cfg *Config) {
 			cfg.Services.FileService.Timeout = time.Duration(timeout) * time.Second
 		}
 	}
+	if val := os.Getenv("SCHEDULE_SERVICE_ADDRESS"); val != "" {
+		cfg.Services.ScheduleService.Address = val
+	}
+	if val := os.Getenv("SCHEDULE_SERVICE_TIMEOUT"); val != "" {
+		if timeout, err := strconv.Atoi(val); err == nil {
+			cfg.Services.ScheduleService.Timeout = time.Duration(timeout) * time.Second
+		}
+	}
+
+	if val := os.Getenv("OVERDUE_DIGEST_ENABLED"); val != "" {
+		if enabled, err := strconv.ParseBool(val); err == nil {
+			cfg.Overdue.DigestEnabled = enabled
+		}
+	}
+	if val := os.Getenv("OVERDUE_DIGEST_HOUR"); val != "" {
+		if hour, err := strconv.Atoi(val); err == nil {
+			cfg.Overdue.DigestHour = hour
+		}
+	}
+
+	if val := os.Getenv("FILES_PUBLIC_URL"); val != "" {
+		cfg.Files.PublicURL = val
+	}
+	if val := os.Getenv("FILES_INTERNAL_URL"); val != "" {
+		cfg.Files.InternalURL = val
+	}
+
+	if val := os.Getenv("DELETION_RETENTION_DAYS"); val != "" {
+		if days, err := strconv.Atoi(val); err == nil {
+			cfg.Deletion.RetentionDays = days
+		}
+	}
 }
 
 func validateConfig(cfg *Config) error {
@@ -185,6 +259,10 @@ func validateConfig(cfg *Config) error {
 		return fmt.Errorf("at least one Kafka broker must be specified")
 	}
 
+	if cfg.Kafka.WorkerPoolSize < 1 {
+		return fmt.Errorf("kafka worker pool size must be positive")
+	}
+
 	if cfg.DB.Host == "" || cfg.DB.User == "" || cfg.DB.DBName == "" {
 		return fmt.Errorf("database configuration is incomplete")
 	}
@@ -197,9 +275,26 @@ func validateConfig(cfg *Config) error {
 		return fmt.Errorf("file service address must be specified")
 	}
 
+	if cfg.Services.ScheduleService.Address == "" {
+		return fmt.Errorf("schedule service address must be specified")
+	}
+
+	if cfg.Overdue.DigestHour < 0 || cfg.Overdue.DigestHour > 23 {
+		return fmt.Errorf("overdue digest hour must be between 0 and 23")
+	}
+
+	if cfg.Deletion.RetentionDays < 0 {
+		return fmt.Errorf("deletion retention days must not be negative")
+	}
+
 	return nil
 }
 
+// DeletionRetention returns how long deleted assignments can be restored.
+func (c *Config) DeletionRetention() time.Duration {
+	return time.Duration(c.Deletion.RetentionDays) * 24 * time.Hour
+}
+
 func (c *Config) GetDBConnectionString() string {
 	return fmt.Sprintf(
 		"host=%s port=%d user=%s password=%s dbname=%s sslmode=%s",
@@ -41,7 +41,7 @@ func (s *IntegrationTestSuite) SetupSuite() {
 	}
 
 	s.conn = fileConn
-	s.fileClient = app.NewFileClient(fileConn)
+	s.fileClient = app.NewFileClient(fileConn, "", "")
 	s.userClient = app.NewUserClient(userConn)
 }
 
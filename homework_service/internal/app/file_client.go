@@ -5,6 +5,13 @@ import (
 	"common_library/utils"
 	"context"
 	filePb "fileservice/pkg/api"
+	"fmt"
+	"homework_service/internal/domain"
+	"io"
+	"net/http"
+	"path"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/google/uuid"
@@ -13,11 +20,21 @@ import (
 )
 
 type FileClient struct {
-	client filePb.FileServiceClient
+	client     filePb.FileServiceClient
+	httpClient *http.Client
+	// Presigned URLs point to publicURL, which may be unreachable from the service,
+	// so it is replaced with internalURL for downloads and uploads made by the service.
+	publicURL   string
+	internalURL string
 }
 
-func NewFileClient(conn *grpc.ClientConn) *FileClient {
-	return &FileClient{client: filePb.NewFileServiceClient(conn)}
+func NewFileClient(conn *grpc.ClientConn, publicURL, internalURL string) *FileClient {
+	return &FileClient{
+		client:      filePb.NewFileServiceClient(conn),
+		httpClient:  &http.Client{Timeout: 5 * time.Minute},
+		publicURL:   strings.TrimSuffix(publicURL, "/"),
+		internalURL: strings.TrimSuffix(internalURL, "/"),
+	}
 }
 
 func (c *FileClient) GetFileURL(ctx context.Context, fileID uuid.UUID) (string, error) {
@@ -36,3 +53,140 @@ func (c *FileClient) GetFileURL(ctx context.Context, fileID uuid.UUID) (string,
 	}
 	return resp.Url, nil
 }
+
+// maxParallelURLRequests bounds concurrent GenerateDownloadURL calls of GetFileURLs.
+const maxParallelURLRequests = 5
+
+// GetFileURLs resolves download URLs of several files concurrently, since file_service
+// has no batch RPC. It fails if any of the URLs cannot be resolved.
+func (c *FileClient) GetFileURLs(ctx context.Context, fileIDs []uuid.UUID) (map[uuid.UUID]string, error) {
+	urls := make(map[uuid.UUID]string, len(fileIDs))
+	if len(fileIDs) == 0 {
+		return urls, nil
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		mu       sync.Mutex
+		wg       sync.WaitGroup
+		firstErr error
+	)
+	sem := make(chan struct{}, maxParallelURLRequests)
+	seen := make(map[uuid.UUID]struct{}, len(fileIDs))
+
+	for _, id := range fileIDs {
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(id uuid.UUID) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			url, err := c.GetFileURL(ctx, id)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				if firstErr == nil {
+					firstErr = err
+					cancel()
+				}
+				return
+			}
+			urls[id] = url
+		}(id)
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	return urls, nil
+}
+
+// DownloadFile fetches the content of a stored file by its presigned URL.
+// The name is the original file name, or the file ID with its extension.
+func (c *FileClient) DownloadFile(ctx context.Context, fileID uuid.UUID) (*domain.FileContent, error) {
+	outCtx := outgoingContext(ctx)
+	meta, err := utils.RetryWithBackoff(outCtx, 3, 100*time.Millisecond, func() (*filePb.File, error) {
+		return c.client.GetFileMeta(outCtx, &filePb.GetFileMetaRequest{FileId: fileID.String()})
+	})
+	if err != nil {
+		return nil, err
+	}
+	url, err := c.GetFileURL(ctx, fileID)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.internal(url), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create download request: %w", err)
+	}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download file %s: %w", fileID, err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		_ = resp.Body.Close()
+		return nil, fmt.Errorf("failed to download file %s: unexpected status %s", fileID, resp.Status)
+	}
+
+	name := fileID.String() + meta.Extension
+	if meta.Filename != nil && path.Base(*meta.Filename) != "" {
+		name = path.Base(*meta.Filename)
+	}
+	return &domain.FileContent{Name: name, Body: resp.Body}, nil
+}
+
+// UploadFile stores size bytes of content as a new file of uploadedBy. The extension
+// of filename must be allowed by file_service.
+func (c *FileClient) UploadFile(ctx context.Context, uploadedBy uuid.UUID, filename string, content io.Reader, size int64) (uuid.UUID, error) {
+	outCtx := outgoingContext(ctx)
+	upload, err := utils.RetryWithBackoff(outCtx, 3, 100*time.Millisecond, func() (*filePb.InitUploadResponse, error) {
+		return c.client.InitUpload(outCtx, &filePb.InitUploadRequest{UploadedBy: uploadedBy.String(), Filename: filename})
+	})
+	if err != nil {
+		return uuid.Nil, err
+	}
+	fileID, err := uuid.Parse(upload.FileId)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("invalid file id %q: %w", upload.FileId, err)
+	}
+
+	method := upload.Method
+	if method == "" {
+		method = http.MethodPut
+	}
+	req, err := http.NewRequestWithContext(ctx, method, c.internal(upload.UploadUrl), content)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("failed to create upload request: %w", err)
+	}
+	req.ContentLength = size
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("failed to upload file: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode/100 != 2 {
+		return uuid.Nil, fmt.Errorf("failed to upload file: unexpected status %s", resp.Status)
+	}
+	return fileID, nil
+}
+
+// internal rewrites a presigned URL to the address reachable from the service.
+func (c *FileClient) internal(url string) string {
+	if c.publicURL == "" || c.internalURL == "" {
+		return url
+	}
+	if rest, ok := strings.CutPrefix(url, c.publicURL); ok {
+		return c.internalURL + rest
+	}
+	return url
+}
@@ -0,0 +1,103 @@
+package app
+
+import (
+	"common_library/ctxdata"
+	"common_library/utils"
+	"context"
+	"homework_service/internal/domain"
+	schedulePb "schedule_service/pkg/api"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type ScheduleClient struct {
+	client schedulePb.ScheduleServiceClient
+}
+
+func NewScheduleClient(conn *grpc.ClientConn) *ScheduleClient {
+	return &ScheduleClient{client: schedulePb.NewScheduleServiceClient(conn)}
+}
+
+// GetLesson returns nil if the lesson does not exist or is not visible to the caller.
+func (c *ScheduleClient) GetLesson(ctx context.Context, lessonID uuid.UUID) (*domain.Lesson, error) {
+	outCtx := outgoingContext(ctx)
+	resp, err := utils.RetryWithBackoff(outCtx, 3, 100*time.Millisecond, func() (*schedulePb.Lesson, error) {
+		return c.client.GetLesson(outCtx, &schedulePb.GetLessonRequest{Id: lessonID.String()})
+	})
+	if err != nil {
+		if code := status.Code(err); code == codes.NotFound || code == codes.PermissionDenied {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return toDomainLesson(resp)
+}
+
+// NextLesson returns the pair's earliest booked lesson starting after the given
+// time, or nil if there is none.
+func (c *ScheduleClient) NextLesson(ctx context.Context, tutorID, studentID uuid.UUID, after time.Time) (*domain.Lesson, error) {
+	req := &schedulePb.ListLessonsByPairRequest{
+		TutorId:      tutorID.String(),
+		StudentId:    studentID.String(),
+		StatusFilter: []schedulePb.LessonStatusFilter{schedulePb.LessonStatusFilter_BOOKED},
+	}
+	outCtx := outgoingContext(ctx)
+	resp, err := utils.RetryWithBackoff(outCtx, 3, 100*time.Millisecond, func() (*schedulePb.ListLessonsResponse, error) {
+		return c.client.ListLessonsByPair(outCtx, req)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var next *schedulePb.Lesson
+	for _, lesson := range resp.Lessons {
+		if lesson.StartsAt == nil || !lesson.StartsAt.AsTime().After(after) {
+			continue
+		}
+		if next == nil || lesson.StartsAt.AsTime().Before(next.StartsAt.AsTime()) {
+			next = lesson
+		}
+	}
+	if next == nil {
+		return nil, nil
+	}
+	return toDomainLesson(next)
+}
+
+func outgoingContext(ctx context.Context) context.Context {
+	outCtx := metadata.NewOutgoingContext(ctx, metadata.Pairs())
+	if id, ok := ctxdata.GetUserID(ctx); ok {
+		outCtx = metadata.AppendToOutgoingContext(outCtx, "x-user-id", id)
+	}
+	if role, ok := ctxdata.GetUserRole(ctx); ok {
+		outCtx = metadata.AppendToOutgoingContext(outCtx, "x-user-role", role)
+	}
+	return outCtx
+}
+
+func toDomainLesson(lesson *schedulePb.Lesson) (*domain.Lesson, error) {
+	id, err := uuid.Parse(lesson.Id)
+	if err != nil {
+		return nil, err
+	}
+	tutorID, err := uuid.Parse(lesson.GetTutorId())
+	if err != nil {
+		return nil, err
+	}
+	studentID, err := uuid.Parse(lesson.StudentId)
+	if err != nil {
+		return nil, err
+	}
+	return &domain.Lesson{
+		ID:        id,
+		TutorID:   tutorID,
+		StudentID: studentID,
+		Status:    lesson.Status,
+		StartsAt:  lesson.StartsAt.AsTime(),
+	}, nil
+}
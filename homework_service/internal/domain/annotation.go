@@ -0,0 +1,41 @@
+package domain
+
+import "github.com/google/uuid"
+
+type AnnotationType string
+
+const (
+	AnnotationHighlight AnnotationType = "highlight"
+	AnnotationPen       AnnotationType = "pen"
+	AnnotationText      AnnotationType = "text"
+)
+
+// Annotation is a shape drawn by the tutor over a page of a submission file.
+// Coordinates are fractions of the page width and height from its top left corner.
+type Annotation struct {
+	FileID uuid.UUID
+	// Page is 1-based; images have a single page.
+	Page int
+	Type AnnotationType
+	// X, Y, Width and Height are the rectangle of a highlight. A text starts at X, Y
+	// and is wrapped at Width if it is set.
+	X      float64
+	Y      float64
+	Width  float64
+	Height float64
+	// Points is the stroke of a pen.
+	Points []AnnotationPoint
+	// Comment is the text of a text annotation and a note to the other shapes.
+	Comment *string
+}
+
+type AnnotationPoint struct {
+	X float64
+	Y float64
+}
+
+// AnnotatedFile is a copy of a submission file with the annotations drawn onto it.
+type AnnotatedFile struct {
+	FileID uuid.UUID
+	URL    string
+}
@@ -13,22 +13,100 @@ type Assignment struct {
 	Description *string
 	FileID      *uuid.UUID
 	DueDate     *time.Time
+	Attachments []Attachment
 	CreatedAt   time.Time
 	EditedAt    time.Time
+
+	// LessonID is the lesson the assignment was given at.
+	LessonID *uuid.UUID
+	// DueBeforeNextLesson makes the due date follow the start of the pair's next
+	// booked lesson, which is stored in DueLessonID.
+	DueBeforeNextLesson bool
+	DueLessonID         *uuid.UUID
+
+	// Quiz makes the assignment an auto-graded quiz; submissions answer its questions.
+	Quiz []QuizQuestion
+
+	// Checklist is the ordered list of tasks of the assignment. ChecklistProgress is
+	// the percentage of done items, nil without a checklist.
+	Checklist         []ChecklistItem
+	ChecklistProgress *int
+
+	// LatePolicy decides what happens to submissions made after the due date
+	// plus the grace period.
+	LatePolicy  LatePolicy
+	GracePeriod *time.Duration
+	// SubmittedLate reports whether the latest submission was flagged as late.
+	SubmittedLate bool
+
+	// State hides drafts and scheduled assignments from the student until they are
+	// published; scheduled ones are published at PublishAt.
+	State       AssignmentState
+	PublishAt   *time.Time
+	PublishedAt *time.Time
+
+	// DeletedAt is set for soft-deleted assignments, which can be restored until
+	// they are purged.
+	DeletedAt *time.Time
+}
+
+func (a *Assignment) IsPublished() bool {
+	return a.State == AssignmentStatePublished
+}
+
+func (a *Assignment) IsQuiz() bool {
+	return len(a.Quiz) > 0
 }
 
+// Deadline returns the time after which submissions are late, or nil without a due date.
+func (a *Assignment) Deadline() *time.Time {
+	if a.DueDate == nil {
+		return nil
+	}
+	deadline := *a.DueDate
+	if a.GracePeriod != nil {
+		deadline = deadline.Add(*a.GracePeriod)
+	}
+	return &deadline
+}
+
+type AssignmentState string
+
+const (
+	AssignmentStateDraft     AssignmentState = "draft"
+	AssignmentStateScheduled AssignmentState = "scheduled"
+	AssignmentStatePublished AssignmentState = "published"
+)
+
+type LatePolicy string
+
+const (
+	// LatePolicyAllow accepts late submissions without flagging them.
+	LatePolicyAllow LatePolicy = "allow"
+	// LatePolicyFlag accepts late submissions and flags them as late.
+	LatePolicyFlag LatePolicy = "flag"
+	// LatePolicyLock rejects submissions after the deadline.
+	LatePolicyLock LatePolicy = "lock"
+)
+
 type AssignmentStatus string
 
 const (
-	AssignmentStatusUnspecified AssignmentStatus = "UNSPECIFIED"
-	AssignmentStatusUnsent      AssignmentStatus = "UNSENT"
-	AssignmentStatusUnreviewed  AssignmentStatus = "UNREVIEWED"
-	AssignmentStatusReviewed    AssignmentStatus = "REVIEWED"
-	AssignmentStatusOverdue     AssignmentStatus = "OVERDUE"
+	AssignmentStatusUnspecified   AssignmentStatus = "UNSPECIFIED"
+	AssignmentStatusUnsent        AssignmentStatus = "UNSENT"
+	AssignmentStatusUnreviewed    AssignmentStatus = "UNREVIEWED"
+	AssignmentStatusReviewed      AssignmentStatus = "REVIEWED"
+	AssignmentStatusOverdue       AssignmentStatus = "OVERDUE"
+	AssignmentStatusNeedsRevision AssignmentStatus = "NEEDS_REVISION"
 )
 
 type AssignmentFilter struct {
 	TutorID   uuid.UUID
 	StudentID uuid.UUID
+	LessonID  uuid.UUID
 	Statuses  []AssignmentStatus
+	// PublishedOnly hides drafts and scheduled assignments.
+	PublishedOnly bool
+	// ChecklistProgress keeps only assignments with a checklist within the range.
+	ChecklistProgress ProgressRange
 }
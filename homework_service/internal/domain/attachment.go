@@ -0,0 +1,31 @@
+package domain
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+type AttachmentOwnerType string
+
+const (
+	AttachmentOwnerAssignment AttachmentOwnerType = "assignment"
+	AttachmentOwnerSubmission AttachmentOwnerType = "submission"
+	AttachmentOwnerFeedback   AttachmentOwnerType = "feedback"
+	AttachmentOwnerTemplate   AttachmentOwnerType = "template"
+	AttachmentOwnerComment    AttachmentOwnerType = "comment"
+)
+
+// Attachment is a file attached to an assignment, submission, feedback, template or comment.
+// Attachments of an owner are ordered by Position starting from 0.
+type Attachment struct {
+	ID        uuid.UUID
+	FileID    uuid.UUID
+	Caption   *string
+	Position  int
+	CreatedAt time.Time
+}
+
+type AttachmentFileURL struct {
+	Attachment
+	URL string
+}
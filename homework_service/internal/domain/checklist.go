@@ -0,0 +1,30 @@
+package domain
+
+import "time"
+
+type ChecklistReview string
+
+const (
+	ChecklistReviewCorrect   ChecklistReview = "correct"
+	ChecklistReviewIncorrect ChecklistReview = "incorrect"
+)
+
+// ChecklistItem is a task of an assignment. The student marks it done, the tutor
+// reviews done items; Review is empty until then.
+type ChecklistItem struct {
+	Text       string
+	Done       bool
+	DoneAt     *time.Time
+	Review     ChecklistReview
+	ReviewedAt *time.Time
+}
+
+// ProgressRange limits the checklist progress in percent; nil bounds are open.
+type ProgressRange struct {
+	Min *int
+	Max *int
+}
+
+func (r ProgressRange) IsSet() bool {
+	return r.Min != nil || r.Max != nil
+}
@@ -0,0 +1,22 @@
+package domain
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+// Comment is a message in the discussion of an assignment or of one of its
+// submissions. Replies reference their parent comment in the same thread.
+// A deleted comment keeps its place in the thread without body and attachments.
+type Comment struct {
+	ID           uuid.UUID
+	AssignmentID uuid.UUID
+	SubmissionID *uuid.UUID
+	ParentID     *uuid.UUID
+	AuthorID     uuid.UUID
+	Body         string
+	Attachments  []Attachment
+	CreatedAt    time.Time
+	EditedAt     time.Time
+	DeletedAt    *time.Time
+}
@@ -11,7 +11,20 @@ const (
 func (s AssignmentStatus) IsValid() bool {
 	switch s {
 	case AssignmentStatusUnspecified, AssignmentStatusUnsent,
-		AssignmentStatusUnreviewed, AssignmentStatusReviewed, AssignmentStatusOverdue:
+		AssignmentStatusUnreviewed, AssignmentStatusReviewed, AssignmentStatusOverdue,
+		AssignmentStatusNeedsRevision:
+		return true
+	default:
+		return false
+	}
+}
+
+// AcceptsQuizAnswers reports whether a quiz in this status can be submitted. A quiz
+// is submitted once, and again only after the tutor asked for a revision, so that
+// the student cannot guess the answers by resubmitting.
+func (s AssignmentStatus) AcceptsQuizAnswers() bool {
+	switch s {
+	case AssignmentStatusUnsent, AssignmentStatusOverdue, AssignmentStatusNeedsRevision:
 		return true
 	default:
 		return false
@@ -28,7 +41,72 @@ func ToAssignmentStatus(status string) AssignmentStatus {
 		return AssignmentStatusReviewed
 	case "OVERDUE":
 		return AssignmentStatusOverdue
+	case "NEEDS_REVISION":
+		return AssignmentStatusNeedsRevision
 	default:
 		return AssignmentStatusUnspecified
 	}
 }
+
+func (t SearchHitType) IsValid() bool {
+	switch t {
+	case SearchHitAssignment, SearchHitSubmission, SearchHitFeedback:
+		return true
+	default:
+		return false
+	}
+}
+
+func (r ChecklistReview) IsValid() bool {
+	switch r {
+	case ChecklistReviewCorrect, ChecklistReviewIncorrect:
+		return true
+	default:
+		return false
+	}
+}
+
+func (s AssignmentState) IsValid() bool {
+	switch s {
+	case AssignmentStateDraft, AssignmentStateScheduled, AssignmentStatePublished:
+		return true
+	default:
+		return false
+	}
+}
+
+func (p LatePolicy) IsValid() bool {
+	switch p {
+	case LatePolicyAllow, LatePolicyFlag, LatePolicyLock:
+		return true
+	default:
+		return false
+	}
+}
+
+func (t QuizQuestionType) IsValid() bool {
+	switch t {
+	case QuizQuestionSingleChoice, QuizQuestionMultipleChoice, QuizQuestionNumeric, QuizQuestionShortText:
+		return true
+	default:
+		return false
+	}
+}
+
+func (t AnnotationType) IsValid() bool {
+	switch t {
+	case AnnotationHighlight, AnnotationPen, AnnotationText:
+		return true
+	default:
+		return false
+	}
+}
+
+func (v FeedbackVerdict) IsValid() bool {
+	switch v {
+	case FeedbackVerdictAccepted, FeedbackVerdictNeedsRevision:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,43 @@
+package domain
+
+import (
+	"io"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ExportStatus string
+
+const (
+	ExportStatusPending ExportStatus = "pending"
+	ExportStatusRunning ExportStatus = "running"
+	ExportStatusDone    ExportStatus = "done"
+	ExportStatusFailed  ExportStatus = "failed"
+)
+
+// PortfolioExport is an asynchronous job that archives the homework of a pair
+// created in [From, To) and stores the archive in file_service as FileID.
+type PortfolioExport struct {
+	ID          uuid.UUID
+	RequestedBy uuid.UUID
+	TutorID     uuid.UUID
+	StudentID   uuid.UUID
+	From        *time.Time
+	To          *time.Time
+	Status      ExportStatus
+	FileID      *uuid.UUID
+	// Error describes why a failed export failed.
+	Error      *string
+	CreatedAt  time.Time
+	StartedAt  *time.Time
+	FinishedAt *time.Time
+	// DownloadURL is resolved on read for finished exports.
+	DownloadURL string
+}
+
+// FileContent is the content of a file stored in file_service. The reader closes Body.
+type FileContent struct {
+	Name string
+	Body io.ReadCloser
+}
@@ -10,6 +10,74 @@ type Feedback struct {
 	SubmissionID uuid.UUID
 	FileID       *uuid.UUID
 	Comment      *string
-	CreatedAt    time.Time
-	EditedAt     time.Time
+	Attachments  []Attachment
+	Score        *float64
+	MaxScore     *float64
+	Rubric       []RubricCriterion
+	Annotations  []Annotation
+	Verdict      FeedbackVerdict
+	// AutoGraded marks the feedback a quiz is graded with on submission, which is
+	// not a tutor review.
+	AutoGraded bool
+	CreatedAt  time.Time
+	EditedAt   time.Time
+}
+
+type FeedbackVerdict string
+
+const (
+	FeedbackVerdictAccepted      FeedbackVerdict = "accepted"
+	FeedbackVerdictNeedsRevision FeedbackVerdict = "needs_revision"
+)
+
+// RubricCriterion is a scored criterion of a feedback rubric.
+type RubricCriterion struct {
+	Name     string
+	Score    float64
+	MaxScore float64
+	Comment  *string
+}
+
+// GradebookEntry is the latest scored feedback of an assignment.
+type GradebookEntry struct {
+	AssignmentID uuid.UUID
+	Title        *string
+	DueDate      *time.Time
+	FeedbackID   uuid.UUID
+	GradedAt     time.Time
+	Score        float64
+	MaxScore     *float64
+	Rubric       []RubricCriterion
+	// IsLate reports whether the graded submission was flagged as late.
+	IsLate bool
+}
+
+// Percent returns the score as a percentage of the max score, if it is known.
+func (e GradebookEntry) Percent() *float64 {
+	if e.MaxScore == nil || *e.MaxScore <= 0 {
+		return nil
+	}
+	percent := e.Score / *e.MaxScore * 100
+	return &percent
+}
+
+type CriterionAverage struct {
+	Name           string
+	Count          int
+	AverageScore   float64
+	AveragePercent float64
+}
+
+type Gradebook struct {
+	TutorID        uuid.UUID
+	StudentID      uuid.UUID
+	Entries        []GradebookEntry
+	AverageScore   *float64
+	AveragePercent *float64
+	// Trend is the least squares slope of the percentage over graded assignments
+	// in grading order, in percentage points per assignment.
+	Trend    *float64
+	Criteria []CriterionAverage
+	// LateCount is the number of entries whose graded submission was late.
+	LateCount int
 }
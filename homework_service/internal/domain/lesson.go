@@ -0,0 +1,20 @@
+package domain
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+const (
+	LessonStatusBooked    = "booked"
+	LessonStatusCancelled = "cancelled"
+)
+
+// Lesson is a lesson from schedule_service.
+type Lesson struct {
+	ID        uuid.UUID
+	TutorID   uuid.UUID
+	StudentID uuid.UUID
+	Status    string
+	StartsAt  time.Time
+}
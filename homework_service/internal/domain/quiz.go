@@ -0,0 +1,48 @@
+package domain
+
+type QuizQuestionType string
+
+const (
+	QuizQuestionSingleChoice   QuizQuestionType = "single_choice"
+	QuizQuestionMultipleChoice QuizQuestionType = "multiple_choice"
+	QuizQuestionNumeric        QuizQuestionType = "numeric"
+	QuizQuestionShortText      QuizQuestionType = "short_text"
+)
+
+// QuizQuestion is a question of an auto-graded quiz assignment. CorrectOptions,
+// NumericAnswer, Tolerance and TextAnswers form the answer key, which is hidden
+// from the student.
+type QuizQuestion struct {
+	Type    QuizQuestionType
+	Text    string
+	Options []string
+	Points  float64
+
+	// CorrectOptions are indexes into Options for choice questions.
+	CorrectOptions []int
+	// A numeric answer is correct within Tolerance of NumericAnswer.
+	NumericAnswer *float64
+	Tolerance     float64
+	// TextAnswers are the accepted short text answers, compared ignoring case and extra spaces.
+	TextAnswers []string
+}
+
+// HideAnswer removes the answer key from the question.
+func (q *QuizQuestion) HideAnswer() {
+	q.CorrectOptions = nil
+	q.NumericAnswer = nil
+	q.Tolerance = 0
+	q.TextAnswers = nil
+}
+
+// QuizAnswer is the answer of a submission to the quiz question with index Question.
+// Correct and Score are set by grading.
+type QuizAnswer struct {
+	Question int
+	Options  []int
+	Number   *float64
+	Text     *string
+
+	Correct bool
+	Score   float64
+}
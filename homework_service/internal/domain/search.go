@@ -0,0 +1,49 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type SearchHitType string
+
+const (
+	SearchHitAssignment SearchHitType = "assignment"
+	SearchHitSubmission SearchHitType = "submission"
+	SearchHitFeedback   SearchHitType = "feedback"
+)
+
+// SearchFilter selects homework texts matching Query among the assignments of UserID.
+type SearchFilter struct {
+	Query  string
+	UserID uuid.UUID
+	// TutorID and StudentID narrow the search to one pair.
+	TutorID   uuid.UUID
+	StudentID uuid.UUID
+	Types     []SearchHitType
+	From      *time.Time
+	To        *time.Time
+	Limit     int
+	Offset    int
+}
+
+type SearchPage struct {
+	Hits []SearchHit
+	// NextPageToken is empty on the last page.
+	NextPageToken string
+}
+
+// SearchHit is an assignment, submission or feedback whose text matches the query.
+type SearchHit struct {
+	Type            SearchHitType
+	ID              uuid.UUID
+	AssignmentID    uuid.UUID
+	TutorID         uuid.UUID
+	StudentID       uuid.UUID
+	AssignmentTitle *string
+	// Snippet is the matching fragment of the text with the matched words highlighted.
+	Snippet   string
+	Rank      float64
+	CreatedAt time.Time
+}
@@ -0,0 +1,56 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// StudentHomeworkStats counts the student's published assignments created in the
+// stats period.
+type StudentHomeworkStats struct {
+	StudentID uuid.UUID
+	Assigned  int
+	// Submitted counts assignments with at least one submission.
+	Submitted int
+	// Due counts assignments that are submitted or whose deadline has passed,
+	// that is whose timeliness is known.
+	Due int
+	// OnTime counts assignments first submitted before the deadline or without one.
+	OnTime int
+	// Unreviewed is the current number of the student's UNREVIEWED assignments,
+	// regardless of the period.
+	Unreviewed int
+}
+
+// CompletionRate returns the share of assigned assignments that are submitted.
+func (s StudentHomeworkStats) CompletionRate() *float64 {
+	return rate(s.Submitted, s.Assigned)
+}
+
+// OnTimeRate returns the share of due assignments that were submitted on time.
+func (s StudentHomeworkStats) OnTimeRate() *float64 {
+	return rate(s.OnTime, s.Due)
+}
+
+func rate(count, total int) *float64 {
+	if total == 0 {
+		return nil
+	}
+	r := float64(count) / float64(total)
+	return &r
+}
+
+type HomeworkStats struct {
+	TutorID uuid.UUID
+	From    *time.Time
+	To      *time.Time
+	// Students are ordered by completion rate, lowest first.
+	Students []StudentHomeworkStats
+	// MedianReviewTurnaround is the median time from a submission to its first
+	// feedback over ReviewedCount reviewed submissions.
+	MedianReviewTurnaround *time.Duration
+	ReviewedCount          int
+	// UnreviewedCount is the current number of UNREVIEWED assignments of the tutor.
+	UnreviewedCount int
+}
@@ -8,8 +8,13 @@ import (
 type Submission struct {
 	ID           uuid.UUID
 	AssignmentID uuid.UUID
+	Version      int
 	FileID       *uuid.UUID
 	Comment      *string
-	CreatedAt    time.Time
-	EditedAt     time.Time
+	Attachments  []Attachment
+	Answers      []QuizAnswer
+	// IsLate is set when the submission was made after the deadline under the flag policy.
+	IsLate    bool
+	CreatedAt time.Time
+	EditedAt  time.Time
 }
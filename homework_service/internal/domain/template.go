@@ -0,0 +1,19 @@
+package domain
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+// AssignmentTemplate is a reusable assignment of a tutor. DueOffset, if set,
+// is added to the assignment time to get the due date.
+type AssignmentTemplate struct {
+	ID          uuid.UUID
+	TutorID     uuid.UUID
+	Title       *string
+	Description *string
+	Attachments []Attachment
+	DueOffset   *time.Duration
+	CreatedAt   time.Time
+	EditedAt    time.Time
+}
@@ -0,0 +1,102 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"homework_service/internal/domain"
+)
+
+// replaceAnnotations replaces the annotations of the feedback, keeping their order.
+func replaceAnnotations(ctx context.Context, tx *sql.Tx, feedbackID uuid.UUID, annotations []domain.Annotation) error {
+	if _, err := tx.ExecContext(ctx, `DELETE FROM feedback_annotations WHERE feedback_id = $1`, feedbackID); err != nil {
+		return fmt.Errorf("failed to delete annotations: %w", err)
+	}
+
+	query := `
+		INSERT INTO feedback_annotations
+			(feedback_id, position, file_id, page, type, x, y, width, height, points, comment)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+	`
+	for i, a := range annotations {
+		_, err := tx.ExecContext(ctx, query,
+			feedbackID,
+			i,
+			a.FileID,
+			a.Page,
+			a.Type,
+			a.X,
+			a.Y,
+			a.Width,
+			a.Height,
+			toPointArray(a.Points),
+			a.Comment,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to create annotation: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// listAnnotations returns the ordered annotations of the given feedbacks keyed by feedback ID.
+func listAnnotations(ctx context.Context, q queryer, feedbackIDs []uuid.UUID) (map[uuid.UUID][]domain.Annotation, error) {
+	result := make(map[uuid.UUID][]domain.Annotation, len(feedbackIDs))
+	if len(feedbackIDs) == 0 {
+		return result, nil
+	}
+
+	query := `
+		SELECT feedback_id, file_id, page, type, x, y, width, height, points, comment
+		FROM feedback_annotations
+		WHERE feedback_id = ANY($1::uuid[])
+		ORDER BY feedback_id, position
+	`
+
+	rows, err := q.QueryContext(ctx, query, pq.Array(uuidStrings(feedbackIDs)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to query annotations: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	for rows.Next() {
+		var feedbackID uuid.UUID
+		var a domain.Annotation
+		var points pq.Float64Array
+		if err := rows.Scan(&feedbackID, &a.FileID, &a.Page, &a.Type, &a.X, &a.Y, &a.Width, &a.Height, &points, &a.Comment); err != nil {
+			return nil, fmt.Errorf("failed to scan annotation: %w", err)
+		}
+		a.Points = fromPointArray(points)
+		result[feedbackID] = append(result[feedbackID], a)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	return result, nil
+}
+
+// toPointArray flattens the points into x1, y1, x2, y2, ...
+func toPointArray(points []domain.AnnotationPoint) pq.Float64Array {
+	values := make(pq.Float64Array, 0, 2*len(points))
+	for _, p := range points {
+		values = append(values, p.X, p.Y)
+	}
+	return values
+}
+
+func fromPointArray(values pq.Float64Array) []domain.AnnotationPoint {
+	if len(values) == 0 {
+		return nil
+	}
+	points := make([]domain.AnnotationPoint, 0, len(values)/2)
+	for i := 0; i+1 < len(values); i += 2 {
+		points = append(points, domain.AnnotationPoint{X: values[i], Y: values[i+1]})
+	}
+	return points
+}
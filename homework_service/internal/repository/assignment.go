@@ -12,41 +12,10 @@ import (
 	"github.com/google/uuid"
 )
 
-const statusSubQuery = `
-WITH latest_submissions AS (
-    SELECT *
-    FROM (
-        SELECT *,
-               ROW_NUMBER() OVER (PARTITION BY assignment_id ORDER BY created_at DESC) AS rn
-        FROM submissions
-    ) s
-    WHERE rn = 1
-),
-latest_feedbacks AS (
-    SELECT *
-    FROM (
-        SELECT *,
-               ROW_NUMBER() OVER (PARTITION BY submission_id ORDER BY created_at DESC) AS rn
-        FROM feedbacks
-    ) f
-    WHERE rn = 1
-),
-assignment_statuses AS (
-    SELECT
-        a.id, a.tutor_id, a.student_id, a.title, a.description,
-        a.file_id, a.due_date, a.created_at, a.edited_at,
-        CASE
-            WHEN ls.id IS NULL AND a.due_date > NOW() THEN 'UNSENT'
-            WHEN ls.id IS NULL AND a.due_date <= NOW() THEN 'OVERDUE'
-            WHEN ls.id IS NOT NULL AND lf.id IS NULL THEN 'UNREVIEWED'
-            WHEN lf.id IS NOT NULL THEN 'REVIEWED'
-            ELSE 'UNSPECIFIED'
-        END AS status
-    FROM assignments a
-    LEFT JOIN latest_submissions ls ON ls.assignment_id = a.id
-    LEFT JOIN latest_feedbacks lf ON lf.submission_id = ls.id
-)
-`
+const assignmentColumns = `id, tutor_id, student_id, title, description, file_id, due_date,
+created_at, edited_at, lesson_id, due_before_next_lesson, due_lesson_id,
+late_policy, grace_period_seconds, submitted_late, state, publish_at, published_at, deleted_at,
+checklist_progress`
 
 type AssignmentRepository struct {
 	db *sql.DB
@@ -66,10 +35,9 @@ func NewAssignmentRepository(db *sql.DB) *AssignmentRepository {
 }
 
 func (r *AssignmentRepository) ListByFilter(ctx context.Context, filter domain.AssignmentFilter) ([]*domain.Assignment, error) {
-	query := statusSubQuery + `
-SELECT id, tutor_id, student_id, title, description, 
-file_id, due_date, created_at, edited_at 
-FROM assignment_statuses WHERE 1=1
+	query := `
+SELECT ` + assignmentColumns + `
+FROM assignments WHERE deleted_at IS NULL
 `
 	var args []interface{}
 	argsCount := 1
@@ -86,6 +54,12 @@ FROM assignment_statuses WHERE 1=1
 		argsCount++
 	}
 
+	if filter.LessonID != uuid.Nil {
+		query += fmt.Sprintf(" AND lesson_id = $%d", argsCount)
+		args = append(args, filter.LessonID)
+		argsCount++
+	}
+
 	if len(filter.Statuses) > 0 {
 		placeholders := make([]string, len(filter.Statuses))
 		for i := range filter.Statuses {
@@ -96,6 +70,24 @@ FROM assignment_statuses WHERE 1=1
 		query += fmt.Sprintf(" AND status IN (%s)", strings.Join(placeholders, ", ")) //nolint:gosec // placeholders are parameterized
 	}
 
+	if filter.PublishedOnly {
+		query += " AND state = 'published'"
+	}
+
+	if filter.ChecklistProgress.IsSet() {
+		query += " AND checklist_progress IS NOT NULL"
+	}
+	if filter.ChecklistProgress.Min != nil {
+		query += fmt.Sprintf(" AND checklist_progress >= $%d", argsCount)
+		args = append(args, *filter.ChecklistProgress.Min)
+		argsCount++
+	}
+	if filter.ChecklistProgress.Max != nil {
+		query += fmt.Sprintf(" AND checklist_progress <= $%d", argsCount)
+		args = append(args, *filter.ChecklistProgress.Max)
+		argsCount++
+	}
+
 	rows, err := r.db.QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, err
@@ -104,33 +96,32 @@ FROM assignment_statuses WHERE 1=1
 
 	var assignments []*domain.Assignment
 	for rows.Next() {
-		var a domain.Assignment
-		if err := rows.Scan(
-			&a.ID,
-			&a.TutorID,
-			&a.StudentID,
-			&a.Title,
-			&a.Description,
-			&a.FileID,
-			&a.DueDate,
-			&a.CreatedAt,
-			&a.EditedAt,
-		); err != nil {
+		a, err := scanAssignment(rows)
+		if err != nil {
 			return nil, err
 		}
-		assignments = append(assignments, &a)
+		assignments = append(assignments, a)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if err := r.loadDetails(ctx, assignments); err != nil {
+		return nil, err
 	}
 
 	return assignments, nil
 }
 
 func (r *AssignmentRepository) FindAssignmentsDueSoon(ctx context.Context, duration time.Duration) ([]*domain.Assignment, error) {
-	query := statusSubQuery + `
-		SELECT id, tutor_id, student_id, title, description, file_id, due_date,
-		       created_at, edited_at
-		FROM assignment_statuses
+	query := `
+		SELECT ` + assignmentColumns + `
+		FROM assignments
 		WHERE due_date BETWEEN NOW() AND $1
 		AND status NOT IN ('REVIEWED', 'OVERDUE')
+		AND state = 'published'
+		AND deleted_at IS NULL
 	`
 
 	deadline := time.Now().Add(duration)
@@ -142,22 +133,210 @@ func (r *AssignmentRepository) FindAssignmentsDueSoon(ctx context.Context, durat
 
 	var assignments []*domain.Assignment
 	for rows.Next() {
-		var a domain.Assignment
-		err := rows.Scan(
-			&a.ID,
-			&a.TutorID,
-			&a.StudentID,
-			&a.Title,
-			&a.Description,
-			&a.FileID,
-			&a.DueDate,
-			&a.CreatedAt,
-			&a.EditedAt,
-		)
+		a, err := scanAssignment(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan assignment: %w", err)
+		}
+		assignments = append(assignments, a)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	return assignments, nil
+}
+
+// ListLessonSyncCandidates returns unsubmitted assignments whose due date follows
+// the pair's next lesson and may still have to be moved: those without a resolved
+// lesson and those due after the given time.
+func (r *AssignmentRepository) ListLessonSyncCandidates(ctx context.Context, dueAfter time.Time) ([]*domain.Assignment, error) {
+	query := `
+		SELECT ` + assignmentColumns + `
+		FROM assignments a
+		WHERE due_before_next_lesson
+		AND (due_lesson_id IS NULL OR due_date > $1)
+		AND NOT EXISTS (SELECT 1 FROM submissions s WHERE s.assignment_id = a.id)
+		AND deleted_at IS NULL
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, dueAfter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query assignments: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var assignments []*domain.Assignment
+	for rows.Next() {
+		a, err := scanAssignment(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan assignment: %w", err)
+		}
+		assignments = append(assignments, a)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	return assignments, nil
+}
+
+// UpdateDueLesson moves the due date of an assignment to the given lesson.
+// It touches nothing else, so it does not overwrite concurrent edits.
+func (r *AssignmentRepository) UpdateDueLesson(ctx context.Context, id uuid.UUID, dueLessonID *uuid.UUID, dueDate *time.Time) error {
+	query := `
+		UPDATE assignments
+		SET due_lesson_id = $1, due_date = $2, edited_at = $3
+		WHERE id = $4 AND due_before_next_lesson
+	`
+
+	return withTx(ctx, r.db, func(tx *sql.Tx) error {
+		result, err := tx.ExecContext(ctx, query, dueLessonID, dueDate, time.Now(), id)
+		if err != nil {
+			return fmt.Errorf("failed to update assignment due lesson: %w", err)
+		}
+
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("failed to get rows affected: %w", err)
+		}
+
+		if rowsAffected == 0 {
+			return ErrNotFound
+		}
+
+		return refreshStatus(ctx, tx, id)
+	})
+}
+
+// MarkOverdue moves unsent assignments whose due date has passed to OVERDUE and
+// returns them. Other transitions happen on writes, this one only needs time.
+func (r *AssignmentRepository) MarkOverdue(ctx context.Context) ([]*domain.Assignment, error) {
+	query := `
+		UPDATE assignments
+		SET status = 'OVERDUE'
+		WHERE status = 'UNSENT' AND due_date <= NOW()
+		RETURNING ` + assignmentColumns
+
+	return r.queryAssignments(ctx, query)
+}
+
+// PublishDue publishes scheduled assignments whose publish time has passed and returns them.
+func (r *AssignmentRepository) PublishDue(ctx context.Context) ([]*domain.Assignment, error) {
+	query := `
+		UPDATE assignments
+		SET state = 'published', published_at = NOW()
+		WHERE state = 'scheduled' AND publish_at <= NOW() AND deleted_at IS NULL
+		RETURNING ` + assignmentColumns
+
+	return r.queryAssignments(ctx, query)
+}
+
+// ClaimPublishedNotifications marks published assignments that have not been
+// announced yet as notified and returns them, so that every assignment is announced once.
+func (r *AssignmentRepository) ClaimPublishedNotifications(ctx context.Context) ([]*domain.Assignment, error) {
+	query := `
+		UPDATE assignments
+		SET published_notified_at = NOW()
+		WHERE state = 'published' AND published_notified_at IS NULL AND deleted_at IS NULL
+		RETURNING ` + assignmentColumns
+
+	return r.queryAssignments(ctx, query)
+}
+
+// ReleasePublishedNotification returns the assignment to the unannounced ones
+// after its notification failed.
+func (r *AssignmentRepository) ReleasePublishedNotification(ctx context.Context, id uuid.UUID) error {
+	query := `UPDATE assignments SET published_notified_at = NULL WHERE id = $1`
+
+	if _, err := r.db.ExecContext(ctx, query, id); err != nil {
+		return fmt.Errorf("failed to release published notification: %w", err)
+	}
+	return nil
+}
+
+// ClaimOverdueNotifications marks overdue assignments that have not been announced
+// yet as notified and returns them, so that every assignment is announced once.
+func (r *AssignmentRepository) ClaimOverdueNotifications(ctx context.Context) ([]*domain.Assignment, error) {
+	query := `
+		UPDATE assignments
+		SET overdue_notified_at = NOW()
+		WHERE status = 'OVERDUE' AND overdue_notified_at IS NULL AND state = 'published'
+			AND deleted_at IS NULL
+		RETURNING ` + assignmentColumns
+
+	return r.queryAssignments(ctx, query)
+}
+
+// ReleaseOverdueNotification returns the assignment to the unannounced ones
+// after its notification failed.
+func (r *AssignmentRepository) ReleaseOverdueNotification(ctx context.Context, id uuid.UUID) error {
+	query := `UPDATE assignments SET overdue_notified_at = NULL WHERE id = $1`
+
+	if _, err := r.db.ExecContext(ctx, query, id); err != nil {
+		return fmt.Errorf("failed to release overdue notification: %w", err)
+	}
+	return nil
+}
+
+// ListOverdue returns all published overdue assignments ordered by tutor, student and due date.
+func (r *AssignmentRepository) ListOverdue(ctx context.Context) ([]*domain.Assignment, error) {
+	query := `
+		SELECT ` + assignmentColumns + `
+		FROM assignments
+		WHERE status = 'OVERDUE' AND state = 'published' AND deleted_at IS NULL
+		ORDER BY tutor_id, student_id, due_date, id
+	`
+
+	return r.queryAssignments(ctx, query)
+}
+
+// ClaimOverdueDigest records the tutor's digest for the day. It returns false if
+// the digest has already been claimed.
+func (r *AssignmentRepository) ClaimOverdueDigest(ctx context.Context, tutorID uuid.UUID, date time.Time) (bool, error) {
+	query := `
+		INSERT INTO overdue_digests (tutor_id, digest_date)
+		VALUES ($1, $2)
+		ON CONFLICT DO NOTHING
+	`
+
+	result, err := r.db.ExecContext(ctx, query, tutorID, date.Format(time.DateOnly))
+	if err != nil {
+		return false, fmt.Errorf("failed to claim overdue digest: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	return rowsAffected > 0, nil
+}
+
+// ReleaseOverdueDigest forgets the tutor's digest for the day after it failed to send.
+func (r *AssignmentRepository) ReleaseOverdueDigest(ctx context.Context, tutorID uuid.UUID, date time.Time) error {
+	query := `DELETE FROM overdue_digests WHERE tutor_id = $1 AND digest_date = $2`
+
+	if _, err := r.db.ExecContext(ctx, query, tutorID, date.Format(time.DateOnly)); err != nil {
+		return fmt.Errorf("failed to release overdue digest: %w", err)
+	}
+	return nil
+}
+
+func (r *AssignmentRepository) queryAssignments(ctx context.Context, query string, args ...any) ([]*domain.Assignment, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query assignments: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var assignments []*domain.Assignment
+	for rows.Next() {
+		a, err := scanAssignment(rows)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan assignment: %w", err)
 		}
-		assignments = append(assignments, &a)
+		assignments = append(assignments, a)
 	}
 
 	if err = rows.Err(); err != nil {
@@ -168,10 +347,74 @@ func (r *AssignmentRepository) FindAssignmentsDueSoon(ctx context.Context, durat
 }
 
 func (r *AssignmentRepository) Create(ctx context.Context, assignment *domain.Assignment) error {
+	return r.CreateBatch(ctx, []*domain.Assignment{assignment})
+}
+
+// CreateBatch creates all assignments in a single transaction.
+func (r *AssignmentRepository) CreateBatch(ctx context.Context, assignments []*domain.Assignment) error {
+	err := withTx(ctx, r.db, func(tx *sql.Tx) error {
+		for _, assignment := range assignments {
+			if err := createAssignment(ctx, tx, assignment); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		for _, assignment := range assignments {
+			assignment.ID = uuid.Nil
+		}
+	}
+	return err
+}
+
+// CreateForLesson creates the follow-up assignment of a completed lesson. It returns
+// false without creating anything if the lesson has already been processed.
+func (r *AssignmentRepository) CreateForLesson(ctx context.Context, lessonID uuid.UUID, assignment *domain.Assignment) (bool, error) {
+	created := false
+	err := withTx(ctx, r.db, func(tx *sql.Tx) error {
+		result, err := tx.ExecContext(ctx,
+			`INSERT INTO lesson_follow_ups (lesson_id) VALUES ($1) ON CONFLICT (lesson_id) DO NOTHING`,
+			lessonID,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to record lesson follow-up: %w", err)
+		}
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("failed to get rows affected: %w", err)
+		}
+		if rowsAffected == 0 {
+			return nil
+		}
+
+		if err := createAssignment(ctx, tx, assignment); err != nil {
+			return err
+		}
+		_, err = tx.ExecContext(ctx,
+			`UPDATE lesson_follow_ups SET assignment_id = $1 WHERE lesson_id = $2`,
+			assignment.ID, lessonID,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to link lesson follow-up: %w", err)
+		}
+		created = true
+		return nil
+	})
+	if err != nil {
+		assignment.ID = uuid.Nil
+		return false, err
+	}
+	return created, nil
+}
+
+func createAssignment(ctx context.Context, tx *sql.Tx, assignment *domain.Assignment) error {
 	query := `
 		INSERT INTO assignments 
-			(id, tutor_id, student_id, title, description, file_id, due_date, created_at, edited_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+			(id, tutor_id, student_id, title, description, file_id, due_date, created_at, edited_at,
+			 lesson_id, due_before_next_lesson, due_lesson_id, late_policy, grace_period_seconds,
+			 state, publish_at, published_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17)
 	`
 
 	id, err := uuid.NewV7()
@@ -179,7 +422,7 @@ func (r *AssignmentRepository) Create(ctx context.Context, assignment *domain.As
 		return fmt.Errorf("failed to generate UUID: %w", err)
 	}
 
-	_, err = r.db.ExecContext(ctx, query,
+	_, err = tx.ExecContext(ctx, query,
 		id,
 		assignment.TutorID,
 		assignment.StudentID,
@@ -189,33 +432,145 @@ func (r *AssignmentRepository) Create(ctx context.Context, assignment *domain.As
 		assignment.DueDate,
 		time.Now(),
 		time.Now(),
+		assignment.LessonID,
+		assignment.DueBeforeNextLesson,
+		assignment.DueLessonID,
+		assignment.LatePolicy,
+		durationToSeconds(assignment.GracePeriod),
+		assignment.State,
+		assignment.PublishAt,
+		assignment.PublishedAt,
 	)
-
 	if err != nil {
 		return fmt.Errorf("failed to create assignment: %w", err)
 	}
 
+	if err := replaceAttachments(ctx, tx, domain.AttachmentOwnerAssignment, id, assignment.Attachments); err != nil {
+		return err
+	}
+	if err := replaceQuiz(ctx, tx, id, assignment.Quiz); err != nil {
+		return err
+	}
+	progress, err := replaceChecklist(ctx, tx, id, assignment.Checklist)
+	if err != nil {
+		return err
+	}
+	if err := refreshStatus(ctx, tx, id); err != nil {
+		return err
+	}
+
 	assignment.ID = id
+	assignment.ChecklistProgress = progress
 	return nil
 }
 
 func (r *AssignmentRepository) Update(ctx context.Context, assignment *domain.Assignment) error {
 	query := `
 		UPDATE assignments 
-		SET title = $1, description = $2, file_id = $3, due_date = $4, edited_at = $5
-		WHERE id = $6
+		SET title = $1, description = $2, file_id = $3, due_date = $4, edited_at = $5,
+		    lesson_id = $6, due_before_next_lesson = $7, due_lesson_id = $8,
+		    late_policy = $9, grace_period_seconds = $10,
+		    state = $11, publish_at = $12, published_at = $13
+		WHERE id = $14
 	`
-	result, err := r.db.ExecContext(ctx, query,
-		assignment.Title,
-		assignment.Description,
-		assignment.FileID,
-		assignment.DueDate,
-		time.Now(),
-		assignment.ID,
-	)
+	return withTx(ctx, r.db, func(tx *sql.Tx) error {
+		result, err := tx.ExecContext(ctx, query,
+			assignment.Title,
+			assignment.Description,
+			assignment.FileID,
+			assignment.DueDate,
+			time.Now(),
+			assignment.LessonID,
+			assignment.DueBeforeNextLesson,
+			assignment.DueLessonID,
+			assignment.LatePolicy,
+			durationToSeconds(assignment.GracePeriod),
+			assignment.State,
+			assignment.PublishAt,
+			assignment.PublishedAt,
+			assignment.ID,
+		)
+
+		if err != nil {
+			return fmt.Errorf("failed to update assignment: %w", err)
+		}
+
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("failed to get rows affected: %w", err)
+		}
+
+		if rowsAffected == 0 {
+			return errors.New("assignment not found")
+		}
 
+		if err := replaceAttachments(ctx, tx, domain.AttachmentOwnerAssignment, assignment.ID, assignment.Attachments); err != nil {
+			return err
+		}
+		if err := replaceQuiz(ctx, tx, assignment.ID, assignment.Quiz); err != nil {
+			return err
+		}
+		assignment.ChecklistProgress, err = replaceChecklist(ctx, tx, assignment.ID, assignment.Checklist)
+		if err != nil {
+			return err
+		}
+		return refreshStatus(ctx, tx, assignment.ID)
+	})
+}
+
+func (r *AssignmentRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.Assignment, error) {
+	query := `
+		SELECT ` + assignmentColumns + `
+		FROM assignments
+		WHERE id = $1 AND deleted_at IS NULL
+	`
+
+	assignment, err := scanAssignment(r.db.QueryRowContext(ctx, query, id))
 	if err != nil {
-		return fmt.Errorf("failed to update assignment: %w", err)
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to get assignment: %w", err)
+	}
+
+	if err := r.loadDetails(ctx, []*domain.Assignment{assignment}); err != nil {
+		return nil, err
+	}
+
+	return assignment, nil
+}
+
+// GetDeletedByID returns a soft-deleted assignment.
+func (r *AssignmentRepository) GetDeletedByID(ctx context.Context, id uuid.UUID) (*domain.Assignment, error) {
+	query := `
+		SELECT ` + assignmentColumns + `
+		FROM assignments
+		WHERE id = $1 AND deleted_at IS NOT NULL
+	`
+
+	assignment, err := scanAssignment(r.db.QueryRowContext(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to get deleted assignment: %w", err)
+	}
+
+	return assignment, nil
+}
+
+// Restore undoes the soft delete of an assignment deleted less than retention ago.
+// It returns ErrNotFound if there is no such assignment.
+func (r *AssignmentRepository) Restore(ctx context.Context, id uuid.UUID, retention time.Duration) error {
+	query := `
+		UPDATE assignments
+		SET deleted_at = NULL
+		WHERE id = $1 AND deleted_at > NOW() - make_interval(secs => $2)
+	`
+
+	result, err := r.db.ExecContext(ctx, query, id, retention.Seconds())
+	if err != nil {
+		return fmt.Errorf("failed to restore assignment: %w", err)
 	}
 
 	rowsAffected, err := result.RowsAffected()
@@ -224,45 +579,60 @@ func (r *AssignmentRepository) Update(ctx context.Context, assignment *domain.As
 	}
 
 	if rowsAffected == 0 {
-		return errors.New("assignment not found")
+		return ErrNotFound
 	}
 
 	return nil
 }
 
-func (r *AssignmentRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.Assignment, error) {
-	query := `
-		SELECT id, tutor_id, student_id, title, description, file_id, due_date, 
-		       created_at, edited_at
-		FROM assignments
-		WHERE id = $1
-	`
+// Purge permanently deletes assignments soft-deleted longer than retention ago,
+// with their submissions, feedbacks and comments, and returns their number.
+func (r *AssignmentRepository) Purge(ctx context.Context, retention time.Duration) (int64, error) {
+	query := `DELETE FROM assignments WHERE deleted_at <= NOW() - make_interval(secs => $1)`
 
-	var assignment domain.Assignment
-	err := r.db.QueryRowContext(ctx, query, id).Scan(
-		&assignment.ID,
-		&assignment.TutorID,
-		&assignment.StudentID,
-		&assignment.Title,
-		&assignment.Description,
-		&assignment.FileID,
-		&assignment.DueDate,
-		&assignment.CreatedAt,
-		&assignment.EditedAt,
-	)
+	result, err := r.db.ExecContext(ctx, query, retention.Seconds())
+	if err != nil {
+		return 0, fmt.Errorf("failed to purge assignments: %w", err)
+	}
 
+	rowsAffected, err := result.RowsAffected()
 	if err != nil {
+		return 0, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	return rowsAffected, nil
+}
+
+// GetStatus returns the current status of the assignment.
+func (r *AssignmentRepository) GetStatus(ctx context.Context, id uuid.UUID) (domain.AssignmentStatus, error) {
+	query := `SELECT status FROM assignments WHERE id = $1`
+
+	var status string
+	if err := r.db.QueryRowContext(ctx, query, id).Scan(&status); err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return nil, ErrNotFound
+			return "", ErrNotFound
 		}
-		return nil, fmt.Errorf("failed to get assignment: %w", err)
+		return "", fmt.Errorf("failed to get assignment status: %w", err)
 	}
 
-	return &assignment, nil
+	return domain.ToAssignmentStatus(status), nil
+}
+
+// HasSubmissions reports whether the assignment has any submission.
+func (r *AssignmentRepository) HasSubmissions(ctx context.Context, id uuid.UUID) (bool, error) {
+	query := `SELECT EXISTS (SELECT 1 FROM submissions WHERE assignment_id = $1)`
+
+	var exists bool
+	if err := r.db.QueryRowContext(ctx, query, id).Scan(&exists); err != nil {
+		return false, fmt.Errorf("failed to check submissions: %w", err)
+	}
+	return exists, nil
 }
 
+// Delete soft-deletes the assignment: it is hidden everywhere but kept with its
+// submissions and feedbacks until it is restored or purged.
 func (r *AssignmentRepository) Delete(ctx context.Context, id uuid.UUID) error {
-	query := `DELETE FROM assignments WHERE id = $1`
+	query := `UPDATE assignments SET deleted_at = NOW() WHERE id = $1 AND deleted_at IS NULL`
 
 	result, err := r.db.ExecContext(ctx, query, id)
 	if err != nil {
@@ -280,3 +650,67 @@ func (r *AssignmentRepository) Delete(ctx context.Context, id uuid.UUID) error {
 
 	return nil
 }
+
+// loadDetails loads attachments, quiz questions and checklists of the assignments.
+func (r *AssignmentRepository) loadDetails(ctx context.Context, assignments []*domain.Assignment) error {
+	ids := make([]uuid.UUID, len(assignments))
+	for i, a := range assignments {
+		ids[i] = a.ID
+	}
+
+	attachments, err := listAttachments(ctx, r.db, domain.AttachmentOwnerAssignment, ids)
+	if err != nil {
+		return err
+	}
+
+	quizzes, err := listQuizzes(ctx, r.db, ids)
+	if err != nil {
+		return err
+	}
+
+	checklists, err := listChecklists(ctx, r.db, ids)
+	if err != nil {
+		return err
+	}
+
+	for _, a := range assignments {
+		a.Attachments = attachments[a.ID]
+		a.Quiz = quizzes[a.ID]
+		a.Checklist = checklists[a.ID]
+	}
+	return nil
+}
+
+func scanAssignment(row rowScanner) (*domain.Assignment, error) {
+	var a domain.Assignment
+	var gracePeriodSeconds sql.NullInt64
+	if err := row.Scan(
+		&a.ID,
+		&a.TutorID,
+		&a.StudentID,
+		&a.Title,
+		&a.Description,
+		&a.FileID,
+		&a.DueDate,
+		&a.CreatedAt,
+		&a.EditedAt,
+		&a.LessonID,
+		&a.DueBeforeNextLesson,
+		&a.DueLessonID,
+		&a.LatePolicy,
+		&gracePeriodSeconds,
+		&a.SubmittedLate,
+		&a.State,
+		&a.PublishAt,
+		&a.PublishedAt,
+		&a.DeletedAt,
+		&a.ChecklistProgress,
+	); err != nil {
+		return nil, err
+	}
+	if gracePeriodSeconds.Valid {
+		gracePeriod := time.Duration(gracePeriodSeconds.Int64) * time.Second
+		a.GracePeriod = &gracePeriod
+	}
+	return &a, nil
+}
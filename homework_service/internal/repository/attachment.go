@@ -0,0 +1,100 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"homework_service/internal/domain"
+)
+
+// attachmentOwnerColumns maps an owner type to its foreign key column in the attachments table.
+var attachmentOwnerColumns = map[domain.AttachmentOwnerType]string{
+	domain.AttachmentOwnerAssignment: "assignment_id",
+	domain.AttachmentOwnerSubmission: "submission_id",
+	domain.AttachmentOwnerFeedback:   "feedback_id",
+	domain.AttachmentOwnerTemplate:   "template_id",
+	domain.AttachmentOwnerComment:    "comment_id",
+}
+
+type queryer interface {
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+}
+
+// replaceAttachments replaces all attachments of the owner, keeping the order of the slice.
+// IDs, positions and creation times are written back to the slice.
+func replaceAttachments(ctx context.Context, tx *sql.Tx, owner domain.AttachmentOwnerType, ownerID uuid.UUID, attachments []domain.Attachment) error {
+	column := attachmentOwnerColumns[owner]
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM attachments WHERE `+column+` = $1`, ownerID); err != nil { //nolint:gosec // column comes from a fixed map
+		return fmt.Errorf("failed to delete attachments: %w", err)
+	}
+
+	query := `
+		INSERT INTO attachments (id, ` + column + `, file_id, caption, position, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	` //nolint:gosec // column comes from a fixed map
+
+	now := time.Now()
+	for i := range attachments {
+		id, err := uuid.NewV7()
+		if err != nil {
+			return fmt.Errorf("failed to generate UUID: %w", err)
+		}
+
+		if _, err := tx.ExecContext(ctx, query, id, ownerID, attachments[i].FileID, attachments[i].Caption, i, now); err != nil {
+			return fmt.Errorf("failed to create attachment: %w", err)
+		}
+
+		attachments[i].ID = id
+		attachments[i].Position = i
+		attachments[i].CreatedAt = now
+	}
+
+	return nil
+}
+
+// listAttachments returns the ordered attachments of the given owners keyed by owner ID.
+func listAttachments(ctx context.Context, q queryer, owner domain.AttachmentOwnerType, ownerIDs []uuid.UUID) (map[uuid.UUID][]domain.Attachment, error) {
+	result := make(map[uuid.UUID][]domain.Attachment, len(ownerIDs))
+	if len(ownerIDs) == 0 {
+		return result, nil
+	}
+
+	ids := make([]string, len(ownerIDs))
+	for i, id := range ownerIDs {
+		ids[i] = id.String()
+	}
+
+	column := attachmentOwnerColumns[owner]
+	query := `
+		SELECT ` + column + `, id, file_id, caption, position, created_at
+		FROM attachments
+		WHERE ` + column + ` = ANY($1::uuid[])
+		ORDER BY ` + column + `, position
+	` //nolint:gosec // column comes from a fixed map
+
+	rows, err := q.QueryContext(ctx, query, pq.Array(ids))
+	if err != nil {
+		return nil, fmt.Errorf("failed to query attachments: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	for rows.Next() {
+		var ownerID uuid.UUID
+		var a domain.Attachment
+		if err := rows.Scan(&ownerID, &a.ID, &a.FileID, &a.Caption, &a.Position, &a.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan attachment: %w", err)
+		}
+		result[ownerID] = append(result[ownerID], a)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,195 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"homework_service/internal/domain"
+)
+
+// refreshChecklistProgressQuery recomputes the stored percentage of done checklist
+// items, which is NULL without a checklist so that progress filters skip such assignments.
+const refreshChecklistProgressQuery = `
+	UPDATE assignments
+	SET checklist_progress = (
+		SELECT 100 * COUNT(*) FILTER (WHERE done) / NULLIF(COUNT(*), 0)
+		FROM checklist_items
+		WHERE assignment_id = $1
+	)
+	WHERE id = $1
+	RETURNING checklist_progress
+`
+
+// replaceChecklist replaces the checklist of the assignment, keeping its order, and
+// returns the new progress. Items whose text has not changed keep their marks, which
+// are copied into items, so that editing the list does not reset the student's progress.
+func replaceChecklist(ctx context.Context, tx *sql.Tx, assignmentID uuid.UUID, items []domain.ChecklistItem) (*int, error) {
+	stored, err := listChecklists(ctx, tx, []uuid.UUID{assignmentID})
+	if err != nil {
+		return nil, err
+	}
+	carryChecklistMarks(stored[assignmentID], items)
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM checklist_items WHERE assignment_id = $1`, assignmentID); err != nil {
+		return nil, fmt.Errorf("failed to delete checklist items: %w", err)
+	}
+
+	query := `
+		INSERT INTO checklist_items (assignment_id, position, text, done, done_at, review, reviewed_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+	`
+	for i, item := range items {
+		_, err := tx.ExecContext(ctx, query,
+			assignmentID,
+			i,
+			item.Text,
+			item.Done,
+			item.DoneAt,
+			nullReview(item.Review),
+			item.ReviewedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create checklist item: %w", err)
+		}
+	}
+
+	return refreshChecklistProgress(ctx, tx, assignmentID)
+}
+
+// carryChecklistMarks copies the marks of stored items to the items with the same
+// text, matching each stored item once. Other items are left unmarked.
+func carryChecklistMarks(stored, items []domain.ChecklistItem) {
+	used := make([]bool, len(stored))
+	for i := range items {
+		items[i] = domain.ChecklistItem{Text: items[i].Text}
+		for j, s := range stored {
+			if !used[j] && s.Text == items[i].Text {
+				items[i] = s
+				used[j] = true
+				break
+			}
+		}
+	}
+}
+
+// listChecklists returns the ordered checklist items of the given assignments keyed by assignment ID.
+func listChecklists(ctx context.Context, q queryer, assignmentIDs []uuid.UUID) (map[uuid.UUID][]domain.ChecklistItem, error) {
+	result := make(map[uuid.UUID][]domain.ChecklistItem, len(assignmentIDs))
+	if len(assignmentIDs) == 0 {
+		return result, nil
+	}
+
+	query := `
+		SELECT assignment_id, text, done, done_at, review, reviewed_at
+		FROM checklist_items
+		WHERE assignment_id = ANY($1::uuid[])
+		ORDER BY assignment_id, position
+	`
+
+	rows, err := q.QueryContext(ctx, query, pq.Array(uuidStrings(assignmentIDs)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to query checklist items: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	for rows.Next() {
+		var assignmentID uuid.UUID
+		var item domain.ChecklistItem
+		var review sql.NullString
+		if err := rows.Scan(
+			&assignmentID,
+			&item.Text,
+			&item.Done,
+			&item.DoneAt,
+			&review,
+			&item.ReviewedAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan checklist item: %w", err)
+		}
+		item.Review = domain.ChecklistReview(review.String)
+		result[assignmentID] = append(result[assignmentID], item)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	return result, nil
+}
+
+// MarkChecklistItem sets whether the checklist item at the position is done.
+// Changing the mark clears the review of the item. It returns ErrNotFound if
+// there is no such item.
+func (r *AssignmentRepository) MarkChecklistItem(ctx context.Context, assignmentID uuid.UUID, position int, done bool) error {
+	query := `
+		UPDATE checklist_items
+		SET done_at = CASE WHEN done = $3 THEN done_at WHEN $3 THEN $4::timestamp END,
+		    review = CASE WHEN done = $3 THEN review END,
+		    reviewed_at = CASE WHEN done = $3 THEN reviewed_at END,
+		    done = $3
+		WHERE assignment_id = $1 AND position = $2
+	`
+
+	return r.updateChecklistItem(ctx, assignmentID, query, assignmentID, position, done, time.Now())
+}
+
+// ReviewChecklistItem sets the review of the done checklist item at the position;
+// an empty review clears it. It returns ErrNotFound if there is no such done item.
+func (r *AssignmentRepository) ReviewChecklistItem(ctx context.Context, assignmentID uuid.UUID, position int, review domain.ChecklistReview) error {
+	query := `
+		UPDATE checklist_items
+		SET review = $3, reviewed_at = CASE WHEN $3::text IS NOT NULL THEN $4::timestamp END
+		WHERE assignment_id = $1 AND position = $2 AND done
+	`
+
+	return r.updateChecklistItem(ctx, assignmentID, query, assignmentID, position, nullReview(review), time.Now())
+}
+
+// updateChecklistItem runs the update of a single checklist item and refreshes the
+// progress. The assignment is locked first, so edits of the checklist do not lose marks.
+func (r *AssignmentRepository) updateChecklistItem(ctx context.Context, assignmentID uuid.UUID, query string, args ...any) error {
+	return withTx(ctx, r.db, func(tx *sql.Tx) error {
+		if err := lockAssignment(ctx, tx, assignmentID); err != nil {
+			return err
+		}
+
+		result, err := tx.ExecContext(ctx, query, args...)
+		if err != nil {
+			return fmt.Errorf("failed to update checklist item: %w", err)
+		}
+
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("failed to get rows affected: %w", err)
+		}
+
+		if rowsAffected == 0 {
+			return ErrNotFound
+		}
+
+		_, err = refreshChecklistProgress(ctx, tx, assignmentID)
+		return err
+	})
+}
+
+// refreshChecklistProgress must run after the checklist changes, in the same
+// transaction, and returns the new progress.
+func refreshChecklistProgress(ctx context.Context, tx *sql.Tx, assignmentID uuid.UUID) (*int, error) {
+	var progress *int
+	if err := tx.QueryRowContext(ctx, refreshChecklistProgressQuery, assignmentID).Scan(&progress); err != nil {
+		return nil, fmt.Errorf("failed to refresh checklist progress: %w", err)
+	}
+	return progress, nil
+}
+
+func nullReview(review domain.ChecklistReview) *string {
+	if review == "" {
+		return nil
+	}
+	s := string(review)
+	return &s
+}
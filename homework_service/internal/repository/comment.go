@@ -0,0 +1,211 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"homework_service/internal/domain"
+)
+
+const commentColumns = `id, assignment_id, submission_id, parent_id, author_id, body,
+created_at, edited_at, deleted_at`
+
+type CommentRepository struct {
+	db *sql.DB
+}
+
+func NewCommentRepository(db *sql.DB) *CommentRepository {
+	return &CommentRepository{db: db}
+}
+
+func (r *CommentRepository) Create(ctx context.Context, comment *domain.Comment) error {
+	query := `
+		INSERT INTO comments
+			(id, assignment_id, submission_id, parent_id, author_id, body, created_at, edited_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+	`
+
+	id, err := uuid.NewV7()
+	if err != nil {
+		return fmt.Errorf("failed to generate UUID: %w", err)
+	}
+
+	now := time.Now()
+	err = withTx(ctx, r.db, func(tx *sql.Tx) error {
+		_, err := tx.ExecContext(ctx, query,
+			id,
+			comment.AssignmentID,
+			comment.SubmissionID,
+			comment.ParentID,
+			comment.AuthorID,
+			comment.Body,
+			now,
+			now,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to create comment: %w", err)
+		}
+
+		return replaceAttachments(ctx, tx, domain.AttachmentOwnerComment, id, comment.Attachments)
+	})
+	if err != nil {
+		return err
+	}
+
+	comment.ID = id
+	comment.CreatedAt = now
+	comment.EditedAt = now
+	return nil
+}
+
+func (r *CommentRepository) Update(ctx context.Context, comment *domain.Comment) error {
+	query := `
+		UPDATE comments
+		SET body = $1, edited_at = $2
+		WHERE id = $3 AND deleted_at IS NULL
+	`
+
+	now := time.Now()
+	err := withTx(ctx, r.db, func(tx *sql.Tx) error {
+		result, err := tx.ExecContext(ctx, query, comment.Body, now, comment.ID)
+		if err != nil {
+			return fmt.Errorf("failed to update comment: %w", err)
+		}
+
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("failed to get rows affected: %w", err)
+		}
+		if rowsAffected == 0 {
+			return ErrNotFound
+		}
+
+		return replaceAttachments(ctx, tx, domain.AttachmentOwnerComment, comment.ID, comment.Attachments)
+	})
+	if err != nil {
+		return err
+	}
+
+	comment.EditedAt = now
+	return nil
+}
+
+// Delete clears the body and the attachments of the comment and marks it deleted,
+// so that its replies stay in the thread.
+func (r *CommentRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	query := `
+		UPDATE comments
+		SET body = '', deleted_at = $1
+		WHERE id = $2 AND deleted_at IS NULL
+	`
+
+	return withTx(ctx, r.db, func(tx *sql.Tx) error {
+		result, err := tx.ExecContext(ctx, query, time.Now(), id)
+		if err != nil {
+			return fmt.Errorf("failed to delete comment: %w", err)
+		}
+
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("failed to get rows affected: %w", err)
+		}
+		if rowsAffected == 0 {
+			return ErrNotFound
+		}
+
+		return replaceAttachments(ctx, tx, domain.AttachmentOwnerComment, id, nil)
+	})
+}
+
+func (r *CommentRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.Comment, error) {
+	query := `SELECT ` + commentColumns + ` FROM comments WHERE id = $1`
+
+	comment, err := scanComment(r.db.QueryRowContext(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to get comment: %w", err)
+	}
+
+	if err := r.loadAttachments(ctx, []*domain.Comment{comment}); err != nil {
+		return nil, err
+	}
+
+	return comment, nil
+}
+
+// ListByThread returns the comments of the assignment itself or, if submissionID
+// is set, of the submission, oldest first.
+func (r *CommentRepository) ListByThread(ctx context.Context, assignmentID uuid.UUID, submissionID *uuid.UUID) ([]*domain.Comment, error) {
+	query := `
+		SELECT ` + commentColumns + `
+		FROM comments
+		WHERE assignment_id = $1 AND submission_id IS NOT DISTINCT FROM $2
+		ORDER BY created_at, id
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, assignmentID, submissionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query comments: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var comments []*domain.Comment
+	for rows.Next() {
+		comment, err := scanComment(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan comment: %w", err)
+		}
+		comments = append(comments, comment)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	if err := r.loadAttachments(ctx, comments); err != nil {
+		return nil, err
+	}
+
+	return comments, nil
+}
+
+func (r *CommentRepository) loadAttachments(ctx context.Context, comments []*domain.Comment) error {
+	ids := make([]uuid.UUID, len(comments))
+	for i, c := range comments {
+		ids[i] = c.ID
+	}
+
+	attachments, err := listAttachments(ctx, r.db, domain.AttachmentOwnerComment, ids)
+	if err != nil {
+		return err
+	}
+
+	for _, c := range comments {
+		c.Attachments = attachments[c.ID]
+	}
+	return nil
+}
+
+func scanComment(row rowScanner) (*domain.Comment, error) {
+	var c domain.Comment
+	if err := row.Scan(
+		&c.ID,
+		&c.AssignmentID,
+		&c.SubmissionID,
+		&c.ParentID,
+		&c.AuthorID,
+		&c.Body,
+		&c.CreatedAt,
+		&c.EditedAt,
+		&c.DeletedAt,
+	); err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
@@ -0,0 +1,166 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"homework_service/internal/domain"
+)
+
+const exportColumns = `id, requested_by, tutor_id, student_id, range_from, range_to,
+status, file_id, error, created_at, started_at, finished_at`
+
+type ExportRepository struct {
+	db *sql.DB
+}
+
+func NewExportRepository(db *sql.DB) *ExportRepository {
+	return &ExportRepository{db: db}
+}
+
+func (r *ExportRepository) Create(ctx context.Context, export *domain.PortfolioExport) error {
+	query := `
+		INSERT INTO portfolio_exports
+			(id, requested_by, tutor_id, student_id, range_from, range_to, status, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+	`
+
+	id, err := uuid.NewV7()
+	if err != nil {
+		return fmt.Errorf("failed to generate UUID: %w", err)
+	}
+
+	now := time.Now()
+	_, err = r.db.ExecContext(ctx, query,
+		id,
+		export.RequestedBy,
+		export.TutorID,
+		export.StudentID,
+		export.From,
+		export.To,
+		domain.ExportStatusPending,
+		now,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create portfolio export: %w", err)
+	}
+
+	export.ID = id
+	export.Status = domain.ExportStatusPending
+	export.CreatedAt = now
+	return nil
+}
+
+func (r *ExportRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.PortfolioExport, error) {
+	query := `SELECT ` + exportColumns + ` FROM portfolio_exports WHERE id = $1`
+
+	export, err := scanExport(r.db.QueryRowContext(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to get portfolio export: %w", err)
+	}
+	return export, nil
+}
+
+// HasActive reports whether the user has a pending or running export.
+func (r *ExportRepository) HasActive(ctx context.Context, requestedBy uuid.UUID) (bool, error) {
+	query := `
+		SELECT EXISTS (
+			SELECT 1 FROM portfolio_exports
+			WHERE requested_by = $1 AND status IN ('pending', 'running')
+		)
+	`
+
+	var exists bool
+	if err := r.db.QueryRowContext(ctx, query, requestedBy).Scan(&exists); err != nil {
+		return false, fmt.Errorf("failed to check active portfolio exports: %w", err)
+	}
+	return exists, nil
+}
+
+// ClaimNext marks the oldest pending export as running and returns it. Exports that
+// have been running for longer than staleAfter are claimed again, since the worker
+// that ran them must have stopped. It returns nil if there is nothing to run.
+func (r *ExportRepository) ClaimNext(ctx context.Context, staleAfter time.Duration) (*domain.PortfolioExport, error) {
+	query := `
+		UPDATE portfolio_exports
+		SET status = 'running', started_at = NOW()
+		WHERE id = (
+			SELECT id FROM portfolio_exports
+			WHERE status = 'pending'
+				OR (status = 'running' AND started_at <= NOW() - make_interval(secs => $1))
+			ORDER BY created_at
+			LIMIT 1
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING ` + exportColumns
+
+	export, err := scanExport(r.db.QueryRowContext(ctx, query, staleAfter.Seconds()))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to claim portfolio export: %w", err)
+	}
+	return export, nil
+}
+
+// Complete marks the export as done with the stored archive.
+func (r *ExportRepository) Complete(ctx context.Context, id, fileID uuid.UUID) error {
+	query := `
+		UPDATE portfolio_exports
+		SET status = 'done', file_id = $2, error = NULL, finished_at = NOW()
+		WHERE id = $1
+	`
+
+	if _, err := r.db.ExecContext(ctx, query, id, fileID); err != nil {
+		return fmt.Errorf("failed to complete portfolio export: %w", err)
+	}
+	return nil
+}
+
+// Fail marks the export as failed with the reason.
+func (r *ExportRepository) Fail(ctx context.Context, id uuid.UUID, reason string) error {
+	query := `
+		UPDATE portfolio_exports
+		SET status = 'failed', error = $2, finished_at = NOW()
+		WHERE id = $1
+	`
+
+	if _, err := r.db.ExecContext(ctx, query, id, reason); err != nil {
+		return fmt.Errorf("failed to fail portfolio export: %w", err)
+	}
+	return nil
+}
+
+func scanExport(row rowScanner) (*domain.PortfolioExport, error) {
+	var (
+		e      domain.PortfolioExport
+		status string
+	)
+	err := row.Scan(
+		&e.ID,
+		&e.RequestedBy,
+		&e.TutorID,
+		&e.StudentID,
+		&e.From,
+		&e.To,
+		&status,
+		&e.FileID,
+		&e.Error,
+		&e.CreatedAt,
+		&e.StartedAt,
+		&e.FinishedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	e.Status = domain.ExportStatus(status)
+	return &e, nil
+}
@@ -10,7 +10,11 @@ import (
 	"homework_service/internal/domain"
 )
 
-var ErrNotFound = errors.New("not found")
+var (
+	ErrNotFound = errors.New("not found")
+	// ErrQuizSubmitted is returned when a quiz no longer accepts answers.
+	ErrQuizSubmitted = errors.New("quiz is already submitted")
+)
 
 type FeedbackRepository struct {
 	db *sql.DB
@@ -21,9 +25,31 @@ func NewFeedbackRepository(db *sql.DB) *FeedbackRepository {
 }
 
 func (r *FeedbackRepository) Create(ctx context.Context, feedback *domain.Feedback) error {
+	query := `SELECT assignment_id FROM submissions WHERE id = $1`
+
+	return withTx(ctx, r.db, func(tx *sql.Tx) error {
+		var assignmentID uuid.UUID
+		if err := tx.QueryRowContext(ctx, query, feedback.SubmissionID).Scan(&assignmentID); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return ErrNotFound
+			}
+			return err
+		}
+		if err := lockAssignment(ctx, tx, assignmentID); err != nil {
+			return err
+		}
+
+		if err := createFeedback(ctx, tx, feedback); err != nil {
+			return err
+		}
+		return refreshStatus(ctx, tx, assignmentID)
+	})
+}
+
+func createFeedback(ctx context.Context, tx *sql.Tx, feedback *domain.Feedback) error {
 	query := `
-		INSERT INTO feedbacks (id, submission_id, file_id, comment, created_at, edited_at)
-		VALUES ($1, $2, $3, $4, $5, $6)
+		INSERT INTO feedbacks (id, submission_id, file_id, comment, score, max_score, verdict, auto_graded, created_at, edited_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
 	`
 
 	id, err := uuid.NewV7()
@@ -31,54 +57,98 @@ func (r *FeedbackRepository) Create(ctx context.Context, feedback *domain.Feedba
 		return err
 	}
 
-	_, err = r.db.ExecContext(ctx, query,
+	_, err = tx.ExecContext(ctx, query,
 		id,
 		feedback.SubmissionID,
 		feedback.FileID,
 		feedback.Comment,
+		feedback.Score,
+		feedback.MaxScore,
+		feedback.Verdict,
+		feedback.AutoGraded,
 		time.Now(),
 		time.Now(),
 	)
-
 	if err != nil {
 		return err
 	}
 
+	if err := replaceAttachments(ctx, tx, domain.AttachmentOwnerFeedback, id, feedback.Attachments); err != nil {
+		return err
+	}
+	if err := replaceRubric(ctx, tx, id, feedback.Rubric); err != nil {
+		return err
+	}
+	if err := replaceAnnotations(ctx, tx, id, feedback.Annotations); err != nil {
+		return err
+	}
+
 	feedback.ID = id
 	return nil
 }
 
 func (r *FeedbackRepository) Update(ctx context.Context, feedback *domain.Feedback) error {
+	assignmentQuery := `
+		SELECT s.assignment_id
+		FROM feedbacks f
+		JOIN submissions s ON s.id = f.submission_id
+		WHERE f.id = $1
+	`
 	query := `
 		UPDATE feedbacks 
-		SET file_id = $1, comment = $2, edited_at = $3
-		WHERE id = $4
+		SET file_id = $1, comment = $2, score = $3, max_score = $4, verdict = $5, edited_at = $6
+		WHERE id = $7
 	`
 
-	result, err := r.db.ExecContext(ctx, query,
-		feedback.FileID,
-		feedback.Comment,
-		time.Now(),
-		feedback.ID,
-	)
-	if err != nil {
-		return err
-	}
+	return withTx(ctx, r.db, func(tx *sql.Tx) error {
+		var assignmentID uuid.UUID
+		if err := tx.QueryRowContext(ctx, assignmentQuery, feedback.ID).Scan(&assignmentID); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return ErrNotFound
+			}
+			return err
+		}
+		if err := lockAssignment(ctx, tx, assignmentID); err != nil {
+			return err
+		}
 
-	rows, err := result.RowsAffected()
-	if err != nil {
-		return err
-	}
-	if rows == 0 {
-		return ErrNotFound
-	}
+		result, err := tx.ExecContext(ctx, query,
+			feedback.FileID,
+			feedback.Comment,
+			feedback.Score,
+			feedback.MaxScore,
+			feedback.Verdict,
+			time.Now(),
+			feedback.ID,
+		)
+		if err != nil {
+			return err
+		}
 
-	return nil
+		rows, err := result.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if rows == 0 {
+			return ErrNotFound
+		}
+
+		if err := replaceAttachments(ctx, tx, domain.AttachmentOwnerFeedback, feedback.ID, feedback.Attachments); err != nil {
+			return err
+		}
+		if err := replaceRubric(ctx, tx, feedback.ID, feedback.Rubric); err != nil {
+			return err
+		}
+		if err := replaceAnnotations(ctx, tx, feedback.ID, feedback.Annotations); err != nil {
+			return err
+		}
+		return refreshStatus(ctx, tx, assignmentID)
+	})
 }
 
 func (r *FeedbackRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.Feedback, error) {
 	query := `
-		SELECT id, submission_id, file_id, comment, created_at, edited_at
+		SELECT id, submission_id, file_id, comment, score, max_score, verdict, created_at, edited_at
 		FROM feedbacks
 		WHERE id = $1
 	`
@@ -89,6 +159,9 @@ func (r *FeedbackRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain
 		&feedback.SubmissionID,
 		&feedback.FileID,
 		&feedback.Comment,
+		&feedback.Score,
+		&feedback.MaxScore,
+		&feedback.Verdict,
 		&feedback.CreatedAt,
 		&feedback.EditedAt,
 	)
@@ -100,16 +173,21 @@ func (r *FeedbackRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain
 		return nil, err
 	}
 
+	if err := r.loadDetails(ctx, []*domain.Feedback{&feedback}); err != nil {
+		return nil, err
+	}
+
 	return &feedback, nil
 }
 
 func (r *FeedbackRepository) ListByAssignment(ctx context.Context, assignmentId uuid.UUID) ([]*domain.Feedback, error) {
 	baseQuery := `
-		SELECT f.id, f.submission_id, f.file_id, f.comment, f.created_at, f.edited_at
+		SELECT f.id, f.submission_id, f.file_id, f.comment, f.score, f.max_score, f.verdict, f.created_at, f.edited_at
 		FROM feedbacks f
 		JOIN submissions s
 		ON s.id = f.submission_id
 		WHERE s.assignment_id = $1
+		ORDER BY s.version, f.created_at
 	`
 
 	rows, err := r.db.QueryContext(ctx, baseQuery, assignmentId)
@@ -126,6 +204,9 @@ func (r *FeedbackRepository) ListByAssignment(ctx context.Context, assignmentId
 			&feedback.SubmissionID,
 			&feedback.FileID,
 			&feedback.Comment,
+			&feedback.Score,
+			&feedback.MaxScore,
+			&feedback.Verdict,
 			&feedback.CreatedAt,
 			&feedback.EditedAt,
 		)
@@ -135,5 +216,109 @@ func (r *FeedbackRepository) ListByAssignment(ctx context.Context, assignmentId
 		feedbacks = append(feedbacks, &feedback)
 	}
 
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if err := r.loadDetails(ctx, feedbacks); err != nil {
+		return nil, err
+	}
+
 	return feedbacks, nil
 }
+
+// loadDetails loads attachments, rubrics and annotations of the feedbacks.
+func (r *FeedbackRepository) loadDetails(ctx context.Context, feedbacks []*domain.Feedback) error {
+	ids := make([]uuid.UUID, len(feedbacks))
+	for i, f := range feedbacks {
+		ids[i] = f.ID
+	}
+
+	attachments, err := listAttachments(ctx, r.db, domain.AttachmentOwnerFeedback, ids)
+	if err != nil {
+		return err
+	}
+
+	rubrics, err := listRubrics(ctx, r.db, ids)
+	if err != nil {
+		return err
+	}
+
+	annotations, err := listAnnotations(ctx, r.db, ids)
+	if err != nil {
+		return err
+	}
+
+	for _, f := range feedbacks {
+		f.Attachments = attachments[f.ID]
+		f.Rubric = rubrics[f.ID]
+		f.Annotations = annotations[f.ID]
+	}
+	return nil
+}
+
+// ListGraded returns the latest scored feedback of every assignment of the pair,
+// graded within [from, to) if the bounds are set, in grading order.
+func (r *FeedbackRepository) ListGraded(ctx context.Context, tutorID, studentID uuid.UUID, from, to *time.Time) ([]domain.GradebookEntry, error) {
+	query := `
+		SELECT assignment_id, title, due_date, feedback_id, graded_at, score, max_score, is_late
+		FROM (
+			SELECT DISTINCT ON (a.id)
+				a.id AS assignment_id, a.title, a.due_date,
+				f.id AS feedback_id, f.created_at AS graded_at, f.score, f.max_score, s.is_late
+			FROM assignments a
+			JOIN submissions s ON s.assignment_id = a.id
+			JOIN feedbacks f ON f.submission_id = s.id
+			WHERE a.tutor_id = $1 AND a.student_id = $2 AND f.score IS NOT NULL
+				AND a.deleted_at IS NULL
+			ORDER BY a.id, f.created_at DESC
+		) latest
+		WHERE ($3::timestamp IS NULL OR graded_at >= $3)
+		  AND ($4::timestamp IS NULL OR graded_at < $4)
+		ORDER BY graded_at
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, tutorID, studentID, from, to)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	var entries []domain.GradebookEntry
+	for rows.Next() {
+		var e domain.GradebookEntry
+		if err := rows.Scan(
+			&e.AssignmentID,
+			&e.Title,
+			&e.DueDate,
+			&e.FeedbackID,
+			&e.GradedAt,
+			&e.Score,
+			&e.MaxScore,
+			&e.IsLate,
+		); err != nil {
+			return nil, err
+		}
+		entries = append(entries, e)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	ids := make([]uuid.UUID, len(entries))
+	for i, e := range entries {
+		ids[i] = e.FeedbackID
+	}
+
+	rubrics, err := listRubrics(ctx, r.db, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range entries {
+		entries[i].Rubric = rubrics[entries[i].FeedbackID]
+	}
+
+	return entries, nil
+}
@@ -0,0 +1,191 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"homework_service/internal/domain"
+)
+
+// replaceQuiz replaces the quiz questions of the assignment, keeping their order.
+func replaceQuiz(ctx context.Context, tx *sql.Tx, assignmentID uuid.UUID, questions []domain.QuizQuestion) error {
+	if _, err := tx.ExecContext(ctx, `DELETE FROM quiz_questions WHERE assignment_id = $1`, assignmentID); err != nil {
+		return fmt.Errorf("failed to delete quiz questions: %w", err)
+	}
+
+	query := `
+		INSERT INTO quiz_questions
+			(assignment_id, position, type, text, options, points, correct_options, numeric_answer, tolerance, text_answers)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+	`
+	for i, q := range questions {
+		_, err := tx.ExecContext(ctx, query,
+			assignmentID,
+			i,
+			q.Type,
+			q.Text,
+			pq.StringArray(q.Options),
+			q.Points,
+			toInt64Array(q.CorrectOptions),
+			q.NumericAnswer,
+			q.Tolerance,
+			pq.StringArray(q.TextAnswers),
+		)
+		if err != nil {
+			return fmt.Errorf("failed to create quiz question: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// listQuizzes returns the ordered quiz questions of the given assignments keyed by assignment ID.
+func listQuizzes(ctx context.Context, q queryer, assignmentIDs []uuid.UUID) (map[uuid.UUID][]domain.QuizQuestion, error) {
+	result := make(map[uuid.UUID][]domain.QuizQuestion, len(assignmentIDs))
+	if len(assignmentIDs) == 0 {
+		return result, nil
+	}
+
+	query := `
+		SELECT assignment_id, type, text, options, points, correct_options, numeric_answer, tolerance, text_answers
+		FROM quiz_questions
+		WHERE assignment_id = ANY($1::uuid[])
+		ORDER BY assignment_id, position
+	`
+
+	rows, err := q.QueryContext(ctx, query, pq.Array(uuidStrings(assignmentIDs)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to query quiz questions: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	for rows.Next() {
+		var assignmentID uuid.UUID
+		var question domain.QuizQuestion
+		var options, textAnswers pq.StringArray
+		var correctOptions pq.Int64Array
+		if err := rows.Scan(
+			&assignmentID,
+			&question.Type,
+			&question.Text,
+			&options,
+			&question.Points,
+			&correctOptions,
+			&question.NumericAnswer,
+			&question.Tolerance,
+			&textAnswers,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan quiz question: %w", err)
+		}
+		question.Options = options
+		question.CorrectOptions = fromInt64Array(correctOptions)
+		question.TextAnswers = textAnswers
+		result[assignmentID] = append(result[assignmentID], question)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	return result, nil
+}
+
+// createAnswers stores the graded quiz answers of a new submission.
+func createAnswers(ctx context.Context, tx *sql.Tx, submissionID uuid.UUID, answers []domain.QuizAnswer) error {
+	query := `
+		INSERT INTO quiz_answers (submission_id, question, options, number, text, correct, score)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+	`
+	for _, a := range answers {
+		_, err := tx.ExecContext(ctx, query,
+			submissionID,
+			a.Question,
+			toInt64Array(a.Options),
+			a.Number,
+			a.Text,
+			a.Correct,
+			a.Score,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to create quiz answer: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// listAnswers returns the quiz answers of the given submissions ordered by question and keyed by submission ID.
+func listAnswers(ctx context.Context, q queryer, submissionIDs []uuid.UUID) (map[uuid.UUID][]domain.QuizAnswer, error) {
+	result := make(map[uuid.UUID][]domain.QuizAnswer, len(submissionIDs))
+	if len(submissionIDs) == 0 {
+		return result, nil
+	}
+
+	query := `
+		SELECT submission_id, question, options, number, text, correct, score
+		FROM quiz_answers
+		WHERE submission_id = ANY($1::uuid[])
+		ORDER BY submission_id, question
+	`
+
+	rows, err := q.QueryContext(ctx, query, pq.Array(uuidStrings(submissionIDs)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to query quiz answers: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	for rows.Next() {
+		var submissionID uuid.UUID
+		var answer domain.QuizAnswer
+		var options pq.Int64Array
+		if err := rows.Scan(
+			&submissionID,
+			&answer.Question,
+			&options,
+			&answer.Number,
+			&answer.Text,
+			&answer.Correct,
+			&answer.Score,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan quiz answer: %w", err)
+		}
+		answer.Options = fromInt64Array(options)
+		result[submissionID] = append(result[submissionID], answer)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	return result, nil
+}
+
+func uuidStrings(ids []uuid.UUID) []string {
+	result := make([]string, len(ids))
+	for i, id := range ids {
+		result[i] = id.String()
+	}
+	return result
+}
+
+func toInt64Array(values []int) pq.Int64Array {
+	result := make(pq.Int64Array, len(values))
+	for i, v := range values {
+		result[i] = int64(v)
+	}
+	return result
+}
+
+func fromInt64Array(values pq.Int64Array) []int {
+	if len(values) == 0 {
+		return nil
+	}
+	result := make([]int, len(values))
+	for i, v := range values {
+		result[i] = int(v)
+	}
+	return result
+}
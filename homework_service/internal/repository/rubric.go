@@ -0,0 +1,71 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"homework_service/internal/domain"
+)
+
+// replaceRubric replaces the rubric of the feedback, keeping the order of the criteria.
+func replaceRubric(ctx context.Context, tx *sql.Tx, feedbackID uuid.UUID, rubric []domain.RubricCriterion) error {
+	if _, err := tx.ExecContext(ctx, `DELETE FROM feedback_rubric_criteria WHERE feedback_id = $1`, feedbackID); err != nil {
+		return fmt.Errorf("failed to delete rubric: %w", err)
+	}
+
+	query := `
+		INSERT INTO feedback_rubric_criteria (feedback_id, position, name, score, max_score, comment)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`
+	for i, c := range rubric {
+		if _, err := tx.ExecContext(ctx, query, feedbackID, i, c.Name, c.Score, c.MaxScore, c.Comment); err != nil {
+			return fmt.Errorf("failed to create rubric criterion: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// listRubrics returns the ordered rubric criteria of the given feedbacks keyed by feedback ID.
+func listRubrics(ctx context.Context, q queryer, feedbackIDs []uuid.UUID) (map[uuid.UUID][]domain.RubricCriterion, error) {
+	result := make(map[uuid.UUID][]domain.RubricCriterion, len(feedbackIDs))
+	if len(feedbackIDs) == 0 {
+		return result, nil
+	}
+
+	ids := make([]string, len(feedbackIDs))
+	for i, id := range feedbackIDs {
+		ids[i] = id.String()
+	}
+
+	query := `
+		SELECT feedback_id, name, score, max_score, comment
+		FROM feedback_rubric_criteria
+		WHERE feedback_id = ANY($1::uuid[])
+		ORDER BY feedback_id, position
+	`
+
+	rows, err := q.QueryContext(ctx, query, pq.Array(ids))
+	if err != nil {
+		return nil, fmt.Errorf("failed to query rubric: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	for rows.Next() {
+		var feedbackID uuid.UUID
+		var c domain.RubricCriterion
+		if err := rows.Scan(&feedbackID, &c.Name, &c.Score, &c.MaxScore, &c.Comment); err != nil {
+			return nil, fmt.Errorf("failed to scan rubric criterion: %w", err)
+		}
+		result[feedbackID] = append(result[feedbackID], c)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	return result, nil
+}
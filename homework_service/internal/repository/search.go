@@ -0,0 +1,140 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+
+	"homework_service/internal/domain"
+)
+
+// Highlighted words are wrapped in control characters, the service turns them into
+// markup after escaping the text.
+const (
+	HighlightStart = "\x02"
+	HighlightStop  = "\x03"
+)
+
+const headlineOptions = "StartSel=" + HighlightStart + ", StopSel=" + HighlightStop +
+	", MaxWords=35, MinWords=15, MaxFragments=2, FragmentDelimiter=\" … \""
+
+// searchQuery ranks matching assignments, submissions and feedbacks of the assignments
+// the user can see: all of the user's as a tutor and published ones as a student.
+// Snippets are built for the requested page only, ts_headline is expensive. The
+// russian configuration stems ASCII words with the english stemmer, so it highlights
+// matches of both languages.
+const searchQuery = `
+	WITH q AS (
+		SELECT websearch_to_tsquery('russian', $1) || websearch_to_tsquery('english', $1) AS query
+	),
+	scope AS (
+		SELECT id, tutor_id, student_id, title
+		FROM assignments
+		WHERE (tutor_id = $2 OR (student_id = $2 AND state = 'published'))
+		  AND deleted_at IS NULL
+		  AND ($3::uuid IS NULL OR tutor_id = $3)
+		  AND ($4::uuid IS NULL OR student_id = $4)
+	),
+	hits AS (
+		SELECT 'assignment' AS type, a.id, a.id AS assignment_id, a.created_at,
+			ts_rank(a.search_vector, q.query) AS rank,
+			concat_ws(E'\n', a.title, a.description) AS body
+		FROM assignments a
+		JOIN scope ON scope.id = a.id, q
+		WHERE a.search_vector @@ q.query
+		UNION ALL
+		SELECT 'submission', s.id, s.assignment_id, s.created_at,
+			ts_rank(s.search_vector, q.query), s.comment
+		FROM submissions s
+		JOIN scope ON scope.id = s.assignment_id, q
+		WHERE s.search_vector @@ q.query
+		UNION ALL
+		SELECT 'feedback', f.id, s.assignment_id, f.created_at,
+			ts_rank(f.search_vector, q.query), f.comment
+		FROM feedbacks f
+		JOIN submissions s ON s.id = f.submission_id
+		JOIN scope ON scope.id = s.assignment_id, q
+		WHERE f.search_vector @@ q.query
+	),
+	page AS (
+		SELECT * FROM hits
+		WHERE ($5::text[] IS NULL OR type = ANY($5))
+		  AND ($6::timestamp IS NULL OR created_at >= $6)
+		  AND ($7::timestamp IS NULL OR created_at < $7)
+		ORDER BY rank DESC, created_at DESC, id
+		LIMIT $8 OFFSET $9
+	)
+	SELECT page.type, page.id, page.assignment_id, scope.tutor_id, scope.student_id, scope.title,
+		ts_headline('russian', page.body, q.query, $10), page.rank, page.created_at
+	FROM page
+	JOIN scope ON scope.id = page.assignment_id, q
+	ORDER BY page.rank DESC, page.created_at DESC, page.id
+`
+
+type SearchRepository struct {
+	db *sql.DB
+}
+
+func NewSearchRepository(db *sql.DB) *SearchRepository {
+	return &SearchRepository{db: db}
+}
+
+func (r *SearchRepository) Search(ctx context.Context, filter domain.SearchFilter) ([]domain.SearchHit, error) {
+	var types []string
+	for _, t := range filter.Types {
+		types = append(types, string(t))
+	}
+
+	rows, err := r.db.QueryContext(ctx, searchQuery,
+		filter.Query,
+		filter.UserID,
+		nullUUID(filter.TutorID),
+		nullUUID(filter.StudentID),
+		pq.StringArray(types),
+		filter.From,
+		filter.To,
+		filter.Limit,
+		filter.Offset,
+		headlineOptions,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search homework: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var hits []domain.SearchHit
+	for rows.Next() {
+		var h domain.SearchHit
+		if err := rows.Scan(
+			&h.Type,
+			&h.ID,
+			&h.AssignmentID,
+			&h.TutorID,
+			&h.StudentID,
+			&h.AssignmentTitle,
+			&h.Snippet,
+			&h.Rank,
+			&h.CreatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan search hit: %w", err)
+		}
+		hits = append(hits, h)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	return hits, nil
+}
+
+// nullUUID turns uuid.Nil into NULL.
+func nullUUID(id uuid.UUID) *uuid.UUID {
+	if id == uuid.Nil {
+		return nil
+	}
+	return &id
+}
@@ -0,0 +1,131 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"homework_service/internal/domain"
+)
+
+// statsScope selects the tutor's published assignments created within [$2, $3),
+// where the bounds are optional.
+const statsScope = `
+	a.tutor_id = $1 AND a.state = 'published' AND a.deleted_at IS NULL
+	AND ($2::timestamp IS NULL OR a.created_at >= $2)
+	AND ($3::timestamp IS NULL OR a.created_at < $3)
+`
+
+// ListStudentStats counts assigned, submitted, due and on-time assignments of every
+// student of the tutor in the period. A submission is on time if the first one was
+// made before the due date plus the grace period.
+func (r *FeedbackRepository) ListStudentStats(ctx context.Context, tutorID uuid.UUID, from, to *time.Time) ([]domain.StudentHomeworkStats, error) {
+	query := `
+		WITH scope AS (
+			SELECT
+				a.student_id,
+				a.due_date + COALESCE(a.grace_period_seconds, 0) * INTERVAL '1 second' AS deadline,
+				(SELECT MIN(s.created_at) FROM submissions s WHERE s.assignment_id = a.id) AS first_submitted_at
+			FROM assignments a
+			WHERE ` + statsScope + `
+		)
+		SELECT
+			student_id,
+			COUNT(*),
+			COUNT(first_submitted_at),
+			COUNT(*) FILTER (WHERE first_submitted_at IS NOT NULL OR deadline <= NOW()),
+			COUNT(*) FILTER (WHERE first_submitted_at IS NOT NULL AND (deadline IS NULL OR first_submitted_at <= deadline))
+		FROM scope
+		GROUP BY student_id
+		ORDER BY student_id
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, tutorID, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query student stats: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var stats []domain.StudentHomeworkStats
+	for rows.Next() {
+		var s domain.StudentHomeworkStats
+		if err := rows.Scan(&s.StudentID, &s.Assigned, &s.Submitted, &s.Due, &s.OnTime); err != nil {
+			return nil, fmt.Errorf("failed to scan student stats: %w", err)
+		}
+		stats = append(stats, s)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	return stats, nil
+}
+
+// GetReviewTurnaround returns the median time from a submission to its first feedback
+// over submissions of the tutor's assignments in the period, and the number of such
+// reviewed submissions. The median is nil if none are reviewed. Auto-graded quiz
+// feedback is not a review and is skipped.
+func (r *FeedbackRepository) GetReviewTurnaround(ctx context.Context, tutorID uuid.UUID, from, to *time.Time) (*time.Duration, int, error) {
+	query := `
+		SELECT
+			percentile_cont(0.5) WITHIN GROUP (ORDER BY EXTRACT(EPOCH FROM f.reviewed_at - s.created_at)),
+			COUNT(*)
+		FROM submissions s
+		JOIN assignments a ON a.id = s.assignment_id
+		JOIN LATERAL (
+			SELECT MIN(created_at) AS reviewed_at FROM feedbacks WHERE submission_id = s.id AND NOT auto_graded
+		) f ON f.reviewed_at IS NOT NULL
+		WHERE ` + statsScope
+
+	var (
+		median sql.NullFloat64
+		count  int
+	)
+	if err := r.db.QueryRowContext(ctx, query, tutorID, from, to).Scan(&median, &count); err != nil {
+		return nil, 0, fmt.Errorf("failed to query review turnaround: %w", err)
+	}
+	if !median.Valid {
+		return nil, count, nil
+	}
+	turnaround := time.Duration(median.Float64 * float64(time.Second))
+	return &turnaround, count, nil
+}
+
+// CountUnreviewed returns the current number of the tutor's published UNREVIEWED
+// assignments by student.
+func (r *FeedbackRepository) CountUnreviewed(ctx context.Context, tutorID uuid.UUID) (map[uuid.UUID]int, error) {
+	query := `
+		SELECT student_id, COUNT(*)
+		FROM assignments
+		WHERE tutor_id = $1 AND status = 'UNREVIEWED' AND state = 'published'
+			AND deleted_at IS NULL
+		GROUP BY student_id
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, tutorID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count unreviewed assignments: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	counts := make(map[uuid.UUID]int)
+	for rows.Next() {
+		var (
+			studentID uuid.UUID
+			count     int
+		)
+		if err := rows.Scan(&studentID, &count); err != nil {
+			return nil, fmt.Errorf("failed to scan unreviewed count: %w", err)
+		}
+		counts[studentID] = count
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	return counts, nil
+}
@@ -0,0 +1,99 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/golang-migrate/migrate/v4"
+	"github.com/golang-migrate/migrate/v4/database/postgres"
+	_ "github.com/golang-migrate/migrate/v4/source/file"
+	"github.com/google/uuid"
+	_ "github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"homework_service/internal/domain"
+)
+
+// newTestDB connects to the database from HOMEWORK_TEST_POSTGRES_URL and migrates it.
+// The test is skipped when the variable is not set.
+func newTestDB(t *testing.T) *sql.DB {
+	t.Helper()
+
+	url := os.Getenv("HOMEWORK_TEST_POSTGRES_URL")
+	if url == "" {
+		t.Skip("HOMEWORK_TEST_POSTGRES_URL is not set")
+	}
+
+	db, err := sql.Open("postgres", url)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+
+	driver, err := postgres.WithInstance(db, &postgres.Config{})
+	require.NoError(t, err)
+	m, err := migrate.NewWithDatabaseInstance("file://../../migrations", "postgres", driver)
+	require.NoError(t, err)
+	if err := m.Up(); err != nil && !errors.Is(err, migrate.ErrNoChange) {
+		require.NoError(t, err)
+	}
+
+	return db
+}
+
+func TestGetReviewTurnaround(t *testing.T) {
+	db := newTestDB(t)
+	ctx := context.Background()
+
+	assignments := NewAssignmentRepository(db)
+	submissions := NewSubmissionRepository(db)
+	feedbacks := NewFeedbackRepository(db)
+
+	tutorID := uuid.New()
+	quiz := &domain.Assignment{
+		TutorID:    tutorID,
+		StudentID:  uuid.New(),
+		LatePolicy: domain.LatePolicyFlag,
+		State:      domain.AssignmentStatePublished,
+		Quiz: []domain.QuizQuestion{
+			{Type: domain.QuizQuestionSingleChoice, Options: []string{"3", "4"}, CorrectOptions: []int{1}, Points: 1},
+		},
+	}
+	require.NoError(t, assignments.Create(ctx, quiz))
+
+	score, maxScore := 1.0, 1.0
+	submission := &domain.Submission{
+		AssignmentID: quiz.ID,
+		Answers:      []domain.QuizAnswer{{Question: 0, Options: []int{1}, Correct: true, Score: 1}},
+	}
+	require.NoError(t, submissions.CreateGraded(ctx, submission, &domain.Feedback{
+		Score:      &score,
+		MaxScore:   &maxScore,
+		Verdict:    domain.FeedbackVerdictAccepted,
+		AutoGraded: true,
+	}))
+
+	t.Run("auto-graded quiz is not reviewed", func(t *testing.T) {
+		turnaround, reviewed, err := feedbacks.GetReviewTurnaround(ctx, tutorID, nil, nil)
+		require.NoError(t, err)
+		assert.Nil(t, turnaround)
+		assert.Zero(t, reviewed)
+	})
+
+	t.Run("tutor feedback on a quiz is a review", func(t *testing.T) {
+		comment := "well done"
+		require.NoError(t, feedbacks.Create(ctx, &domain.Feedback{
+			SubmissionID: submission.ID,
+			Comment:      &comment,
+			Verdict:      domain.FeedbackVerdictAccepted,
+		}))
+
+		turnaround, reviewed, err := feedbacks.GetReviewTurnaround(ctx, tutorID, nil, nil)
+		require.NoError(t, err)
+		require.NotNil(t, turnaround)
+		assert.Positive(t, *turnaround)
+		assert.Equal(t, 1, reviewed)
+	})
+}
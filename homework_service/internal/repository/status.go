@@ -0,0 +1,77 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+type execer interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+}
+
+// refreshStatusQuery recomputes the stored status of an assignment from its latest
+// submission and the latest feedback on it. Without submissions the assignment is
+// UNSENT until its due date and OVERDUE after it. The lateness of the latest
+// submission is copied along, so listings do not have to look it up.
+const refreshStatusQuery = `
+	UPDATE assignments a
+	SET status = COALESCE(
+		(
+			SELECT CASE
+				WHEN f.verdict IS NULL THEN 'UNREVIEWED'
+				WHEN f.verdict = 'needs_revision' THEN 'NEEDS_REVISION'
+				ELSE 'REVIEWED'
+			END
+			FROM (
+				SELECT id FROM submissions
+				WHERE assignment_id = a.id
+				ORDER BY version DESC
+				LIMIT 1
+			) s
+			LEFT JOIN LATERAL (
+				SELECT verdict FROM feedbacks
+				WHERE submission_id = s.id
+				ORDER BY created_at DESC
+				LIMIT 1
+			) f ON TRUE
+		),
+		CASE WHEN a.due_date <= NOW() THEN 'OVERDUE' ELSE 'UNSENT' END
+	),
+	submitted_late = COALESCE(
+		(
+			SELECT is_late FROM submissions
+			WHERE assignment_id = a.id
+			ORDER BY version DESC
+			LIMIT 1
+		),
+		FALSE
+	)
+	WHERE a.id = $1
+`
+
+// refreshStatus must run after the writes that change the status, in the same
+// transaction. Writers lock the assignment row first, so concurrent refreshes
+// see each other's submissions and feedbacks.
+func refreshStatus(ctx context.Context, e execer, assignmentID uuid.UUID) error {
+	if _, err := e.ExecContext(ctx, refreshStatusQuery, assignmentID); err != nil {
+		return fmt.Errorf("failed to refresh assignment status: %w", err)
+	}
+	return nil
+}
+
+// lockAssignment locks the assignment row until the end of the transaction.
+func lockAssignment(ctx context.Context, tx *sql.Tx, assignmentID uuid.UUID) error {
+	var id uuid.UUID
+	err := tx.QueryRowContext(ctx, `SELECT id FROM assignments WHERE id = $1 FOR UPDATE`, assignmentID).Scan(&id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrNotFound
+		}
+		return err
+	}
+	return nil
+}
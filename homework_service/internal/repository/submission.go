@@ -19,9 +19,18 @@ func NewSubmissionRepository(db *sql.DB) *SubmissionRepository {
 }
 
 func (r *SubmissionRepository) Create(ctx context.Context, submission *domain.Submission) error {
+	return r.CreateGraded(ctx, submission, nil)
+}
+
+// CreateGraded creates the submission together with its quiz answers and, if set,
+// the feedback grading it, so an auto-graded submission is never left unreviewed.
+func (r *SubmissionRepository) CreateGraded(ctx context.Context, submission *domain.Submission, feedback *domain.Feedback) error {
 	query := `
-		INSERT INTO submissions (id, assignment_id, file_id, comment, created_at, edited_at)
-		VALUES ($1, $2, $3, $4, $5, $6)
+		INSERT INTO submissions (id, assignment_id, version, file_id, comment, created_at, edited_at, is_late)
+		SELECT $1, $2, COALESCE(MAX(version), 0) + 1, $3, $4, $5, $6, $7
+		FROM submissions
+		WHERE assignment_id = $2
+		RETURNING version
 	`
 
 	id, err := uuid.NewV7()
@@ -29,15 +38,50 @@ func (r *SubmissionRepository) Create(ctx context.Context, submission *domain.Su
 		return err
 	}
 
-	_, err = r.db.ExecContext(ctx, query,
-		id,
-		submission.AssignmentID,
-		submission.FileID,
-		submission.Comment,
-		time.Now(),
-		time.Now(),
-	)
+	err = withTx(ctx, r.db, func(tx *sql.Tx) error {
+		// The assignment row is locked so that concurrent submissions get consecutive versions.
+		if err := lockAssignment(ctx, tx, submission.AssignmentID); err != nil {
+			return err
+		}
+		// The status is checked again under the lock: a concurrent submission of the
+		// same quiz may have been committed since the service checked it.
+		var status string
+		err := tx.QueryRowContext(ctx, `SELECT status FROM assignments WHERE id = $1`, submission.AssignmentID).Scan(&status)
+		if err != nil {
+			return err
+		}
+		if !domain.ToAssignmentStatus(status).AcceptsQuizAnswers() {
+			return ErrQuizSubmitted
+		}
 
+		err = tx.QueryRowContext(ctx, query,
+			id,
+			submission.AssignmentID,
+			submission.FileID,
+			submission.Comment,
+			time.Now(),
+			time.Now(),
+			submission.IsLate,
+		).Scan(&submission.Version)
+		if err != nil {
+			return err
+		}
+
+		if err := replaceAttachments(ctx, tx, domain.AttachmentOwnerSubmission, id, submission.Attachments); err != nil {
+			return err
+		}
+		if err := createAnswers(ctx, tx, id, submission.Answers); err != nil {
+			return err
+		}
+
+		if feedback != nil {
+			feedback.SubmissionID = id
+			if err := createFeedback(ctx, tx, feedback); err != nil {
+				return err
+			}
+		}
+		return refreshStatus(ctx, tx, submission.AssignmentID)
+	})
 	if err != nil {
 		return err
 	}
@@ -48,7 +92,7 @@ func (r *SubmissionRepository) Create(ctx context.Context, submission *domain.Su
 
 func (r *SubmissionRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.Submission, error) {
 	query := `
-		SELECT id, assignment_id, file_id, comment, created_at, edited_at
+		SELECT id, assignment_id, version, file_id, comment, created_at, edited_at, is_late
 		FROM submissions
 		WHERE id = $1
 	`
@@ -57,10 +101,12 @@ func (r *SubmissionRepository) GetByID(ctx context.Context, id uuid.UUID) (*doma
 	err := r.db.QueryRowContext(ctx, query, id).Scan(
 		&submission.ID,
 		&submission.AssignmentID,
+		&submission.Version,
 		&submission.FileID,
 		&submission.Comment,
 		&submission.CreatedAt,
 		&submission.EditedAt,
+		&submission.IsLate,
 	)
 
 	if err != nil {
@@ -70,14 +116,19 @@ func (r *SubmissionRepository) GetByID(ctx context.Context, id uuid.UUID) (*doma
 		return nil, err
 	}
 
+	if err := r.loadDetails(ctx, []*domain.Submission{&submission}); err != nil {
+		return nil, err
+	}
+
 	return &submission, nil
 }
 
 func (r *SubmissionRepository) ListByAssignment(ctx context.Context, assignmentId uuid.UUID) ([]*domain.Submission, error) {
 	query := `
-		SELECT id, assignment_id, file_id, comment, created_at, edited_at
+		SELECT id, assignment_id, version, file_id, comment, created_at, edited_at, is_late
 		FROM submissions
 		WHERE assignment_id = $1
+		ORDER BY version
 	`
 
 	rows, err := r.db.QueryContext(ctx, query, assignmentId)
@@ -92,10 +143,12 @@ func (r *SubmissionRepository) ListByAssignment(ctx context.Context, assignmentI
 		err := rows.Scan(
 			&submission.ID,
 			&submission.AssignmentID,
+			&submission.Version,
 			&submission.FileID,
 			&submission.Comment,
 			&submission.CreatedAt,
 			&submission.EditedAt,
+			&submission.IsLate,
 		)
 		if err != nil {
 			return nil, err
@@ -103,5 +156,37 @@ func (r *SubmissionRepository) ListByAssignment(ctx context.Context, assignmentI
 		submissions = append(submissions, &submission)
 	}
 
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if err := r.loadDetails(ctx, submissions); err != nil {
+		return nil, err
+	}
+
 	return submissions, nil
 }
+
+// loadDetails loads attachments and quiz answers of the submissions.
+func (r *SubmissionRepository) loadDetails(ctx context.Context, submissions []*domain.Submission) error {
+	ids := make([]uuid.UUID, len(submissions))
+	for i, s := range submissions {
+		ids[i] = s.ID
+	}
+
+	attachments, err := listAttachments(ctx, r.db, domain.AttachmentOwnerSubmission, ids)
+	if err != nil {
+		return err
+	}
+
+	answers, err := listAnswers(ctx, r.db, ids)
+	if err != nil {
+		return err
+	}
+
+	for _, s := range submissions {
+		s.Attachments = attachments[s.ID]
+		s.Answers = answers[s.ID]
+	}
+	return nil
+}
@@ -0,0 +1,270 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"homework_service/internal/domain"
+)
+
+type TemplateRepository struct {
+	db *sql.DB
+}
+
+func NewTemplateRepository(db *sql.DB) *TemplateRepository {
+	return &TemplateRepository{db: db}
+}
+
+func (r *TemplateRepository) Create(ctx context.Context, template *domain.AssignmentTemplate) error {
+	query := `
+		INSERT INTO assignment_templates
+			(id, tutor_id, title, description, due_offset_seconds, created_at, edited_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+	`
+
+	id, err := uuid.NewV7()
+	if err != nil {
+		return fmt.Errorf("failed to generate UUID: %w", err)
+	}
+
+	now := time.Now()
+	err = withTx(ctx, r.db, func(tx *sql.Tx) error {
+		_, err := tx.ExecContext(ctx, query,
+			id,
+			template.TutorID,
+			template.Title,
+			template.Description,
+			durationToSeconds(template.DueOffset),
+			now,
+			now,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to create template: %w", err)
+		}
+
+		return replaceAttachments(ctx, tx, domain.AttachmentOwnerTemplate, id, template.Attachments)
+	})
+	if err != nil {
+		return err
+	}
+
+	template.ID = id
+	template.CreatedAt = now
+	template.EditedAt = now
+	return nil
+}
+
+func (r *TemplateRepository) Update(ctx context.Context, template *domain.AssignmentTemplate) error {
+	query := `
+		UPDATE assignment_templates
+		SET title = $1, description = $2, due_offset_seconds = $3, edited_at = $4
+		WHERE id = $5
+	`
+
+	now := time.Now()
+	err := withTx(ctx, r.db, func(tx *sql.Tx) error {
+		result, err := tx.ExecContext(ctx, query,
+			template.Title,
+			template.Description,
+			durationToSeconds(template.DueOffset),
+			now,
+			template.ID,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to update template: %w", err)
+		}
+
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("failed to get rows affected: %w", err)
+		}
+		if rowsAffected == 0 {
+			return ErrNotFound
+		}
+
+		return replaceAttachments(ctx, tx, domain.AttachmentOwnerTemplate, template.ID, template.Attachments)
+	})
+	if err != nil {
+		return err
+	}
+
+	template.EditedAt = now
+	return nil
+}
+
+func (r *TemplateRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.AssignmentTemplate, error) {
+	query := `
+		SELECT id, tutor_id, title, description, due_offset_seconds, created_at, edited_at
+		FROM assignment_templates
+		WHERE id = $1
+	`
+
+	template, err := scanTemplate(r.db.QueryRowContext(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to get template: %w", err)
+	}
+
+	if err := r.loadAttachments(ctx, []*domain.AssignmentTemplate{template}); err != nil {
+		return nil, err
+	}
+
+	return template, nil
+}
+
+func (r *TemplateRepository) ListByTutor(ctx context.Context, tutorID uuid.UUID) ([]*domain.AssignmentTemplate, error) {
+	query := `
+		SELECT id, tutor_id, title, description, due_offset_seconds, created_at, edited_at
+		FROM assignment_templates
+		WHERE tutor_id = $1
+		ORDER BY created_at DESC
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, tutorID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query templates: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var templates []*domain.AssignmentTemplate
+	for rows.Next() {
+		template, err := scanTemplate(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan template: %w", err)
+		}
+		templates = append(templates, template)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	if err := r.loadAttachments(ctx, templates); err != nil {
+		return nil, err
+	}
+
+	return templates, nil
+}
+
+func (r *TemplateRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM assignment_templates WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete template: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *TemplateRepository) loadAttachments(ctx context.Context, templates []*domain.AssignmentTemplate) error {
+	ids := make([]uuid.UUID, len(templates))
+	for i, t := range templates {
+		ids[i] = t.ID
+	}
+
+	attachments, err := listAttachments(ctx, r.db, domain.AttachmentOwnerTemplate, ids)
+	if err != nil {
+		return err
+	}
+
+	for _, t := range templates {
+		t.Attachments = attachments[t.ID]
+	}
+	return nil
+}
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanTemplate(row rowScanner) (*domain.AssignmentTemplate, error) {
+	var template domain.AssignmentTemplate
+	var dueOffsetSeconds sql.NullInt64
+	if err := row.Scan(
+		&template.ID,
+		&template.TutorID,
+		&template.Title,
+		&template.Description,
+		&dueOffsetSeconds,
+		&template.CreatedAt,
+		&template.EditedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	if dueOffsetSeconds.Valid {
+		offset := time.Duration(dueOffsetSeconds.Int64) * time.Second
+		template.DueOffset = &offset
+	}
+	return &template, nil
+}
+
+func durationToSeconds(d *time.Duration) *int64 {
+	if d == nil {
+		return nil
+	}
+	seconds := int64(d.Seconds())
+	return &seconds
+}
+
+// SetPairDefault stores the template assigned to the student after each completed
+// lesson with the tutor. A nil template removes the default.
+func (r *TemplateRepository) SetPairDefault(ctx context.Context, tutorID, studentID uuid.UUID, templateID *uuid.UUID) error {
+	if templateID == nil {
+		_, err := r.db.ExecContext(ctx,
+			`DELETE FROM pair_default_templates WHERE tutor_id = $1 AND student_id = $2`,
+			tutorID, studentID,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to clear default template: %w", err)
+		}
+		return nil
+	}
+
+	query := `
+		INSERT INTO pair_default_templates (tutor_id, student_id, template_id)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (tutor_id, student_id) DO UPDATE SET template_id = EXCLUDED.template_id
+	`
+	if _, err := r.db.ExecContext(ctx, query, tutorID, studentID, *templateID); err != nil {
+		return fmt.Errorf("failed to set default template: %w", err)
+	}
+	return nil
+}
+
+// GetPairDefault returns the pair's default template or ErrNotFound if none is set.
+func (r *TemplateRepository) GetPairDefault(ctx context.Context, tutorID, studentID uuid.UUID) (*domain.AssignmentTemplate, error) {
+	query := `
+		SELECT t.id, t.tutor_id, t.title, t.description, t.due_offset_seconds, t.created_at, t.edited_at
+		FROM pair_default_templates d
+		JOIN assignment_templates t ON t.id = d.template_id
+		WHERE d.tutor_id = $1 AND d.student_id = $2
+	`
+
+	template, err := scanTemplate(r.db.QueryRowContext(ctx, query, tutorID, studentID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to get default template: %w", err)
+	}
+
+	if err := r.loadAttachments(ctx, []*domain.AssignmentTemplate{template}); err != nil {
+		return nil, err
+	}
+
+	return template, nil
+}
@@ -0,0 +1,110 @@
+package homework_grpc
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"homework_service/internal/domain"
+	v1 "homework_service/pkg/api"
+)
+
+func (h *HomeworkHandler) FlattenFeedbackAnnotations(ctx context.Context, req *v1.FlattenFeedbackAnnotationsRequest) (*v1.AnnotatedFile, error) {
+	feedbackId, err := uuid.Parse(req.FeedbackId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	fileId, err := uuid.Parse(req.FileId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	file, err := h.feedbackService.FlattenAnnotations(ctx, feedbackId, fileId)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return &v1.AnnotatedFile{
+		FileId: file.FileID.String(),
+		Url:    file.URL,
+	}, nil
+}
+
+func fromProtoAnnotations(annotations []*v1.Annotation) ([]domain.Annotation, error) {
+	result := make([]domain.Annotation, 0, len(annotations))
+	for _, a := range annotations {
+		fileId, err := uuid.Parse(a.FileId)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		var points []domain.AnnotationPoint
+		for _, p := range a.Points {
+			points = append(points, domain.AnnotationPoint{X: p.X, Y: p.Y})
+		}
+
+		result = append(result, domain.Annotation{
+			FileID:  fileId,
+			Page:    int(a.Page),
+			Type:    fromProtoAnnotationType(a.Type),
+			X:       a.X,
+			Y:       a.Y,
+			Width:   a.Width,
+			Height:  a.Height,
+			Points:  points,
+			Comment: a.Comment,
+		})
+	}
+	return result, nil
+}
+
+func toProtoAnnotations(annotations []domain.Annotation) []*v1.Annotation {
+	var result []*v1.Annotation
+	for _, a := range annotations {
+		var points []*v1.AnnotationPoint
+		for _, p := range a.Points {
+			points = append(points, &v1.AnnotationPoint{X: p.X, Y: p.Y})
+		}
+
+		result = append(result, &v1.Annotation{
+			FileId:  a.FileID.String(),
+			Page:    int32(a.Page), //nolint:gosec // pages are validated to be small positive numbers
+			Type:    toProtoAnnotationType(a.Type),
+			X:       a.X,
+			Y:       a.Y,
+			Width:   a.Width,
+			Height:  a.Height,
+			Points:  points,
+			Comment: a.Comment,
+		})
+	}
+	return result
+}
+
+func fromProtoAnnotationType(t v1.AnnotationType) domain.AnnotationType {
+	switch t {
+	case v1.AnnotationType_ANNOTATION_HIGHLIGHT:
+		return domain.AnnotationHighlight
+	case v1.AnnotationType_ANNOTATION_PEN:
+		return domain.AnnotationPen
+	case v1.AnnotationType_ANNOTATION_TEXT:
+		return domain.AnnotationText
+	default:
+		return ""
+	}
+}
+
+func toProtoAnnotationType(t domain.AnnotationType) v1.AnnotationType {
+	switch t {
+	case domain.AnnotationHighlight:
+		return v1.AnnotationType_ANNOTATION_HIGHLIGHT
+	case domain.AnnotationPen:
+		return v1.AnnotationType_ANNOTATION_PEN
+	case domain.AnnotationText:
+		return v1.AnnotationType_ANNOTATION_TEXT
+	default:
+		return v1.AnnotationType_ANNOTATION_TYPE_UNSPECIFIED
+	}
+}
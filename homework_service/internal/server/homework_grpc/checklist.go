@@ -0,0 +1,105 @@
+package homework_grpc
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"homework_service/internal/domain"
+	v1 "homework_service/pkg/api"
+)
+
+func (h *HomeworkHandler) MarkChecklistItem(ctx context.Context, req *v1.MarkChecklistItemRequest) (*v1.Assignment, error) {
+	id, err := uuid.Parse(req.AssignmentId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	assignment, err := h.assignmentService.MarkChecklistItem(ctx, id, int(req.Position), req.Done)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return toProtoAssignment(assignment), nil
+}
+
+func (h *HomeworkHandler) ReviewChecklistItem(ctx context.Context, req *v1.ReviewChecklistItemRequest) (*v1.Assignment, error) {
+	id, err := uuid.Parse(req.AssignmentId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	assignment, err := h.assignmentService.ReviewChecklistItem(ctx, id, int(req.Position), fromProtoChecklistReview(req.Review))
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return toProtoAssignment(assignment), nil
+}
+
+func fromProtoChecklist(texts []string) []domain.ChecklistItem {
+	items := make([]domain.ChecklistItem, len(texts))
+	for i, text := range texts {
+		items[i] = domain.ChecklistItem{Text: text}
+	}
+	return items
+}
+
+func toProtoChecklist(items []domain.ChecklistItem) []*v1.ChecklistItem {
+	result := make([]*v1.ChecklistItem, len(items))
+	for i, item := range items {
+		result[i] = &v1.ChecklistItem{
+			Text:   item.Text,
+			Done:   item.Done,
+			Review: toProtoChecklistReview(item.Review),
+		}
+		if item.DoneAt != nil {
+			result[i].DoneAt = timestamppb.New(*item.DoneAt)
+		}
+		if item.ReviewedAt != nil {
+			result[i].ReviewedAt = timestamppb.New(*item.ReviewedAt)
+		}
+	}
+	return result
+}
+
+func fromProtoProgressFilter(filter *v1.ChecklistProgressFilter) domain.ProgressRange {
+	var r domain.ProgressRange
+	if filter == nil {
+		return r
+	}
+	if filter.Min != nil {
+		lower := int(*filter.Min)
+		r.Min = &lower
+	}
+	if filter.Max != nil {
+		upper := int(*filter.Max)
+		r.Max = &upper
+	}
+	return r
+}
+
+func fromProtoChecklistReview(r v1.ChecklistReview) domain.ChecklistReview {
+	switch r {
+	case v1.ChecklistReview_CHECKLIST_REVIEW_CORRECT:
+		return domain.ChecklistReviewCorrect
+	case v1.ChecklistReview_CHECKLIST_REVIEW_INCORRECT:
+		return domain.ChecklistReviewIncorrect
+	default:
+		return ""
+	}
+}
+
+func toProtoChecklistReview(r domain.ChecklistReview) v1.ChecklistReview {
+	switch r {
+	case domain.ChecklistReviewCorrect:
+		return v1.ChecklistReview_CHECKLIST_REVIEW_CORRECT
+	case domain.ChecklistReviewIncorrect:
+		return v1.ChecklistReview_CHECKLIST_REVIEW_INCORRECT
+	default:
+		return v1.ChecklistReview_CHECKLIST_REVIEW_UNSPECIFIED
+	}
+}
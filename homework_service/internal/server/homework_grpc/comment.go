@@ -0,0 +1,145 @@
+package homework_grpc
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"homework_service/internal/domain"
+	v1 "homework_service/pkg/api"
+)
+
+func (h *HomeworkHandler) CreateComment(ctx context.Context, req *v1.CreateCommentRequest) (*v1.Comment, error) {
+	assignmentId, err := uuid.Parse(req.AssignmentId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	submissionId, err := parseOptionalID(req.SubmissionId)
+	if err != nil {
+		return nil, err
+	}
+	parentId, err := parseOptionalID(req.ParentId)
+	if err != nil {
+		return nil, err
+	}
+
+	comment := &domain.Comment{
+		AssignmentID: assignmentId,
+		SubmissionID: submissionId,
+		ParentID:     parentId,
+		Body:         req.Body,
+	}
+	comment.Attachments, err = parseAttachments(req.Attachments)
+	if err != nil {
+		return nil, err
+	}
+
+	createdComment, err := h.commentService.CreateComment(ctx, comment)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return toProtoComment(createdComment), nil
+}
+
+func (h *HomeworkHandler) UpdateComment(ctx context.Context, req *v1.UpdateCommentRequest) (*v1.Comment, error) {
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	comment, err := h.commentService.GetComment(ctx, id)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	updatedComment := *comment
+	if req.Body != nil {
+		updatedComment.Body = *req.Body
+	}
+	if req.Attachments != nil {
+		updatedComment.Attachments, err = parseAttachments(req.Attachments.Items)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := h.commentService.UpdateComment(ctx, &updatedComment); err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return toProtoComment(&updatedComment), nil
+}
+
+func (h *HomeworkHandler) DeleteComment(ctx context.Context, req *v1.DeleteCommentRequest) (*v1.Empty, error) {
+	id, err := uuid.Parse(req.CommentId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := h.commentService.DeleteComment(ctx, id); err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return &v1.Empty{}, nil
+}
+
+func (h *HomeworkHandler) ListComments(ctx context.Context, req *v1.ListCommentsRequest) (*v1.ListCommentsResponse, error) {
+	assignmentId, err := uuid.Parse(req.AssignmentId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	submissionId, err := parseOptionalID(req.SubmissionId)
+	if err != nil {
+		return nil, err
+	}
+
+	comments, err := h.commentService.ListComments(ctx, assignmentId, submissionId)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	resp := &v1.ListCommentsResponse{Comments: make([]*v1.Comment, 0, len(comments))}
+	for _, c := range comments {
+		resp.Comments = append(resp.Comments, toProtoComment(c))
+	}
+	return resp, nil
+}
+
+func parseOptionalID(raw *string) (*uuid.UUID, error) {
+	if raw == nil {
+		return nil, nil
+	}
+	id, err := uuid.Parse(*raw)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &id, nil
+}
+
+func toProtoComment(c *domain.Comment) *v1.Comment {
+	comment := &v1.Comment{
+		Id:           c.ID.String(),
+		AssignmentId: c.AssignmentID.String(),
+		AuthorId:     c.AuthorID.String(),
+		Body:         c.Body,
+		Attachments:  toProtoAttachments(c.Attachments),
+		CreatedAt:    timestamppb.New(c.CreatedAt),
+		EditedAt:     timestamppb.New(c.EditedAt),
+		Deleted:      c.DeletedAt != nil,
+	}
+
+	if c.SubmissionID != nil {
+		id := c.SubmissionID.String()
+		comment.SubmissionId = &id
+	}
+	if c.ParentID != nil {
+		id := c.ParentID.String()
+		comment.ParentId = &id
+	}
+
+	return comment
+}
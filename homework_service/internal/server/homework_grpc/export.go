@@ -0,0 +1,102 @@
+package homework_grpc
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"homework_service/internal/domain"
+	v1 "homework_service/pkg/api"
+)
+
+func (h *HomeworkHandler) CreatePortfolioExport(ctx context.Context, req *v1.CreatePortfolioExportRequest) (*v1.PortfolioExport, error) {
+	tutorId, err := uuid.Parse(req.TutorId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	studentId, err := uuid.Parse(req.StudentId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	export := &domain.PortfolioExport{
+		TutorID:   tutorId,
+		StudentID: studentId,
+	}
+	if req.From != nil {
+		from := req.From.AsTime()
+		export.From = &from
+	}
+	if req.To != nil {
+		to := req.To.AsTime()
+		export.To = &to
+	}
+
+	created, err := h.exportService.CreatePortfolioExport(ctx, export)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return toProtoPortfolioExport(created), nil
+}
+
+func (h *HomeworkHandler) GetPortfolioExport(ctx context.Context, req *v1.GetPortfolioExportRequest) (*v1.PortfolioExport, error) {
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	export, err := h.exportService.GetPortfolioExport(ctx, id)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return toProtoPortfolioExport(export), nil
+}
+
+func toProtoPortfolioExport(e *domain.PortfolioExport) *v1.PortfolioExport {
+	export := &v1.PortfolioExport{
+		Id:          e.ID.String(),
+		RequestedBy: e.RequestedBy.String(),
+		TutorId:     e.TutorID.String(),
+		StudentId:   e.StudentID.String(),
+		Status:      toProtoExportStatus(e.Status),
+		Error:       e.Error,
+		CreatedAt:   timestamppb.New(e.CreatedAt),
+	}
+	if e.From != nil {
+		export.From = timestamppb.New(*e.From)
+	}
+	if e.To != nil {
+		export.To = timestamppb.New(*e.To)
+	}
+	if e.FileID != nil {
+		fileId := e.FileID.String()
+		export.FileId = &fileId
+	}
+	if e.DownloadURL != "" {
+		export.DownloadUrl = &e.DownloadURL
+	}
+	if e.FinishedAt != nil {
+		export.FinishedAt = timestamppb.New(*e.FinishedAt)
+	}
+	return export
+}
+
+func toProtoExportStatus(s domain.ExportStatus) v1.PortfolioExportStatus {
+	switch s {
+	case domain.ExportStatusPending:
+		return v1.PortfolioExportStatus_PORTFOLIO_EXPORT_PENDING
+	case domain.ExportStatusRunning:
+		return v1.PortfolioExportStatus_PORTFOLIO_EXPORT_RUNNING
+	case domain.ExportStatusDone:
+		return v1.PortfolioExportStatus_PORTFOLIO_EXPORT_DONE
+	case domain.ExportStatusFailed:
+		return v1.PortfolioExportStatus_PORTFOLIO_EXPORT_FAILED
+	default:
+		return v1.PortfolioExportStatus_PORTFOLIO_EXPORT_STATUS_UNSPECIFIED
+	}
+}
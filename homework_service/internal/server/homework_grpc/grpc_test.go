@@ -2,6 +2,7 @@ package homework_grpc_test
 
 import (
 	"context"
+	"fmt"
 	"testing"
 	"time"
 
@@ -14,6 +15,7 @@ import (
 
 	"homework_service/internal/domain"
 	"homework_service/internal/repository"
+	"homework_service/internal/service"
 	v1 "homework_service/pkg/api"
 	"homework_service/pkg/logger"
 
@@ -50,26 +52,60 @@ func (m *MockAssignmentService) DeleteAssignment(ctx context.Context, id uuid.UU
 	return args.Error(0)
 }
 
-func (m *MockAssignmentService) ListAssignmentsByTutor(ctx context.Context, tutorID uuid.UUID, statuses []domain.AssignmentStatus) ([]*domain.Assignment, error) {
-	args := m.Called(ctx, tutorID, statuses)
+func (m *MockAssignmentService) RestoreAssignment(ctx context.Context, id uuid.UUID) (*domain.Assignment, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Assignment), args.Error(1)
+}
+
+func (m *MockAssignmentService) ListAssignmentsByTutor(ctx context.Context, tutorID uuid.UUID, statuses []domain.AssignmentStatus, progress domain.ProgressRange) ([]*domain.Assignment, error) {
+	args := m.Called(ctx, tutorID, statuses, progress)
+	return args.Get(0).([]*domain.Assignment), args.Error(1)
+}
+
+func (m *MockAssignmentService) ListAssignmentsByStudent(ctx context.Context, studentID uuid.UUID, statuses []domain.AssignmentStatus, progress domain.ProgressRange) ([]*domain.Assignment, error) {
+	args := m.Called(ctx, studentID, statuses, progress)
 	return args.Get(0).([]*domain.Assignment), args.Error(1)
 }
 
-func (m *MockAssignmentService) ListAssignmentsByStudent(ctx context.Context, studentID uuid.UUID, statuses []domain.AssignmentStatus) ([]*domain.Assignment, error) {
-	args := m.Called(ctx, studentID, statuses)
+func (m *MockAssignmentService) ListAssignmentsByPair(ctx context.Context, tutorID, studentID uuid.UUID, statuses []domain.AssignmentStatus, progress domain.ProgressRange) ([]*domain.Assignment, error) {
+	args := m.Called(ctx, tutorID, studentID, statuses, progress)
 	return args.Get(0).([]*domain.Assignment), args.Error(1)
 }
 
-func (m *MockAssignmentService) ListAssignmentsByPair(ctx context.Context, tutorID, studentID uuid.UUID, statuses []domain.AssignmentStatus) ([]*domain.Assignment, error) {
-	args := m.Called(ctx, tutorID, studentID, statuses)
+func (m *MockAssignmentService) ListAssignmentsByLesson(ctx context.Context, lessonID uuid.UUID, statuses []domain.AssignmentStatus, progress domain.ProgressRange) ([]*domain.Assignment, error) {
+	args := m.Called(ctx, lessonID, statuses, progress)
 	return args.Get(0).([]*domain.Assignment), args.Error(1)
 }
 
+func (m *MockAssignmentService) MarkChecklistItem(ctx context.Context, id uuid.UUID, position int, done bool) (*domain.Assignment, error) {
+	args := m.Called(ctx, id, position, done)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Assignment), args.Error(1)
+}
+
+func (m *MockAssignmentService) ReviewChecklistItem(ctx context.Context, id uuid.UUID, position int, review domain.ChecklistReview) (*domain.Assignment, error) {
+	args := m.Called(ctx, id, position, review)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Assignment), args.Error(1)
+}
+
 func (m *MockAssignmentService) GetAssignmentFileURL(ctx context.Context, id uuid.UUID) (string, error) {
 	args := m.Called(ctx, id)
 	return args.String(0), args.Error(1)
 }
 
+func (m *MockAssignmentService) ListAttachmentFileURLs(ctx context.Context, id uuid.UUID) ([]domain.AttachmentFileURL, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).([]domain.AttachmentFileURL), args.Error(1)
+}
+
 type MockSubmissionService struct {
 	mock.Mock
 }
@@ -100,6 +136,11 @@ func (m *MockSubmissionService) GetSubmissionFileURL(ctx context.Context, id uui
 	return args.String(0), args.Error(1)
 }
 
+func (m *MockSubmissionService) ListAttachmentFileURLs(ctx context.Context, id uuid.UUID) ([]domain.AttachmentFileURL, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).([]domain.AttachmentFileURL), args.Error(1)
+}
+
 type MockFeedbackService struct {
 	mock.Mock
 }
@@ -138,6 +179,163 @@ func (m *MockFeedbackService) GetFeedbackFileURL(ctx context.Context, id uuid.UU
 	return args.String(0), args.Error(1)
 }
 
+func (m *MockFeedbackService) GetGradebook(ctx context.Context, tutorID, studentID uuid.UUID, from, to *time.Time) (*domain.Gradebook, error) {
+	args := m.Called(ctx, tutorID, studentID, from, to)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Gradebook), args.Error(1)
+}
+
+func (m *MockFeedbackService) GetHomeworkStats(ctx context.Context, tutorID uuid.UUID, from, to *time.Time) (*domain.HomeworkStats, error) {
+	args := m.Called(ctx, tutorID, from, to)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.HomeworkStats), args.Error(1)
+}
+
+func (m *MockFeedbackService) FlattenAnnotations(ctx context.Context, feedbackID, fileID uuid.UUID) (*domain.AnnotatedFile, error) {
+	args := m.Called(ctx, feedbackID, fileID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.AnnotatedFile), args.Error(1)
+}
+
+func (m *MockFeedbackService) ListAttachmentFileURLs(ctx context.Context, id uuid.UUID) ([]domain.AttachmentFileURL, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).([]domain.AttachmentFileURL), args.Error(1)
+}
+
+type MockCommentService struct {
+	mock.Mock
+}
+
+func (m *MockCommentService) CreateComment(ctx context.Context, comment *domain.Comment) (*domain.Comment, error) {
+	args := m.Called(ctx, comment)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Comment), args.Error(1)
+}
+
+func (m *MockCommentService) UpdateComment(ctx context.Context, comment *domain.Comment) error {
+	args := m.Called(ctx, comment)
+	return args.Error(0)
+}
+
+func (m *MockCommentService) DeleteComment(ctx context.Context, id uuid.UUID) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockCommentService) GetComment(ctx context.Context, id uuid.UUID) (*domain.Comment, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Comment), args.Error(1)
+}
+
+func (m *MockCommentService) ListComments(ctx context.Context, assignmentID uuid.UUID, submissionID *uuid.UUID) ([]*domain.Comment, error) {
+	args := m.Called(ctx, assignmentID, submissionID)
+	return args.Get(0).([]*domain.Comment), args.Error(1)
+}
+
+func (m *MockCommentService) ListAttachmentFileURLs(ctx context.Context, id uuid.UUID) ([]domain.AttachmentFileURL, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).([]domain.AttachmentFileURL), args.Error(1)
+}
+
+type MockSearchService struct {
+	mock.Mock
+}
+
+func (m *MockSearchService) SearchHomework(ctx context.Context, filter domain.SearchFilter, pageSize int, pageToken string) (*domain.SearchPage, error) {
+	args := m.Called(ctx, filter, pageSize, pageToken)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.SearchPage), args.Error(1)
+}
+
+type MockExportService struct {
+	mock.Mock
+}
+
+func (m *MockExportService) CreatePortfolioExport(ctx context.Context, export *domain.PortfolioExport) (*domain.PortfolioExport, error) {
+	args := m.Called(ctx, export)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.PortfolioExport), args.Error(1)
+}
+
+func (m *MockExportService) GetPortfolioExport(ctx context.Context, id uuid.UUID) (*domain.PortfolioExport, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.PortfolioExport), args.Error(1)
+}
+
+type MockTemplateService struct {
+	mock.Mock
+}
+
+func (m *MockTemplateService) CreateTemplate(ctx context.Context, template *domain.AssignmentTemplate) (*domain.AssignmentTemplate, error) {
+	args := m.Called(ctx, template)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.AssignmentTemplate), args.Error(1)
+}
+
+func (m *MockTemplateService) GetTemplate(ctx context.Context, id uuid.UUID) (*domain.AssignmentTemplate, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.AssignmentTemplate), args.Error(1)
+}
+
+func (m *MockTemplateService) UpdateTemplate(ctx context.Context, template *domain.AssignmentTemplate) error {
+	args := m.Called(ctx, template)
+	return args.Error(0)
+}
+
+func (m *MockTemplateService) DeleteTemplate(ctx context.Context, id uuid.UUID) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockTemplateService) ListTemplates(ctx context.Context, tutorID uuid.UUID) ([]*domain.AssignmentTemplate, error) {
+	args := m.Called(ctx, tutorID)
+	return args.Get(0).([]*domain.AssignmentTemplate), args.Error(1)
+}
+
+func (m *MockTemplateService) AssignFromTemplate(ctx context.Context, templateID uuid.UUID, studentIDs []uuid.UUID, dueDate *time.Time) ([]*domain.Assignment, error) {
+	args := m.Called(ctx, templateID, studentIDs, dueDate)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Assignment), args.Error(1)
+}
+
+func (m *MockTemplateService) SetDefaultTemplate(ctx context.Context, tutorID, studentID uuid.UUID, templateID *uuid.UUID) error {
+	args := m.Called(ctx, tutorID, studentID, templateID)
+	return args.Error(0)
+}
+
+func (m *MockTemplateService) GetDefaultTemplate(ctx context.Context, tutorID, studentID uuid.UUID) (*domain.AssignmentTemplate, error) {
+	args := m.Called(ctx, tutorID, studentID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.AssignmentTemplate), args.Error(1)
+}
+
 func TestHomeworkHandler(t *testing.T) {
 	log := logger.New()
 	ctx := context.Background()
@@ -155,6 +353,10 @@ func TestHomeworkHandler(t *testing.T) {
 			assignmentService,
 			submissionService,
 			feedbackService,
+			&MockTemplateService{},
+			&MockCommentService{},
+			&MockSearchService{},
+			&MockExportService{},
 			log,
 		)
 
@@ -211,6 +413,10 @@ func TestHomeworkHandler(t *testing.T) {
 			assignmentService,
 			submissionService,
 			feedbackService,
+			&MockTemplateService{},
+			&MockCommentService{},
+			&MockSearchService{},
+			&MockExportService{},
 			log,
 		)
 
@@ -245,6 +451,10 @@ func TestHomeworkHandler(t *testing.T) {
 			assignmentService,
 			submissionService,
 			feedbackService,
+			&MockTemplateService{},
+			&MockCommentService{},
+			&MockSearchService{},
+			&MockExportService{},
 			log,
 		)
 
@@ -270,6 +480,10 @@ func TestHomeworkHandler(t *testing.T) {
 			assignmentService,
 			submissionService,
 			feedbackService,
+			&MockTemplateService{},
+			&MockCommentService{},
+			&MockSearchService{},
+			&MockExportService{},
 			log,
 		)
 
@@ -314,6 +528,10 @@ func TestHomeworkHandler(t *testing.T) {
 			assignmentService,
 			submissionService,
 			feedbackService,
+			&MockTemplateService{},
+			&MockCommentService{},
+			&MockSearchService{},
+			&MockExportService{},
 			log,
 		)
 
@@ -354,6 +572,10 @@ func TestHomeworkHandler(t *testing.T) {
 			assignmentService,
 			submissionService,
 			feedbackService,
+			&MockTemplateService{},
+			&MockCommentService{},
+			&MockSearchService{},
+			&MockExportService{},
 			log,
 		)
 
@@ -369,4 +591,1489 @@ func TestHomeworkHandler(t *testing.T) {
 		assert.NoError(t, err)
 		assert.Equal(t, fileURL, resp.Url)
 	})
+
+	t.Run("CreateSubmission - attachments", func(t *testing.T) {
+		assignmentService := &MockAssignmentService{}
+		submissionService := &MockSubmissionService{}
+		feedbackService := &MockFeedbackService{}
+
+		h := handler.NewHomeworkHandler(
+			assignmentService,
+			submissionService,
+			feedbackService,
+			&MockTemplateService{},
+			&MockCommentService{},
+			&MockSearchService{},
+			&MockExportService{},
+			log,
+		)
+
+		assignmentID := uuid.New()
+		firstFile := uuid.New()
+		secondFile := uuid.New()
+		attachments := []domain.Attachment{
+			{ID: uuid.New(), FileID: firstFile, Caption: str("page 1"), Position: 0},
+			{ID: uuid.New(), FileID: secondFile, Position: 1},
+		}
+
+		submissionService.On("CreateSubmission", ctx, mock.MatchedBy(func(s *domain.Submission) bool {
+			return len(s.Attachments) == 2 &&
+				s.Attachments[0].FileID == firstFile && *s.Attachments[0].Caption == "page 1" &&
+				s.Attachments[1].FileID == secondFile && s.Attachments[1].Caption == nil
+		})).Return(&domain.Submission{
+			ID:           uuid.New(),
+			AssignmentID: assignmentID,
+			FileID:       &firstFile,
+			Attachments:  attachments,
+		}, nil)
+
+		resp, err := h.CreateSubmission(ctx, &v1.CreateSubmissionRequest{
+			AssignmentId: assignmentID.String(),
+			Attachments: []*v1.AttachmentInput{
+				{FileId: firstFile.String(), Caption: str("page 1")},
+				{FileId: secondFile.String()},
+			},
+		})
+
+		assert.NoError(t, err)
+		assert.Len(t, resp.Attachments, 2)
+		assert.Equal(t, secondFile.String(), resp.Attachments[1].FileId)
+		assert.Equal(t, int32(1), resp.Attachments[1].Position)
+		assert.Equal(t, firstFile.String(), *resp.FileId)
+	})
+
+	t.Run("CreateAssignment - invalid attachment", func(t *testing.T) {
+		assignmentService := &MockAssignmentService{}
+		submissionService := &MockSubmissionService{}
+		feedbackService := &MockFeedbackService{}
+
+		h := handler.NewHomeworkHandler(
+			assignmentService,
+			submissionService,
+			feedbackService,
+			&MockTemplateService{},
+			&MockCommentService{},
+			&MockSearchService{},
+			&MockExportService{},
+			log,
+		)
+
+		_, err := h.CreateAssignment(ctx, &v1.CreateAssignmentRequest{
+			TutorId:     uuid.New().String(),
+			StudentId:   uuid.New().String(),
+			Attachments: []*v1.AttachmentInput{{FileId: "invalid-uuid"}},
+		})
+
+		assert.Error(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assignmentService.AssertNotCalled(t, "CreateAssignment", mock.Anything, mock.Anything)
+	})
+
+	t.Run("UpdateFeedback - clear attachments", func(t *testing.T) {
+		assignmentService := &MockAssignmentService{}
+		submissionService := &MockSubmissionService{}
+		feedbackService := &MockFeedbackService{}
+
+		h := handler.NewHomeworkHandler(
+			assignmentService,
+			submissionService,
+			feedbackService,
+			&MockTemplateService{},
+			&MockCommentService{},
+			&MockSearchService{},
+			&MockExportService{},
+			log,
+		)
+
+		feedbackID := uuid.New()
+		existing := &domain.Feedback{ID: feedbackID, SubmissionID: uuid.New()}
+
+		feedbackService.On("GetFeedback", ctx, feedbackID).Return(existing, nil)
+		feedbackService.On("UpdateFeedback", ctx, mock.MatchedBy(func(f *domain.Feedback) bool {
+			return f.Attachments != nil && len(f.Attachments) == 0
+		})).Return(existing, nil)
+
+		_, err := h.UpdateFeedback(ctx, &v1.UpdateFeedbackRequest{
+			Id:          feedbackID.String(),
+			Attachments: &v1.AttachmentList{},
+		})
+
+		assert.NoError(t, err)
+		feedbackService.AssertExpectations(t)
+	})
+
+	t.Run("ListAttachmentFileURLs - submission", func(t *testing.T) {
+		assignmentService := &MockAssignmentService{}
+		submissionService := &MockSubmissionService{}
+		feedbackService := &MockFeedbackService{}
+
+		h := handler.NewHomeworkHandler(
+			assignmentService,
+			submissionService,
+			feedbackService,
+			&MockTemplateService{},
+			&MockCommentService{},
+			&MockSearchService{},
+			&MockExportService{},
+			log,
+		)
+
+		submissionID := uuid.New()
+		fileID := uuid.New()
+		submissionService.On("ListAttachmentFileURLs", ctx, submissionID).
+			Return([]domain.AttachmentFileURL{{
+				Attachment: domain.Attachment{FileID: fileID, Caption: str("notebook"), Position: 0},
+				URL:        "http://example.com/notebook.jpg",
+			}}, nil)
+
+		resp, err := h.ListAttachmentFileURLs(ctx, &v1.ListAttachmentFileURLsRequest{
+			OwnerType: v1.AttachmentOwnerType_ATTACHMENT_OWNER_SUBMISSION,
+			OwnerId:   submissionID.String(),
+		})
+
+		assert.NoError(t, err)
+		assert.Len(t, resp.Attachments, 1)
+		assert.Equal(t, fileID.String(), resp.Attachments[0].FileId)
+		assert.Equal(t, "notebook", *resp.Attachments[0].Caption)
+		assert.Equal(t, "http://example.com/notebook.jpg", resp.Attachments[0].Url)
+		assignmentService.AssertNotCalled(t, "ListAttachmentFileURLs", mock.Anything, mock.Anything)
+	})
+
+	t.Run("ListAttachmentFileURLs - unspecified owner type", func(t *testing.T) {
+		assignmentService := &MockAssignmentService{}
+		submissionService := &MockSubmissionService{}
+		feedbackService := &MockFeedbackService{}
+
+		h := handler.NewHomeworkHandler(
+			assignmentService,
+			submissionService,
+			feedbackService,
+			&MockTemplateService{},
+			&MockCommentService{},
+			&MockSearchService{},
+			&MockExportService{},
+			log,
+		)
+
+		_, err := h.ListAttachmentFileURLs(ctx, &v1.ListAttachmentFileURLsRequest{
+			OwnerId: uuid.New().String(),
+		})
+
+		assert.Error(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("CreateFeedback - rubric", func(t *testing.T) {
+		assignmentService := &MockAssignmentService{}
+		submissionService := &MockSubmissionService{}
+		feedbackService := &MockFeedbackService{}
+
+		h := handler.NewHomeworkHandler(
+			assignmentService,
+			submissionService,
+			feedbackService,
+			&MockTemplateService{},
+			&MockCommentService{},
+			&MockSearchService{},
+			&MockExportService{},
+			log,
+		)
+
+		score, maxScore := 7.5, 10.0
+		rubric := []domain.RubricCriterion{
+			{Name: "grammar", Score: 4, MaxScore: 5, Comment: str("articles")},
+			{Name: "vocabulary", Score: 3.5, MaxScore: 5},
+		}
+
+		feedbackService.On("CreateFeedback", ctx, mock.MatchedBy(func(f *domain.Feedback) bool {
+			return f.Score == nil && len(f.Rubric) == 2 && f.Rubric[0].Name == "grammar" && *f.Rubric[0].Comment == "articles"
+		})).Return(&domain.Feedback{
+			ID:           uuid.New(),
+			SubmissionID: uuid.New(),
+			Score:        &score,
+			MaxScore:     &maxScore,
+			Rubric:       rubric,
+		}, nil)
+
+		resp, err := h.CreateFeedback(ctx, &v1.CreateFeedbackRequest{
+			SubmissionId: uuid.New().String(),
+			Rubric: &v1.Rubric{Criteria: []*v1.RubricCriterion{
+				{Name: "grammar", Score: 4, MaxScore: 5, Comment: str("articles")},
+				{Name: "vocabulary", Score: 3.5, MaxScore: 5},
+			}},
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, 7.5, resp.GetScore())
+		assert.Equal(t, 10.0, resp.GetMaxScore())
+		assert.Len(t, resp.Rubric, 2)
+	})
+
+	t.Run("GetGradebook - success", func(t *testing.T) {
+		assignmentService := &MockAssignmentService{}
+		submissionService := &MockSubmissionService{}
+		feedbackService := &MockFeedbackService{}
+
+		h := handler.NewHomeworkHandler(
+			assignmentService,
+			submissionService,
+			feedbackService,
+			&MockTemplateService{},
+			&MockCommentService{},
+			&MockSearchService{},
+			&MockExportService{},
+			log,
+		)
+
+		tutorID := uuid.New()
+		studentID := uuid.New()
+		from := time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC)
+		maxScore := 10.0
+		average, trend := 75.0, 10.0
+
+		feedbackService.On("GetGradebook", ctx, tutorID, studentID, &from, (*time.Time)(nil)).
+			Return(&domain.Gradebook{
+				TutorID:   tutorID,
+				StudentID: studentID,
+				Entries: []domain.GradebookEntry{
+					{AssignmentID: uuid.New(), FeedbackID: uuid.New(), GradedAt: from, Score: 7, MaxScore: &maxScore},
+					{AssignmentID: uuid.New(), FeedbackID: uuid.New(), GradedAt: from, Score: 8},
+				},
+				AveragePercent: &average,
+				Trend:          &trend,
+				Criteria:       []domain.CriterionAverage{{Name: "grammar", Count: 2, AverageScore: 4, AveragePercent: 80}},
+			}, nil)
+
+		resp, err := h.GetGradebook(ctx, &v1.GetGradebookRequest{
+			TutorId:   tutorID.String(),
+			StudentId: studentID.String(),
+			From:      timestamppb.New(from),
+		})
+
+		assert.NoError(t, err)
+		assert.Len(t, resp.Entries, 2)
+		assert.InDelta(t, 70.0, resp.Entries[0].GetPercent(), 1e-9)
+		assert.Nil(t, resp.Entries[1].Percent)
+		assert.Equal(t, 75.0, resp.GetAveragePercent())
+		assert.Equal(t, 10.0, resp.GetTrend())
+		assert.Equal(t, int32(2), resp.Criteria[0].Count)
+	})
+
+	t.Run("GetGradebook - invalid student ID", func(t *testing.T) {
+		assignmentService := &MockAssignmentService{}
+		submissionService := &MockSubmissionService{}
+		feedbackService := &MockFeedbackService{}
+
+		h := handler.NewHomeworkHandler(
+			assignmentService,
+			submissionService,
+			feedbackService,
+			&MockTemplateService{},
+			&MockCommentService{},
+			&MockSearchService{},
+			&MockExportService{},
+			log,
+		)
+
+		_, err := h.GetGradebook(ctx, &v1.GetGradebookRequest{
+			TutorId:   uuid.New().String(),
+			StudentId: "invalid-uuid",
+		})
+
+		assert.Error(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("UpdateFeedback - needs revision", func(t *testing.T) {
+		assignmentService := &MockAssignmentService{}
+		submissionService := &MockSubmissionService{}
+		feedbackService := &MockFeedbackService{}
+
+		h := handler.NewHomeworkHandler(
+			assignmentService,
+			submissionService,
+			feedbackService,
+			&MockTemplateService{},
+			&MockCommentService{},
+			&MockSearchService{},
+			&MockExportService{},
+			log,
+		)
+
+		feedbackID := uuid.New()
+		existing := &domain.Feedback{ID: feedbackID, SubmissionID: uuid.New(), Verdict: domain.FeedbackVerdictAccepted}
+		updated := *existing
+		updated.Verdict = domain.FeedbackVerdictNeedsRevision
+
+		feedbackService.On("GetFeedback", ctx, feedbackID).Return(existing, nil)
+		feedbackService.On("UpdateFeedback", ctx, mock.MatchedBy(func(f *domain.Feedback) bool {
+			return f.Verdict == domain.FeedbackVerdictNeedsRevision
+		})).Return(&updated, nil)
+
+		resp, err := h.UpdateFeedback(ctx, &v1.UpdateFeedbackRequest{
+			Id:      feedbackID.String(),
+			Verdict: v1.FeedbackVerdict_FEEDBACK_VERDICT_NEEDS_REVISION,
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, v1.FeedbackVerdict_FEEDBACK_VERDICT_NEEDS_REVISION, resp.Verdict)
+	})
+
+	t.Run("ListAssignmentsByStudent - needs revision filter", func(t *testing.T) {
+		assignmentService := &MockAssignmentService{}
+		submissionService := &MockSubmissionService{}
+		feedbackService := &MockFeedbackService{}
+
+		h := handler.NewHomeworkHandler(
+			assignmentService,
+			submissionService,
+			feedbackService,
+			&MockTemplateService{},
+			&MockCommentService{},
+			&MockSearchService{},
+			&MockExportService{},
+			log,
+		)
+
+		studentID := uuid.New()
+		assignmentService.On("ListAssignmentsByStudent", ctx, studentID,
+			[]domain.AssignmentStatus{domain.AssignmentStatusNeedsRevision}, domain.ProgressRange{}).
+			Return([]*domain.Assignment{}, nil)
+
+		_, err := h.ListAssignmentsByStudent(ctx, &v1.ListAssignmentsByStudentRequest{
+			StudentId:    studentID.String(),
+			StatusFilter: []v1.AssignmentStatusFilter{v1.AssignmentStatusFilter_NEEDS_REVISION},
+		})
+
+		assert.NoError(t, err)
+		assignmentService.AssertExpectations(t)
+	})
+
+	t.Run("ListSubmissionsByAssignment - versions", func(t *testing.T) {
+		assignmentService := &MockAssignmentService{}
+		submissionService := &MockSubmissionService{}
+		feedbackService := &MockFeedbackService{}
+
+		h := handler.NewHomeworkHandler(
+			assignmentService,
+			submissionService,
+			feedbackService,
+			&MockTemplateService{},
+			&MockCommentService{},
+			&MockSearchService{},
+			&MockExportService{},
+			log,
+		)
+
+		assignmentID := uuid.New()
+		submissionService.On("ListSubmissionsByAssignment", ctx, assignmentID).
+			Return([]*domain.Submission{
+				{ID: uuid.New(), AssignmentID: assignmentID, Version: 1},
+				{ID: uuid.New(), AssignmentID: assignmentID, Version: 2},
+			}, nil)
+
+		resp, err := h.ListSubmissionsByAssignment(ctx, &v1.ListSubmissionsByAssignmentRequest{
+			AssignmentId: assignmentID.String(),
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, int32(1), resp.Submissions[0].Version)
+		assert.Equal(t, int32(2), resp.Submissions[1].Version)
+	})
+
+	t.Run("CreateAssignmentTemplate - success", func(t *testing.T) {
+		templateService := &MockTemplateService{}
+
+		h := handler.NewHomeworkHandler(
+			&MockAssignmentService{},
+			&MockSubmissionService{},
+			&MockFeedbackService{},
+			templateService,
+			&MockCommentService{},
+			&MockSearchService{},
+			&MockExportService{},
+			log,
+		)
+
+		tutorID := uuid.New()
+		fileID := uuid.New()
+		offset := 7 * 24 * time.Hour
+		offsetSeconds := int64(offset.Seconds())
+
+		templateService.On("CreateTemplate", ctx, mock.MatchedBy(func(tpl *domain.AssignmentTemplate) bool {
+			return tpl.TutorID == tutorID && *tpl.DueOffset == offset &&
+				len(tpl.Attachments) == 1 && tpl.Attachments[0].FileID == fileID
+		})).Return(&domain.AssignmentTemplate{
+			ID:          uuid.New(),
+			TutorID:     tutorID,
+			Title:       str("Essay"),
+			Attachments: []domain.Attachment{{FileID: fileID}},
+			DueOffset:   &offset,
+		}, nil)
+
+		resp, err := h.CreateAssignmentTemplate(ctx, &v1.CreateAssignmentTemplateRequest{
+			TutorId:          tutorID.String(),
+			Title:            str("Essay"),
+			Attachments:      []*v1.AttachmentInput{{FileId: fileID.String()}},
+			DueOffsetSeconds: &offsetSeconds,
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, offsetSeconds, resp.GetDueOffsetSeconds())
+		assert.Len(t, resp.Attachments, 1)
+	})
+
+	t.Run("AssignFromTemplate - success", func(t *testing.T) {
+		templateService := &MockTemplateService{}
+
+		h := handler.NewHomeworkHandler(
+			&MockAssignmentService{},
+			&MockSubmissionService{},
+			&MockFeedbackService{},
+			templateService,
+			&MockCommentService{},
+			&MockSearchService{},
+			&MockExportService{},
+			log,
+		)
+
+		templateID := uuid.New()
+		tutorID := uuid.New()
+		studentIDs := []uuid.UUID{uuid.New(), uuid.New()}
+		dueDate := time.Date(2025, 10, 1, 18, 0, 0, 0, time.UTC)
+
+		templateService.On("AssignFromTemplate", ctx, templateID, studentIDs, &dueDate).
+			Return([]*domain.Assignment{
+				{ID: uuid.New(), TutorID: tutorID, StudentID: studentIDs[0], DueDate: &dueDate},
+				{ID: uuid.New(), TutorID: tutorID, StudentID: studentIDs[1], DueDate: &dueDate},
+			}, nil)
+
+		resp, err := h.AssignFromTemplate(ctx, &v1.AssignFromTemplateRequest{
+			TemplateId: templateID.String(),
+			StudentIds: []string{studentIDs[0].String(), studentIDs[1].String()},
+			DueDate:    timestamppb.New(dueDate),
+		})
+
+		assert.NoError(t, err)
+		assert.Len(t, resp.Assignments, 2)
+		assert.Equal(t, studentIDs[1].String(), resp.Assignments[1].StudentId)
+	})
+
+	t.Run("AssignFromTemplate - invalid student ID", func(t *testing.T) {
+		templateService := &MockTemplateService{}
+
+		h := handler.NewHomeworkHandler(
+			&MockAssignmentService{},
+			&MockSubmissionService{},
+			&MockFeedbackService{},
+			templateService,
+			&MockCommentService{},
+			&MockSearchService{},
+			&MockExportService{},
+			log,
+		)
+
+		_, err := h.AssignFromTemplate(ctx, &v1.AssignFromTemplateRequest{
+			TemplateId: uuid.New().String(),
+			StudentIds: []string{uuid.New().String(), "invalid-uuid"},
+		})
+
+		assert.Error(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		templateService.AssertNotCalled(t, "AssignFromTemplate", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("CreateAssignment - due before next lesson", func(t *testing.T) {
+		assignmentService := &MockAssignmentService{}
+
+		h := handler.NewHomeworkHandler(
+			assignmentService,
+			&MockSubmissionService{},
+			&MockFeedbackService{},
+			&MockTemplateService{},
+			&MockCommentService{},
+			&MockSearchService{},
+			&MockExportService{},
+			log,
+		)
+
+		lessonID := uuid.New()
+		dueLessonID := uuid.New()
+		dueDate := time.Now().Add(48 * time.Hour)
+		expected := &domain.Assignment{
+			ID:                  uuid.New(),
+			TutorID:             uuid.New(),
+			StudentID:           uuid.New(),
+			LessonID:            &lessonID,
+			DueBeforeNextLesson: true,
+			DueLessonID:         &dueLessonID,
+			DueDate:             &dueDate,
+		}
+
+		assignmentService.On("CreateAssignment", ctx, mock.MatchedBy(func(a *domain.Assignment) bool {
+			return a.LessonID != nil && *a.LessonID == lessonID && a.DueBeforeNextLesson && a.DueDate == nil
+		})).Return(expected, nil)
+
+		lessonIDStr := lessonID.String()
+		resp, err := h.CreateAssignment(ctx, &v1.CreateAssignmentRequest{
+			TutorId:             expected.TutorID.String(),
+			StudentId:           expected.StudentID.String(),
+			LessonId:            &lessonIDStr,
+			DueBeforeNextLesson: true,
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, lessonID.String(), resp.GetLessonId())
+		assert.Equal(t, dueLessonID.String(), resp.GetDueLessonId())
+		assert.True(t, resp.DueBeforeNextLesson)
+		assignmentService.AssertExpectations(t)
+	})
+
+	t.Run("UpdateAssignment - due date with due before next lesson", func(t *testing.T) {
+		assignmentService := &MockAssignmentService{}
+
+		h := handler.NewHomeworkHandler(
+			assignmentService,
+			&MockSubmissionService{},
+			&MockFeedbackService{},
+			&MockTemplateService{},
+			&MockCommentService{},
+			&MockSearchService{},
+			&MockExportService{},
+			log,
+		)
+
+		id := uuid.New()
+		assignmentService.On("GetAssignment", ctx, id).
+			Return(&domain.Assignment{ID: id, TutorID: uuid.New(), StudentID: uuid.New()}, nil)
+
+		dueBeforeNextLesson := true
+		_, err := h.UpdateAssignment(ctx, &v1.UpdateAssignmentRequest{
+			Id:                  id.String(),
+			DueDate:             timestamppb.New(time.Now().Add(time.Hour)),
+			DueBeforeNextLesson: &dueBeforeNextLesson,
+		})
+
+		assert.Error(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assignmentService.AssertNotCalled(t, "UpdateAssignment", mock.Anything, mock.Anything)
+	})
+
+	t.Run("UpdateAssignment - unlink lesson", func(t *testing.T) {
+		assignmentService := &MockAssignmentService{}
+
+		h := handler.NewHomeworkHandler(
+			assignmentService,
+			&MockSubmissionService{},
+			&MockFeedbackService{},
+			&MockTemplateService{},
+			&MockCommentService{},
+			&MockSearchService{},
+			&MockExportService{},
+			log,
+		)
+
+		id := uuid.New()
+		lessonID := uuid.New()
+		assignmentService.On("GetAssignment", ctx, id).
+			Return(&domain.Assignment{ID: id, TutorID: uuid.New(), StudentID: uuid.New(), LessonID: &lessonID}, nil)
+		assignmentService.On("UpdateAssignment", ctx, mock.MatchedBy(func(a *domain.Assignment) bool {
+			return a.LessonID == nil
+		})).Return(nil)
+
+		resp, err := h.UpdateAssignment(ctx, &v1.UpdateAssignmentRequest{
+			Id:       id.String(),
+			LessonId: str(""),
+		})
+
+		assert.NoError(t, err)
+		assert.Nil(t, resp.LessonId)
+		assignmentService.AssertExpectations(t)
+	})
+
+	t.Run("ListAssignmentsByLesson - success", func(t *testing.T) {
+		assignmentService := &MockAssignmentService{}
+
+		h := handler.NewHomeworkHandler(
+			assignmentService,
+			&MockSubmissionService{},
+			&MockFeedbackService{},
+			&MockTemplateService{},
+			&MockCommentService{},
+			&MockSearchService{},
+			&MockExportService{},
+			log,
+		)
+
+		lessonID := uuid.New()
+		assignmentService.On("ListAssignmentsByLesson", ctx, lessonID,
+			[]domain.AssignmentStatus{domain.AssignmentStatusUnsent}, domain.ProgressRange{}).
+			Return([]*domain.Assignment{{ID: uuid.New(), LessonID: &lessonID}}, nil)
+
+		resp, err := h.ListAssignmentsByLesson(ctx, &v1.ListAssignmentsByLessonRequest{
+			LessonId:     lessonID.String(),
+			StatusFilter: []v1.AssignmentStatusFilter{v1.AssignmentStatusFilter_UNSENT},
+		})
+
+		assert.NoError(t, err)
+		assert.Len(t, resp.Assignments, 1)
+		assignmentService.AssertExpectations(t)
+	})
+
+	t.Run("CreateComment - reply on submission", func(t *testing.T) {
+		commentService := &MockCommentService{}
+
+		h := handler.NewHomeworkHandler(
+			&MockAssignmentService{},
+			&MockSubmissionService{},
+			&MockFeedbackService{},
+			&MockTemplateService{},
+			commentService,
+			&MockSearchService{},
+			&MockExportService{},
+			log,
+		)
+
+		assignmentID := uuid.New()
+		submissionID := uuid.New()
+		parentID := uuid.New()
+		commentService.On("CreateComment", ctx, mock.MatchedBy(func(c *domain.Comment) bool {
+			return c.AssignmentID == assignmentID && *c.SubmissionID == submissionID &&
+				*c.ParentID == parentID && c.Body == "Why is this wrong?"
+		})).Return(&domain.Comment{
+			ID:           uuid.New(),
+			AssignmentID: assignmentID,
+			SubmissionID: &submissionID,
+			ParentID:     &parentID,
+			AuthorID:     uuid.New(),
+			Body:         "Why is this wrong?",
+		}, nil)
+
+		resp, err := h.CreateComment(ctx, &v1.CreateCommentRequest{
+			AssignmentId: assignmentID.String(),
+			SubmissionId: str(submissionID.String()),
+			ParentId:     str(parentID.String()),
+			Body:         "Why is this wrong?",
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, parentID.String(), resp.GetParentId())
+		assert.False(t, resp.Deleted)
+		commentService.AssertExpectations(t)
+	})
+
+	t.Run("CreateComment - invalid parent ID", func(t *testing.T) {
+		commentService := &MockCommentService{}
+
+		h := handler.NewHomeworkHandler(
+			&MockAssignmentService{},
+			&MockSubmissionService{},
+			&MockFeedbackService{},
+			&MockTemplateService{},
+			commentService,
+			&MockSearchService{},
+			&MockExportService{},
+			log,
+		)
+
+		_, err := h.CreateComment(ctx, &v1.CreateCommentRequest{
+			AssignmentId: uuid.New().String(),
+			ParentId:     str("invalid-uuid"),
+			Body:         "Hi",
+		})
+
+		assert.Error(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		commentService.AssertNotCalled(t, "CreateComment", mock.Anything, mock.Anything)
+	})
+
+	t.Run("UpdateComment - keeps attachments", func(t *testing.T) {
+		commentService := &MockCommentService{}
+
+		h := handler.NewHomeworkHandler(
+			&MockAssignmentService{},
+			&MockSubmissionService{},
+			&MockFeedbackService{},
+			&MockTemplateService{},
+			commentService,
+			&MockSearchService{},
+			&MockExportService{},
+			log,
+		)
+
+		id := uuid.New()
+		attachments := []domain.Attachment{{FileID: uuid.New()}}
+		commentService.On("GetComment", ctx, id).
+			Return(&domain.Comment{ID: id, Body: "old", Attachments: attachments}, nil)
+		commentService.On("UpdateComment", ctx, mock.MatchedBy(func(c *domain.Comment) bool {
+			return c.Body == "new" && len(c.Attachments) == 1
+		})).Return(nil)
+
+		resp, err := h.UpdateComment(ctx, &v1.UpdateCommentRequest{Id: id.String(), Body: str("new")})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "new", resp.Body)
+		commentService.AssertExpectations(t)
+	})
+
+	t.Run("DeleteComment - permission denied", func(t *testing.T) {
+		commentService := &MockCommentService{}
+
+		h := handler.NewHomeworkHandler(
+			&MockAssignmentService{},
+			&MockSubmissionService{},
+			&MockFeedbackService{},
+			&MockTemplateService{},
+			commentService,
+			&MockSearchService{},
+			&MockExportService{},
+			log,
+		)
+
+		id := uuid.New()
+		commentService.On("DeleteComment", ctx, id).Return(service.ErrPermissionDenied)
+
+		_, err := h.DeleteComment(ctx, &v1.DeleteCommentRequest{CommentId: id.String()})
+
+		assert.Error(t, err)
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("ListComments - deleted comment", func(t *testing.T) {
+		commentService := &MockCommentService{}
+
+		h := handler.NewHomeworkHandler(
+			&MockAssignmentService{},
+			&MockSubmissionService{},
+			&MockFeedbackService{},
+			&MockTemplateService{},
+			commentService,
+			&MockSearchService{},
+			&MockExportService{},
+			log,
+		)
+
+		assignmentID := uuid.New()
+		deletedAt := time.Now()
+		commentService.On("ListComments", ctx, assignmentID, (*uuid.UUID)(nil)).
+			Return([]*domain.Comment{{ID: uuid.New(), AssignmentID: assignmentID, DeletedAt: &deletedAt}}, nil)
+
+		resp, err := h.ListComments(ctx, &v1.ListCommentsRequest{AssignmentId: assignmentID.String()})
+
+		assert.NoError(t, err)
+		assert.Len(t, resp.Comments, 1)
+		assert.True(t, resp.Comments[0].Deleted)
+	})
+
+	t.Run("CreateAssignment - quiz", func(t *testing.T) {
+		assignmentService := &MockAssignmentService{}
+
+		h := handler.NewHomeworkHandler(
+			assignmentService,
+			&MockSubmissionService{},
+			&MockFeedbackService{},
+			&MockTemplateService{},
+			&MockCommentService{},
+			&MockSearchService{},
+			&MockExportService{},
+			log,
+		)
+
+		answer := 42.0
+		quiz := []domain.QuizQuestion{
+			{Type: domain.QuizQuestionSingleChoice, Text: "2+2", Options: []string{"3", "4"}, Points: 1, CorrectOptions: []int{1}},
+			{Type: domain.QuizQuestionNumeric, Text: "answer", Points: 2, NumericAnswer: &answer},
+		}
+
+		assignmentService.On("CreateAssignment", ctx, mock.MatchedBy(func(a *domain.Assignment) bool {
+			return len(a.Quiz) == 2 &&
+				a.Quiz[0].Type == domain.QuizQuestionSingleChoice && a.Quiz[0].CorrectOptions[0] == 1 &&
+				a.Quiz[1].Type == domain.QuizQuestionNumeric && *a.Quiz[1].NumericAnswer == answer
+		})).Return(&domain.Assignment{ID: uuid.New(), Quiz: quiz}, nil)
+
+		resp, err := h.CreateAssignment(ctx, &v1.CreateAssignmentRequest{
+			TutorId:   uuid.New().String(),
+			StudentId: uuid.New().String(),
+			Quiz: []*v1.QuizQuestion{
+				{Type: v1.QuizQuestionType_QUIZ_QUESTION_SINGLE_CHOICE, Text: "2+2", Options: []string{"3", "4"}, CorrectOptions: []int32{1}},
+				{Type: v1.QuizQuestionType_QUIZ_QUESTION_NUMERIC, Text: "answer", Points: 2, NumericAnswer: &answer},
+			},
+		})
+
+		assert.NoError(t, err)
+		assert.Len(t, resp.Quiz, 2)
+		assert.Equal(t, []int32{1}, resp.Quiz[0].CorrectOptions)
+		assert.Equal(t, v1.QuizQuestionType_QUIZ_QUESTION_NUMERIC, resp.Quiz[1].Type)
+	})
+
+	t.Run("CreateSubmission - quiz answers", func(t *testing.T) {
+		submissionService := &MockSubmissionService{}
+
+		h := handler.NewHomeworkHandler(
+			&MockAssignmentService{},
+			submissionService,
+			&MockFeedbackService{},
+			&MockTemplateService{},
+			&MockCommentService{},
+			&MockSearchService{},
+			&MockExportService{},
+			log,
+		)
+
+		assignmentID := uuid.New()
+		submissionService.On("CreateSubmission", ctx, mock.MatchedBy(func(s *domain.Submission) bool {
+			return len(s.Answers) == 1 && s.Answers[0].Question == 0 && s.Answers[0].Options[0] == 1
+		})).Return(&domain.Submission{
+			ID:           uuid.New(),
+			AssignmentID: assignmentID,
+			Answers:      []domain.QuizAnswer{{Question: 0, Options: []int{1}, Correct: true, Score: 1}},
+		}, nil)
+
+		resp, err := h.CreateSubmission(ctx, &v1.CreateSubmissionRequest{
+			AssignmentId: assignmentID.String(),
+			Answers:      []*v1.QuizAnswer{{Question: 0, Options: []int32{1}}},
+		})
+
+		assert.NoError(t, err)
+		assert.Len(t, resp.Answers, 1)
+		assert.True(t, resp.Answers[0].Correct)
+		assert.Equal(t, 1.0, resp.Answers[0].Score)
+	})
+
+	t.Run("CreateSubmission - quiz already submitted", func(t *testing.T) {
+		submissionService := &MockSubmissionService{}
+
+		h := handler.NewHomeworkHandler(
+			&MockAssignmentService{},
+			submissionService,
+			&MockFeedbackService{},
+			&MockTemplateService{},
+			&MockCommentService{},
+			&MockSearchService{},
+			&MockExportService{},
+			log,
+		)
+
+		submissionService.On("CreateSubmission", ctx, mock.AnythingOfType("*domain.Submission")).
+			Return(nil, fmt.Errorf("%w: quiz is already submitted", service.ErrFailedPrecondition))
+
+		_, err := h.CreateSubmission(ctx, &v1.CreateSubmissionRequest{AssignmentId: uuid.New().String()})
+
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+
+	t.Run("UpdateAssignment - late policy and grace period", func(t *testing.T) {
+		assignmentService := &MockAssignmentService{}
+
+		h := handler.NewHomeworkHandler(
+			assignmentService,
+			&MockSubmissionService{},
+			&MockFeedbackService{},
+			&MockTemplateService{},
+			&MockCommentService{},
+			&MockSearchService{},
+			&MockExportService{},
+			log,
+		)
+
+		id := uuid.New()
+		grace := time.Hour
+		assignmentService.On("GetAssignment", ctx, id).
+			Return(&domain.Assignment{ID: id, TutorID: uuid.New(), StudentID: uuid.New(),
+				LatePolicy: domain.LatePolicyFlag, GracePeriod: &grace}, nil)
+		assignmentService.On("UpdateAssignment", ctx, mock.MatchedBy(func(a *domain.Assignment) bool {
+			return a.LatePolicy == domain.LatePolicyLock && a.GracePeriod == nil
+		})).Return(nil)
+
+		lock := v1.LatePolicy_LATE_POLICY_LOCK
+		noGrace := int64(0)
+		resp, err := h.UpdateAssignment(ctx, &v1.UpdateAssignmentRequest{
+			Id:                 id.String(),
+			LatePolicy:         &lock,
+			GracePeriodSeconds: &noGrace,
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, v1.LatePolicy_LATE_POLICY_LOCK, resp.LatePolicy)
+		assert.Nil(t, resp.GracePeriodSeconds)
+		assignmentService.AssertExpectations(t)
+	})
+
+	t.Run("CreateSubmission - locked after deadline", func(t *testing.T) {
+		submissionService := &MockSubmissionService{}
+
+		h := handler.NewHomeworkHandler(
+			&MockAssignmentService{},
+			submissionService,
+			&MockFeedbackService{},
+			&MockTemplateService{},
+			&MockCommentService{},
+			&MockSearchService{},
+			&MockExportService{},
+			log,
+		)
+
+		submissionService.On("CreateSubmission", ctx, mock.AnythingOfType("*domain.Submission")).
+			Return(nil, fmt.Errorf("%w: assignment is locked after the deadline", service.ErrFailedPrecondition))
+
+		_, err := h.CreateSubmission(ctx, &v1.CreateSubmissionRequest{AssignmentId: uuid.New().String()})
+
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+
+	t.Run("GetGradebook - late entries", func(t *testing.T) {
+		feedbackService := &MockFeedbackService{}
+
+		h := handler.NewHomeworkHandler(
+			&MockAssignmentService{},
+			&MockSubmissionService{},
+			feedbackService,
+			&MockTemplateService{},
+			&MockCommentService{},
+			&MockSearchService{},
+			&MockExportService{},
+			log,
+		)
+
+		tutorID := uuid.New()
+		studentID := uuid.New()
+		feedbackService.On("GetGradebook", ctx, tutorID, studentID, (*time.Time)(nil), (*time.Time)(nil)).
+			Return(&domain.Gradebook{
+				TutorID:   tutorID,
+				StudentID: studentID,
+				Entries:   []domain.GradebookEntry{{AssignmentID: uuid.New(), FeedbackID: uuid.New(), Score: 5, IsLate: true}},
+				LateCount: 1,
+			}, nil)
+
+		resp, err := h.GetGradebook(ctx, &v1.GetGradebookRequest{
+			TutorId:   tutorID.String(),
+			StudentId: studentID.String(),
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, int32(1), resp.LateCount)
+		assert.True(t, resp.Entries[0].IsLate)
+	})
+
+	t.Run("CreateAssignment - scheduled by publish time", func(t *testing.T) {
+		assignmentService := &MockAssignmentService{}
+
+		h := handler.NewHomeworkHandler(
+			assignmentService,
+			&MockSubmissionService{},
+			&MockFeedbackService{},
+			&MockTemplateService{},
+			&MockCommentService{},
+			&MockSearchService{},
+			&MockExportService{},
+			log,
+		)
+
+		tutorID := uuid.New()
+		studentID := uuid.New()
+		publishAt := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+		assignmentService.On("CreateAssignment", ctx, mock.MatchedBy(func(a *domain.Assignment) bool {
+			return a.State == domain.AssignmentStateScheduled && a.PublishAt.Equal(publishAt)
+		})).Return(&domain.Assignment{
+			ID:        uuid.New(),
+			TutorID:   tutorID,
+			StudentID: studentID,
+			State:     domain.AssignmentStateScheduled,
+			PublishAt: &publishAt,
+		}, nil)
+
+		resp, err := h.CreateAssignment(ctx, &v1.CreateAssignmentRequest{
+			TutorId:   tutorID.String(),
+			StudentId: studentID.String(),
+			PublishAt: timestamppb.New(publishAt),
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, v1.AssignmentState_ASSIGNMENT_STATE_SCHEDULED, resp.State)
+		assert.Equal(t, publishAt, resp.PublishAt.AsTime())
+		assert.Nil(t, resp.PublishedAt)
+		assignmentService.AssertExpectations(t)
+	})
+
+	t.Run("UpdateAssignment - cannot unpublish", func(t *testing.T) {
+		assignmentService := &MockAssignmentService{}
+
+		h := handler.NewHomeworkHandler(
+			assignmentService,
+			&MockSubmissionService{},
+			&MockFeedbackService{},
+			&MockTemplateService{},
+			&MockCommentService{},
+			&MockSearchService{},
+			&MockExportService{},
+			log,
+		)
+
+		id := uuid.New()
+		assignmentService.On("GetAssignment", ctx, id).
+			Return(&domain.Assignment{ID: id, TutorID: uuid.New(), StudentID: uuid.New(), State: domain.AssignmentStatePublished}, nil)
+		assignmentService.On("UpdateAssignment", ctx, mock.MatchedBy(func(a *domain.Assignment) bool {
+			return a.State == domain.AssignmentStateDraft
+		})).Return(fmt.Errorf("%w: published assignment cannot be unpublished", service.ErrFailedPrecondition))
+
+		draft := v1.AssignmentState_ASSIGNMENT_STATE_DRAFT
+		_, err := h.UpdateAssignment(ctx, &v1.UpdateAssignmentRequest{
+			Id:    id.String(),
+			State: &draft,
+		})
+
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+		assignmentService.AssertExpectations(t)
+	})
+
+	t.Run("SearchHomework - success", func(t *testing.T) {
+		searchService := &MockSearchService{}
+
+		h := handler.NewHomeworkHandler(
+			&MockAssignmentService{},
+			&MockSubmissionService{},
+			&MockFeedbackService{},
+			&MockTemplateService{},
+			&MockCommentService{},
+			searchService,
+			&MockExportService{},
+			log,
+		)
+
+		studentID := uuid.New()
+		hit := domain.SearchHit{
+			Type:         domain.SearchHitFeedback,
+			ID:           uuid.New(),
+			AssignmentID: uuid.New(),
+			TutorID:      uuid.New(),
+			StudentID:    studentID,
+			Snippet:      "<b>треугольник</b> решён верно",
+			Rank:         0.5,
+		}
+		searchService.On("SearchHomework", ctx, domain.SearchFilter{
+			Query:     "треугольник",
+			StudentID: studentID,
+			Types:     []domain.SearchHitType{domain.SearchHitFeedback},
+		}, 10, "").Return(&domain.SearchPage{Hits: []domain.SearchHit{hit}, NextPageToken: "MTA"}, nil)
+
+		resp, err := h.SearchHomework(ctx, &v1.SearchHomeworkRequest{
+			Query:     "треугольник",
+			StudentId: str(studentID.String()),
+			Types:     []v1.SearchHitType{v1.SearchHitType_SEARCH_HIT_FEEDBACK},
+			PageSize:  10,
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "MTA", resp.NextPageToken)
+		assert.Len(t, resp.Hits, 1)
+		assert.Equal(t, v1.SearchHitType_SEARCH_HIT_FEEDBACK, resp.Hits[0].Type)
+		assert.Equal(t, hit.ID.String(), resp.Hits[0].Id)
+		assert.Equal(t, hit.Snippet, resp.Hits[0].Snippet)
+		searchService.AssertExpectations(t)
+	})
+
+	t.Run("SearchHomework - invalid argument", func(t *testing.T) {
+		searchService := &MockSearchService{}
+
+		h := handler.NewHomeworkHandler(
+			&MockAssignmentService{},
+			&MockSubmissionService{},
+			&MockFeedbackService{},
+			&MockTemplateService{},
+			&MockCommentService{},
+			searchService,
+			&MockExportService{},
+			log,
+		)
+
+		_, err := h.SearchHomework(ctx, &v1.SearchHomeworkRequest{Query: "x", Types: []v1.SearchHitType{v1.SearchHitType_SEARCH_HIT_TYPE_UNSPECIFIED}})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		searchService.On("SearchHomework", ctx, mock.Anything, 0, "").
+			Return(nil, fmt.Errorf("%w: query is required", service.ErrInvalidArgument))
+
+		_, err = h.SearchHomework(ctx, &v1.SearchHomeworkRequest{})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("CreatePortfolioExport - success", func(t *testing.T) {
+		exportService := &MockExportService{}
+
+		h := handler.NewHomeworkHandler(
+			&MockAssignmentService{},
+			&MockSubmissionService{},
+			&MockFeedbackService{},
+			&MockTemplateService{},
+			&MockCommentService{},
+			&MockSearchService{},
+			exportService,
+			log,
+		)
+
+		tutorID := uuid.New()
+		studentID := uuid.New()
+		from := time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC)
+		created := &domain.PortfolioExport{
+			ID:          uuid.New(),
+			RequestedBy: tutorID,
+			TutorID:     tutorID,
+			StudentID:   studentID,
+			From:        &from,
+			Status:      domain.ExportStatusPending,
+			CreatedAt:   time.Now(),
+		}
+		exportService.On("CreatePortfolioExport", ctx, &domain.PortfolioExport{
+			TutorID:   tutorID,
+			StudentID: studentID,
+			From:      &from,
+		}).Return(created, nil)
+
+		resp, err := h.CreatePortfolioExport(ctx, &v1.CreatePortfolioExportRequest{
+			TutorId:   tutorID.String(),
+			StudentId: studentID.String(),
+			From:      timestamppb.New(from),
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, created.ID.String(), resp.Id)
+		assert.Equal(t, v1.PortfolioExportStatus_PORTFOLIO_EXPORT_PENDING, resp.Status)
+		assert.Equal(t, from, resp.From.AsTime())
+		assert.Nil(t, resp.To)
+		assert.Nil(t, resp.DownloadUrl)
+		exportService.AssertExpectations(t)
+
+		_, err = h.CreatePortfolioExport(ctx, &v1.CreatePortfolioExportRequest{TutorId: "bad", StudentId: studentID.String()})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("GetPortfolioExport - done", func(t *testing.T) {
+		exportService := &MockExportService{}
+
+		h := handler.NewHomeworkHandler(
+			&MockAssignmentService{},
+			&MockSubmissionService{},
+			&MockFeedbackService{},
+			&MockTemplateService{},
+			&MockCommentService{},
+			&MockSearchService{},
+			exportService,
+			log,
+		)
+
+		id := uuid.New()
+		fileID := uuid.New()
+		finishedAt := time.Now()
+		exportService.On("GetPortfolioExport", ctx, id).Return(&domain.PortfolioExport{
+			ID:          id,
+			Status:      domain.ExportStatusDone,
+			FileID:      &fileID,
+			DownloadURL: "http://files/portfolio.zip",
+			FinishedAt:  &finishedAt,
+		}, nil)
+
+		resp, err := h.GetPortfolioExport(ctx, &v1.GetPortfolioExportRequest{Id: id.String()})
+
+		assert.NoError(t, err)
+		assert.Equal(t, v1.PortfolioExportStatus_PORTFOLIO_EXPORT_DONE, resp.Status)
+		assert.Equal(t, fileID.String(), resp.GetFileId())
+		assert.Equal(t, "http://files/portfolio.zip", resp.GetDownloadUrl())
+		assert.NotNil(t, resp.FinishedAt)
+		exportService.AssertExpectations(t)
+
+		missing := uuid.New()
+		exportService.On("GetPortfolioExport", ctx, missing).Return(nil, repository.ErrNotFound)
+
+		_, err = h.GetPortfolioExport(ctx, &v1.GetPortfolioExportRequest{Id: missing.String()})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("GetHomeworkStats - success", func(t *testing.T) {
+		feedbackService := &MockFeedbackService{}
+
+		h := handler.NewHomeworkHandler(
+			&MockAssignmentService{},
+			&MockSubmissionService{},
+			feedbackService,
+			&MockTemplateService{},
+			&MockCommentService{},
+			&MockSearchService{},
+			&MockExportService{},
+			log,
+		)
+
+		tutorID := uuid.New()
+		studentID := uuid.New()
+		to := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+		turnaround := 90 * time.Minute
+		feedbackService.On("GetHomeworkStats", ctx, tutorID, (*time.Time)(nil), &to).Return(&domain.HomeworkStats{
+			TutorID: tutorID,
+			Students: []domain.StudentHomeworkStats{
+				{StudentID: studentID, Assigned: 4, Submitted: 2, Due: 4, OnTime: 1, Unreviewed: 1},
+				{StudentID: uuid.New(), Unreviewed: 2},
+			},
+			MedianReviewTurnaround: &turnaround,
+			ReviewedCount:          2,
+			UnreviewedCount:        3,
+		}, nil)
+
+		resp, err := h.GetHomeworkStats(ctx, &v1.GetHomeworkStatsRequest{
+			TutorId: tutorID.String(),
+			To:      timestamppb.New(to),
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(5400), resp.GetMedianReviewTurnaroundSeconds())
+		assert.Equal(t, int32(3), resp.UnreviewedCount)
+		assert.Len(t, resp.Students, 2)
+		assert.Equal(t, studentID.String(), resp.Students[0].StudentId)
+		assert.InDelta(t, 0.5, resp.Students[0].GetCompletionRate(), 1e-9)
+		assert.InDelta(t, 0.25, resp.Students[0].GetOnTimeRate(), 1e-9)
+		assert.Nil(t, resp.Students[1].CompletionRate)
+		feedbackService.AssertExpectations(t)
+
+		feedbackService.On("GetHomeworkStats", ctx, studentID, (*time.Time)(nil), (*time.Time)(nil)).Return(nil, service.ErrPermissionDenied)
+
+		_, err = h.GetHomeworkStats(ctx, &v1.GetHomeworkStatsRequest{TutorId: studentID.String()})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("RestoreAssignment", func(t *testing.T) {
+		assignmentService := &MockAssignmentService{}
+
+		h := handler.NewHomeworkHandler(
+			assignmentService,
+			&MockSubmissionService{},
+			&MockFeedbackService{},
+			&MockTemplateService{},
+			&MockCommentService{},
+			&MockSearchService{},
+			&MockExportService{},
+			log,
+		)
+
+		id := uuid.New()
+		assignmentService.On("RestoreAssignment", ctx, id).Return(&domain.Assignment{ID: id, TutorID: uuid.New(), StudentID: uuid.New()}, nil)
+
+		resp, err := h.RestoreAssignment(ctx, &v1.RestoreAssignmentRequest{AssignmentId: id.String()})
+		assert.NoError(t, err)
+		assert.Equal(t, id.String(), resp.Id)
+
+		expired := uuid.New()
+		assignmentService.On("RestoreAssignment", ctx, expired).Return(nil, service.ErrFailedPrecondition)
+
+		_, err = h.RestoreAssignment(ctx, &v1.RestoreAssignmentRequest{AssignmentId: expired.String()})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+		assignmentService.AssertExpectations(t)
+	})
+
+	t.Run("CreateFeedback - annotations", func(t *testing.T) {
+		feedbackService := &MockFeedbackService{}
+
+		h := handler.NewHomeworkHandler(
+			&MockAssignmentService{},
+			&MockSubmissionService{},
+			feedbackService,
+			&MockTemplateService{},
+			&MockCommentService{},
+			&MockSearchService{},
+			&MockExportService{},
+			log,
+		)
+
+		fileID := uuid.New()
+		annotations := []domain.Annotation{
+			{FileID: fileID, Page: 2, Type: domain.AnnotationHighlight, X: 0.1, Y: 0.2, Width: 0.3, Height: 0.05, Comment: str("sign")},
+			{FileID: fileID, Page: 2, Type: domain.AnnotationPen, Points: []domain.AnnotationPoint{{X: 0.1, Y: 0.1}, {X: 0.2, Y: 0.3}}},
+		}
+
+		feedbackService.On("CreateFeedback", ctx, mock.MatchedBy(func(f *domain.Feedback) bool {
+			return len(f.Annotations) == 2 && f.Annotations[0].Type == domain.AnnotationHighlight &&
+				f.Annotations[0].FileID == fileID && len(f.Annotations[1].Points) == 2
+		})).Return(&domain.Feedback{
+			ID:           uuid.New(),
+			SubmissionID: uuid.New(),
+			Annotations:  annotations,
+		}, nil)
+
+		resp, err := h.CreateFeedback(ctx, &v1.CreateFeedbackRequest{
+			SubmissionId: uuid.New().String(),
+			Annotations: []*v1.Annotation{
+				{FileId: fileID.String(), Page: 2, Type: v1.AnnotationType_ANNOTATION_HIGHLIGHT, X: 0.1, Y: 0.2, Width: 0.3, Height: 0.05, Comment: str("sign")},
+				{FileId: fileID.String(), Page: 2, Type: v1.AnnotationType_ANNOTATION_PEN, Points: []*v1.AnnotationPoint{{X: 0.1, Y: 0.1}, {X: 0.2, Y: 0.3}}},
+			},
+		})
+
+		assert.NoError(t, err)
+		assert.Len(t, resp.Annotations, 2)
+		assert.Equal(t, v1.AnnotationType_ANNOTATION_PEN, resp.Annotations[1].Type)
+		assert.Equal(t, int32(2), resp.Annotations[0].Page)
+		assert.Equal(t, "sign", resp.Annotations[0].GetComment())
+
+		_, err = h.CreateFeedback(ctx, &v1.CreateFeedbackRequest{
+			SubmissionId: uuid.New().String(),
+			Annotations:  []*v1.Annotation{{FileId: "not-a-uuid", Page: 1, Type: v1.AnnotationType_ANNOTATION_TEXT}},
+		})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("FlattenFeedbackAnnotations", func(t *testing.T) {
+		feedbackService := &MockFeedbackService{}
+
+		h := handler.NewHomeworkHandler(
+			&MockAssignmentService{},
+			&MockSubmissionService{},
+			feedbackService,
+			&MockTemplateService{},
+			&MockCommentService{},
+			&MockSearchService{},
+			&MockExportService{},
+			log,
+		)
+
+		feedbackID := uuid.New()
+		fileID := uuid.New()
+		copyID := uuid.New()
+		feedbackService.On("FlattenAnnotations", ctx, feedbackID, fileID).
+			Return(&domain.AnnotatedFile{FileID: copyID, URL: "http://files/copy.png"}, nil)
+
+		resp, err := h.FlattenFeedbackAnnotations(ctx, &v1.FlattenFeedbackAnnotationsRequest{
+			FeedbackId: feedbackID.String(),
+			FileId:     fileID.String(),
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, copyID.String(), resp.FileId)
+		assert.Equal(t, "http://files/copy.png", resp.Url)
+
+		otherFile := uuid.New()
+		feedbackService.On("FlattenAnnotations", ctx, feedbackID, otherFile).Return(nil, service.ErrFailedPrecondition)
+
+		_, err = h.FlattenFeedbackAnnotations(ctx, &v1.FlattenFeedbackAnnotationsRequest{
+			FeedbackId: feedbackID.String(),
+			FileId:     otherFile.String(),
+		})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+		feedbackService.AssertExpectations(t)
+	})
+
+	t.Run("ListAssignmentsByTutor - checklist progress filter", func(t *testing.T) {
+		assignmentService := &MockAssignmentService{}
+
+		h := handler.NewHomeworkHandler(
+			assignmentService,
+			&MockSubmissionService{},
+			&MockFeedbackService{},
+			&MockTemplateService{},
+			&MockCommentService{},
+			&MockSearchService{},
+			&MockExportService{},
+			log,
+		)
+
+		tutorID := uuid.New()
+		lower := 50
+		progress := 75
+		assignmentService.On("ListAssignmentsByTutor", ctx, tutorID, []domain.AssignmentStatus{}, domain.ProgressRange{Min: &lower}).
+			Return([]*domain.Assignment{{
+				ID:                uuid.New(),
+				Checklist:         []domain.ChecklistItem{{Text: "1", Done: true, Review: domain.ChecklistReviewCorrect}, {Text: "2"}},
+				ChecklistProgress: &progress,
+			}}, nil)
+
+		minProgress := int32(50)
+		resp, err := h.ListAssignmentsByTutor(ctx, &v1.ListAssignmentsByTutorRequest{
+			TutorId:           tutorID.String(),
+			ChecklistProgress: &v1.ChecklistProgressFilter{Min: &minProgress},
+		})
+
+		assert.NoError(t, err)
+		assert.Len(t, resp.Assignments, 1)
+		assert.Equal(t, int32(75), resp.Assignments[0].GetChecklistProgress())
+		assert.Len(t, resp.Assignments[0].Checklist, 2)
+		assert.Equal(t, v1.ChecklistReview_CHECKLIST_REVIEW_CORRECT, resp.Assignments[0].Checklist[0].Review)
+		assert.False(t, resp.Assignments[0].Checklist[1].Done)
+		assignmentService.AssertExpectations(t)
+	})
+
+	t.Run("MarkChecklistItem and ReviewChecklistItem", func(t *testing.T) {
+		assignmentService := &MockAssignmentService{}
+
+		h := handler.NewHomeworkHandler(
+			assignmentService,
+			&MockSubmissionService{},
+			&MockFeedbackService{},
+			&MockTemplateService{},
+			&MockCommentService{},
+			&MockSearchService{},
+			&MockExportService{},
+			log,
+		)
+
+		id := uuid.New()
+		assignmentService.On("MarkChecklistItem", ctx, id, 1, true).Return(&domain.Assignment{ID: id}, nil)
+		assignmentService.On("ReviewChecklistItem", ctx, id, 1, domain.ChecklistReviewIncorrect).Return(&domain.Assignment{ID: id}, nil)
+		assignmentService.On("ReviewChecklistItem", ctx, id, 2, domain.ChecklistReview("")).Return(nil, service.ErrFailedPrecondition)
+
+		resp, err := h.MarkChecklistItem(ctx, &v1.MarkChecklistItemRequest{AssignmentId: id.String(), Position: 1, Done: true})
+		assert.NoError(t, err)
+		assert.Equal(t, id.String(), resp.Id)
+
+		_, err = h.ReviewChecklistItem(ctx, &v1.ReviewChecklistItemRequest{
+			AssignmentId: id.String(),
+			Position:     1,
+			Review:       v1.ChecklistReview_CHECKLIST_REVIEW_INCORRECT,
+		})
+		assert.NoError(t, err)
+
+		_, err = h.ReviewChecklistItem(ctx, &v1.ReviewChecklistItemRequest{AssignmentId: id.String(), Position: 2})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+		_, err = h.MarkChecklistItem(ctx, &v1.MarkChecklistItemRequest{AssignmentId: "bad"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assignmentService.AssertExpectations(t)
+	})
+
+	t.Run("SetDefaultTemplate and GetDefaultTemplate", func(t *testing.T) {
+		templateService := &MockTemplateService{}
+
+		h := handler.NewHomeworkHandler(
+			&MockAssignmentService{},
+			&MockSubmissionService{},
+			&MockFeedbackService{},
+			templateService,
+			&MockCommentService{},
+			&MockSearchService{},
+			&MockExportService{},
+			log,
+		)
+
+		tutorID := uuid.New()
+		studentID := uuid.New()
+		templateID := uuid.New()
+		templateService.On("SetDefaultTemplate", ctx, tutorID, studentID, &templateID).Return(nil)
+		templateService.On("SetDefaultTemplate", ctx, tutorID, studentID, (*uuid.UUID)(nil)).Return(nil)
+		templateService.On("GetDefaultTemplate", ctx, tutorID, studentID).
+			Return(&domain.AssignmentTemplate{ID: templateID, TutorID: tutorID}, nil).Once()
+		templateService.On("GetDefaultTemplate", ctx, tutorID, studentID).Return(nil, repository.ErrNotFound)
+
+		_, err := h.SetDefaultTemplate(ctx, &v1.SetDefaultTemplateRequest{
+			TutorId:    tutorID.String(),
+			StudentId:  studentID.String(),
+			TemplateId: templateID.String(),
+		})
+		assert.NoError(t, err)
+
+		resp, err := h.GetDefaultTemplate(ctx, &v1.GetDefaultTemplateRequest{TutorId: tutorID.String(), StudentId: studentID.String()})
+		assert.NoError(t, err)
+		assert.Equal(t, templateID.String(), resp.Id)
+
+		_, err = h.SetDefaultTemplate(ctx, &v1.SetDefaultTemplateRequest{TutorId: tutorID.String(), StudentId: studentID.String()})
+		assert.NoError(t, err)
+
+		_, err = h.GetDefaultTemplate(ctx, &v1.GetDefaultTemplateRequest{TutorId: tutorID.String(), StudentId: studentID.String()})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+
+		_, err = h.SetDefaultTemplate(ctx, &v1.SetDefaultTemplateRequest{TutorId: tutorID.String(), StudentId: "bad"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		templateService.AssertExpectations(t)
+	})
 }
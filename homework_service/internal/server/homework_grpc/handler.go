@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"github.com/google/uuid"
+	"time"
 
 	"go.uber.org/zap"
 
@@ -26,6 +27,10 @@ type HomeworkHandler struct {
 	assignmentService service.AssignmentServiceInterface
 	submissionService service.SubmissionServiceInterface
 	feedbackService   service.FeedbackServiceInterface
+	templateService   service.TemplateServiceInterface
+	commentService    service.CommentServiceInterface
+	searchService     service.SearchServiceInterface
+	exportService     service.ExportServiceInterface
 	logger            *logger.Logger
 }
 
@@ -37,12 +42,20 @@ func NewHomeworkHandler(
 	assignmentService service.AssignmentServiceInterface,
 	submissionService service.SubmissionServiceInterface,
 	feedbackService service.FeedbackServiceInterface,
+	templateService service.TemplateServiceInterface,
+	commentService service.CommentServiceInterface,
+	searchService service.SearchServiceInterface,
+	exportService service.ExportServiceInterface,
 	logger *logger.Logger,
 ) *HomeworkHandler {
 	return &HomeworkHandler{
 		assignmentService: assignmentService,
 		submissionService: submissionService,
 		feedbackService:   feedbackService,
+		templateService:   templateService,
+		commentService:    commentService,
+		searchService:     searchService,
+		exportService:     exportService,
 		logger:            logger,
 	}
 }
@@ -57,10 +70,16 @@ func (h *HomeworkHandler) CreateAssignment(ctx context.Context, req *v1.CreateAs
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 	assignment := &domain.Assignment{
-		TutorID:     tutorId,
-		StudentID:   studentId,
-		Title:       req.Title,
-		Description: req.Description,
+		TutorID:             tutorId,
+		StudentID:           studentId,
+		Title:               req.Title,
+		Description:         req.Description,
+		DueBeforeNextLesson: req.DueBeforeNextLesson,
+		Quiz:                fromProtoQuiz(req.Quiz),
+		Checklist:           fromProtoChecklist(req.Checklist),
+		LatePolicy:          fromProtoLatePolicy(req.LatePolicy),
+		GracePeriod:         secondsToDuration(req.GracePeriodSeconds),
+		State:               fromProtoAssignmentState(req.State),
 	}
 
 	if req.FileId != nil {
@@ -70,10 +89,30 @@ func (h *HomeworkHandler) CreateAssignment(ctx context.Context, req *v1.CreateAs
 		}
 		assignment.FileID = &fileId
 	}
+	if len(req.Attachments) > 0 {
+		assignment.Attachments, err = parseAttachments(req.Attachments)
+		if err != nil {
+			return nil, err
+		}
+	}
 	if req.DueDate != nil {
 		dueDate := req.DueDate.AsTime()
 		assignment.DueDate = &dueDate
 	}
+	if req.LessonId != nil {
+		lessonId, err := uuid.Parse(*req.LessonId)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		assignment.LessonID = &lessonId
+	}
+	if req.PublishAt != nil {
+		publishAt := req.PublishAt.AsTime()
+		assignment.PublishAt = &publishAt
+		if assignment.State == "" {
+			assignment.State = domain.AssignmentStateScheduled
+		}
+	}
 
 	createdAssignment, err := h.assignmentService.CreateAssignment(ctx, assignment)
 	if err != nil {
@@ -108,17 +147,68 @@ func (h *HomeworkHandler) UpdateAssignment(ctx context.Context, req *v1.UpdateAs
 		updatedAssignment.Description = req.Description
 	}
 
-	if req.FileId != nil {
+	// A new attachment list replaces the old one, a lone file ID replaces all attachments.
+	if req.Attachments != nil {
+		updatedAssignment.FileID = nil
+		updatedAssignment.Attachments, err = parseAttachments(req.Attachments.Items)
+		if err != nil {
+			return nil, err
+		}
+	} else if req.FileId != nil {
 		fileId, err := uuid.Parse(*req.FileId)
 		if err != nil {
 			return nil, status.Error(codes.InvalidArgument, err.Error())
 		}
 		updatedAssignment.FileID = &fileId
+		updatedAssignment.Attachments = nil
 	}
 
 	if req.DueDate != nil {
+		if req.GetDueBeforeNextLesson() {
+			return nil, status.Error(codes.InvalidArgument, "due_date cannot be set together with due_before_next_lesson")
+		}
 		dueDate := req.DueDate.AsTime()
 		updatedAssignment.DueDate = &dueDate
+		updatedAssignment.DueBeforeNextLesson = false
+	} else if req.DueBeforeNextLesson != nil {
+		updatedAssignment.DueBeforeNextLesson = *req.DueBeforeNextLesson
+	}
+
+	if req.LessonId != nil {
+		updatedAssignment.LessonID = nil
+		if *req.LessonId != "" {
+			lessonId, err := uuid.Parse(*req.LessonId)
+			if err != nil {
+				return nil, status.Error(codes.InvalidArgument, err.Error())
+			}
+			updatedAssignment.LessonID = &lessonId
+		}
+	}
+
+	if req.Quiz != nil {
+		updatedAssignment.Quiz = fromProtoQuiz(req.Quiz.Items)
+	}
+	if req.Checklist != nil {
+		updatedAssignment.Checklist = fromProtoChecklist(req.Checklist.Items)
+	}
+
+	if req.LatePolicy != nil {
+		updatedAssignment.LatePolicy = fromProtoLatePolicy(*req.LatePolicy)
+	}
+	if req.GracePeriodSeconds != nil {
+		updatedAssignment.GracePeriod = nil
+		if *req.GracePeriodSeconds != 0 {
+			updatedAssignment.GracePeriod = secondsToDuration(req.GracePeriodSeconds)
+		}
+	}
+
+	if req.PublishAt != nil {
+		publishAt := req.PublishAt.AsTime()
+		updatedAssignment.PublishAt = &publishAt
+		updatedAssignment.State = domain.AssignmentStateScheduled
+	}
+	if req.State != nil && *req.State != v1.AssignmentState_ASSIGNMENT_STATE_UNSPECIFIED {
+		updatedAssignment.State = fromProtoAssignmentState(*req.State)
 	}
 
 	err = h.assignmentService.UpdateAssignment(ctx, &updatedAssignment)
@@ -143,6 +233,20 @@ func (h *HomeworkHandler) DeleteAssignment(ctx context.Context, req *v1.DeleteAs
 	return &v1.Empty{}, nil
 }
 
+func (h *HomeworkHandler) RestoreAssignment(ctx context.Context, req *v1.RestoreAssignmentRequest) (*v1.Assignment, error) {
+	id, err := uuid.Parse(req.AssignmentId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	assignment, err := h.assignmentService.RestoreAssignment(ctx, id)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return toProtoAssignment(assignment), nil
+}
+
 func (h *HomeworkHandler) ListAssignmentsByTutor(ctx context.Context, req *v1.ListAssignmentsByTutorRequest) (*v1.ListAssignmentsResponse, error) {
 	statuses := make([]domain.AssignmentStatus, 0, len(req.StatusFilter))
 	for _, s := range req.StatusFilter {
@@ -156,7 +260,7 @@ func (h *HomeworkHandler) ListAssignmentsByTutor(ctx context.Context, req *v1.Li
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
-	assignments, err := h.assignmentService.ListAssignmentsByTutor(ctx, tutorId, statuses)
+	assignments, err := h.assignmentService.ListAssignmentsByTutor(ctx, tutorId, statuses, fromProtoProgressFilter(req.GetChecklistProgress()))
 	if err != nil {
 		return nil, toGRPCError(err)
 	}
@@ -178,7 +282,7 @@ func (h *HomeworkHandler) ListAssignmentsByStudent(ctx context.Context, req *v1.
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
-	assignments, err := h.assignmentService.ListAssignmentsByStudent(ctx, studentId, statuses)
+	assignments, err := h.assignmentService.ListAssignmentsByStudent(ctx, studentId, statuses, fromProtoProgressFilter(req.GetChecklistProgress()))
 	if err != nil {
 		return nil, toGRPCError(err)
 	}
@@ -205,7 +309,29 @@ func (h *HomeworkHandler) ListAssignmentsByPair(ctx context.Context, req *v1.Lis
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
-	assignments, err := h.assignmentService.ListAssignmentsByPair(ctx, tutorId, studentId, statuses)
+	assignments, err := h.assignmentService.ListAssignmentsByPair(ctx, tutorId, studentId, statuses, fromProtoProgressFilter(req.GetChecklistProgress()))
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return &v1.ListAssignmentsResponse{
+		Assignments: toProtoAssignments(assignments),
+	}, nil
+}
+
+func (h *HomeworkHandler) ListAssignmentsByLesson(ctx context.Context, req *v1.ListAssignmentsByLessonRequest) (*v1.ListAssignmentsResponse, error) {
+	statuses := make([]domain.AssignmentStatus, 0, len(req.StatusFilter))
+	for _, s := range req.StatusFilter {
+		ds := domain.ToAssignmentStatus(s.String())
+		if ds != domain.AssignmentStatusUnspecified {
+			statuses = append(statuses, ds)
+		}
+	}
+	lessonId, err := uuid.Parse(req.LessonId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	assignments, err := h.assignmentService.ListAssignmentsByLesson(ctx, lessonId, statuses, fromProtoProgressFilter(req.GetChecklistProgress()))
 	if err != nil {
 		return nil, toGRPCError(err)
 	}
@@ -232,6 +358,13 @@ func (h *HomeworkHandler) CreateSubmission(ctx context.Context, req *v1.CreateSu
 		AssignmentID: assignmentId,
 		Comment:      req.Comment,
 		FileID:       fileId,
+		Answers:      fromProtoAnswers(req.Answers),
+	}
+	if len(req.Attachments) > 0 {
+		submission.Attachments, err = parseAttachments(req.Attachments)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	createdSubmission, err := h.submissionService.CreateSubmission(ctx, submission)
@@ -275,6 +408,24 @@ func (h *HomeworkHandler) CreateFeedback(ctx context.Context, req *v1.CreateFeed
 		SubmissionID: submissionId,
 		Comment:      req.Comment,
 		FileID:       fileId,
+		Score:        req.Score,
+		MaxScore:     req.MaxScore,
+		Verdict:      fromProtoVerdict(req.Verdict),
+	}
+	if req.Rubric != nil {
+		feedback.Rubric = fromProtoRubric(req.Rubric.Criteria)
+	}
+	if len(req.Attachments) > 0 {
+		feedback.Attachments, err = parseAttachments(req.Attachments)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if len(req.Annotations) > 0 {
+		feedback.Annotations, err = fromProtoAnnotations(req.Annotations)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	createdFeedback, err := h.feedbackService.CreateFeedback(ctx, feedback)
@@ -313,6 +464,26 @@ func (h *HomeworkHandler) UpdateFeedback(ctx context.Context, req *v1.UpdateFeed
 		update.FileID = &fileId
 	}
 
+	if req.Attachments != nil {
+		update.Attachments, err = parseAttachments(req.Attachments.Items)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	update.Score = req.Score
+	update.MaxScore = req.MaxScore
+	update.Verdict = fromProtoVerdict(req.Verdict)
+	if req.Rubric != nil {
+		update.Rubric = fromProtoRubric(req.Rubric.Criteria)
+	}
+	if req.Annotations != nil {
+		update.Annotations, err = fromProtoAnnotations(req.Annotations.Items)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	updatedFeedback, err := h.feedbackService.UpdateFeedback(ctx, update)
 	if err != nil {
 		return nil, toGRPCError(err)
@@ -336,6 +507,58 @@ func (h *HomeworkHandler) ListFeedbacksByAssignment(ctx context.Context, req *v1
 	}, nil
 }
 
+func (h *HomeworkHandler) GetGradebook(ctx context.Context, req *v1.GetGradebookRequest) (*v1.Gradebook, error) {
+	tutorId, err := uuid.Parse(req.TutorId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	studentId, err := uuid.Parse(req.StudentId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var from, to *time.Time
+	if req.From != nil {
+		t := req.From.AsTime()
+		from = &t
+	}
+	if req.To != nil {
+		t := req.To.AsTime()
+		to = &t
+	}
+
+	gradebook, err := h.feedbackService.GetGradebook(ctx, tutorId, studentId, from, to)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return toProtoGradebook(gradebook), nil
+}
+
+func (h *HomeworkHandler) GetHomeworkStats(ctx context.Context, req *v1.GetHomeworkStatsRequest) (*v1.HomeworkStats, error) {
+	tutorId, err := uuid.Parse(req.TutorId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var from, to *time.Time
+	if req.From != nil {
+		t := req.From.AsTime()
+		from = &t
+	}
+	if req.To != nil {
+		t := req.To.AsTime()
+		to = &t
+	}
+
+	stats, err := h.feedbackService.GetHomeworkStats(ctx, tutorId, from, to)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return toProtoHomeworkStats(stats), nil
+}
+
 func (h *HomeworkHandler) GetAssignmentFile(ctx context.Context, req *v1.GetAssignmentFileRequest) (*v1.HomeworkFileURL, error) {
 	id, err := uuid.Parse(req.AssignmentId)
 	if err != nil {
@@ -375,6 +598,56 @@ func (h *HomeworkHandler) GetFeedbackFile(ctx context.Context, req *v1.GetFeedba
 	return &v1.HomeworkFileURL{Url: url}, nil
 }
 
+func (h *HomeworkHandler) ListAttachmentFileURLs(ctx context.Context, req *v1.ListAttachmentFileURLsRequest) (*v1.ListAttachmentFileURLsResponse, error) {
+	id, err := uuid.Parse(req.OwnerId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var urls []domain.AttachmentFileURL
+	switch req.OwnerType {
+	case v1.AttachmentOwnerType_ATTACHMENT_OWNER_ASSIGNMENT:
+		urls, err = h.assignmentService.ListAttachmentFileURLs(ctx, id)
+	case v1.AttachmentOwnerType_ATTACHMENT_OWNER_SUBMISSION:
+		urls, err = h.submissionService.ListAttachmentFileURLs(ctx, id)
+	case v1.AttachmentOwnerType_ATTACHMENT_OWNER_FEEDBACK:
+		urls, err = h.feedbackService.ListAttachmentFileURLs(ctx, id)
+	case v1.AttachmentOwnerType_ATTACHMENT_OWNER_COMMENT:
+		urls, err = h.commentService.ListAttachmentFileURLs(ctx, id)
+	default:
+		return nil, status.Error(codes.InvalidArgument, "owner type is required")
+	}
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	resp := &v1.ListAttachmentFileURLsResponse{
+		Attachments: make([]*v1.AttachmentFileURL, 0, len(urls)),
+	}
+	for _, u := range urls {
+		resp.Attachments = append(resp.Attachments, &v1.AttachmentFileURL{
+			FileId:   u.FileID.String(),
+			Caption:  u.Caption,
+			Position: int32(u.Position), //nolint:gosec // positions are bounded by the attachment limit
+			Url:      u.URL,
+		})
+	}
+
+	return resp, nil
+}
+
+func parseAttachments(inputs []*v1.AttachmentInput) ([]domain.Attachment, error) {
+	attachments := make([]domain.Attachment, 0, len(inputs))
+	for _, in := range inputs {
+		fileId, err := uuid.Parse(in.FileId)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		attachments = append(attachments, domain.Attachment{FileID: fileId, Caption: in.Caption})
+	}
+	return attachments, nil
+}
+
 func toGRPCError(err error) error {
 	switch {
 	case errors.Is(err, repository.ErrNotFound):
@@ -383,6 +656,8 @@ func toGRPCError(err error) error {
 		return status.Error(codes.PermissionDenied, err.Error())
 	case errors.Is(err, service.ErrInvalidArgument):
 		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, service.ErrFailedPrecondition):
+		return status.Error(codes.FailedPrecondition, err.Error())
 	default:
 		return status.Error(codes.Internal, "internal server error")
 	}
@@ -397,6 +672,14 @@ func toProtoAssignment(a *domain.Assignment) *v1.Assignment {
 		Description: a.Description,
 		CreatedAt:   timestamppb.New(a.CreatedAt),
 		EditedAt:    timestamppb.New(a.EditedAt),
+		Attachments: toProtoAttachments(a.Attachments),
+
+		DueBeforeNextLesson: a.DueBeforeNextLesson,
+		Quiz:                toProtoQuiz(a.Quiz),
+		Checklist:           toProtoChecklist(a.Checklist),
+		LatePolicy:          toProtoLatePolicy(a.LatePolicy),
+		SubmittedLate:       a.SubmittedLate,
+		State:               toProtoAssignmentState(a.State),
 	}
 
 	if a.FileID != nil {
@@ -406,6 +689,28 @@ func toProtoAssignment(a *domain.Assignment) *v1.Assignment {
 	if a.DueDate != nil {
 		assignment.DueDate = timestamppb.New(*a.DueDate)
 	}
+	if a.LessonID != nil {
+		id := a.LessonID.String()
+		assignment.LessonId = &id
+	}
+	if a.DueLessonID != nil {
+		id := a.DueLessonID.String()
+		assignment.DueLessonId = &id
+	}
+	if a.GracePeriod != nil {
+		seconds := int64(a.GracePeriod.Seconds())
+		assignment.GracePeriodSeconds = &seconds
+	}
+	if a.PublishAt != nil {
+		assignment.PublishAt = timestamppb.New(*a.PublishAt)
+	}
+	if a.PublishedAt != nil {
+		assignment.PublishedAt = timestamppb.New(*a.PublishedAt)
+	}
+	if a.ChecklistProgress != nil {
+		progress := int32(*a.ChecklistProgress)
+		assignment.ChecklistProgress = &progress
+	}
 
 	return assignment
 }
@@ -422,9 +727,13 @@ func toProtoSubmission(s *domain.Submission) *v1.Submission {
 	submission := &v1.Submission{
 		Id:           s.ID.String(),
 		AssignmentId: s.AssignmentID.String(),
+		Version:      int32(s.Version), //nolint:gosec // attempt numbers are small
 		Comment:      s.Comment,
 		CreatedAt:    timestamppb.New(s.CreatedAt),
 		EditedAt:     timestamppb.New(s.EditedAt),
+		Attachments:  toProtoAttachments(s.Attachments),
+		Answers:      toProtoAnswers(s.Answers),
+		IsLate:       s.IsLate,
 	}
 
 	if s.FileID != nil {
@@ -450,6 +759,12 @@ func toProtoFeedback(f *domain.Feedback) *v1.Feedback {
 		Comment:      f.Comment,
 		CreatedAt:    timestamppb.New(f.CreatedAt),
 		EditedAt:     timestamppb.New(f.EditedAt),
+		Attachments:  toProtoAttachments(f.Attachments),
+		Score:        f.Score,
+		MaxScore:     f.MaxScore,
+		Rubric:       toProtoRubric(f.Rubric),
+		Verdict:      toProtoVerdict(f.Verdict),
+		Annotations:  toProtoAnnotations(f.Annotations),
 	}
 
 	if f.FileID != nil {
@@ -467,3 +782,184 @@ func toProtoFeedbacks(feedbacks []*domain.Feedback) []*v1.Feedback {
 	}
 	return protoFeedbacks
 }
+
+func toProtoAttachments(attachments []domain.Attachment) []*v1.Attachment {
+	var protoAttachments []*v1.Attachment
+	for _, a := range attachments {
+		protoAttachments = append(protoAttachments, &v1.Attachment{
+			Id:        a.ID.String(),
+			FileId:    a.FileID.String(),
+			Caption:   a.Caption,
+			Position:  int32(a.Position), //nolint:gosec // positions are bounded by the attachment limit
+			CreatedAt: timestamppb.New(a.CreatedAt),
+		})
+	}
+	return protoAttachments
+}
+
+func fromProtoRubric(criteria []*v1.RubricCriterion) []domain.RubricCriterion {
+	rubric := make([]domain.RubricCriterion, 0, len(criteria))
+	for _, c := range criteria {
+		rubric = append(rubric, domain.RubricCriterion{
+			Name:     c.Name,
+			Score:    c.Score,
+			MaxScore: c.MaxScore,
+			Comment:  c.Comment,
+		})
+	}
+	return rubric
+}
+
+func toProtoRubric(rubric []domain.RubricCriterion) []*v1.RubricCriterion {
+	var protoRubric []*v1.RubricCriterion
+	for _, c := range rubric {
+		protoRubric = append(protoRubric, &v1.RubricCriterion{
+			Name:     c.Name,
+			Score:    c.Score,
+			MaxScore: c.MaxScore,
+			Comment:  c.Comment,
+		})
+	}
+	return protoRubric
+}
+
+func toProtoGradebook(g *domain.Gradebook) *v1.Gradebook {
+	gradebook := &v1.Gradebook{
+		TutorId:        g.TutorID.String(),
+		StudentId:      g.StudentID.String(),
+		AverageScore:   g.AverageScore,
+		AveragePercent: g.AveragePercent,
+		Trend:          g.Trend,
+		LateCount:      int32(g.LateCount), //nolint:gosec // bounded by the number of entries
+	}
+
+	for _, e := range g.Entries {
+		entry := &v1.GradebookEntry{
+			AssignmentId: e.AssignmentID.String(),
+			Title:        e.Title,
+			FeedbackId:   e.FeedbackID.String(),
+			GradedAt:     timestamppb.New(e.GradedAt),
+			Score:        e.Score,
+			MaxScore:     e.MaxScore,
+			Percent:      e.Percent(),
+			Rubric:       toProtoRubric(e.Rubric),
+			IsLate:       e.IsLate,
+		}
+		if e.DueDate != nil {
+			entry.DueDate = timestamppb.New(*e.DueDate)
+		}
+		gradebook.Entries = append(gradebook.Entries, entry)
+	}
+
+	for _, c := range g.Criteria {
+		gradebook.Criteria = append(gradebook.Criteria, &v1.CriterionAverage{
+			Name:           c.Name,
+			Count:          int32(c.Count), //nolint:gosec // bounded by the number of feedbacks
+			AverageScore:   c.AverageScore,
+			AveragePercent: c.AveragePercent,
+		})
+	}
+
+	return gradebook
+}
+
+func toProtoHomeworkStats(s *domain.HomeworkStats) *v1.HomeworkStats {
+	stats := &v1.HomeworkStats{
+		TutorId:         s.TutorID.String(),
+		ReviewedCount:   int32(s.ReviewedCount),   //nolint:gosec // bounded by the number of submissions
+		UnreviewedCount: int32(s.UnreviewedCount), //nolint:gosec // bounded by the number of assignments
+	}
+	if s.MedianReviewTurnaround != nil {
+		seconds := int64(s.MedianReviewTurnaround.Seconds())
+		stats.MedianReviewTurnaroundSeconds = &seconds
+	}
+
+	for _, st := range s.Students {
+		stats.Students = append(stats.Students, &v1.StudentHomeworkStats{
+			StudentId:      st.StudentID.String(),
+			Assigned:       int32(st.Assigned),  //nolint:gosec // bounded by the number of assignments
+			Submitted:      int32(st.Submitted), //nolint:gosec // bounded by the number of assignments
+			Due:            int32(st.Due),       //nolint:gosec // bounded by the number of assignments
+			OnTime:         int32(st.OnTime),    //nolint:gosec // bounded by the number of assignments
+			CompletionRate: st.CompletionRate(),
+			OnTimeRate:     st.OnTimeRate(),
+			Unreviewed:     int32(st.Unreviewed), //nolint:gosec // bounded by the number of assignments
+		})
+	}
+
+	return stats
+}
+
+func fromProtoVerdict(v v1.FeedbackVerdict) domain.FeedbackVerdict {
+	switch v {
+	case v1.FeedbackVerdict_FEEDBACK_VERDICT_ACCEPTED:
+		return domain.FeedbackVerdictAccepted
+	case v1.FeedbackVerdict_FEEDBACK_VERDICT_NEEDS_REVISION:
+		return domain.FeedbackVerdictNeedsRevision
+	default:
+		return ""
+	}
+}
+
+func toProtoVerdict(v domain.FeedbackVerdict) v1.FeedbackVerdict {
+	switch v {
+	case domain.FeedbackVerdictAccepted:
+		return v1.FeedbackVerdict_FEEDBACK_VERDICT_ACCEPTED
+	case domain.FeedbackVerdictNeedsRevision:
+		return v1.FeedbackVerdict_FEEDBACK_VERDICT_NEEDS_REVISION
+	default:
+		return v1.FeedbackVerdict_FEEDBACK_VERDICT_UNSPECIFIED
+	}
+}
+
+func fromProtoLatePolicy(p v1.LatePolicy) domain.LatePolicy {
+	switch p {
+	case v1.LatePolicy_LATE_POLICY_ALLOW:
+		return domain.LatePolicyAllow
+	case v1.LatePolicy_LATE_POLICY_FLAG:
+		return domain.LatePolicyFlag
+	case v1.LatePolicy_LATE_POLICY_LOCK:
+		return domain.LatePolicyLock
+	default:
+		return ""
+	}
+}
+
+func toProtoLatePolicy(p domain.LatePolicy) v1.LatePolicy {
+	switch p {
+	case domain.LatePolicyAllow:
+		return v1.LatePolicy_LATE_POLICY_ALLOW
+	case domain.LatePolicyFlag:
+		return v1.LatePolicy_LATE_POLICY_FLAG
+	case domain.LatePolicyLock:
+		return v1.LatePolicy_LATE_POLICY_LOCK
+	default:
+		return v1.LatePolicy_LATE_POLICY_UNSPECIFIED
+	}
+}
+
+func fromProtoAssignmentState(s v1.AssignmentState) domain.AssignmentState {
+	switch s {
+	case v1.AssignmentState_ASSIGNMENT_STATE_DRAFT:
+		return domain.AssignmentStateDraft
+	case v1.AssignmentState_ASSIGNMENT_STATE_SCHEDULED:
+		return domain.AssignmentStateScheduled
+	case v1.AssignmentState_ASSIGNMENT_STATE_PUBLISHED:
+		return domain.AssignmentStatePublished
+	default:
+		return ""
+	}
+}
+
+func toProtoAssignmentState(s domain.AssignmentState) v1.AssignmentState {
+	switch s {
+	case domain.AssignmentStateDraft:
+		return v1.AssignmentState_ASSIGNMENT_STATE_DRAFT
+	case domain.AssignmentStateScheduled:
+		return v1.AssignmentState_ASSIGNMENT_STATE_SCHEDULED
+	case domain.AssignmentStatePublished:
+		return v1.AssignmentState_ASSIGNMENT_STATE_PUBLISHED
+	default:
+		return v1.AssignmentState_ASSIGNMENT_STATE_UNSPECIFIED
+	}
+}
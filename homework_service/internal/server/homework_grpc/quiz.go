@@ -0,0 +1,122 @@
+package homework_grpc
+
+import (
+	"homework_service/internal/domain"
+	v1 "homework_service/pkg/api"
+)
+
+func fromProtoQuiz(questions []*v1.QuizQuestion) []domain.QuizQuestion {
+	if len(questions) == 0 {
+		return nil
+	}
+
+	quiz := make([]domain.QuizQuestion, 0, len(questions))
+	for _, q := range questions {
+		quiz = append(quiz, domain.QuizQuestion{
+			Type:           fromProtoQuestionType(q.Type),
+			Text:           q.Text,
+			Options:        q.Options,
+			Points:         q.Points,
+			CorrectOptions: fromProtoInts(q.CorrectOptions),
+			NumericAnswer:  q.NumericAnswer,
+			Tolerance:      q.Tolerance,
+			TextAnswers:    q.TextAnswers,
+		})
+	}
+	return quiz
+}
+
+func toProtoQuiz(quiz []domain.QuizQuestion) []*v1.QuizQuestion {
+	var questions []*v1.QuizQuestion
+	for _, q := range quiz {
+		questions = append(questions, &v1.QuizQuestion{
+			Type:           toProtoQuestionType(q.Type),
+			Text:           q.Text,
+			Options:        q.Options,
+			Points:         q.Points,
+			CorrectOptions: toProtoInts(q.CorrectOptions),
+			NumericAnswer:  q.NumericAnswer,
+			Tolerance:      q.Tolerance,
+			TextAnswers:    q.TextAnswers,
+		})
+	}
+	return questions
+}
+
+func fromProtoAnswers(answers []*v1.QuizAnswer) []domain.QuizAnswer {
+	if len(answers) == 0 {
+		return nil
+	}
+
+	result := make([]domain.QuizAnswer, 0, len(answers))
+	for _, a := range answers {
+		result = append(result, domain.QuizAnswer{
+			Question: int(a.Question),
+			Options:  fromProtoInts(a.Options),
+			Number:   a.Number,
+			Text:     a.Text,
+		})
+	}
+	return result
+}
+
+func toProtoAnswers(answers []domain.QuizAnswer) []*v1.QuizAnswer {
+	var result []*v1.QuizAnswer
+	for _, a := range answers {
+		result = append(result, &v1.QuizAnswer{
+			Question: int32(a.Question), //nolint:gosec // quizzes have at most 100 questions
+			Options:  toProtoInts(a.Options),
+			Number:   a.Number,
+			Text:     a.Text,
+			Correct:  a.Correct,
+			Score:    a.Score,
+		})
+	}
+	return result
+}
+
+func fromProtoInts(values []int32) []int {
+	var result []int
+	for _, v := range values {
+		result = append(result, int(v))
+	}
+	return result
+}
+
+func toProtoInts(values []int) []int32 {
+	var result []int32
+	for _, v := range values {
+		result = append(result, int32(v)) //nolint:gosec // option indexes are small
+	}
+	return result
+}
+
+func fromProtoQuestionType(t v1.QuizQuestionType) domain.QuizQuestionType {
+	switch t {
+	case v1.QuizQuestionType_QUIZ_QUESTION_SINGLE_CHOICE:
+		return domain.QuizQuestionSingleChoice
+	case v1.QuizQuestionType_QUIZ_QUESTION_MULTIPLE_CHOICE:
+		return domain.QuizQuestionMultipleChoice
+	case v1.QuizQuestionType_QUIZ_QUESTION_NUMERIC:
+		return domain.QuizQuestionNumeric
+	case v1.QuizQuestionType_QUIZ_QUESTION_SHORT_TEXT:
+		return domain.QuizQuestionShortText
+	default:
+		return ""
+	}
+}
+
+func toProtoQuestionType(t domain.QuizQuestionType) v1.QuizQuestionType {
+	switch t {
+	case domain.QuizQuestionSingleChoice:
+		return v1.QuizQuestionType_QUIZ_QUESTION_SINGLE_CHOICE
+	case domain.QuizQuestionMultipleChoice:
+		return v1.QuizQuestionType_QUIZ_QUESTION_MULTIPLE_CHOICE
+	case domain.QuizQuestionNumeric:
+		return v1.QuizQuestionType_QUIZ_QUESTION_NUMERIC
+	case domain.QuizQuestionShortText:
+		return v1.QuizQuestionType_QUIZ_QUESTION_SHORT_TEXT
+	default:
+		return v1.QuizQuestionType_QUIZ_QUESTION_TYPE_UNSPECIFIED
+	}
+}
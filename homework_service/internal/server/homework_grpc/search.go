@@ -0,0 +1,98 @@
+package homework_grpc
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"homework_service/internal/domain"
+	v1 "homework_service/pkg/api"
+)
+
+func (h *HomeworkHandler) SearchHomework(ctx context.Context, req *v1.SearchHomeworkRequest) (*v1.SearchHomeworkResponse, error) {
+	filter := domain.SearchFilter{Query: req.Query}
+
+	tutorId, err := parseOptionalID(req.TutorId)
+	if err != nil {
+		return nil, err
+	}
+	if tutorId != nil {
+		filter.TutorID = *tutorId
+	}
+	studentId, err := parseOptionalID(req.StudentId)
+	if err != nil {
+		return nil, err
+	}
+	if studentId != nil {
+		filter.StudentID = *studentId
+	}
+
+	for _, t := range req.Types {
+		hitType := fromProtoSearchHitType(t)
+		if hitType == "" {
+			return nil, status.Error(codes.InvalidArgument, "unknown result type")
+		}
+		filter.Types = append(filter.Types, hitType)
+	}
+	if req.From != nil {
+		from := req.From.AsTime()
+		filter.From = &from
+	}
+	if req.To != nil {
+		to := req.To.AsTime()
+		filter.To = &to
+	}
+
+	page, err := h.searchService.SearchHomework(ctx, filter, int(req.PageSize), req.PageToken)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	resp := &v1.SearchHomeworkResponse{NextPageToken: page.NextPageToken}
+	for _, hit := range page.Hits {
+		resp.Hits = append(resp.Hits, toProtoSearchHit(hit))
+	}
+	return resp, nil
+}
+
+func toProtoSearchHit(h domain.SearchHit) *v1.SearchHit {
+	return &v1.SearchHit{
+		Type:            toProtoSearchHitType(h.Type),
+		Id:              h.ID.String(),
+		AssignmentId:    h.AssignmentID.String(),
+		TutorId:         h.TutorID.String(),
+		StudentId:       h.StudentID.String(),
+		AssignmentTitle: h.AssignmentTitle,
+		Snippet:         h.Snippet,
+		Rank:            h.Rank,
+		CreatedAt:       timestamppb.New(h.CreatedAt),
+	}
+}
+
+func fromProtoSearchHitType(t v1.SearchHitType) domain.SearchHitType {
+	switch t {
+	case v1.SearchHitType_SEARCH_HIT_ASSIGNMENT:
+		return domain.SearchHitAssignment
+	case v1.SearchHitType_SEARCH_HIT_SUBMISSION:
+		return domain.SearchHitSubmission
+	case v1.SearchHitType_SEARCH_HIT_FEEDBACK:
+		return domain.SearchHitFeedback
+	default:
+		return ""
+	}
+}
+
+func toProtoSearchHitType(t domain.SearchHitType) v1.SearchHitType {
+	switch t {
+	case domain.SearchHitAssignment:
+		return v1.SearchHitType_SEARCH_HIT_ASSIGNMENT
+	case domain.SearchHitSubmission:
+		return v1.SearchHitType_SEARCH_HIT_SUBMISSION
+	case domain.SearchHitFeedback:
+		return v1.SearchHitType_SEARCH_HIT_FEEDBACK
+	default:
+		return v1.SearchHitType_SEARCH_HIT_TYPE_UNSPECIFIED
+	}
+}
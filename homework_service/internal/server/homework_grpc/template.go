@@ -0,0 +1,221 @@
+package homework_grpc
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"homework_service/internal/domain"
+	v1 "homework_service/pkg/api"
+)
+
+func (h *HomeworkHandler) CreateAssignmentTemplate(ctx context.Context, req *v1.CreateAssignmentTemplateRequest) (*v1.AssignmentTemplate, error) {
+	tutorId, err := uuid.Parse(req.TutorId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	template := &domain.AssignmentTemplate{
+		TutorID:     tutorId,
+		Title:       req.Title,
+		Description: req.Description,
+		DueOffset:   secondsToDuration(req.DueOffsetSeconds),
+	}
+	template.Attachments, err = parseAttachments(req.Attachments)
+	if err != nil {
+		return nil, err
+	}
+
+	createdTemplate, err := h.templateService.CreateTemplate(ctx, template)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return toProtoTemplate(createdTemplate), nil
+}
+
+func (h *HomeworkHandler) UpdateAssignmentTemplate(ctx context.Context, req *v1.UpdateAssignmentTemplateRequest) (*v1.AssignmentTemplate, error) {
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	template, err := h.templateService.GetTemplate(ctx, id)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	updatedTemplate := *template
+	if req.Title != nil {
+		updatedTemplate.Title = req.Title
+	}
+	if req.Description != nil {
+		updatedTemplate.Description = req.Description
+	}
+	if req.DueOffsetSeconds != nil {
+		updatedTemplate.DueOffset = secondsToDuration(req.DueOffsetSeconds)
+	}
+	if req.Attachments != nil {
+		updatedTemplate.Attachments, err = parseAttachments(req.Attachments.Items)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := h.templateService.UpdateTemplate(ctx, &updatedTemplate); err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return toProtoTemplate(&updatedTemplate), nil
+}
+
+func (h *HomeworkHandler) DeleteAssignmentTemplate(ctx context.Context, req *v1.DeleteAssignmentTemplateRequest) (*v1.Empty, error) {
+	id, err := uuid.Parse(req.TemplateId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := h.templateService.DeleteTemplate(ctx, id); err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return &v1.Empty{}, nil
+}
+
+func (h *HomeworkHandler) ListAssignmentTemplates(ctx context.Context, req *v1.ListAssignmentTemplatesRequest) (*v1.ListAssignmentTemplatesResponse, error) {
+	tutorId, err := uuid.Parse(req.TutorId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	templates, err := h.templateService.ListTemplates(ctx, tutorId)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	resp := &v1.ListAssignmentTemplatesResponse{}
+	for _, t := range templates {
+		resp.Templates = append(resp.Templates, toProtoTemplate(t))
+	}
+	return resp, nil
+}
+
+func (h *HomeworkHandler) AssignFromTemplate(ctx context.Context, req *v1.AssignFromTemplateRequest) (*v1.ListAssignmentsResponse, error) {
+	templateId, err := uuid.Parse(req.TemplateId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	studentIds := make([]uuid.UUID, 0, len(req.StudentIds))
+	for _, raw := range req.StudentIds {
+		id, err := uuid.Parse(raw)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		studentIds = append(studentIds, id)
+	}
+
+	var dueDate *time.Time
+	if req.DueDate != nil {
+		t := req.DueDate.AsTime()
+		dueDate = &t
+	}
+
+	assignments, err := h.templateService.AssignFromTemplate(ctx, templateId, studentIds, dueDate)
+	if err != nil {
+		h.logger.Error("failed to assign from template",
+			zap.Error(err),
+			zap.String("template_id", templateId.String()),
+		)
+		return nil, toGRPCError(err)
+	}
+
+	h.logger.Info("assignments created from template",
+		zap.String("template_id", templateId.String()),
+		zap.Int("count", len(assignments)),
+	)
+
+	return &v1.ListAssignmentsResponse{
+		Assignments: toProtoAssignments(assignments),
+	}, nil
+}
+
+func (h *HomeworkHandler) SetDefaultTemplate(ctx context.Context, req *v1.SetDefaultTemplateRequest) (*v1.Empty, error) {
+	tutorId, studentId, err := parsePair(req.TutorId, req.StudentId)
+	if err != nil {
+		return nil, err
+	}
+
+	var templateId *uuid.UUID
+	if req.TemplateId != "" {
+		id, err := uuid.Parse(req.TemplateId)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		templateId = &id
+	}
+
+	if err := h.templateService.SetDefaultTemplate(ctx, tutorId, studentId, templateId); err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return &v1.Empty{}, nil
+}
+
+func (h *HomeworkHandler) GetDefaultTemplate(ctx context.Context, req *v1.GetDefaultTemplateRequest) (*v1.AssignmentTemplate, error) {
+	tutorId, studentId, err := parsePair(req.TutorId, req.StudentId)
+	if err != nil {
+		return nil, err
+	}
+
+	template, err := h.templateService.GetDefaultTemplate(ctx, tutorId, studentId)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return toProtoTemplate(template), nil
+}
+
+func parsePair(rawTutorId, rawStudentId string) (uuid.UUID, uuid.UUID, error) {
+	tutorId, err := uuid.Parse(rawTutorId)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	studentId, err := uuid.Parse(rawStudentId)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return tutorId, studentId, nil
+}
+
+func secondsToDuration(seconds *int64) *time.Duration {
+	if seconds == nil {
+		return nil
+	}
+	d := time.Duration(*seconds) * time.Second
+	return &d
+}
+
+func toProtoTemplate(t *domain.AssignmentTemplate) *v1.AssignmentTemplate {
+	template := &v1.AssignmentTemplate{
+		Id:          t.ID.String(),
+		TutorId:     t.TutorID.String(),
+		Title:       t.Title,
+		Description: t.Description,
+		Attachments: toProtoAttachments(t.Attachments),
+		CreatedAt:   timestamppb.New(t.CreatedAt),
+		EditedAt:    timestamppb.New(t.EditedAt),
+	}
+
+	if t.DueOffset != nil {
+		seconds := int64(t.DueOffset.Seconds())
+		template.DueOffsetSeconds = &seconds
+	}
+
+	return template
+}
@@ -0,0 +1,133 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+
+	"homework_service/internal/domain"
+)
+
+const (
+	maxAnnotations             = 200
+	maxAnnotationPoints        = 2000
+	maxAnnotationCommentLength = 1000
+)
+
+// validateAnnotations checks that the annotations are drawn within the pages of the
+// submission files. Fields the type does not use are cleared.
+func validateAnnotations(annotations []domain.Annotation, submission *domain.Submission) error {
+	if len(annotations) > maxAnnotations {
+		return fmt.Errorf("%w: at most %d annotations are allowed", ErrInvalidArgument, maxAnnotations)
+	}
+
+	files := make(map[uuid.UUID]bool)
+	if submission.FileID != nil {
+		files[*submission.FileID] = true
+	}
+	for _, a := range submission.Attachments {
+		files[a.FileID] = true
+	}
+
+	for i := range annotations {
+		a := &annotations[i]
+		if !a.Type.IsValid() {
+			return fmt.Errorf("%w: annotation %d has an unknown type", ErrInvalidArgument, i)
+		}
+		if !files[a.FileID] {
+			return fmt.Errorf("%w: annotation %d is not on a file of the submission", ErrInvalidArgument, i)
+		}
+		if a.Page < 1 {
+			return fmt.Errorf("%w: annotation %d must have a positive page", ErrInvalidArgument, i)
+		}
+		if a.Comment != nil && utf8.RuneCountInString(*a.Comment) > maxAnnotationCommentLength {
+			return fmt.Errorf("%w: annotation %d comment exceeds %d characters", ErrInvalidArgument, i, maxAnnotationCommentLength)
+		}
+
+		switch a.Type {
+		case domain.AnnotationHighlight:
+			if !inUnit(a.X) || !inUnit(a.Y) || a.Width <= 0 || a.Height <= 0 || !inUnit(a.X+a.Width) || !inUnit(a.Y+a.Height) {
+				return fmt.Errorf("%w: highlight %d must be a non-empty rectangle within the page", ErrInvalidArgument, i)
+			}
+			a.Points = nil
+		case domain.AnnotationText:
+			if !inUnit(a.X) || !inUnit(a.Y) || a.Width < 0 || !inUnit(a.X+a.Width) {
+				return fmt.Errorf("%w: text %d must start within the page", ErrInvalidArgument, i)
+			}
+			if a.Comment == nil || strings.TrimSpace(*a.Comment) == "" {
+				return fmt.Errorf("%w: text %d has no comment", ErrInvalidArgument, i)
+			}
+			a.Height = 0
+			a.Points = nil
+		case domain.AnnotationPen:
+			if len(a.Points) < 2 || len(a.Points) > maxAnnotationPoints {
+				return fmt.Errorf("%w: pen %d must have from 2 to %d points", ErrInvalidArgument, i, maxAnnotationPoints)
+			}
+			for _, p := range a.Points {
+				if !inUnit(p.X) || !inUnit(p.Y) {
+					return fmt.Errorf("%w: pen %d must be within the page", ErrInvalidArgument, i)
+				}
+			}
+			a.X, a.Y, a.Width, a.Height = 0, 0, 0, 0
+		}
+	}
+
+	return nil
+}
+
+// inUnit reports whether a normalized coordinate is within the page.
+func inUnit(v float64) bool {
+	return v >= 0 && v <= 1
+}
+
+// FlattenAnnotations draws the annotations of the feedback onto a copy of the
+// submission file and stores it in file_service as a file of the caller. Images are
+// copied as PNG, PDF files as PDF.
+func (s *feedbackService) FlattenAnnotations(ctx context.Context, feedbackID, fileID uuid.UUID) (*domain.AnnotatedFile, error) {
+	feedback, err := s.GetFeedback(ctx, feedbackID)
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := uuid.Parse(callerID(ctx))
+	if err != nil {
+		return nil, ErrPermissionDenied
+	}
+
+	var annotations []domain.Annotation
+	for _, a := range feedback.Annotations {
+		if a.FileID == fileID {
+			annotations = append(annotations, a)
+		}
+	}
+	if len(annotations) == 0 {
+		return nil, fmt.Errorf("%w: the feedback has no annotations on the file", ErrFailedPrecondition)
+	}
+
+	content, err := s.fileClient.DownloadFile(ctx, fileID)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = content.Body.Close() }()
+
+	flattened, err := flattenAnnotations(content, annotations)
+	if err != nil {
+		return nil, err
+	}
+
+	copyID, err := s.fileClient.UploadFile(ctx, userID, flattened.Name, bytes.NewReader(flattened.Data), int64(len(flattened.Data)))
+	if err != nil {
+		return nil, err
+	}
+
+	url, err := s.fileClient.GetFileURL(ctx, copyID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.AnnotatedFile{FileID: copyID, URL: url}, nil
+}
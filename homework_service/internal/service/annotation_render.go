@@ -0,0 +1,529 @@
+package service
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	_ "image/gif"  // registers the GIF decoder
+	_ "image/jpeg" // registers the JPEG decoder
+	"image/png"
+	"io"
+	"math"
+	"net/http"
+	"path"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/pdfcpu/pdfcpu/pkg/api"
+	"github.com/pdfcpu/pdfcpu/pkg/pdfcpu"
+	"github.com/pdfcpu/pdfcpu/pkg/pdfcpu/model"
+	"github.com/pdfcpu/pdfcpu/pkg/pdfcpu/types"
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/gofont/goregular"
+	"golang.org/x/image/font/opentype"
+	"golang.org/x/image/math/fixed"
+	"golang.org/x/image/vector"
+	_ "golang.org/x/image/webp" // registers the WebP decoder
+
+	"homework_service/internal/domain"
+)
+
+const (
+	// maxAnnotatedFileSize limits the size of a file the annotations are drawn onto.
+	maxAnnotatedFileSize = 50 << 20
+	// maxAnnotatedImagePixels limits the size of a decoded image.
+	maxAnnotatedImagePixels = 50_000_000
+	// pdfOverlaySize is the length in pixels of the longer side of the layer of
+	// annotations stamped onto a PDF page.
+	pdfOverlaySize = 2000
+)
+
+var (
+	highlightColor = color.NRGBA{R: 255, G: 214, B: 0, A: 96}
+	inkColor       = color.NRGBA{R: 220, G: 38, B: 38, A: 255}
+	labelColor     = color.NRGBA{R: 255, G: 255, B: 255, A: 224}
+)
+
+var annotationFont = mustParseFont(goregular.TTF)
+
+func mustParseFont(ttf []byte) *opentype.Font {
+	f, err := opentype.Parse(ttf)
+	if err != nil {
+		panic(err)
+	}
+	return f
+}
+
+type flattenedFile struct {
+	Name string
+	Data []byte
+}
+
+// flattenAnnotations draws the annotations onto a copy of the file: a PNG for images
+// and a PDF for PDF files.
+func flattenAnnotations(content *domain.FileContent, annotations []domain.Annotation) (*flattenedFile, error) {
+	data, err := io.ReadAll(io.LimitReader(content.Body, maxAnnotatedFileSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read file: %w", err)
+	}
+	if len(data) > maxAnnotatedFileSize {
+		return nil, fmt.Errorf("%w: the file is larger than 50 MB", ErrFailedPrecondition)
+	}
+
+	name := sanitizeFileName(content.Name)
+	name = strings.TrimSuffix(name, path.Ext(name)) + "-annotated"
+
+	switch http.DetectContentType(data) {
+	case "application/pdf":
+		out, err := flattenPDF(data, annotations)
+		if err != nil {
+			return nil, err
+		}
+		return &flattenedFile{Name: name + ".pdf", Data: out}, nil
+	case "image/png", "image/jpeg", "image/gif", "image/webp":
+		out, err := flattenImage(data, annotations)
+		if err != nil {
+			return nil, err
+		}
+		return &flattenedFile{Name: name + ".png", Data: out}, nil
+	default:
+		return nil, fmt.Errorf("%w: annotations can be drawn only onto images and PDF files", ErrFailedPrecondition)
+	}
+}
+
+func flattenImage(data []byte, annotations []domain.Annotation) ([]byte, error) {
+	for _, a := range annotations {
+		if a.Page != 1 {
+			return nil, fmt.Errorf("%w: an annotation is on page %d of an image", ErrFailedPrecondition, a.Page)
+		}
+	}
+
+	config, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to decode the image", ErrFailedPrecondition)
+	}
+	if config.Width*config.Height > maxAnnotatedImagePixels {
+		return nil, fmt.Errorf("%w: the image is larger than 50 megapixels", ErrFailedPrecondition)
+	}
+
+	src, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to decode the image", ErrFailedPrecondition)
+	}
+
+	// Annotations are drawn over the image as it is displayed.
+	canvas := orient(src, jpegOrientation(data))
+	if err := drawAnnotations(canvas, annotations); err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, canvas); err != nil {
+		return nil, fmt.Errorf("failed to encode image: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// flattenPDF stamps every annotated page with a transparent image of its annotations.
+func flattenPDF(data []byte, annotations []domain.Annotation) ([]byte, error) {
+	// Keeps pdfcpu from writing its configuration into the home directory.
+	api.DisableConfigDir()
+
+	conf := model.NewDefaultConfiguration()
+	conf.Cmd = model.ADDWATERMARKS
+	conf.ValidationMode = model.ValidationRelaxed
+
+	pdf, err := api.ReadValidateAndOptimize(bytes.NewReader(data), conf)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to read the PDF file", ErrFailedPrecondition)
+	}
+	boundaries, err := pdf.PageBoundaries(nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to read the PDF pages", ErrFailedPrecondition)
+	}
+
+	pages := make(map[int][]domain.Annotation)
+	for _, a := range annotations {
+		if a.Page > len(boundaries) {
+			return nil, fmt.Errorf("%w: an annotation is on page %d of a %d page file", ErrFailedPrecondition, a.Page, len(boundaries))
+		}
+		pages[a.Page] = append(pages[a.Page], a)
+	}
+
+	stamps := make(map[int]*model.Watermark, len(pages))
+	for page, pageAnnotations := range pages {
+		// The layer has the proportions of the visible page, which the stamp fills.
+		box := boundaries[page-1].CropBox()
+		width, height := box.Width(), box.Height()
+		if boundaries[page-1].Rot%180 != 0 {
+			width, height = height, width
+		}
+
+		overlay := image.NewNRGBA(overlayBounds(width, height))
+		if err := drawAnnotations(overlay, pageAnnotations); err != nil {
+			return nil, err
+		}
+
+		var buf bytes.Buffer
+		if err := png.Encode(&buf, overlay); err != nil {
+			return nil, fmt.Errorf("failed to encode annotations: %w", err)
+		}
+		stamp, err := api.ImageWatermarkForReader(&buf, "scalefactor:1 rel, position:c, rotation:0", true, false, types.POINTS)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create stamp: %w", err)
+		}
+		stamps[page] = stamp
+	}
+
+	if err := pdfcpu.AddWatermarksMap(pdf, stamps); err != nil {
+		return nil, fmt.Errorf("failed to stamp annotations: %w", err)
+	}
+
+	var out bytes.Buffer
+	if err := api.Write(pdf, &out, conf); err != nil {
+		return nil, fmt.Errorf("failed to write PDF: %w", err)
+	}
+	return out.Bytes(), nil
+}
+
+// overlayBounds returns the bounds of a layer of pdfOverlaySize pixels on the longer
+// side with the proportions of the page.
+func overlayBounds(width, height float64) image.Rectangle {
+	scale := pdfOverlaySize / math.Max(width, height)
+	return image.Rect(0, 0, max(1, int(math.Round(width*scale))), max(1, int(math.Round(height*scale))))
+}
+
+// drawAnnotations draws the annotations scaled to the bounds of dst. Comments are
+// drawn last so that shapes do not cover them.
+func drawAnnotations(dst *image.NRGBA, annotations []domain.Annotation) error {
+	b := dst.Bounds()
+	w, h := float64(b.Dx()), float64(b.Dy())
+	unit := math.Min(w, h)
+	penWidth := math.Max(2, unit/250)
+
+	face, err := opentype.NewFace(annotationFont, &opentype.FaceOptions{
+		Size:    math.Max(12, unit/40),
+		DPI:     72,
+		Hinting: font.HintingFull,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create font face: %w", err)
+	}
+	defer func() { _ = face.Close() }()
+
+	for _, a := range annotations {
+		switch a.Type {
+		case domain.AnnotationHighlight:
+			rect := image.Rect(
+				int(math.Floor(a.X*w)), int(math.Floor(a.Y*h)),
+				int(math.Ceil((a.X+a.Width)*w)), int(math.Ceil((a.Y+a.Height)*h)),
+			)
+			draw.Draw(dst, rect.Add(b.Min), image.NewUniform(highlightColor), image.Point{}, draw.Over)
+		case domain.AnnotationPen:
+			points := make([]point, len(a.Points))
+			for i, p := range a.Points {
+				points[i] = point{p.X * w, p.Y * h}
+			}
+			drawStroke(dst, points, penWidth, inkColor)
+		}
+	}
+
+	for _, a := range annotations {
+		if a.Comment == nil || strings.TrimSpace(*a.Comment) == "" {
+			continue
+		}
+		switch a.Type {
+		case domain.AnnotationText:
+			width := a.Width * w
+			if width <= 0 {
+				width = 0.4 * w
+			}
+			drawLabel(dst, face, *a.Comment, point{a.X * w, a.Y * h}, width)
+		case domain.AnnotationHighlight:
+			drawLabel(dst, face, *a.Comment, point{a.X * w, (a.Y+a.Height)*h + penWidth}, 0.4*w)
+		case domain.AnnotationPen:
+			last := a.Points[len(a.Points)-1]
+			drawLabel(dst, face, *a.Comment, point{last.X*w + penWidth, last.Y*h + penWidth}, 0.4*w)
+		}
+	}
+	return nil
+}
+
+type point struct {
+	X, Y float64
+}
+
+// drawStroke draws a polyline of the given width with round joins and caps.
+func drawStroke(dst *image.NRGBA, points []point, width float64, c color.Color) {
+	b := dst.Bounds()
+	z := vector.NewRasterizer(b.Dx(), b.Dy())
+	z.DrawOp = draw.Over
+
+	r := width / 2
+	for i, p := range points {
+		addPolygon(z, circle(p, r))
+		if i == 0 {
+			continue
+		}
+		prev := points[i-1]
+		dx, dy := p.X-prev.X, p.Y-prev.Y
+		length := math.Hypot(dx, dy)
+		if length == 0 {
+			continue
+		}
+		nx, ny := -dy/length*r, dx/length*r
+		addPolygon(z, []point{
+			{prev.X + nx, prev.Y + ny},
+			{p.X + nx, p.Y + ny},
+			{p.X - nx, p.Y - ny},
+			{prev.X - nx, prev.Y - ny},
+		})
+	}
+
+	z.Draw(dst, b, image.NewUniform(c), image.Point{})
+}
+
+func circle(c point, r float64) []point {
+	const segments = 24
+	points := make([]point, segments)
+	for i := range points {
+		angle := 2 * math.Pi * float64(i) / segments
+		points[i] = point{c.X + r*math.Cos(angle), c.Y + r*math.Sin(angle)}
+	}
+	return points
+}
+
+// addPolygon adds a closed polygon to the rasterizer. All polygons are wound the
+// same way, since overlapping polygons of opposite winding cancel each other out.
+func addPolygon(z *vector.Rasterizer, points []point) {
+	var area float64
+	for i, p := range points {
+		next := points[(i+1)%len(points)]
+		area += p.X*next.Y - next.X*p.Y
+	}
+	if area < 0 {
+		for i, j := 0, len(points)-1; i < j; i, j = i+1, j-1 {
+			points[i], points[j] = points[j], points[i]
+		}
+	}
+
+	z.MoveTo(float32(points[0].X), float32(points[0].Y))
+	for _, p := range points[1:] {
+		z.LineTo(float32(p.X), float32(p.Y))
+	}
+	z.ClosePath()
+}
+
+// drawLabel draws the text wrapped at maxWidth on a light box at the given point,
+// moved to fit into dst.
+func drawLabel(dst *image.NRGBA, face font.Face, text string, at point, maxWidth float64) {
+	b := dst.Bounds()
+	metrics := face.Metrics()
+	lineHeight := metrics.Height.Ceil()
+	pad := max(2, lineHeight/4)
+
+	lines := wrapText(face, strings.TrimSpace(text), int(maxWidth)-2*pad)
+	if maxLines := (b.Dy() - 2*pad) / lineHeight; len(lines) > maxLines {
+		lines = lines[:max(1, maxLines)]
+		lines[len(lines)-1] += "…"
+	}
+
+	width := 0
+	for _, line := range lines {
+		width = max(width, font.MeasureString(face, line).Ceil())
+	}
+	box := image.Rect(0, 0, width+2*pad, len(lines)*lineHeight+2*pad).Add(b.Min).Add(image.Pt(int(at.X), int(at.Y)))
+	if box.Max.X > b.Max.X {
+		box = box.Sub(image.Pt(box.Max.X-b.Max.X, 0))
+	}
+	if box.Max.Y > b.Max.Y {
+		box = box.Sub(image.Pt(0, box.Max.Y-b.Max.Y))
+	}
+	if box.Min.X < b.Min.X {
+		box = box.Add(image.Pt(b.Min.X-box.Min.X, 0))
+	}
+	if box.Min.Y < b.Min.Y {
+		box = box.Add(image.Pt(0, b.Min.Y-box.Min.Y))
+	}
+
+	draw.Draw(dst, box, image.NewUniform(labelColor), image.Point{}, draw.Over)
+	d := font.Drawer{Dst: dst, Src: image.NewUniform(inkColor), Face: face}
+	for i, line := range lines {
+		d.Dot = fixed.P(box.Min.X+pad, box.Min.Y+pad+i*lineHeight+metrics.Ascent.Ceil())
+		d.DrawString(line)
+	}
+}
+
+// wrapText splits the text into lines no wider than width, keeping its line breaks.
+// Words wider than a line are broken at any character.
+func wrapText(face font.Face, text string, width int) []string {
+	width = max(width, 1)
+	fits := func(s string) bool {
+		return font.MeasureString(face, s).Ceil() <= width
+	}
+	// prefix returns the length of the longest prefix of the word that fits,
+	// at least one character.
+	prefix := func(word string) int {
+		n := 0
+		for i, r := range word {
+			end := i + utf8.RuneLen(r)
+			if n > 0 && !fits(word[:end]) {
+				break
+			}
+			n = end
+		}
+		return n
+	}
+
+	var lines []string
+	for _, paragraph := range strings.Split(text, "\n") {
+		line := ""
+		for _, word := range strings.Fields(paragraph) {
+			candidate := word
+			if line != "" {
+				candidate = line + " " + word
+			}
+			if fits(candidate) {
+				line = candidate
+				continue
+			}
+			if line != "" {
+				lines = append(lines, line)
+			}
+			for !fits(word) {
+				n := prefix(word)
+				if n == len(word) {
+					break
+				}
+				lines = append(lines, word[:n])
+				word = word[n:]
+			}
+			line = word
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// jpegOrientation returns the EXIF orientation of a JPEG image, or 1 if it is not set.
+func jpegOrientation(data []byte) int {
+	if len(data) < 4 || data[0] != 0xFF || data[1] != 0xD8 {
+		return 1
+	}
+
+	for i := 2; i+4 <= len(data); {
+		if data[i] != 0xFF {
+			return 1
+		}
+		marker := data[i+1]
+		switch {
+		case marker == 0xFF:
+			// Fill byte before a marker.
+			i++
+			continue
+		case marker == 0x01 || (marker >= 0xD0 && marker <= 0xD8):
+			// Markers without a segment.
+			i += 2
+			continue
+		case marker == 0xDA:
+			// Image data starts, EXIF comes before it.
+			return 1
+		}
+
+		size := int(binary.BigEndian.Uint16(data[i+2:]))
+		if size < 2 || i+2+size > len(data) {
+			return 1
+		}
+		if marker == 0xE1 {
+			if o := exifOrientation(data[i+4 : i+2+size]); o != 0 {
+				return o
+			}
+		}
+		i += 2 + size
+	}
+	return 1
+}
+
+// exifOrientation reads the orientation tag from the first IFD of an APP1 segment.
+// It returns 0 if there is none.
+func exifOrientation(segment []byte) int {
+	const orientationTag = 0x0112
+
+	if len(segment) < 14 || string(segment[:6]) != "Exif\x00\x00" {
+		return 0
+	}
+	tiff := segment[6:]
+
+	var order binary.ByteOrder
+	switch string(tiff[:2]) {
+	case "II":
+		order = binary.LittleEndian
+	case "MM":
+		order = binary.BigEndian
+	default:
+		return 0
+	}
+
+	offset := int(order.Uint32(tiff[4:]))
+	if offset < 8 || offset+2 > len(tiff) {
+		return 0
+	}
+	count := int(order.Uint16(tiff[offset:]))
+	for i := 0; i < count; i++ {
+		entry := offset + 2 + 12*i
+		if entry+12 > len(tiff) {
+			return 0
+		}
+		if order.Uint16(tiff[entry:]) != orientationTag {
+			continue
+		}
+		if o := int(order.Uint16(tiff[entry+8:])); o >= 1 && o <= 8 {
+			return o
+		}
+		return 0
+	}
+	return 0
+}
+
+// orient returns a copy of the image turned as its EXIF orientation tells.
+func orient(src image.Image, orientation int) *image.NRGBA {
+	b := src.Bounds()
+	w, h := b.Dx(), b.Dy()
+	upright := image.NewNRGBA(image.Rect(0, 0, w, h))
+	draw.Draw(upright, upright.Bounds(), src, b.Min, draw.Src)
+	if orientation < 2 || orientation > 8 {
+		return upright
+	}
+
+	dw, dh := w, h
+	if orientation >= 5 {
+		dw, dh = h, w
+	}
+	dst := image.NewNRGBA(image.Rect(0, 0, dw, dh))
+	for y := 0; y < dh; y++ {
+		for x := 0; x < dw; x++ {
+			var sx, sy int
+			switch orientation {
+			case 2:
+				sx, sy = w-1-x, y
+			case 3:
+				sx, sy = w-1-x, h-1-y
+			case 4:
+				sx, sy = x, h-1-y
+			case 5:
+				sx, sy = y, x
+			case 6:
+				sx, sy = y, h-1-x
+			case 7:
+				sx, sy = w-1-y, h-1-x
+			case 8:
+				sx, sy = w-1-y, x
+			}
+			copy(dst.Pix[dst.PixOffset(x, y):][:4], upright.Pix[upright.PixOffset(sx, sy):][:4])
+		}
+	}
+	return dst
+}
@@ -0,0 +1,195 @@
+package service
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/png"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/pdfcpu/pdfcpu/pkg/api"
+	"github.com/pdfcpu/pdfcpu/pkg/pdfcpu/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/opentype"
+
+	"homework_service/internal/domain"
+)
+
+func str(s string) *string {
+	return &s
+}
+
+func TestValidateAnnotations(t *testing.T) {
+	fileID := uuid.New()
+	submission := &domain.Submission{Attachments: []domain.Attachment{{FileID: fileID}}}
+
+	valid := []domain.Annotation{
+		{FileID: fileID, Page: 1, Type: domain.AnnotationHighlight, X: 0.5, Y: 0.5, Width: 0.5, Height: 0.1, Points: []domain.AnnotationPoint{{X: 0, Y: 0}}},
+		{FileID: fileID, Page: 3, Type: domain.AnnotationText, X: 0.9, Y: 0.9, Comment: str("проверь знак")},
+		{FileID: fileID, Page: 1, Type: domain.AnnotationPen, X: 0.3, Points: []domain.AnnotationPoint{{X: 0, Y: 0}, {X: 1, Y: 1}}},
+	}
+	require.NoError(t, validateAnnotations(valid, submission))
+	assert.Nil(t, valid[0].Points)
+	assert.Zero(t, valid[2].X)
+
+	tests := map[string]domain.Annotation{
+		"unknown type":        {FileID: fileID, Page: 1, Type: "arrow"},
+		"foreign file":        {FileID: uuid.New(), Page: 1, Type: domain.AnnotationText, Comment: str("x")},
+		"zero page":           {FileID: fileID, Type: domain.AnnotationText, Comment: str("x")},
+		"empty highlight":     {FileID: fileID, Page: 1, Type: domain.AnnotationHighlight, X: 0.1, Y: 0.1},
+		"highlight off page":  {FileID: fileID, Page: 1, Type: domain.AnnotationHighlight, X: 0.8, Y: 0.1, Width: 0.3, Height: 0.1},
+		"text without text":   {FileID: fileID, Page: 1, Type: domain.AnnotationText, Comment: str("  ")},
+		"single point pen":    {FileID: fileID, Page: 1, Type: domain.AnnotationPen, Points: []domain.AnnotationPoint{{X: 0.1, Y: 0.1}}},
+		"pen off page":        {FileID: fileID, Page: 1, Type: domain.AnnotationPen, Points: []domain.AnnotationPoint{{X: 0.1, Y: 0.1}, {X: -0.1, Y: 0.1}}},
+		"too long comment":    {FileID: fileID, Page: 1, Type: domain.AnnotationText, Comment: str(strings.Repeat("я", maxAnnotationCommentLength+1))},
+		"text starts outside": {FileID: fileID, Page: 1, Type: domain.AnnotationText, X: 1.5, Comment: str("x")},
+	}
+	for name, a := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.ErrorIs(t, validateAnnotations([]domain.Annotation{a}, submission), ErrInvalidArgument)
+		})
+	}
+}
+
+func TestFlattenImage(t *testing.T) {
+	src := image.NewNRGBA(image.Rect(0, 0, 400, 200))
+	for i := range src.Pix {
+		src.Pix[i] = 255
+	}
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, src))
+
+	fileID := uuid.New()
+	flattened, err := flattenAnnotations(&domain.FileContent{Name: "решение.png", Body: io.NopCloser(&buf)}, []domain.Annotation{
+		{FileID: fileID, Page: 1, Type: domain.AnnotationHighlight, X: 0, Y: 0, Width: 0.25, Height: 0.25},
+		{FileID: fileID, Page: 1, Type: domain.AnnotationPen, Points: []domain.AnnotationPoint{{X: 0.5, Y: 0.5}, {X: 0.75, Y: 0.5}}},
+		{FileID: fileID, Page: 1, Type: domain.AnnotationText, X: 0.5, Y: 0.9, Comment: str("Ошибка в знаке")},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "решение-annotated.png", flattened.Name)
+
+	out, err := png.Decode(bytes.NewReader(flattened.Data))
+	require.NoError(t, err)
+	assert.Equal(t, src.Bounds(), out.Bounds())
+
+	highlighted := color.NRGBAModel.Convert(out.At(10, 10)).(color.NRGBA)
+	assert.Equal(t, uint8(255), highlighted.R)
+	assert.Less(t, highlighted.B, uint8(255))
+
+	stroke := color.NRGBAModel.Convert(out.At(250, 100)).(color.NRGBA)
+	assert.Equal(t, inkColor, stroke)
+
+	untouched := color.NRGBAModel.Convert(out.At(100, 150)).(color.NRGBA)
+	assert.Equal(t, color.NRGBA{R: 255, G: 255, B: 255, A: 255}, untouched)
+
+	// The label of the text is moved up to fit the image.
+	assert.True(t, hasColor(out, image.Rect(200, 150, 400, 200), func(c color.NRGBA) bool { return c.G < 200 }))
+}
+
+func TestFlattenImageErrors(t *testing.T) {
+	src := image.NewNRGBA(image.Rect(0, 0, 10, 10))
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, src))
+
+	_, err := flattenAnnotations(&domain.FileContent{Name: "a.png", Body: io.NopCloser(bytes.NewReader(buf.Bytes()))}, []domain.Annotation{
+		{Page: 2, Type: domain.AnnotationText, Comment: str("x")},
+	})
+	assert.ErrorIs(t, err, ErrFailedPrecondition)
+
+	_, err = flattenAnnotations(&domain.FileContent{Name: "a.txt", Body: io.NopCloser(strings.NewReader("plain text"))}, []domain.Annotation{
+		{Page: 1, Type: domain.AnnotationText, Comment: str("x")},
+	})
+	assert.ErrorIs(t, err, ErrFailedPrecondition)
+}
+
+func TestFlattenPDF(t *testing.T) {
+	page := image.NewNRGBA(image.Rect(0, 0, 300, 400))
+	var img bytes.Buffer
+	require.NoError(t, png.Encode(&img, page))
+
+	api.DisableConfigDir()
+	var pdf bytes.Buffer
+	require.NoError(t, api.ImportImages(nil, &pdf, []io.Reader{&img, bytes.NewReader(img.Bytes())}, nil, model.NewDefaultConfiguration()))
+
+	fileID := uuid.New()
+	flattened, err := flattenAnnotations(&domain.FileContent{Name: "work.pdf", Body: io.NopCloser(bytes.NewReader(pdf.Bytes()))}, []domain.Annotation{
+		{FileID: fileID, Page: 2, Type: domain.AnnotationHighlight, X: 0.1, Y: 0.1, Width: 0.5, Height: 0.1, Comment: str("здесь")},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "work-annotated.pdf", flattened.Name)
+
+	count, err := api.PageCount(bytes.NewReader(flattened.Data), nil)
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+	assert.Greater(t, len(flattened.Data), pdf.Len())
+
+	_, err = flattenAnnotations(&domain.FileContent{Name: "work.pdf", Body: io.NopCloser(bytes.NewReader(pdf.Bytes()))}, []domain.Annotation{
+		{FileID: fileID, Page: 3, Type: domain.AnnotationText, Comment: str("x")},
+	})
+	assert.ErrorIs(t, err, ErrFailedPrecondition)
+}
+
+func TestWrapText(t *testing.T) {
+	face, err := opentype.NewFace(annotationFont, &opentype.FaceOptions{Size: 12, DPI: 72})
+	require.NoError(t, err)
+
+	width := font.MeasureString(face, "короткий").Ceil() + 2
+	text := "короткий текст\nсловослишкомдлинноедляоднойстроки"
+	lines := wrapText(face, text, width)
+
+	assert.Greater(t, len(lines), 3)
+	assert.Equal(t, []string{"короткий", "текст"}, lines[:2])
+	for _, line := range lines {
+		assert.LessOrEqual(t, font.MeasureString(face, line).Ceil(), width, line)
+	}
+	assert.Equal(t, strings.Join(strings.Fields(text), ""), strings.Join(lines, ""))
+}
+
+func TestJPEGOrientation(t *testing.T) {
+	exif := func(order string, orientation byte) []byte {
+		segment := []byte("Exif\x00\x00")
+		if order == "II" {
+			segment = append(segment, 'I', 'I', 42, 0, 8, 0, 0, 0, 1, 0, 0x12, 0x01, 3, 0, 1, 0, 0, 0, orientation, 0, 0, 0)
+		} else {
+			segment = append(segment, 'M', 'M', 0, 42, 0, 0, 0, 8, 0, 1, 0x01, 0x12, 0, 3, 0, 0, 0, 1, 0, orientation, 0, 0)
+		}
+		data := []byte{0xFF, 0xD8, 0xFF, 0xE0, 0, 4, 0, 0, 0xFF, 0xE1, 0, byte(len(segment) + 2)}
+		return append(append(data, segment...), 0xFF, 0xDA, 0, 2)
+	}
+
+	assert.Equal(t, 6, jpegOrientation(exif("II", 6)))
+	assert.Equal(t, 8, jpegOrientation(exif("MM", 8)))
+	assert.Equal(t, 1, jpegOrientation(exif("II", 9)))
+	assert.Equal(t, 1, jpegOrientation([]byte{0x89, 'P', 'N', 'G'}))
+}
+
+func TestOrient(t *testing.T) {
+	src := image.NewNRGBA(image.Rect(0, 0, 3, 2))
+	red := color.NRGBA{R: 255, A: 255}
+	src.SetNRGBA(0, 0, red)
+
+	rotated := orient(src, 6)
+	assert.Equal(t, image.Rect(0, 0, 2, 3), rotated.Bounds())
+	assert.Equal(t, red, rotated.NRGBAAt(1, 0))
+
+	counterRotated := orient(src, 8)
+	assert.Equal(t, red, counterRotated.NRGBAAt(0, 2))
+
+	assert.Equal(t, red, orient(src, 1).NRGBAAt(0, 0))
+}
+
+func hasColor(img image.Image, rect image.Rectangle, match func(color.NRGBA) bool) bool {
+	for y := rect.Min.Y; y < rect.Max.Y; y++ {
+		for x := rect.Min.X; x < rect.Max.X; x++ {
+			if match(color.NRGBAModel.Convert(img.At(x, y)).(color.NRGBA)) {
+				return true
+			}
+		}
+	}
+	return false
+}
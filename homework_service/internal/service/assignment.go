@@ -4,6 +4,7 @@ import (
 	"common_library/ctxdata"
 	"context"
 	"errors"
+	"fmt"
 	"github.com/google/uuid"
 	"time"
 
@@ -16,27 +17,39 @@ type AssignmentServiceInterface interface {
 	GetAssignment(ctx context.Context, id uuid.UUID) (*domain.Assignment, error)
 	UpdateAssignment(ctx context.Context, assignment *domain.Assignment) error
 	DeleteAssignment(ctx context.Context, id uuid.UUID) error
-	ListAssignmentsByTutor(ctx context.Context, tutorID uuid.UUID, statuses []domain.AssignmentStatus) ([]*domain.Assignment, error)
-	ListAssignmentsByStudent(ctx context.Context, studentID uuid.UUID, statuses []domain.AssignmentStatus) ([]*domain.Assignment, error)
-	ListAssignmentsByPair(ctx context.Context, tutorID uuid.UUID, studentID uuid.UUID, statuses []domain.AssignmentStatus) ([]*domain.Assignment, error)
+	RestoreAssignment(ctx context.Context, id uuid.UUID) (*domain.Assignment, error)
+	MarkChecklistItem(ctx context.Context, id uuid.UUID, position int, done bool) (*domain.Assignment, error)
+	ReviewChecklistItem(ctx context.Context, id uuid.UUID, position int, review domain.ChecklistReview) (*domain.Assignment, error)
+	ListAssignmentsByTutor(ctx context.Context, tutorID uuid.UUID, statuses []domain.AssignmentStatus, progress domain.ProgressRange) ([]*domain.Assignment, error)
+	ListAssignmentsByStudent(ctx context.Context, studentID uuid.UUID, statuses []domain.AssignmentStatus, progress domain.ProgressRange) ([]*domain.Assignment, error)
+	ListAssignmentsByPair(ctx context.Context, tutorID uuid.UUID, studentID uuid.UUID, statuses []domain.AssignmentStatus, progress domain.ProgressRange) ([]*domain.Assignment, error)
+	ListAssignmentsByLesson(ctx context.Context, lessonID uuid.UUID, statuses []domain.AssignmentStatus, progress domain.ProgressRange) ([]*domain.Assignment, error)
 	GetAssignmentFileURL(ctx context.Context, id uuid.UUID) (string, error)
+	ListAttachmentFileURLs(ctx context.Context, id uuid.UUID) ([]domain.AttachmentFileURL, error)
 }
 
 type AssignmentService struct {
 	assignmentRepo repository.AssignmentRepository
 	userClient     UserClient
 	fileClient     FileClient
+	scheduleClient ScheduleClient
+	// retention is how long deleted assignments can be restored.
+	retention time.Duration
 }
 
 func NewAssignmentService(
 	assignmentRepo repository.AssignmentRepository,
 	userClient UserClient,
 	fileClient FileClient,
+	scheduleClient ScheduleClient,
+	retention time.Duration,
 ) *AssignmentService {
 	return &AssignmentService{
 		assignmentRepo: assignmentRepo,
 		userClient:     userClient,
 		fileClient:     fileClient,
+		scheduleClient: scheduleClient,
+		retention:      retention,
 	}
 }
 
@@ -55,16 +68,57 @@ func (s *AssignmentService) CreateAssignment(ctx context.Context, req *domain.As
 		return nil, errors.New("not a tutor-student pair")
 	}
 
+	fileID, attachments, err := syncAttachments(req.FileID, req.Attachments)
+	if err != nil {
+		return nil, err
+	}
+
 	now := time.Now()
 	assignment := &domain.Assignment{
-		TutorID:     req.TutorID,
-		StudentID:   req.StudentID,
-		Title:       req.Title,
-		Description: req.Description,
-		FileID:      req.FileID,
-		DueDate:     req.DueDate,
-		CreatedAt:   now,
-		EditedAt:    now,
+		TutorID:             req.TutorID,
+		StudentID:           req.StudentID,
+		Title:               req.Title,
+		Description:         req.Description,
+		FileID:              fileID,
+		Attachments:         attachments,
+		DueDate:             req.DueDate,
+		LessonID:            req.LessonID,
+		DueBeforeNextLesson: req.DueBeforeNextLesson,
+		Quiz:                req.Quiz,
+		Checklist:           req.Checklist,
+		LatePolicy:          req.LatePolicy,
+		GracePeriod:         req.GracePeriod,
+		State:               req.State,
+		PublishAt:           req.PublishAt,
+		CreatedAt:           now,
+		EditedAt:            now,
+	}
+
+	if err := validateQuiz(assignment.Quiz); err != nil {
+		return nil, err
+	}
+	if err := validateChecklist(assignment.Checklist); err != nil {
+		return nil, err
+	}
+	if err := validateLatePolicy(assignment); err != nil {
+		return nil, err
+	}
+	if err := preparePublishing(assignment, nil, now); err != nil {
+		return nil, err
+	}
+
+	if assignment.LessonID != nil {
+		if err := s.checkLesson(ctx, assignment); err != nil {
+			return nil, err
+		}
+	}
+	if assignment.DueBeforeNextLesson {
+		if assignment.DueDate != nil {
+			return nil, fmt.Errorf("%w: due date cannot be set together with due before next lesson", ErrInvalidArgument)
+		}
+		if err := s.resolveDueLesson(ctx, assignment); err != nil {
+			return nil, err
+		}
 	}
 
 	err = s.assignmentRepo.Create(ctx, assignment)
@@ -92,7 +146,11 @@ func (s *AssignmentService) GetAssignment(ctx context.Context, id uuid.UUID) (*d
 	if assignment.TutorID.String() != userID && assignment.StudentID.String() != userID {
 		return nil, ErrPermissionDenied
 	}
+	if !isVisible(assignment, userID) {
+		return nil, repository.ErrNotFound
+	}
 
+	hideQuizAnswers(userID, assignment)
 	return assignment, nil
 }
 
@@ -102,6 +160,62 @@ func (s *AssignmentService) UpdateAssignment(ctx context.Context, assignment *do
 		return ErrPermissionDenied
 	}
 
+	stored, err := s.assignmentRepo.GetByID(ctx, assignment.ID)
+	if err != nil {
+		return err
+	}
+	if stored.TutorID.String() != userID {
+		return ErrPermissionDenied
+	}
+
+	fileID, attachments, err := syncAttachments(assignment.FileID, assignment.Attachments)
+	if err != nil {
+		return err
+	}
+	assignment.FileID = fileID
+	assignment.Attachments = attachments
+
+	if err := validateQuiz(assignment.Quiz); err != nil {
+		return err
+	}
+	if err := validateChecklist(assignment.Checklist); err != nil {
+		return err
+	}
+	if err := validateLatePolicy(assignment); err != nil {
+		return err
+	}
+	if err := preparePublishing(assignment, stored, time.Now()); err != nil {
+		return err
+	}
+	// Submissions are graded against the quiz, so it is frozen after the first one.
+	if !equalQuizzes(assignment.Quiz, stored.Quiz) {
+		submitted, err := s.assignmentRepo.HasSubmissions(ctx, assignment.ID)
+		if err != nil {
+			return err
+		}
+		if submitted {
+			return fmt.Errorf("%w: quiz cannot be changed after the first submission", ErrFailedPrecondition)
+		}
+	}
+
+	if assignment.LessonID != nil && !equalIDs(assignment.LessonID, stored.LessonID) {
+		if err := s.checkLesson(ctx, assignment); err != nil {
+			return err
+		}
+	}
+
+	switch {
+	case !assignment.DueBeforeNextLesson:
+		assignment.DueLessonID = nil
+	case !stored.DueBeforeNextLesson:
+		if err := s.resolveDueLesson(ctx, assignment); err != nil {
+			return err
+		}
+	default:
+		assignment.DueLessonID = stored.DueLessonID
+		assignment.DueDate = stored.DueDate
+	}
+
 	return s.assignmentRepo.Update(ctx, assignment)
 }
 
@@ -119,31 +233,130 @@ func (s *AssignmentService) DeleteAssignment(ctx context.Context, id uuid.UUID)
 	return s.assignmentRepo.Delete(ctx, id)
 }
 
-func (s *AssignmentService) ListAssignmentsByTutor(ctx context.Context, tutorID uuid.UUID, statuses []domain.AssignmentStatus) ([]*domain.Assignment, error) {
+// RestoreAssignment undoes the deletion of the tutor's assignment if it was deleted
+// within the retention window.
+func (s *AssignmentService) RestoreAssignment(ctx context.Context, id uuid.UUID) (*domain.Assignment, error) {
+	assignment, err := s.assignmentRepo.GetDeletedByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	userID, ok := ctxdata.GetUserID(ctx)
+	if !ok || assignment.TutorID.String() != userID {
+		return nil, ErrPermissionDenied
+	}
+
+	if err := s.assignmentRepo.Restore(ctx, id, s.retention); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, fmt.Errorf("%w: the assignment was deleted more than %d days ago", ErrFailedPrecondition, int(s.retention.Hours()/24))
+		}
+		return nil, err
+	}
+
+	return s.assignmentRepo.GetByID(ctx, id)
+}
+
+func (s *AssignmentService) ListAssignmentsByTutor(ctx context.Context, tutorID uuid.UUID, statuses []domain.AssignmentStatus, progress domain.ProgressRange) ([]*domain.Assignment, error) {
 	userID, ok := ctxdata.GetUserID(ctx)
 	if !ok || tutorID.String() != userID {
 		return nil, ErrPermissionDenied
 	}
 
-	return s.assignmentRepo.ListByFilter(ctx, domain.AssignmentFilter{TutorID: tutorID, Statuses: statuses})
+	return s.listByFilter(ctx, userID, domain.AssignmentFilter{TutorID: tutorID, Statuses: statuses, ChecklistProgress: progress})
 }
 
-func (s *AssignmentService) ListAssignmentsByStudent(ctx context.Context, studentID uuid.UUID, statuses []domain.AssignmentStatus) ([]*domain.Assignment, error) {
+func (s *AssignmentService) ListAssignmentsByStudent(ctx context.Context, studentID uuid.UUID, statuses []domain.AssignmentStatus, progress domain.ProgressRange) ([]*domain.Assignment, error) {
 	userID, ok := ctxdata.GetUserID(ctx)
 	if !ok || studentID.String() != userID {
 		return nil, ErrPermissionDenied
 	}
 
-	return s.assignmentRepo.ListByFilter(ctx, domain.AssignmentFilter{StudentID: studentID, Statuses: statuses})
+	return s.listByFilter(ctx, userID, domain.AssignmentFilter{StudentID: studentID, Statuses: statuses, ChecklistProgress: progress})
 }
 
-func (s *AssignmentService) ListAssignmentsByPair(ctx context.Context, tutorID uuid.UUID, studentID uuid.UUID, statuses []domain.AssignmentStatus) ([]*domain.Assignment, error) {
+func (s *AssignmentService) ListAssignmentsByPair(ctx context.Context, tutorID uuid.UUID, studentID uuid.UUID, statuses []domain.AssignmentStatus, progress domain.ProgressRange) ([]*domain.Assignment, error) {
 	userID, ok := ctxdata.GetUserID(ctx)
 	if !ok || (tutorID.String() != userID && studentID.String() != userID) {
 		return nil, ErrPermissionDenied
 	}
 
-	return s.assignmentRepo.ListByFilter(ctx, domain.AssignmentFilter{TutorID: tutorID, StudentID: studentID, Statuses: statuses})
+	return s.listByFilter(ctx, userID, domain.AssignmentFilter{TutorID: tutorID, StudentID: studentID, Statuses: statuses, ChecklistProgress: progress})
+}
+
+// ListAssignmentsByLesson returns the caller's assignments given at the lesson.
+func (s *AssignmentService) ListAssignmentsByLesson(ctx context.Context, lessonID uuid.UUID, statuses []domain.AssignmentStatus, progress domain.ProgressRange) ([]*domain.Assignment, error) {
+	userID, ok := ctxdata.GetUserID(ctx)
+	if !ok {
+		return nil, ErrPermissionDenied
+	}
+	callerID, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, ErrPermissionDenied
+	}
+
+	filter := domain.AssignmentFilter{LessonID: lessonID, Statuses: statuses, ChecklistProgress: progress}
+	if role, _ := ctxdata.GetUserRole(ctx); role == "tutor" {
+		filter.TutorID = callerID
+	} else {
+		filter.StudentID = callerID
+	}
+
+	return s.listByFilter(ctx, userID, filter)
+}
+
+// listByFilter lists assignments as seen by the user: only tutors see their
+// unpublished assignments.
+func (s *AssignmentService) listByFilter(ctx context.Context, userID string, filter domain.AssignmentFilter) ([]*domain.Assignment, error) {
+	if err := validateProgressRange(filter.ChecklistProgress); err != nil {
+		return nil, err
+	}
+
+	filter.PublishedOnly = filter.TutorID.String() != userID
+	assignments, err := s.assignmentRepo.ListByFilter(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	hideQuizAnswers(userID, assignments...)
+	return assignments, nil
+}
+
+// checkLesson verifies that the assignment's lesson exists and belongs to its pair.
+func (s *AssignmentService) checkLesson(ctx context.Context, assignment *domain.Assignment) error {
+	lesson, err := s.scheduleClient.GetLesson(ctx, *assignment.LessonID)
+	if err != nil {
+		return err
+	}
+	if lesson == nil {
+		return fmt.Errorf("%w: lesson not found", ErrInvalidArgument)
+	}
+	if lesson.TutorID != assignment.TutorID || lesson.StudentID != assignment.StudentID {
+		return fmt.Errorf("%w: lesson belongs to another tutor-student pair", ErrInvalidArgument)
+	}
+	return nil
+}
+
+// resolveDueLesson sets the due date to the start of the pair's next booked lesson.
+func (s *AssignmentService) resolveDueLesson(ctx context.Context, assignment *domain.Assignment) error {
+	next, err := s.scheduleClient.NextLesson(ctx, assignment.TutorID, assignment.StudentID, time.Now())
+	if err != nil {
+		return err
+	}
+	if next == nil {
+		return fmt.Errorf("%w: the pair has no upcoming lesson", ErrInvalidArgument)
+	}
+
+	startsAt := next.StartsAt
+	assignment.DueLessonID = &next.ID
+	assignment.DueDate = &startsAt
+	return nil
+}
+
+func equalIDs(a, b *uuid.UUID) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
 }
 
 func (s *AssignmentService) GetAssignmentFileURL(ctx context.Context, id uuid.UUID) (string, error) {
@@ -160,3 +373,12 @@ func (s *AssignmentService) GetAssignmentFileURL(ctx context.Context, id uuid.UU
 	}
 	return url, nil
 }
+
+func (s *AssignmentService) ListAttachmentFileURLs(ctx context.Context, id uuid.UUID) ([]domain.AttachmentFileURL, error) {
+	assignment, err := s.GetAssignment(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return resolveAttachmentURLs(ctx, s.fileClient, assignment.Attachments)
+}
@@ -0,0 +1,56 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"homework_service/internal/domain"
+)
+
+const maxAttachments = 20
+
+// syncAttachments validates attachments and keeps the legacy single file ID equal to
+// the first attachment. A file ID given without attachments becomes the only attachment.
+func syncAttachments(fileID *uuid.UUID, attachments []domain.Attachment) (*uuid.UUID, []domain.Attachment, error) {
+	if len(attachments) == 0 && fileID != nil {
+		attachments = []domain.Attachment{{FileID: *fileID}}
+	}
+	if len(attachments) > maxAttachments {
+		return nil, nil, fmt.Errorf("%w: at most %d attachments are allowed", ErrInvalidArgument, maxAttachments)
+	}
+	for _, a := range attachments {
+		if a.FileID == uuid.Nil {
+			return nil, nil, fmt.Errorf("%w: attachment file id is required", ErrInvalidArgument)
+		}
+	}
+
+	if len(attachments) == 0 {
+		return nil, attachments, nil
+	}
+	first := attachments[0].FileID
+	return &first, attachments, nil
+}
+
+// resolveAttachmentURLs returns download URLs of the attachments in their order.
+func resolveAttachmentURLs(ctx context.Context, fileClient FileClient, attachments []domain.Attachment) ([]domain.AttachmentFileURL, error) {
+	fileIDs := make([]uuid.UUID, len(attachments))
+	for i, a := range attachments {
+		fileIDs[i] = a.FileID
+	}
+
+	urls, err := fileClient.GetFileURLs(ctx, fileIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]domain.AttachmentFileURL, 0, len(attachments))
+	for _, a := range attachments {
+		url, ok := urls[a.FileID]
+		if !ok {
+			return nil, ErrFileNotFound
+		}
+		result = append(result, domain.AttachmentFileURL{Attachment: a, URL: url})
+	}
+	return result, nil
+}
@@ -0,0 +1,105 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+
+	"homework_service/internal/domain"
+	"homework_service/internal/repository"
+)
+
+const (
+	maxChecklistItems      = 100
+	maxChecklistItemLength = 500
+)
+
+// validateChecklist checks the texts of the checklist items and trims them.
+func validateChecklist(items []domain.ChecklistItem) error {
+	if len(items) > maxChecklistItems {
+		return fmt.Errorf("%w: at most %d checklist items are allowed", ErrInvalidArgument, maxChecklistItems)
+	}
+
+	for i := range items {
+		items[i].Text = strings.TrimSpace(items[i].Text)
+		if items[i].Text == "" {
+			return fmt.Errorf("%w: checklist item %d has no text", ErrInvalidArgument, i)
+		}
+		if utf8.RuneCountInString(items[i].Text) > maxChecklistItemLength {
+			return fmt.Errorf("%w: checklist item %d exceeds %d characters", ErrInvalidArgument, i, maxChecklistItemLength)
+		}
+	}
+
+	return nil
+}
+
+// validateProgressRange checks that the bounds are percentages and do not cross.
+func validateProgressRange(r domain.ProgressRange) error {
+	for _, bound := range []*int{r.Min, r.Max} {
+		if bound != nil && (*bound < 0 || *bound > 100) {
+			return fmt.Errorf("%w: checklist progress must be from 0 to 100", ErrInvalidArgument)
+		}
+	}
+	if r.Min != nil && r.Max != nil && *r.Min > *r.Max {
+		return fmt.Errorf("%w: minimal checklist progress exceeds the maximal one", ErrInvalidArgument)
+	}
+	return nil
+}
+
+// MarkChecklistItem lets the student of the assignment mark the checklist item at
+// the position as done or not done. Changing the mark clears the tutor's review.
+func (s *AssignmentService) MarkChecklistItem(ctx context.Context, id uuid.UUID, position int, done bool) (*domain.Assignment, error) {
+	assignment, err := s.GetAssignment(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if assignment.StudentID.String() != callerID(ctx) {
+		return nil, ErrPermissionDenied
+	}
+	if position < 0 || position >= len(assignment.Checklist) {
+		return nil, fmt.Errorf("%w: the assignment has no checklist item %d", ErrInvalidArgument, position)
+	}
+
+	if err := s.assignmentRepo.MarkChecklistItem(ctx, id, position, done); err != nil {
+		return nil, err
+	}
+
+	return s.GetAssignment(ctx, id)
+}
+
+// ReviewChecklistItem lets the tutor of the assignment mark a done checklist item
+// as correct or incorrect; an empty review clears it.
+func (s *AssignmentService) ReviewChecklistItem(ctx context.Context, id uuid.UUID, position int, review domain.ChecklistReview) (*domain.Assignment, error) {
+	assignment, err := s.GetAssignment(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if assignment.TutorID.String() != callerID(ctx) {
+		return nil, ErrPermissionDenied
+	}
+	if review != "" && !review.IsValid() {
+		return nil, fmt.Errorf("%w: unknown checklist review", ErrInvalidArgument)
+	}
+	if position < 0 || position >= len(assignment.Checklist) {
+		return nil, fmt.Errorf("%w: the assignment has no checklist item %d", ErrInvalidArgument, position)
+	}
+
+	errNotDone := fmt.Errorf("%w: checklist item %d is not done", ErrFailedPrecondition, position)
+	if !assignment.Checklist[position].Done {
+		return nil, errNotDone
+	}
+
+	if err := s.assignmentRepo.ReviewChecklistItem(ctx, id, position, review); err != nil {
+		// The student has unmarked the item in the meantime.
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, errNotDone
+		}
+		return nil, err
+	}
+
+	return s.GetAssignment(ctx, id)
+}
@@ -0,0 +1,44 @@
+package service
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"homework_service/internal/domain"
+)
+
+func TestValidateChecklist(t *testing.T) {
+	t.Run("valid checklist", func(t *testing.T) {
+		items := []domain.ChecklistItem{{Text: " упражнения 1–6 "}, {Text: "упражнения 7–12"}}
+
+		require.NoError(t, validateChecklist(items))
+		assert.Equal(t, "упражнения 1–6", items[0].Text)
+	})
+
+	t.Run("invalid checklists", func(t *testing.T) {
+		for name, items := range map[string][]domain.ChecklistItem{
+			"empty text":     {{Text: "a"}, {Text: "  "}},
+			"too long text":  {{Text: strings.Repeat("я", maxChecklistItemLength+1)}},
+			"too many items": make([]domain.ChecklistItem, maxChecklistItems+1),
+		} {
+			assert.ErrorIs(t, validateChecklist(items), ErrInvalidArgument, name)
+		}
+	})
+}
+
+func TestValidateProgressRange(t *testing.T) {
+	percent := func(v int) *int {
+		return &v
+	}
+
+	assert.NoError(t, validateProgressRange(domain.ProgressRange{}))
+	assert.NoError(t, validateProgressRange(domain.ProgressRange{Min: percent(0), Max: percent(100)}))
+	assert.NoError(t, validateProgressRange(domain.ProgressRange{Min: percent(50), Max: percent(50)}))
+
+	assert.ErrorIs(t, validateProgressRange(domain.ProgressRange{Min: percent(-1)}), ErrInvalidArgument)
+	assert.ErrorIs(t, validateProgressRange(domain.ProgressRange{Max: percent(101)}), ErrInvalidArgument)
+	assert.ErrorIs(t, validateProgressRange(domain.ProgressRange{Min: percent(60), Max: percent(40)}), ErrInvalidArgument)
+}
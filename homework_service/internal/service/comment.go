@@ -0,0 +1,276 @@
+package service
+
+import (
+	"common_library/ctxdata"
+	"context"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"homework_service/internal/domain"
+	"homework_service/internal/repository"
+	"homework_service/pkg/logger"
+)
+
+// maxCommentLength limits the comment body in characters.
+const maxCommentLength = 10000
+
+const (
+	CommentEventCreated = "comment.created"
+	CommentEventUpdated = "comment.updated"
+	CommentEventDeleted = "comment.deleted"
+)
+
+// CommentEvent is sent to homeworkEventsTopic when a comment is created, edited or deleted.
+// RecipientID is the other participant of the assignment.
+type CommentEvent struct {
+	EventType    string     `json:"event_type"`
+	CommentID    uuid.UUID  `json:"comment_id"`
+	AssignmentID uuid.UUID  `json:"assignment_id"`
+	SubmissionID *uuid.UUID `json:"submission_id,omitempty"`
+	ParentID     *uuid.UUID `json:"parent_id,omitempty"`
+	AuthorID     uuid.UUID  `json:"author_id"`
+	RecipientID  uuid.UUID  `json:"recipient_id"`
+	Body         string     `json:"body,omitempty"`
+	OccurredAt   time.Time  `json:"occurred_at"`
+}
+
+type CommentServiceInterface interface {
+	CreateComment(ctx context.Context, comment *domain.Comment) (*domain.Comment, error)
+	UpdateComment(ctx context.Context, comment *domain.Comment) error
+	DeleteComment(ctx context.Context, id uuid.UUID) error
+	GetComment(ctx context.Context, id uuid.UUID) (*domain.Comment, error)
+	ListComments(ctx context.Context, assignmentID uuid.UUID, submissionID *uuid.UUID) ([]*domain.Comment, error)
+	ListAttachmentFileURLs(ctx context.Context, id uuid.UUID) ([]domain.AttachmentFileURL, error)
+}
+
+type commentService struct {
+	commentRepo    *repository.CommentRepository
+	assignmentRepo *repository.AssignmentRepository
+	submissionRepo *repository.SubmissionRepository
+	fileClient     FileClient
+	events         EventSender
+	logger         *logger.Logger
+}
+
+func NewCommentService(
+	commentRepo *repository.CommentRepository,
+	assignmentRepo *repository.AssignmentRepository,
+	submissionRepo *repository.SubmissionRepository,
+	fileClient FileClient,
+	events EventSender,
+	logger *logger.Logger,
+) CommentServiceInterface {
+	return &commentService{
+		commentRepo:    commentRepo,
+		assignmentRepo: assignmentRepo,
+		submissionRepo: submissionRepo,
+		fileClient:     fileClient,
+		events:         events,
+		logger:         logger,
+	}
+}
+
+func (s *commentService) CreateComment(ctx context.Context, comment *domain.Comment) (*domain.Comment, error) {
+	assignment, err := s.participantAssignment(ctx, comment.AssignmentID)
+	if err != nil {
+		return nil, err
+	}
+	authorID, err := uuid.Parse(callerID(ctx))
+	if err != nil {
+		return nil, ErrPermissionDenied
+	}
+	comment.AuthorID = authorID
+
+	if comment.SubmissionID != nil {
+		submission, err := s.submissionRepo.GetByID(ctx, *comment.SubmissionID)
+		if err != nil {
+			return nil, err
+		}
+		if submission.AssignmentID != assignment.ID {
+			return nil, fmt.Errorf("%w: submission belongs to another assignment", ErrInvalidArgument)
+		}
+	}
+
+	if comment.ParentID != nil {
+		parent, err := s.commentRepo.GetByID(ctx, *comment.ParentID)
+		if err != nil {
+			return nil, err
+		}
+		if parent.AssignmentID != comment.AssignmentID || !equalIDs(parent.SubmissionID, comment.SubmissionID) {
+			return nil, fmt.Errorf("%w: parent comment belongs to another thread", ErrInvalidArgument)
+		}
+		if parent.DeletedAt != nil {
+			return nil, fmt.Errorf("%w: parent comment is deleted", ErrInvalidArgument)
+		}
+	}
+
+	if err := validateComment(comment); err != nil {
+		return nil, err
+	}
+
+	if err := s.commentRepo.Create(ctx, comment); err != nil {
+		return nil, err
+	}
+
+	s.sendEvent(ctx, CommentEventCreated, assignment, comment)
+	return comment, nil
+}
+
+func (s *commentService) UpdateComment(ctx context.Context, comment *domain.Comment) error {
+	existing, err := s.GetComment(ctx, comment.ID)
+	if err != nil {
+		return err
+	}
+	if existing.AuthorID.String() != callerID(ctx) {
+		return ErrPermissionDenied
+	}
+	if existing.DeletedAt != nil {
+		return repository.ErrNotFound
+	}
+
+	existing.Body = comment.Body
+	existing.Attachments = comment.Attachments
+	if err := validateComment(existing); err != nil {
+		return err
+	}
+
+	if err := s.commentRepo.Update(ctx, existing); err != nil {
+		return err
+	}
+	*comment = *existing
+
+	assignment, err := s.assignmentRepo.GetByID(ctx, comment.AssignmentID)
+	if err != nil {
+		return err
+	}
+	s.sendEvent(ctx, CommentEventUpdated, assignment, comment)
+	return nil
+}
+
+// DeleteComment can be called by the author and by the tutor of the assignment.
+func (s *commentService) DeleteComment(ctx context.Context, id uuid.UUID) error {
+	comment, err := s.commentRepo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	assignment, err := s.participantAssignment(ctx, comment.AssignmentID)
+	if err != nil {
+		return err
+	}
+
+	userID := callerID(ctx)
+	if comment.AuthorID.String() != userID && assignment.TutorID.String() != userID {
+		return ErrPermissionDenied
+	}
+
+	if err := s.commentRepo.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	comment.Body = ""
+	s.sendEvent(ctx, CommentEventDeleted, assignment, comment)
+	return nil
+}
+
+func (s *commentService) GetComment(ctx context.Context, id uuid.UUID) (*domain.Comment, error) {
+	comment, err := s.commentRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.participantAssignment(ctx, comment.AssignmentID); err != nil {
+		return nil, err
+	}
+	return comment, nil
+}
+
+func (s *commentService) ListComments(ctx context.Context, assignmentID uuid.UUID, submissionID *uuid.UUID) ([]*domain.Comment, error) {
+	if _, err := s.participantAssignment(ctx, assignmentID); err != nil {
+		return nil, err
+	}
+	return s.commentRepo.ListByThread(ctx, assignmentID, submissionID)
+}
+
+func (s *commentService) ListAttachmentFileURLs(ctx context.Context, id uuid.UUID) ([]domain.AttachmentFileURL, error) {
+	comment, err := s.GetComment(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return resolveAttachmentURLs(ctx, s.fileClient, comment.Attachments)
+}
+
+// participantAssignment returns the assignment if the caller is its tutor or student.
+func (s *commentService) participantAssignment(ctx context.Context, assignmentID uuid.UUID) (*domain.Assignment, error) {
+	assignment, err := s.assignmentRepo.GetByID(ctx, assignmentID)
+	if err != nil {
+		return nil, err
+	}
+
+	userID := callerID(ctx)
+	if userID == "" || (assignment.TutorID.String() != userID && assignment.StudentID.String() != userID) {
+		return nil, ErrPermissionDenied
+	}
+	if !isVisible(assignment, userID) {
+		return nil, repository.ErrNotFound
+	}
+	return assignment, nil
+}
+
+// sendEvent does not fail the request: the comment is already saved.
+func (s *commentService) sendEvent(ctx context.Context, eventType string, assignment *domain.Assignment, comment *domain.Comment) {
+	if s.events == nil {
+		return
+	}
+
+	recipientID := assignment.StudentID
+	if comment.AuthorID == assignment.StudentID {
+		recipientID = assignment.TutorID
+	}
+
+	event := CommentEvent{
+		EventType:    eventType,
+		CommentID:    comment.ID,
+		AssignmentID: comment.AssignmentID,
+		SubmissionID: comment.SubmissionID,
+		ParentID:     comment.ParentID,
+		AuthorID:     comment.AuthorID,
+		RecipientID:  recipientID,
+		Body:         comment.Body,
+		OccurredAt:   time.Now(),
+	}
+	if err := s.events.Send(context.WithoutCancel(ctx), homeworkEventsTopic, event); err != nil {
+		s.logger.Error("failed to send comment event",
+			zap.String("comment_id", comment.ID.String()),
+			zap.String("event_type", eventType),
+			zap.Error(err),
+		)
+	}
+}
+
+func validateComment(comment *domain.Comment) error {
+	comment.Body = strings.TrimSpace(comment.Body)
+	if comment.Body == "" && len(comment.Attachments) == 0 {
+		return fmt.Errorf("%w: comment must have a body or attachments", ErrInvalidArgument)
+	}
+	if utf8.RuneCountInString(comment.Body) > maxCommentLength {
+		return fmt.Errorf("%w: comment is longer than %d characters", ErrInvalidArgument, maxCommentLength)
+	}
+
+	_, attachments, err := syncAttachments(nil, comment.Attachments)
+	if err != nil {
+		return err
+	}
+	comment.Attachments = attachments
+	return nil
+}
+
+// callerID returns the authenticated user ID or an empty string.
+func callerID(ctx context.Context) string {
+	userID, _ := ctxdata.GetUserID(ctx)
+	return userID
+}
@@ -0,0 +1,36 @@
+package service
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"homework_service/internal/domain"
+)
+
+func TestValidateComment(t *testing.T) {
+	t.Run("trims body", func(t *testing.T) {
+		comment := &domain.Comment{Body: "  question?\n"}
+
+		assert.NoError(t, validateComment(comment))
+		assert.Equal(t, "question?", comment.Body)
+	})
+
+	t.Run("attachments without body", func(t *testing.T) {
+		comment := &domain.Comment{Body: " ", Attachments: []domain.Attachment{{FileID: uuid.New()}}}
+
+		assert.NoError(t, validateComment(comment))
+	})
+
+	t.Run("empty comment", func(t *testing.T) {
+		assert.ErrorIs(t, validateComment(&domain.Comment{Body: "   "}), ErrInvalidArgument)
+	})
+
+	t.Run("too long", func(t *testing.T) {
+		comment := &domain.Comment{Body: strings.Repeat("ы", maxCommentLength+1)}
+
+		assert.ErrorIs(t, validateComment(comment), ErrInvalidArgument)
+	})
+}
@@ -0,0 +1,13 @@
+package service
+
+import (
+	"context"
+)
+
+// homeworkEventsTopic receives homework events for notification_service.
+const homeworkEventsTopic = "homework-events"
+
+// EventSender publishes events to a message broker topic.
+type EventSender interface {
+	Send(ctx context.Context, topic string, message interface{}) error
+}
@@ -0,0 +1,223 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"time"
+
+	"common_library/ctxdata"
+	"github.com/google/uuid"
+
+	"homework_service/internal/domain"
+	"homework_service/internal/repository"
+)
+
+// exportStaleAfter is how long an export may run before it is considered abandoned
+// by a stopped worker and run again.
+const exportStaleAfter = 30 * time.Minute
+
+type ExportServiceInterface interface {
+	CreatePortfolioExport(ctx context.Context, export *domain.PortfolioExport) (*domain.PortfolioExport, error)
+	GetPortfolioExport(ctx context.Context, id uuid.UUID) (*domain.PortfolioExport, error)
+}
+
+type exportService struct {
+	exportRepo *repository.ExportRepository
+	userClient UserClient
+	fileClient FileClient
+}
+
+func NewExportService(
+	exportRepo *repository.ExportRepository,
+	userClient UserClient,
+	fileClient FileClient,
+) ExportServiceInterface {
+	return &exportService{
+		exportRepo: exportRepo,
+		userClient: userClient,
+		fileClient: fileClient,
+	}
+}
+
+// CreatePortfolioExport queues an export of the pair's homework. Only the tutor and
+// the student of the pair may request it, one export at a time.
+func (s *exportService) CreatePortfolioExport(ctx context.Context, export *domain.PortfolioExport) (*domain.PortfolioExport, error) {
+	userID, err := uuid.Parse(callerID(ctx))
+	if err != nil || (export.TutorID != userID && export.StudentID != userID) {
+		return nil, ErrPermissionDenied
+	}
+	if export.From != nil && export.To != nil && !export.From.Before(*export.To) {
+		return nil, fmt.Errorf("%w: from must be before to", ErrInvalidArgument)
+	}
+
+	isPair, err := s.userClient.IsPair(ctx, export.TutorID, export.StudentID)
+	if err != nil {
+		return nil, err
+	}
+	if !isPair {
+		return nil, fmt.Errorf("%w: not a tutor-student pair", ErrPermissionDenied)
+	}
+
+	active, err := s.exportRepo.HasActive(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if active {
+		return nil, fmt.Errorf("%w: another export is in progress", ErrFailedPrecondition)
+	}
+
+	export.RequestedBy = userID
+	if err := s.exportRepo.Create(ctx, export); err != nil {
+		return nil, err
+	}
+	return export, nil
+}
+
+// GetPortfolioExport returns the export with a download URL of the archive once it is done.
+func (s *exportService) GetPortfolioExport(ctx context.Context, id uuid.UUID) (*domain.PortfolioExport, error) {
+	export, err := s.exportRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	userID := callerID(ctx)
+	if export.RequestedBy.String() != userID && export.TutorID.String() != userID && export.StudentID.String() != userID {
+		return nil, ErrPermissionDenied
+	}
+
+	if export.Status == domain.ExportStatusDone && export.FileID != nil {
+		url, err := s.fileClient.GetFileURL(ctx, *export.FileID)
+		if err != nil {
+			return nil, err
+		}
+		export.DownloadURL = url
+	}
+	return export, nil
+}
+
+// PortfolioExporter runs queued portfolio exports: it archives the homework of the
+// pair and stores the archive in file_service.
+type PortfolioExporter struct {
+	exportRepo     *repository.ExportRepository
+	assignmentRepo *repository.AssignmentRepository
+	submissionRepo *repository.SubmissionRepository
+	feedbackRepo   *repository.FeedbackRepository
+	fileClient     FileClient
+}
+
+func NewPortfolioExporter(
+	exportRepo *repository.ExportRepository,
+	assignmentRepo *repository.AssignmentRepository,
+	submissionRepo *repository.SubmissionRepository,
+	feedbackRepo *repository.FeedbackRepository,
+	fileClient FileClient,
+) *PortfolioExporter {
+	return &PortfolioExporter{
+		exportRepo:     exportRepo,
+		assignmentRepo: assignmentRepo,
+		submissionRepo: submissionRepo,
+		feedbackRepo:   feedbackRepo,
+		fileClient:     fileClient,
+	}
+}
+
+// RunNext claims the oldest queued export and runs it. It returns false if the queue
+// is empty. A failed export is marked as failed with a reason safe to show to the user.
+func (e *PortfolioExporter) RunNext(ctx context.Context) (bool, error) {
+	export, err := e.exportRepo.ClaimNext(ctx, exportStaleAfter)
+	if err != nil || export == nil {
+		return false, err
+	}
+
+	// file_service calls are made on behalf of the user who requested the export.
+	ctx = ctxdata.WithUserID(ctx, export.RequestedBy.String())
+
+	fileID, err := e.export(ctx, export)
+	if err != nil {
+		reason := "failed to build the archive"
+		if errors.Is(err, errPortfolioTooLarge) {
+			reason = err.Error()
+		}
+		if failErr := e.exportRepo.Fail(ctx, export.ID, reason); failErr != nil {
+			err = errors.Join(err, failErr)
+		}
+		return true, fmt.Errorf("export %s: %w", export.ID, err)
+	}
+
+	return true, e.exportRepo.Complete(ctx, export.ID, fileID)
+}
+
+func (e *PortfolioExporter) export(ctx context.Context, export *domain.PortfolioExport) (uuid.UUID, error) {
+	manifest, err := e.collect(ctx, export)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	archive, err := os.CreateTemp("", "portfolio-*.zip")
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("failed to create temporary file: %w", err)
+	}
+	defer func() {
+		_ = archive.Close()
+		_ = os.Remove(archive.Name())
+	}()
+
+	if err := writePortfolio(ctx, archive, manifest, e.fileClient.DownloadFile); err != nil {
+		return uuid.Nil, err
+	}
+	size, err := archive.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("failed to measure archive: %w", err)
+	}
+	if _, err := archive.Seek(0, io.SeekStart); err != nil {
+		return uuid.Nil, fmt.Errorf("failed to rewind archive: %w", err)
+	}
+
+	filename := fmt.Sprintf("portfolio-%s.zip", export.CreatedAt.Format("2006-01-02"))
+	return e.fileClient.UploadFile(ctx, export.RequestedBy, filename, archive, size)
+}
+
+// collect loads the published assignments of the pair created within the export
+// range, with their submissions and feedbacks, oldest first.
+func (e *PortfolioExporter) collect(ctx context.Context, export *domain.PortfolioExport) (*portfolioManifest, error) {
+	assignments, err := e.assignmentRepo.ListByFilter(ctx, domain.AssignmentFilter{
+		TutorID:       export.TutorID,
+		StudentID:     export.StudentID,
+		PublishedOnly: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var selected []*domain.Assignment
+	for _, a := range assignments {
+		if export.From != nil && a.CreatedAt.Before(*export.From) {
+			continue
+		}
+		if export.To != nil && !a.CreatedAt.Before(*export.To) {
+			continue
+		}
+		selected = append(selected, a)
+	}
+	sort.SliceStable(selected, func(i, j int) bool {
+		return selected[i].CreatedAt.Before(selected[j].CreatedAt)
+	})
+
+	manifest := newPortfolioManifest(export, time.Now())
+	for _, a := range selected {
+		submissions, err := e.submissionRepo.ListByAssignment(ctx, a.ID)
+		if err != nil {
+			return nil, err
+		}
+		feedbacks, err := e.feedbackRepo.ListByAssignment(ctx, a.ID)
+		if err != nil {
+			return nil, err
+		}
+		manifest.addAssignment(a, submissions, feedbacks)
+	}
+	return manifest, nil
+}
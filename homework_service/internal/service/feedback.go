@@ -19,6 +19,7 @@ var (
 	ErrAssignmentNotFound  = errors.New("assignment not found")
 	ErrPermissionDenied    = errors.New("permission denied")
 	ErrInvalidArgument     = errors.New("invalid argument")
+	ErrFailedPrecondition  = errors.New("failed precondition")
 )
 
 type FeedbackServiceInterface interface {
@@ -27,6 +28,10 @@ type FeedbackServiceInterface interface {
 	UpdateFeedback(ctx context.Context, feedback *domain.Feedback) (*domain.Feedback, error)
 	ListFeedbacksByAssignment(ctx context.Context, assignmentID uuid.UUID) ([]*domain.Feedback, error)
 	GetFeedbackFileURL(ctx context.Context, id uuid.UUID) (string, error)
+	ListAttachmentFileURLs(ctx context.Context, id uuid.UUID) ([]domain.AttachmentFileURL, error)
+	GetGradebook(ctx context.Context, tutorID, studentID uuid.UUID, from, to *time.Time) (*domain.Gradebook, error)
+	GetHomeworkStats(ctx context.Context, tutorID uuid.UUID, from, to *time.Time) (*domain.HomeworkStats, error)
+	FlattenAnnotations(ctx context.Context, feedbackID, fileID uuid.UUID) (*domain.AnnotatedFile, error)
 }
 
 type feedbackService struct {
@@ -77,15 +82,40 @@ func (s *feedbackService) CreateFeedback(ctx context.Context, feedback *domain.F
 		return nil, ErrPermissionDenied
 	}
 
+	fileID, attachments, err := syncAttachments(feedback.FileID, feedback.Attachments)
+	if err != nil {
+		return nil, err
+	}
+
 	now := time.Now()
 	newFeedback := &domain.Feedback{
 		SubmissionID: feedback.SubmissionID,
-		FileID:       feedback.FileID,
+		FileID:       fileID,
+		Attachments:  attachments,
 		Comment:      feedback.Comment,
+		Score:        feedback.Score,
+		MaxScore:     feedback.MaxScore,
+		Rubric:       feedback.Rubric,
+		Annotations:  feedback.Annotations,
+		Verdict:      feedback.Verdict,
 		CreatedAt:    now,
 		EditedAt:     now,
 	}
 
+	if newFeedback.Verdict == "" {
+		newFeedback.Verdict = domain.FeedbackVerdictAccepted
+	}
+	if !newFeedback.Verdict.IsValid() {
+		return nil, ErrInvalidArgument
+	}
+
+	if err := applyGrade(newFeedback); err != nil {
+		return nil, err
+	}
+	if err := validateAnnotations(newFeedback.Annotations, submission); err != nil {
+		return nil, err
+	}
+
 	if err := s.feedbackRepo.Create(ctx, newFeedback); err != nil {
 		return nil, err
 	}
@@ -126,8 +156,49 @@ func (s *feedbackService) UpdateFeedback(ctx context.Context, feedback *domain.F
 		existingFeedback.Comment = feedback.Comment
 	}
 
-	if feedback.FileID != nil {
+	// A new attachment list replaces the old one, a lone file ID replaces all attachments.
+	if feedback.Attachments != nil {
+		existingFeedback.FileID = nil
+		existingFeedback.Attachments = feedback.Attachments
+	} else if feedback.FileID != nil {
 		existingFeedback.FileID = feedback.FileID
+		existingFeedback.Attachments = nil
+	}
+
+	existingFeedback.FileID, existingFeedback.Attachments, err = syncAttachments(existingFeedback.FileID, existingFeedback.Attachments)
+	if err != nil {
+		return nil, err
+	}
+
+	// A new rubric rescores the feedback unless a score is given explicitly.
+	if feedback.Rubric != nil {
+		existingFeedback.Rubric = feedback.Rubric
+		existingFeedback.Score = nil
+		existingFeedback.MaxScore = nil
+	}
+	if feedback.Score != nil {
+		existingFeedback.Score = feedback.Score
+	}
+	if feedback.MaxScore != nil {
+		existingFeedback.MaxScore = feedback.MaxScore
+	}
+
+	if feedback.Annotations != nil {
+		if err := validateAnnotations(feedback.Annotations, submission); err != nil {
+			return nil, err
+		}
+		existingFeedback.Annotations = feedback.Annotations
+	}
+
+	if feedback.Verdict != "" {
+		if !feedback.Verdict.IsValid() {
+			return nil, ErrInvalidArgument
+		}
+		existingFeedback.Verdict = feedback.Verdict
+	}
+
+	if err := applyGrade(existingFeedback); err != nil {
+		return nil, err
 	}
 
 	existingFeedback.EditedAt = time.Now()
@@ -245,3 +316,12 @@ func (s *feedbackService) GetFeedbackFileURL(ctx context.Context, id uuid.UUID)
 
 	return url, nil
 }
+
+func (s *feedbackService) ListAttachmentFileURLs(ctx context.Context, id uuid.UUID) ([]domain.AttachmentFileURL, error) {
+	feedback, err := s.GetFeedback(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return resolveAttachmentURLs(ctx, s.fileClient, feedback.Attachments)
+}
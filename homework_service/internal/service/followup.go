@@ -0,0 +1,120 @@
+package service
+
+import (
+	"common_library/ctxdata"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"homework_service/internal/domain"
+	"homework_service/internal/repository"
+)
+
+// LessonEventCompleted is the schedule_service event type of a lesson that took place.
+const LessonEventCompleted = "completed"
+
+// LessonEvent is the part of a schedule_service lesson event the follow-ups need.
+type LessonEvent struct {
+	EventType string    `json:"event_type"`
+	LessonID  uuid.UUID `json:"lesson_id"`
+	TutorID   uuid.UUID `json:"tutor_id"`
+	StudentID uuid.UUID `json:"student_id"`
+}
+
+// LessonFollowUpCreator assigns the pair's default template after a completed lesson.
+// The follow-up is due before the pair's next lesson; while none is booked the due
+// date stays empty and DueLessonSyncer fills it in once a lesson appears.
+type LessonFollowUpCreator struct {
+	templateRepo   *repository.TemplateRepository
+	assignmentRepo *repository.AssignmentRepository
+	scheduleClient ScheduleClient
+}
+
+func NewLessonFollowUpCreator(
+	templateRepo *repository.TemplateRepository,
+	assignmentRepo *repository.AssignmentRepository,
+	scheduleClient ScheduleClient,
+) *LessonFollowUpCreator {
+	return &LessonFollowUpCreator{
+		templateRepo:   templateRepo,
+		assignmentRepo: assignmentRepo,
+		scheduleClient: scheduleClient,
+	}
+}
+
+// CreateFollowUp creates the follow-up assignment of the completed lesson. It returns
+// false if the pair has no default template or the lesson has already been processed.
+func (c *LessonFollowUpCreator) CreateFollowUp(ctx context.Context, lessonID, tutorID, studentID uuid.UUID) (bool, error) {
+	template, err := c.templateRepo.GetPairDefault(ctx, tutorID, studentID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	now := time.Now()
+	// schedule_service only shows lessons to their participants.
+	scheduleCtx := ctxdata.WithUserRole(ctxdata.WithUserID(ctx, tutorID.String()), "tutor")
+	next, err := c.scheduleClient.NextLesson(scheduleCtx, tutorID, studentID, now)
+	if err != nil {
+		return false, err
+	}
+
+	assignment, err := followUpAssignment(template, studentID, lessonID, next, now)
+	if err != nil {
+		return false, err
+	}
+
+	return c.assignmentRepo.CreateForLesson(ctx, lessonID, assignment)
+}
+
+// HandleLessonEvent creates the follow-up of a completed lesson and ignores other events.
+func (c *LessonFollowUpCreator) HandleLessonEvent(ctx context.Context, event LessonEvent) (bool, error) {
+	if event.EventType != LessonEventCompleted {
+		return false, nil
+	}
+	if event.LessonID == uuid.Nil || event.TutorID == uuid.Nil || event.StudentID == uuid.Nil {
+		return false, fmt.Errorf("%w: lesson event without lesson, tutor or student id", ErrInvalidArgument)
+	}
+	return c.CreateFollowUp(ctx, event.LessonID, event.TutorID, event.StudentID)
+}
+
+// followUpAssignment builds a published assignment from the template, linked to the
+// completed lesson and due at the start of next, which may be nil.
+func followUpAssignment(
+	template *domain.AssignmentTemplate,
+	studentID, lessonID uuid.UUID,
+	next *domain.Lesson,
+	now time.Time,
+) (*domain.Assignment, error) {
+	fileID, attachments, err := syncAttachments(nil, template.Attachments)
+	if err != nil {
+		return nil, err
+	}
+
+	assignment := &domain.Assignment{
+		TutorID:             template.TutorID,
+		StudentID:           studentID,
+		Title:               template.Title,
+		Description:         template.Description,
+		FileID:              fileID,
+		Attachments:         copyAttachments(attachments),
+		LessonID:            &lessonID,
+		DueBeforeNextLesson: true,
+		LatePolicy:          domain.LatePolicyFlag,
+		State:               domain.AssignmentStatePublished,
+		PublishedAt:         &now,
+		CreatedAt:           now,
+		EditedAt:            now,
+	}
+	if next != nil {
+		dueDate := next.StartsAt
+		assignment.DueLessonID = &next.ID
+		assignment.DueDate = &dueDate
+	}
+	return assignment, nil
+}
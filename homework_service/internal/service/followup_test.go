@@ -0,0 +1,54 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"homework_service/internal/domain"
+)
+
+func TestFollowUpAssignment(t *testing.T) {
+	now := time.Date(2026, 3, 2, 18, 0, 0, 0, time.UTC)
+	title := "Homework after the lesson"
+	fileID := uuid.New()
+	template := &domain.AssignmentTemplate{
+		ID:          uuid.New(),
+		TutorID:     uuid.New(),
+		Title:       &title,
+		Attachments: []domain.Attachment{{ID: uuid.New(), FileID: fileID}},
+	}
+	studentID := uuid.New()
+	lessonID := uuid.New()
+
+	t.Run("due before the next lesson", func(t *testing.T) {
+		next := &domain.Lesson{ID: uuid.New(), StartsAt: now.Add(7 * 24 * time.Hour)}
+
+		assignment, err := followUpAssignment(template, studentID, lessonID, next, now)
+		require.NoError(t, err)
+		assert.Equal(t, template.TutorID, assignment.TutorID)
+		assert.Equal(t, studentID, assignment.StudentID)
+		assert.Equal(t, &title, assignment.Title)
+		assert.Equal(t, &lessonID, assignment.LessonID)
+		assert.True(t, assignment.DueBeforeNextLesson)
+		assert.Equal(t, &next.ID, assignment.DueLessonID)
+		require.NotNil(t, assignment.DueDate)
+		assert.True(t, next.StartsAt.Equal(*assignment.DueDate))
+		assert.Equal(t, domain.AssignmentStatePublished, assignment.State)
+		assert.Equal(t, &now, assignment.PublishedAt)
+		assert.Equal(t, &fileID, assignment.FileID)
+		require.Len(t, assignment.Attachments, 1)
+		assert.Equal(t, uuid.Nil, assignment.Attachments[0].ID)
+	})
+
+	t.Run("no next lesson leaves the due date to the syncer", func(t *testing.T) {
+		assignment, err := followUpAssignment(template, studentID, lessonID, nil, now)
+		require.NoError(t, err)
+		assert.True(t, assignment.DueBeforeNextLesson)
+		assert.Nil(t, assignment.DueLessonID)
+		assert.Nil(t, assignment.DueDate)
+	})
+}
@@ -0,0 +1,170 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"common_library/ctxdata"
+	"github.com/google/uuid"
+	"homework_service/internal/domain"
+)
+
+const maxRubricCriteria = 50
+
+// applyGrade validates the score and the rubric of the feedback. A rubric without
+// an explicit score scores the feedback with the sums of its criteria.
+func applyGrade(feedback *domain.Feedback) error {
+	if len(feedback.Rubric) > maxRubricCriteria {
+		return fmt.Errorf("%w: at most %d rubric criteria are allowed", ErrInvalidArgument, maxRubricCriteria)
+	}
+
+	var score, maxScore float64
+	for _, c := range feedback.Rubric {
+		if strings.TrimSpace(c.Name) == "" {
+			return fmt.Errorf("%w: rubric criterion name is required", ErrInvalidArgument)
+		}
+		if c.MaxScore <= 0 || c.Score < 0 || c.Score > c.MaxScore {
+			return fmt.Errorf("%w: rubric criterion %q must have 0 <= score <= max_score and max_score > 0", ErrInvalidArgument, c.Name)
+		}
+		score += c.Score
+		maxScore += c.MaxScore
+	}
+
+	if len(feedback.Rubric) > 0 && feedback.Score == nil {
+		feedback.Score = &score
+		feedback.MaxScore = &maxScore
+	}
+
+	if feedback.MaxScore != nil && feedback.Score == nil {
+		return fmt.Errorf("%w: max_score requires score", ErrInvalidArgument)
+	}
+	if feedback.Score != nil && *feedback.Score < 0 {
+		return fmt.Errorf("%w: score must not be negative", ErrInvalidArgument)
+	}
+	if feedback.MaxScore != nil && (*feedback.MaxScore <= 0 || *feedback.Score > *feedback.MaxScore) {
+		return fmt.Errorf("%w: score must not exceed a positive max_score", ErrInvalidArgument)
+	}
+
+	return nil
+}
+
+func (s *feedbackService) GetGradebook(ctx context.Context, tutorID, studentID uuid.UUID, from, to *time.Time) (*domain.Gradebook, error) {
+	userID, ok := ctxdata.GetUserID(ctx)
+	if !ok || (tutorID.String() != userID && studentID.String() != userID) {
+		return nil, ErrPermissionDenied
+	}
+
+	if from != nil && to != nil && !from.Before(*to) {
+		return nil, fmt.Errorf("%w: from must be before to", ErrInvalidArgument)
+	}
+
+	entries, err := s.feedbackRepo.ListGraded(ctx, tutorID, studentID, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	return buildGradebook(tutorID, studentID, entries), nil
+}
+
+// buildGradebook aggregates entries ordered by grading time.
+func buildGradebook(tutorID, studentID uuid.UUID, entries []domain.GradebookEntry) *domain.Gradebook {
+	gradebook := &domain.Gradebook{
+		TutorID:   tutorID,
+		StudentID: studentID,
+		Entries:   entries,
+	}
+	if len(entries) == 0 {
+		return gradebook
+	}
+
+	var scoreSum float64
+	var percents []float64
+	for _, e := range entries {
+		scoreSum += e.Score
+		if e.IsLate {
+			gradebook.LateCount++
+		}
+		if p := e.Percent(); p != nil {
+			percents = append(percents, *p)
+		}
+	}
+	averageScore := scoreSum / float64(len(entries))
+	gradebook.AverageScore = &averageScore
+
+	if len(percents) > 0 {
+		averagePercent := mean(percents)
+		gradebook.AveragePercent = &averagePercent
+	}
+	if len(percents) > 1 {
+		trend := slope(percents)
+		gradebook.Trend = &trend
+	}
+
+	gradebook.Criteria = criterionAverages(entries)
+	return gradebook
+}
+
+// criterionAverages averages rubric criteria by name in order of first appearance.
+func criterionAverages(entries []domain.GradebookEntry) []domain.CriterionAverage {
+	type sums struct {
+		count   int
+		score   float64
+		percent float64
+	}
+
+	var names []string
+	byName := make(map[string]*sums)
+	for _, e := range entries {
+		for _, c := range e.Rubric {
+			acc, ok := byName[c.Name]
+			if !ok {
+				acc = &sums{}
+				byName[c.Name] = acc
+				names = append(names, c.Name)
+			}
+			acc.count++
+			acc.score += c.Score
+			acc.percent += c.Score / c.MaxScore * 100
+		}
+	}
+
+	averages := make([]domain.CriterionAverage, 0, len(names))
+	for _, name := range names {
+		acc := byName[name]
+		averages = append(averages, domain.CriterionAverage{
+			Name:           name,
+			Count:          acc.count,
+			AverageScore:   acc.score / float64(acc.count),
+			AveragePercent: acc.percent / float64(acc.count),
+		})
+	}
+	return averages
+}
+
+func mean(values []float64) float64 {
+	var sum float64
+	for _, v := range values {
+		sum += v
+	}
+	return sum / float64(len(values))
+}
+
+// slope returns the least squares slope of values over their indexes.
+func slope(values []float64) float64 {
+	n := float64(len(values))
+	meanX := (n - 1) / 2
+	meanY := mean(values)
+
+	var num, den float64
+	for i, v := range values {
+		dx := float64(i) - meanX
+		num += dx * (v - meanY)
+		den += dx * dx
+	}
+	if den == 0 {
+		return 0
+	}
+	return num / den
+}
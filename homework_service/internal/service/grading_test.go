@@ -0,0 +1,74 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"homework_service/internal/domain"
+)
+
+func TestApplyGrade(t *testing.T) {
+	float := func(f float64) *float64 {
+		return &f
+	}
+
+	t.Run("rubric scores feedback", func(t *testing.T) {
+		feedback := &domain.Feedback{Rubric: []domain.RubricCriterion{
+			{Name: "grammar", Score: 4, MaxScore: 5},
+			{Name: "vocabulary", Score: 2.5, MaxScore: 5},
+		}}
+
+		assert.NoError(t, applyGrade(feedback))
+		assert.Equal(t, 6.5, *feedback.Score)
+		assert.Equal(t, 10.0, *feedback.MaxScore)
+	})
+
+	t.Run("explicit score wins", func(t *testing.T) {
+		feedback := &domain.Feedback{
+			Score:  float(3),
+			Rubric: []domain.RubricCriterion{{Name: "grammar", Score: 4, MaxScore: 5}},
+		}
+
+		assert.NoError(t, applyGrade(feedback))
+		assert.Equal(t, 3.0, *feedback.Score)
+		assert.Nil(t, feedback.MaxScore)
+	})
+
+	t.Run("invalid grades", func(t *testing.T) {
+		for _, feedback := range []*domain.Feedback{
+			{Score: float(-1)},
+			{Score: float(11), MaxScore: float(10)},
+			{MaxScore: float(10)},
+			{Rubric: []domain.RubricCriterion{{Name: " ", Score: 1, MaxScore: 5}}},
+			{Rubric: []domain.RubricCriterion{{Name: "grammar", Score: 6, MaxScore: 5}}},
+		} {
+			assert.ErrorIs(t, applyGrade(feedback), ErrInvalidArgument)
+		}
+	})
+}
+
+func TestBuildGradebook(t *testing.T) {
+	ten := 10.0
+	entries := []domain.GradebookEntry{
+		{Score: 5, MaxScore: &ten, Rubric: []domain.RubricCriterion{{Name: "grammar", Score: 2, MaxScore: 5}}},
+		{Score: 7, MaxScore: &ten, Rubric: []domain.RubricCriterion{{Name: "grammar", Score: 4, MaxScore: 5}}},
+		{Score: 9, MaxScore: &ten, IsLate: true},
+		{Score: 3},
+	}
+
+	gradebook := buildGradebook(uuid.New(), uuid.New(), entries)
+
+	assert.Equal(t, 6.0, *gradebook.AverageScore)
+	assert.InDelta(t, 70.0, *gradebook.AveragePercent, 1e-9)
+	assert.InDelta(t, 20.0, *gradebook.Trend, 1e-9)
+	assert.Equal(t, []domain.CriterionAverage{
+		{Name: "grammar", Count: 2, AverageScore: 3, AveragePercent: 60},
+	}, gradebook.Criteria)
+	assert.Equal(t, 1, gradebook.LateCount)
+
+	empty := buildGradebook(uuid.New(), uuid.New(), nil)
+	assert.Nil(t, empty.AverageScore)
+	assert.Nil(t, empty.Trend)
+}
@@ -3,6 +3,10 @@ package service
 import (
 	"context"
 	"github.com/google/uuid"
+	"io"
+	"time"
+
+	"homework_service/internal/domain"
 )
 
 type UserClient interface {
@@ -11,4 +15,17 @@ type UserClient interface {
 
 type FileClient interface {
 	GetFileURL(ctx context.Context, fileID uuid.UUID) (string, error)
+	// GetFileURLs resolves download URLs of several files, keyed by file ID.
+	GetFileURLs(ctx context.Context, fileIDs []uuid.UUID) (map[uuid.UUID]string, error)
+	// DownloadFile fetches the content of a stored file. The caller closes the body.
+	DownloadFile(ctx context.Context, fileID uuid.UUID) (*domain.FileContent, error)
+	// UploadFile stores size bytes of content as a new file of uploadedBy and returns its ID.
+	UploadFile(ctx context.Context, uploadedBy uuid.UUID, filename string, content io.Reader, size int64) (uuid.UUID, error)
+}
+
+type ScheduleClient interface {
+	// GetLesson returns nil if the lesson does not exist or is not visible to the caller.
+	GetLesson(ctx context.Context, lessonID uuid.UUID) (*domain.Lesson, error)
+	// NextLesson returns the pair's earliest booked lesson starting after the given time, or nil.
+	NextLesson(ctx context.Context, tutorID, studentID uuid.UUID, after time.Time) (*domain.Lesson, error)
 }
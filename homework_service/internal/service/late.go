@@ -0,0 +1,41 @@
+package service
+
+import (
+	"fmt"
+	"time"
+
+	"homework_service/internal/domain"
+)
+
+// validateLatePolicy defaults the late policy to flag, which keeps accepting late
+// submissions but makes them visible to the tutor.
+func validateLatePolicy(assignment *domain.Assignment) error {
+	if assignment.LatePolicy == "" {
+		assignment.LatePolicy = domain.LatePolicyFlag
+	}
+	if !assignment.LatePolicy.IsValid() {
+		return fmt.Errorf("%w: unknown late policy %q", ErrInvalidArgument, assignment.LatePolicy)
+	}
+	if assignment.GracePeriod != nil && *assignment.GracePeriod <= 0 {
+		return fmt.Errorf("%w: grace period must be positive", ErrInvalidArgument)
+	}
+	return nil
+}
+
+// checkDeadline applies the late policy of the assignment to a submission made at
+// the given time and reports whether the submission is flagged as late.
+func checkDeadline(assignment *domain.Assignment, at time.Time) (bool, error) {
+	deadline := assignment.Deadline()
+	if deadline == nil || !at.After(*deadline) {
+		return false, nil
+	}
+
+	switch assignment.LatePolicy {
+	case domain.LatePolicyLock:
+		return false, fmt.Errorf("%w: assignment is locked after the deadline", ErrFailedPrecondition)
+	case domain.LatePolicyAllow:
+		return false, nil
+	default:
+		return true, nil
+	}
+}
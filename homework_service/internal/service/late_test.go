@@ -0,0 +1,67 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"homework_service/internal/domain"
+)
+
+func TestValidateLatePolicy(t *testing.T) {
+	assignment := &domain.Assignment{}
+	require.NoError(t, validateLatePolicy(assignment))
+	assert.Equal(t, domain.LatePolicyFlag, assignment.LatePolicy)
+
+	err := validateLatePolicy(&domain.Assignment{LatePolicy: "ignore"})
+	assert.ErrorIs(t, err, ErrInvalidArgument)
+
+	zero := time.Duration(0)
+	err = validateLatePolicy(&domain.Assignment{LatePolicy: domain.LatePolicyLock, GracePeriod: &zero})
+	assert.ErrorIs(t, err, ErrInvalidArgument)
+}
+
+func TestCheckDeadline(t *testing.T) {
+	due := time.Date(2024, 5, 1, 18, 0, 0, 0, time.UTC)
+	grace := time.Hour
+	withinGrace := due.Add(30 * time.Minute)
+	afterGrace := due.Add(2 * time.Hour)
+
+	tests := []struct {
+		name   string
+		policy domain.LatePolicy
+		grace  *time.Duration
+		at     time.Time
+		late   bool
+		err    error
+	}{
+		{name: "on time", policy: domain.LatePolicyLock, at: due, late: false},
+		{name: "flag late", policy: domain.LatePolicyFlag, at: withinGrace, late: true},
+		{name: "flag within grace", policy: domain.LatePolicyFlag, grace: &grace, at: withinGrace, late: false},
+		{name: "allow late", policy: domain.LatePolicyAllow, at: afterGrace, late: false},
+		{name: "lock within grace", policy: domain.LatePolicyLock, grace: &grace, at: withinGrace, late: false},
+		{name: "lock after grace", policy: domain.LatePolicyLock, grace: &grace, at: afterGrace, err: ErrFailedPrecondition},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assignment := &domain.Assignment{DueDate: &due, LatePolicy: tt.policy, GracePeriod: tt.grace}
+
+			late, err := checkDeadline(assignment, tt.at)
+			if tt.err != nil {
+				assert.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.late, late)
+		})
+	}
+
+	t.Run("no due date", func(t *testing.T) {
+		late, err := checkDeadline(&domain.Assignment{LatePolicy: domain.LatePolicyLock}, afterGrace)
+		require.NoError(t, err)
+		assert.False(t, late)
+	})
+}
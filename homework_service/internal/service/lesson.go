@@ -0,0 +1,97 @@
+package service
+
+import (
+	"common_library/ctxdata"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"homework_service/internal/domain"
+	"homework_service/internal/repository"
+)
+
+// dueLessonLookback keeps assignments whose lesson has just started among the sync
+// candidates, so that a cancellation shortly before the start is still noticed.
+const dueLessonLookback = time.Hour
+
+// DueLessonSyncer keeps due dates of assignments in the "due before next lesson"
+// mode in line with the schedule. A booked lesson cannot be moved in schedule_service,
+// so a reschedule is a cancellation plus a new booking: when the due lesson is
+// cancelled, the due date moves to the pair's next booked lesson.
+type DueLessonSyncer struct {
+	assignmentRepo *repository.AssignmentRepository
+	scheduleClient ScheduleClient
+}
+
+func NewDueLessonSyncer(assignmentRepo *repository.AssignmentRepository, scheduleClient ScheduleClient) *DueLessonSyncer {
+	return &DueLessonSyncer{
+		assignmentRepo: assignmentRepo,
+		scheduleClient: scheduleClient,
+	}
+}
+
+// Sync moves due dates of all candidates and returns the number of moved assignments.
+// A failed assignment does not stop the others, its error is joined to the result.
+func (s *DueLessonSyncer) Sync(ctx context.Context) (int, error) {
+	now := time.Now()
+	assignments, err := s.assignmentRepo.ListLessonSyncCandidates(ctx, now.Add(-dueLessonLookback))
+	if err != nil {
+		return 0, err
+	}
+
+	moved := 0
+	var errs []error
+	for _, assignment := range assignments {
+		changed, err := s.syncAssignment(ctx, assignment, now)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("assignment %s: %w", assignment.ID, err))
+			continue
+		}
+		if changed {
+			moved++
+		}
+	}
+
+	return moved, errors.Join(errs...)
+}
+
+func (s *DueLessonSyncer) syncAssignment(ctx context.Context, assignment *domain.Assignment, now time.Time) (bool, error) {
+	// schedule_service only shows lessons to their participants.
+	ctx = ctxdata.WithUserRole(ctxdata.WithUserID(ctx, assignment.TutorID.String()), "tutor")
+
+	if assignment.DueLessonID != nil {
+		lesson, err := s.scheduleClient.GetLesson(ctx, *assignment.DueLessonID)
+		if err != nil {
+			return false, err
+		}
+		if lesson != nil && lesson.Status != domain.LessonStatusCancelled {
+			if lesson.Status != domain.LessonStatusBooked || sameTime(assignment.DueDate, lesson.StartsAt) {
+				return false, nil
+			}
+			return true, s.moveDue(ctx, assignment, lesson)
+		}
+	}
+
+	next, err := s.scheduleClient.NextLesson(ctx, assignment.TutorID, assignment.StudentID, now)
+	if err != nil {
+		return false, err
+	}
+	if next == nil {
+		if assignment.DueLessonID == nil {
+			return false, nil
+		}
+		// Keep the due date until a new lesson is booked.
+		return true, s.assignmentRepo.UpdateDueLesson(ctx, assignment.ID, nil, assignment.DueDate)
+	}
+	return true, s.moveDue(ctx, assignment, next)
+}
+
+func (s *DueLessonSyncer) moveDue(ctx context.Context, assignment *domain.Assignment, lesson *domain.Lesson) error {
+	startsAt := lesson.StartsAt
+	return s.assignmentRepo.UpdateDueLesson(ctx, assignment.ID, &lesson.ID, &startsAt)
+}
+
+func sameTime(t *time.Time, other time.Time) bool {
+	return t != nil && t.Equal(other)
+}
@@ -0,0 +1,163 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"homework_service/internal/domain"
+	"homework_service/internal/repository"
+)
+
+const (
+	AssignmentEventOverdue       = "assignment.overdue"
+	AssignmentEventOverdueDigest = "assignment.overdue_digest"
+)
+
+// OverdueEvent is sent to homeworkEventsTopic once per assignment when its due date
+// passes without a submission.
+type OverdueEvent struct {
+	EventType    string     `json:"event_type"`
+	AssignmentID uuid.UUID  `json:"assignment_id"`
+	TutorID      uuid.UUID  `json:"tutor_id"`
+	StudentID    uuid.UUID  `json:"student_id"`
+	Title        *string    `json:"title,omitempty"`
+	DueDate      *time.Time `json:"due_date,omitempty"`
+	OccurredAt   time.Time  `json:"occurred_at"`
+}
+
+// OverdueDigestEvent is the daily list of a tutor's overdue assignments grouped by student.
+type OverdueDigestEvent struct {
+	EventType  string                 `json:"event_type"`
+	TutorID    uuid.UUID              `json:"tutor_id"`
+	Date       string                 `json:"date"`
+	Students   []OverdueDigestStudent `json:"students"`
+	OccurredAt time.Time              `json:"occurred_at"`
+}
+
+type OverdueDigestStudent struct {
+	StudentID   uuid.UUID                 `json:"student_id"`
+	Assignments []OverdueDigestAssignment `json:"assignments"`
+}
+
+type OverdueDigestAssignment struct {
+	AssignmentID uuid.UUID  `json:"assignment_id"`
+	Title        *string    `json:"title,omitempty"`
+	DueDate      *time.Time `json:"due_date,omitempty"`
+}
+
+// OverdueNotifier moves assignments past their due date to OVERDUE and announces them.
+type OverdueNotifier struct {
+	assignmentRepo *repository.AssignmentRepository
+	events         EventSender
+}
+
+func NewOverdueNotifier(assignmentRepo *repository.AssignmentRepository, events EventSender) *OverdueNotifier {
+	return &OverdueNotifier{
+		assignmentRepo: assignmentRepo,
+		events:         events,
+	}
+}
+
+// Notify marks overdue assignments and sends an event for each one that has not been
+// announced yet. Assignments are claimed before sending and released if sending fails,
+// so they are retried on the next run. It returns the number of sent events.
+func (n *OverdueNotifier) Notify(ctx context.Context) (int, error) {
+	if _, err := n.assignmentRepo.MarkOverdue(ctx); err != nil {
+		return 0, err
+	}
+
+	assignments, err := n.assignmentRepo.ClaimOverdueNotifications(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	sent := 0
+	var errs []error
+	for _, a := range assignments {
+		event := OverdueEvent{
+			EventType:    AssignmentEventOverdue,
+			AssignmentID: a.ID,
+			TutorID:      a.TutorID,
+			StudentID:    a.StudentID,
+			Title:        a.Title,
+			DueDate:      a.DueDate,
+			OccurredAt:   time.Now(),
+		}
+		if err := n.events.Send(ctx, homeworkEventsTopic, event); err != nil {
+			errs = append(errs, fmt.Errorf("assignment %s: %w", a.ID, err))
+			if err := n.assignmentRepo.ReleaseOverdueNotification(ctx, a.ID); err != nil {
+				errs = append(errs, fmt.Errorf("assignment %s: %w", a.ID, err))
+			}
+			continue
+		}
+		sent++
+	}
+
+	return sent, errors.Join(errs...)
+}
+
+// SendDigests sends every tutor with overdue assignments the digest for the day of now
+// (UTC), unless it has already been sent. It returns the number of sent digests.
+func (n *OverdueNotifier) SendDigests(ctx context.Context, now time.Time) (int, error) {
+	assignments, err := n.assignmentRepo.ListOverdue(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	day := now.UTC()
+	sent := 0
+	var errs []error
+	for _, digest := range buildOverdueDigests(assignments, day) {
+		claimed, err := n.assignmentRepo.ClaimOverdueDigest(ctx, digest.TutorID, day)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("tutor %s: %w", digest.TutorID, err))
+			continue
+		}
+		if !claimed {
+			continue
+		}
+
+		if err := n.events.Send(ctx, homeworkEventsTopic, digest); err != nil {
+			errs = append(errs, fmt.Errorf("tutor %s: %w", digest.TutorID, err))
+			if err := n.assignmentRepo.ReleaseOverdueDigest(ctx, digest.TutorID, day); err != nil {
+				errs = append(errs, fmt.Errorf("tutor %s: %w", digest.TutorID, err))
+			}
+			continue
+		}
+		sent++
+	}
+
+	return sent, errors.Join(errs...)
+}
+
+// buildOverdueDigests groups assignments ordered by tutor and student into one digest per tutor.
+func buildOverdueDigests(assignments []*domain.Assignment, day time.Time) []OverdueDigestEvent {
+	var digests []OverdueDigestEvent
+	for _, a := range assignments {
+		if len(digests) == 0 || digests[len(digests)-1].TutorID != a.TutorID {
+			digests = append(digests, OverdueDigestEvent{
+				EventType:  AssignmentEventOverdueDigest,
+				TutorID:    a.TutorID,
+				Date:       day.Format(time.DateOnly),
+				OccurredAt: day,
+			})
+		}
+		digest := &digests[len(digests)-1]
+
+		if len(digest.Students) == 0 || digest.Students[len(digest.Students)-1].StudentID != a.StudentID {
+			digest.Students = append(digest.Students, OverdueDigestStudent{StudentID: a.StudentID})
+		}
+		student := &digest.Students[len(digest.Students)-1]
+
+		student.Assignments = append(student.Assignments, OverdueDigestAssignment{
+			AssignmentID: a.ID,
+			Title:        a.Title,
+			DueDate:      a.DueDate,
+		})
+	}
+	return digests
+}
@@ -0,0 +1,43 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"homework_service/internal/domain"
+)
+
+func TestBuildOverdueDigests(t *testing.T) {
+	day := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	firstTutor, secondTutor := uuid.New(), uuid.New()
+	firstStudent, secondStudent := uuid.New(), uuid.New()
+
+	assignments := []*domain.Assignment{
+		{ID: uuid.New(), TutorID: firstTutor, StudentID: firstStudent},
+		{ID: uuid.New(), TutorID: firstTutor, StudentID: firstStudent},
+		{ID: uuid.New(), TutorID: firstTutor, StudentID: secondStudent},
+		{ID: uuid.New(), TutorID: secondTutor, StudentID: firstStudent},
+	}
+
+	digests := buildOverdueDigests(assignments, day)
+
+	require.Len(t, digests, 2)
+	assert.Equal(t, firstTutor, digests[0].TutorID)
+	assert.Equal(t, AssignmentEventOverdueDigest, digests[0].EventType)
+	assert.Equal(t, "2026-03-02", digests[0].Date)
+	require.Len(t, digests[0].Students, 2)
+	assert.Equal(t, firstStudent, digests[0].Students[0].StudentID)
+	assert.Len(t, digests[0].Students[0].Assignments, 2)
+	assert.Equal(t, assignments[1].ID, digests[0].Students[0].Assignments[1].AssignmentID)
+	assert.Len(t, digests[0].Students[1].Assignments, 1)
+
+	assert.Equal(t, secondTutor, digests[1].TutorID)
+	require.Len(t, digests[1].Students, 1)
+	assert.Equal(t, assignments[3].ID, digests[1].Students[0].Assignments[0].AssignmentID)
+
+	assert.Empty(t, buildOverdueDigests(nil, day))
+}
@@ -0,0 +1,345 @@
+package service
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html/template"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/google/uuid"
+
+	"homework_service/internal/domain"
+)
+
+const (
+	// maxPortfolioSize limits the total size of the files put into a portfolio.
+	maxPortfolioSize = 1 << 30
+	// maxPortfolioSlugLength limits assignment directory names in runes.
+	maxPortfolioSlugLength = 40
+)
+
+var errPortfolioTooLarge = errors.New("portfolio files exceed 1 GB")
+
+// portfolioManifest is written to manifest.json of the portfolio archive. File paths
+// are relative to the archive root.
+type portfolioManifest struct {
+	ExportID    uuid.UUID              `json:"export_id"`
+	TutorID     uuid.UUID              `json:"tutor_id"`
+	StudentID   uuid.UUID              `json:"student_id"`
+	From        *time.Time             `json:"from,omitempty"`
+	To          *time.Time             `json:"to,omitempty"`
+	GeneratedAt time.Time              `json:"generated_at"`
+	Assignments []*portfolioAssignment `json:"assignments"`
+
+	// files lists all files of the portfolio in archive order.
+	files []*portfolioFile
+}
+
+type portfolioAssignment struct {
+	ID          uuid.UUID              `json:"id"`
+	Title       *string                `json:"title,omitempty"`
+	Description *string                `json:"description,omitempty"`
+	DueDate     *time.Time             `json:"due_date,omitempty"`
+	CreatedAt   time.Time              `json:"created_at"`
+	Files       []*portfolioFile       `json:"files"`
+	Submissions []*portfolioSubmission `json:"submissions"`
+}
+
+type portfolioSubmission struct {
+	ID        uuid.UUID            `json:"id"`
+	Version   int                  `json:"version"`
+	Comment   *string              `json:"comment,omitempty"`
+	IsLate    bool                 `json:"is_late"`
+	CreatedAt time.Time            `json:"created_at"`
+	Files     []*portfolioFile     `json:"files"`
+	Feedbacks []*portfolioFeedback `json:"feedbacks"`
+}
+
+type portfolioFeedback struct {
+	ID        uuid.UUID        `json:"id"`
+	Comment   *string          `json:"comment,omitempty"`
+	Score     *float64         `json:"score,omitempty"`
+	MaxScore  *float64         `json:"max_score,omitempty"`
+	Verdict   string           `json:"verdict,omitempty"`
+	CreatedAt time.Time        `json:"created_at"`
+	Files     []*portfolioFile `json:"files"`
+}
+
+type portfolioFile struct {
+	FileID  uuid.UUID `json:"file_id"`
+	Caption *string   `json:"caption,omitempty"`
+	Name    string    `json:"name"`
+	Path    string    `json:"path"`
+
+	// dir and index place the file in the archive before its name is known.
+	dir   string
+	index int
+}
+
+func newPortfolioManifest(export *domain.PortfolioExport, generatedAt time.Time) *portfolioManifest {
+	return &portfolioManifest{
+		ExportID:    export.ID,
+		TutorID:     export.TutorID,
+		StudentID:   export.StudentID,
+		From:        export.From,
+		To:          export.To,
+		GeneratedAt: generatedAt,
+		Assignments: []*portfolioAssignment{},
+	}
+}
+
+// addAssignment adds the assignment with its submissions and their feedbacks. Every
+// assignment gets a numbered directory with the files of its submissions and
+// feedbacks in subdirectories.
+func (m *portfolioManifest) addAssignment(a *domain.Assignment, submissions []*domain.Submission, feedbacks []*domain.Feedback) {
+	title := ""
+	if a.Title != nil {
+		title = *a.Title
+	}
+	dir := fmt.Sprintf("assignments/%03d-%s", len(m.Assignments)+1, portfolioSlug(title, "assignment"))
+
+	assignment := &portfolioAssignment{
+		ID:          a.ID,
+		Title:       a.Title,
+		Description: a.Description,
+		DueDate:     a.DueDate,
+		CreatedAt:   a.CreatedAt,
+		Files:       m.addFiles(dir, a.FileID, a.Attachments),
+		Submissions: []*portfolioSubmission{},
+	}
+
+	bySubmission := make(map[uuid.UUID][]*domain.Feedback)
+	for _, f := range feedbacks {
+		bySubmission[f.SubmissionID] = append(bySubmission[f.SubmissionID], f)
+	}
+
+	for _, s := range submissions {
+		subDir := fmt.Sprintf("%s/submissions/v%d", dir, s.Version)
+		submission := &portfolioSubmission{
+			ID:        s.ID,
+			Version:   s.Version,
+			Comment:   s.Comment,
+			IsLate:    s.IsLate,
+			CreatedAt: s.CreatedAt,
+			Files:     m.addFiles(subDir, s.FileID, s.Attachments),
+			Feedbacks: []*portfolioFeedback{},
+		}
+		for i, f := range bySubmission[s.ID] {
+			submission.Feedbacks = append(submission.Feedbacks, &portfolioFeedback{
+				ID:        f.ID,
+				Comment:   f.Comment,
+				Score:     f.Score,
+				MaxScore:  f.MaxScore,
+				Verdict:   string(f.Verdict),
+				CreatedAt: f.CreatedAt,
+				Files:     m.addFiles(fmt.Sprintf("%s/feedback-%d", subDir, i+1), f.FileID, f.Attachments),
+			})
+		}
+		assignment.Submissions = append(assignment.Submissions, submission)
+	}
+
+	m.Assignments = append(m.Assignments, assignment)
+}
+
+// addFiles registers the attachments of an owner, preceded by its legacy file
+// unless it is among the attachments.
+func (m *portfolioManifest) addFiles(dir string, fileID *uuid.UUID, attachments []domain.Attachment) []*portfolioFile {
+	files := []*portfolioFile{}
+	if fileID != nil && !containsFile(attachments, *fileID) {
+		files = append(files, &portfolioFile{FileID: *fileID, dir: dir})
+	}
+	for _, a := range attachments {
+		files = append(files, &portfolioFile{FileID: a.FileID, Caption: a.Caption, dir: dir})
+	}
+	for i, f := range files {
+		f.index = i + 1
+	}
+	m.files = append(m.files, files...)
+	return files
+}
+
+func containsFile(attachments []domain.Attachment, fileID uuid.UUID) bool {
+	for _, a := range attachments {
+		if a.FileID == fileID {
+			return true
+		}
+	}
+	return false
+}
+
+type downloadFunc func(ctx context.Context, fileID uuid.UUID) (*domain.FileContent, error)
+
+// writePortfolio writes the portfolio archive: the downloaded files, manifest.json
+// and index.html linking the files.
+func writePortfolio(ctx context.Context, w io.Writer, m *portfolioManifest, download downloadFunc) error {
+	zw := zip.NewWriter(w)
+
+	budget := int64(maxPortfolioSize)
+	for _, f := range m.files {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		content, err := download(ctx, f.FileID)
+		if err != nil {
+			return fmt.Errorf("failed to download file %s: %w", f.FileID, err)
+		}
+		f.Name = content.Name
+		// The number keeps names unique when owners have files with the same name.
+		f.Path = fmt.Sprintf("%s/%02d-%s", f.dir, f.index, sanitizeFileName(content.Name))
+
+		written, err := writeEntry(zw, f.Path, m.GeneratedAt, io.LimitReader(content.Body, budget+1))
+		_ = content.Body.Close()
+		if err != nil {
+			return err
+		}
+		if written > budget {
+			return errPortfolioTooLarge
+		}
+		budget -= written
+	}
+
+	manifest, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode manifest: %w", err)
+	}
+	if _, err := writeEntry(zw, "manifest.json", m.GeneratedAt, bytes.NewReader(manifest)); err != nil {
+		return err
+	}
+
+	var index strings.Builder
+	if err := portfolioIndex.Execute(&index, m); err != nil {
+		return fmt.Errorf("failed to render index: %w", err)
+	}
+	if _, err := writeEntry(zw, "index.html", m.GeneratedAt, strings.NewReader(index.String())); err != nil {
+		return err
+	}
+
+	if err := zw.Close(); err != nil {
+		return fmt.Errorf("failed to finish archive: %w", err)
+	}
+	return nil
+}
+
+func writeEntry(zw *zip.Writer, name string, modified time.Time, r io.Reader) (int64, error) {
+	entry, err := zw.CreateHeader(&zip.FileHeader{
+		Name:     name,
+		Method:   zip.Deflate,
+		Modified: modified,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to add %s to archive: %w", name, err)
+	}
+	written, err := io.Copy(entry, r)
+	if err != nil {
+		return written, fmt.Errorf("failed to write %s to archive: %w", name, err)
+	}
+	return written, nil
+}
+
+// portfolioSlug turns a title into a directory name of lowercase letters and digits
+// separated by dashes.
+func portfolioSlug(title, fallback string) string {
+	var b strings.Builder
+	runes := 0
+	dash := false
+	for _, r := range strings.ToLower(title) {
+		if runes >= maxPortfolioSlugLength {
+			break
+		}
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			b.WriteRune(r)
+			runes++
+			dash = false
+			continue
+		}
+		if runes > 0 && !dash {
+			b.WriteByte('-')
+			runes++
+			dash = true
+		}
+	}
+
+	slug := strings.Trim(b.String(), "-")
+	if slug == "" {
+		return fallback
+	}
+	return slug
+}
+
+// sanitizeFileName replaces characters that are unsafe in archive paths.
+func sanitizeFileName(name string) string {
+	name = strings.Map(func(r rune) rune {
+		if r == '/' || r == '\\' || unicode.IsControl(r) {
+			return '_'
+		}
+		return r
+	}, strings.TrimSpace(name))
+	if name == "" || name == "." || name == ".." {
+		return "file"
+	}
+	return name
+}
+
+var portfolioIndex = template.Must(template.New("index").Funcs(template.FuncMap{
+	"date": func(t time.Time) string {
+		return t.UTC().Format("02.01.2006 15:04")
+	},
+	"num": func(f float64) string {
+		return strconv.FormatFloat(f, 'f', -1, 64)
+	},
+	"verdict": func(v string) string {
+		switch domain.FeedbackVerdict(v) {
+		case domain.FeedbackVerdictAccepted:
+			return "принято"
+		case domain.FeedbackVerdictNeedsRevision:
+			return "на доработку"
+		default:
+			return v
+		}
+	},
+}).Parse(`<!DOCTYPE html>
+<html lang="ru">
+<head>
+<meta charset="utf-8">
+<title>Портфолио</title>
+<style>
+body { font-family: sans-serif; max-width: 960px; margin: 2em auto; padding: 0 1em; }
+section { border-top: 1px solid #ccc; margin-top: 2em; }
+.text { white-space: pre-wrap; }
+.late { color: #b00; }
+</style>
+</head>
+<body>
+<h1>Портфолио</h1>
+<p>Сформировано {{date .GeneratedAt}} UTC{{with .From}}, задания с {{date .}}{{end}}{{with .To}} по {{date .}}{{end}}.</p>
+{{range .Assignments}}<section>
+<h2>{{with .Title}}{{.}}{{else}}Без названия{{end}}</h2>
+<p>Выдано {{date .CreatedAt}}{{with .DueDate}}, срок {{date .}}{{end}}</p>
+{{with .Description}}<p class="text">{{.}}</p>
+{{end}}{{template "files" .Files}}
+{{range .Submissions}}<h3>Решение, версия {{.Version}}</h3>
+<p>Отправлено {{date .CreatedAt}}{{if .IsLate}} <span class="late">с опозданием</span>{{end}}</p>
+{{with .Comment}}<p class="text">{{.}}</p>
+{{end}}{{template "files" .Files}}
+{{range .Feedbacks}}<h4>Отзыв от {{date .CreatedAt}}</h4>
+{{if .Score}}<p>Оценка: {{num .Score}}{{with .MaxScore}} из {{num .}}{{end}}</p>
+{{end}}{{with .Verdict}}<p>Решение {{verdict .}}</p>
+{{end}}{{with .Comment}}<p class="text">{{.}}</p>
+{{end}}{{template "files" .Files}}
+{{end}}{{end}}</section>
+{{else}}<p>За выбранный период заданий нет.</p>
+{{end}}</body>
+</html>
+{{define "files"}}{{if .}}<ul>
+{{range .}}<li><a href="{{.Path}}">{{with .Caption}}{{.}}{{else}}{{.Name}}{{end}}</a></li>
+{{end}}</ul>
+{{end}}{{end}}`))
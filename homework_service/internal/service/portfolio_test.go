@@ -0,0 +1,136 @@
+package service
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"homework_service/internal/domain"
+)
+
+func TestPortfolioSlug(t *testing.T) {
+	assert.Equal(t, "теорема-пифагора-1", portfolioSlug("  Теорема Пифагора: №1! ", "assignment"))
+	assert.Equal(t, "assignment", portfolioSlug("?!", "assignment"))
+	assert.Equal(t, maxPortfolioSlugLength, len([]rune(portfolioSlug(strings.Repeat("a", 100), "assignment"))))
+}
+
+func TestSanitizeFileName(t *testing.T) {
+	assert.Equal(t, ".._.._etc_passwd", sanitizeFileName("../../etc/passwd"))
+	assert.Equal(t, "решение.pdf", sanitizeFileName("решение.pdf"))
+	assert.Equal(t, "file", sanitizeFileName(".."))
+	assert.Equal(t, "file", sanitizeFileName(" "))
+}
+
+func TestWritePortfolio(t *testing.T) {
+	title := "Теорема Пифагора"
+	caption := "Условие"
+	comment := "Готово"
+	score, maxScore := 8.0, 10.0
+	createdAt := time.Date(2026, 9, 1, 10, 0, 0, 0, time.UTC)
+
+	legacyFile := uuid.New()
+	attachedFile := uuid.New()
+	submissionFile := uuid.New()
+	feedbackFile := uuid.New()
+
+	assignment := &domain.Assignment{
+		ID:        uuid.New(),
+		Title:     &title,
+		FileID:    &legacyFile,
+		CreatedAt: createdAt,
+		Attachments: []domain.Attachment{
+			{FileID: attachedFile, Caption: &caption},
+		},
+	}
+	submission := &domain.Submission{
+		ID:           uuid.New(),
+		AssignmentID: assignment.ID,
+		Version:      1,
+		Comment:      &comment,
+		IsLate:       true,
+		CreatedAt:    createdAt.Add(time.Hour),
+		Attachments:  []domain.Attachment{{FileID: submissionFile}},
+	}
+	feedback := &domain.Feedback{
+		ID:           uuid.New(),
+		SubmissionID: submission.ID,
+		FileID:       &feedbackFile,
+		Score:        &score,
+		MaxScore:     &maxScore,
+		Verdict:      domain.FeedbackVerdictAccepted,
+		CreatedAt:    createdAt.Add(2 * time.Hour),
+		Attachments:  []domain.Attachment{{FileID: feedbackFile}},
+	}
+
+	manifest := newPortfolioManifest(&domain.PortfolioExport{ID: uuid.New()}, createdAt.Add(24*time.Hour))
+	manifest.addAssignment(assignment, []*domain.Submission{submission}, []*domain.Feedback{feedback})
+
+	names := map[uuid.UUID]string{
+		legacyFile:     "task.pdf",
+		attachedFile:   "task.pdf",
+		submissionFile: "../answer.docx",
+		feedbackFile:   "review.png",
+	}
+	download := func(ctx context.Context, fileID uuid.UUID) (*domain.FileContent, error) {
+		return &domain.FileContent{Name: names[fileID], Body: io.NopCloser(strings.NewReader("content of " + fileID.String()))}, nil
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, writePortfolio(context.Background(), &buf, manifest, download))
+
+	archive, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.NoError(t, err)
+	entries := make(map[string]string)
+	for _, f := range archive.File {
+		r, err := f.Open()
+		require.NoError(t, err)
+		content, err := io.ReadAll(r)
+		require.NoError(t, err)
+		entries[f.Name] = string(content)
+	}
+
+	dir := "assignments/001-теорема-пифагора"
+	assert.Equal(t, "content of "+legacyFile.String(), entries[dir+"/01-task.pdf"])
+	assert.Equal(t, "content of "+attachedFile.String(), entries[dir+"/02-task.pdf"])
+	assert.Equal(t, "content of "+submissionFile.String(), entries[dir+"/submissions/v1/01-.._answer.docx"])
+	assert.Equal(t, "content of "+feedbackFile.String(), entries[dir+"/submissions/v1/feedback-1/01-review.png"])
+	assert.Len(t, entries, 6)
+
+	var decoded portfolioManifest
+	require.NoError(t, json.Unmarshal([]byte(entries["manifest.json"]), &decoded))
+	require.Len(t, decoded.Assignments, 1)
+	assert.Len(t, decoded.Assignments[0].Files, 2)
+	require.Len(t, decoded.Assignments[0].Submissions, 1)
+	assert.True(t, decoded.Assignments[0].Submissions[0].IsLate)
+	require.Len(t, decoded.Assignments[0].Submissions[0].Feedbacks, 1)
+	assert.Equal(t, dir+"/submissions/v1/feedback-1/01-review.png", decoded.Assignments[0].Submissions[0].Feedbacks[0].Files[0].Path)
+
+	index := entries["index.html"]
+	assert.Contains(t, index, "Теорема Пифагора")
+	assert.Contains(t, index, "Условие</a>")
+	assert.Contains(t, index, "Оценка: 8 из 10")
+	assert.Contains(t, index, "с опозданием")
+}
+
+func TestWritePortfolioDownloadError(t *testing.T) {
+	fileID := uuid.New()
+	manifest := newPortfolioManifest(&domain.PortfolioExport{ID: uuid.New()}, time.Now())
+	manifest.addAssignment(&domain.Assignment{ID: uuid.New(), FileID: &fileID}, nil, nil)
+
+	download := func(ctx context.Context, id uuid.UUID) (*domain.FileContent, error) {
+		return nil, errors.New("unavailable")
+	}
+
+	err := writePortfolio(context.Background(), io.Discard, manifest, download)
+	assert.ErrorContains(t, err, fileID.String())
+}
@@ -0,0 +1,127 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"homework_service/internal/domain"
+	"homework_service/internal/repository"
+)
+
+const AssignmentEventCreated = "assignment.created"
+
+// AssignmentCreatedEvent is sent to homeworkEventsTopic once per assignment when it
+// becomes visible to the student: right after creation or at its publish time.
+type AssignmentCreatedEvent struct {
+	EventType    string     `json:"event_type"`
+	AssignmentID uuid.UUID  `json:"assignment_id"`
+	TutorID      uuid.UUID  `json:"tutor_id"`
+	StudentID    uuid.UUID  `json:"student_id"`
+	Title        *string    `json:"title,omitempty"`
+	DueDate      *time.Time `json:"due_date,omitempty"`
+	OccurredAt   time.Time  `json:"occurred_at"`
+}
+
+// preparePublishing checks the state of a new or edited assignment and sets its
+// publication fields. stored is nil for a new assignment. The state defaults to
+// published, and a published assignment cannot be hidden again.
+func preparePublishing(assignment, stored *domain.Assignment, now time.Time) error {
+	if assignment.State == "" {
+		assignment.State = domain.AssignmentStatePublished
+	}
+	if !assignment.State.IsValid() {
+		return fmt.Errorf("%w: unknown assignment state %q", ErrInvalidArgument, assignment.State)
+	}
+
+	if stored != nil && stored.IsPublished() {
+		if !assignment.IsPublished() {
+			return fmt.Errorf("%w: published assignment cannot be unpublished", ErrFailedPrecondition)
+		}
+		assignment.PublishAt = stored.PublishAt
+		assignment.PublishedAt = stored.PublishedAt
+		return nil
+	}
+
+	switch assignment.State {
+	case domain.AssignmentStateDraft:
+		assignment.PublishAt = nil
+	case domain.AssignmentStateScheduled:
+		if assignment.PublishAt == nil {
+			return fmt.Errorf("%w: publish_at is required for scheduled assignments", ErrInvalidArgument)
+		}
+	case domain.AssignmentStatePublished:
+		assignment.PublishAt = nil
+		assignment.PublishedAt = &now
+	}
+	return nil
+}
+
+// isVisible reports whether the user may see the assignment: its tutor always,
+// anyone else only after it is published.
+func isVisible(assignment *domain.Assignment, userID string) bool {
+	return assignment.IsPublished() || assignment.TutorID.String() == userID
+}
+
+// AssignmentPublisher publishes scheduled assignments and announces published ones.
+type AssignmentPublisher struct {
+	assignmentRepo *repository.AssignmentRepository
+	events         EventSender
+}
+
+func NewAssignmentPublisher(assignmentRepo *repository.AssignmentRepository, events EventSender) *AssignmentPublisher {
+	return &AssignmentPublisher{
+		assignmentRepo: assignmentRepo,
+		events:         events,
+	}
+}
+
+// Publish publishes scheduled assignments whose time has come and sends the created
+// event for every published assignment that has not been announced yet, including
+// those published on creation or edit. Assignments are claimed before sending and
+// released if sending fails, so they are retried on the next run. It returns the
+// number of sent events.
+func (p *AssignmentPublisher) Publish(ctx context.Context) (int, error) {
+	if _, err := p.assignmentRepo.PublishDue(ctx); err != nil {
+		return 0, err
+	}
+
+	assignments, err := p.assignmentRepo.ClaimPublishedNotifications(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	sent := 0
+	var errs []error
+	for _, a := range assignments {
+		if err := p.events.Send(ctx, homeworkEventsTopic, newAssignmentCreatedEvent(a)); err != nil {
+			errs = append(errs, fmt.Errorf("assignment %s: %w", a.ID, err))
+			if err := p.assignmentRepo.ReleasePublishedNotification(ctx, a.ID); err != nil {
+				errs = append(errs, fmt.Errorf("assignment %s: %w", a.ID, err))
+			}
+			continue
+		}
+		sent++
+	}
+
+	return sent, errors.Join(errs...)
+}
+
+func newAssignmentCreatedEvent(a *domain.Assignment) AssignmentCreatedEvent {
+	occurredAt := time.Now()
+	if a.PublishedAt != nil {
+		occurredAt = *a.PublishedAt
+	}
+	return AssignmentCreatedEvent{
+		EventType:    AssignmentEventCreated,
+		AssignmentID: a.ID,
+		TutorID:      a.TutorID,
+		StudentID:    a.StudentID,
+		Title:        a.Title,
+		DueDate:      a.DueDate,
+		OccurredAt:   occurredAt,
+	}
+}
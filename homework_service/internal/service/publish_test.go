@@ -0,0 +1,70 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"homework_service/internal/domain"
+)
+
+func TestPreparePublishing(t *testing.T) {
+	now := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	publishAt := now.Add(24 * time.Hour)
+
+	t.Run("published by default", func(t *testing.T) {
+		assignment := &domain.Assignment{}
+
+		require.NoError(t, preparePublishing(assignment, nil, now))
+		assert.Equal(t, domain.AssignmentStatePublished, assignment.State)
+		assert.Equal(t, &now, assignment.PublishedAt)
+	})
+
+	t.Run("scheduled requires publish time", func(t *testing.T) {
+		err := preparePublishing(&domain.Assignment{State: domain.AssignmentStateScheduled}, nil, now)
+		assert.ErrorIs(t, err, ErrInvalidArgument)
+
+		assignment := &domain.Assignment{State: domain.AssignmentStateScheduled, PublishAt: &publishAt}
+		require.NoError(t, preparePublishing(assignment, nil, now))
+		assert.Equal(t, &publishAt, assignment.PublishAt)
+		assert.Nil(t, assignment.PublishedAt)
+	})
+
+	t.Run("draft drops publish time", func(t *testing.T) {
+		stored := &domain.Assignment{State: domain.AssignmentStateScheduled, PublishAt: &publishAt}
+		assignment := &domain.Assignment{State: domain.AssignmentStateDraft, PublishAt: &publishAt}
+
+		require.NoError(t, preparePublishing(assignment, stored, now))
+		assert.Nil(t, assignment.PublishAt)
+	})
+
+	t.Run("published cannot be unpublished", func(t *testing.T) {
+		stored := &domain.Assignment{State: domain.AssignmentStatePublished, PublishedAt: &now}
+
+		err := preparePublishing(&domain.Assignment{State: domain.AssignmentStateDraft}, stored, now)
+		assert.ErrorIs(t, err, ErrFailedPrecondition)
+
+		later := now.Add(time.Hour)
+		assignment := &domain.Assignment{State: domain.AssignmentStatePublished}
+		require.NoError(t, preparePublishing(assignment, stored, later))
+		assert.Equal(t, &now, assignment.PublishedAt)
+	})
+
+	t.Run("unknown state", func(t *testing.T) {
+		err := preparePublishing(&domain.Assignment{State: "hidden"}, nil, now)
+		assert.ErrorIs(t, err, ErrInvalidArgument)
+	})
+}
+
+func TestIsVisible(t *testing.T) {
+	tutorID, studentID := uuid.New(), uuid.New()
+	draft := &domain.Assignment{TutorID: tutorID, StudentID: studentID, State: domain.AssignmentStateDraft}
+	published := &domain.Assignment{TutorID: tutorID, StudentID: studentID, State: domain.AssignmentStatePublished}
+
+	assert.True(t, isVisible(draft, tutorID.String()))
+	assert.False(t, isVisible(draft, studentID.String()))
+	assert.True(t, isVisible(published, studentID.String()))
+}
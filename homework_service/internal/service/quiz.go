@@ -0,0 +1,183 @@
+package service
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+
+	"homework_service/internal/domain"
+)
+
+const (
+	maxQuizQuestions = 100
+	maxQuizOptions   = 20
+)
+
+// validateQuiz checks the questions and the answer key of a quiz. Points default to 1.
+func validateQuiz(questions []domain.QuizQuestion) error {
+	if len(questions) > maxQuizQuestions {
+		return fmt.Errorf("%w: at most %d quiz questions are allowed", ErrInvalidArgument, maxQuizQuestions)
+	}
+
+	for i := range questions {
+		q := &questions[i]
+		if !q.Type.IsValid() {
+			return fmt.Errorf("%w: question %d has an unknown type", ErrInvalidArgument, i)
+		}
+		if strings.TrimSpace(q.Text) == "" {
+			return fmt.Errorf("%w: question %d has no text", ErrInvalidArgument, i)
+		}
+		if q.Points == 0 {
+			q.Points = 1
+		}
+		if q.Points < 0 {
+			return fmt.Errorf("%w: question %d must have positive points", ErrInvalidArgument, i)
+		}
+		if q.Tolerance < 0 {
+			return fmt.Errorf("%w: question %d must have a non-negative tolerance", ErrInvalidArgument, i)
+		}
+
+		switch q.Type {
+		case domain.QuizQuestionSingleChoice, domain.QuizQuestionMultipleChoice:
+			if len(q.Options) < 2 || len(q.Options) > maxQuizOptions {
+				return fmt.Errorf("%w: question %d must have from 2 to %d options", ErrInvalidArgument, i, maxQuizOptions)
+			}
+			if err := validateOptions(q.CorrectOptions, len(q.Options)); err != nil {
+				return fmt.Errorf("%w: question %d: %v", ErrInvalidArgument, i, err)
+			}
+			if q.Type == domain.QuizQuestionSingleChoice && len(q.CorrectOptions) != 1 {
+				return fmt.Errorf("%w: single choice question %d must have exactly one correct option", ErrInvalidArgument, i)
+			}
+			if len(q.CorrectOptions) == 0 {
+				return fmt.Errorf("%w: question %d must have a correct option", ErrInvalidArgument, i)
+			}
+		case domain.QuizQuestionNumeric:
+			if q.NumericAnswer == nil {
+				return fmt.Errorf("%w: numeric question %d must have an answer", ErrInvalidArgument, i)
+			}
+		case domain.QuizQuestionShortText:
+			if !slices.ContainsFunc(q.TextAnswers, func(a string) bool { return normalizeText(a) != "" }) {
+				return fmt.Errorf("%w: short text question %d must have an accepted answer", ErrInvalidArgument, i)
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateOptions checks that the options are distinct indexes of n options.
+func validateOptions(options []int, n int) error {
+	seen := make(map[int]bool, len(options))
+	for _, o := range options {
+		if o < 0 || o >= n {
+			return fmt.Errorf("option %d is out of range", o)
+		}
+		if seen[o] {
+			return fmt.Errorf("option %d is repeated", o)
+		}
+		seen[o] = true
+	}
+	return nil
+}
+
+// gradeQuiz scores the answers in place and returns the feedback grading them.
+// A question is worth its points if answered exactly right and nothing otherwise;
+// unanswered questions score zero.
+func gradeQuiz(questions []domain.QuizQuestion, answers []domain.QuizAnswer) (*domain.Feedback, error) {
+	answered := make(map[int]bool, len(answers))
+	for i := range answers {
+		a := &answers[i]
+		if a.Question < 0 || a.Question >= len(questions) {
+			return nil, fmt.Errorf("%w: answer to unknown question %d", ErrInvalidArgument, a.Question)
+		}
+		if answered[a.Question] {
+			return nil, fmt.Errorf("%w: question %d is answered twice", ErrInvalidArgument, a.Question)
+		}
+		answered[a.Question] = true
+
+		q := questions[a.Question]
+		if err := validateOptions(a.Options, len(q.Options)); err != nil {
+			return nil, fmt.Errorf("%w: answer to question %d: %v", ErrInvalidArgument, a.Question, err)
+		}
+
+		a.Correct = isCorrect(q, *a)
+		a.Score = 0
+		if a.Correct {
+			a.Score = q.Points
+		}
+	}
+	slices.SortFunc(answers, func(a, b domain.QuizAnswer) int { return a.Question - b.Question })
+
+	var score, maxScore float64
+	for _, q := range questions {
+		maxScore += q.Points
+	}
+	for _, a := range answers {
+		score += a.Score
+	}
+
+	return &domain.Feedback{
+		Score:      &score,
+		MaxScore:   &maxScore,
+		Verdict:    domain.FeedbackVerdictAccepted,
+		AutoGraded: true,
+	}, nil
+}
+
+func isCorrect(q domain.QuizQuestion, a domain.QuizAnswer) bool {
+	switch q.Type {
+	case domain.QuizQuestionSingleChoice, domain.QuizQuestionMultipleChoice:
+		return len(a.Options) == len(q.CorrectOptions) &&
+			!slices.ContainsFunc(a.Options, func(o int) bool { return !slices.Contains(q.CorrectOptions, o) })
+	case domain.QuizQuestionNumeric:
+		return a.Number != nil && q.NumericAnswer != nil && math.Abs(*a.Number-*q.NumericAnswer) <= q.Tolerance
+	case domain.QuizQuestionShortText:
+		if a.Text == nil {
+			return false
+		}
+		text := normalizeText(*a.Text)
+		return text != "" && slices.ContainsFunc(q.TextAnswers, func(accepted string) bool {
+			return normalizeText(accepted) == text
+		})
+	default:
+		return false
+	}
+}
+
+// normalizeText lowercases the text and collapses whitespace.
+func normalizeText(s string) string {
+	return strings.ToLower(strings.Join(strings.Fields(s), " "))
+}
+
+// hideQuizAnswers removes the answer keys from the quizzes the caller does not own.
+func hideQuizAnswers(userID string, assignments ...*domain.Assignment) {
+	for _, a := range assignments {
+		if a.TutorID.String() == userID {
+			continue
+		}
+		for i := range a.Quiz {
+			a.Quiz[i].HideAnswer()
+		}
+	}
+}
+
+func equalQuizzes(a, b []domain.QuizQuestion) bool {
+	return slices.EqualFunc(a, b, func(x, y domain.QuizQuestion) bool {
+		return x.Type == y.Type &&
+			x.Text == y.Text &&
+			slices.Equal(x.Options, y.Options) &&
+			x.Points == y.Points &&
+			slices.Equal(x.CorrectOptions, y.CorrectOptions) &&
+			equalFloats(x.NumericAnswer, y.NumericAnswer) &&
+			x.Tolerance == y.Tolerance &&
+			slices.Equal(x.TextAnswers, y.TextAnswers)
+	})
+}
+
+func equalFloats(a, b *float64) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
@@ -0,0 +1,131 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"homework_service/internal/domain"
+)
+
+func TestValidateQuiz(t *testing.T) {
+	float := func(f float64) *float64 {
+		return &f
+	}
+
+	t.Run("valid quiz", func(t *testing.T) {
+		quiz := []domain.QuizQuestion{
+			{Type: domain.QuizQuestionSingleChoice, Text: "2+2", Options: []string{"3", "4"}, CorrectOptions: []int{1}},
+			{Type: domain.QuizQuestionNumeric, Text: "pi", NumericAnswer: float(3.14), Tolerance: 0.01, Points: 2},
+		}
+
+		require.NoError(t, validateQuiz(quiz))
+		assert.Equal(t, 1.0, quiz[0].Points)
+		assert.Equal(t, 2.0, quiz[1].Points)
+	})
+
+	t.Run("invalid quizzes", func(t *testing.T) {
+		for _, q := range []domain.QuizQuestion{
+			{Type: "essay", Text: "why"},
+			{Type: domain.QuizQuestionNumeric, Text: " ", NumericAnswer: float(1)},
+			{Type: domain.QuizQuestionNumeric, Text: "x", NumericAnswer: float(1), Points: -1},
+			{Type: domain.QuizQuestionNumeric, Text: "x"},
+			{Type: domain.QuizQuestionSingleChoice, Text: "x", Options: []string{"a"}, CorrectOptions: []int{0}},
+			{Type: domain.QuizQuestionSingleChoice, Text: "x", Options: []string{"a", "b"}, CorrectOptions: []int{0, 1}},
+			{Type: domain.QuizQuestionMultipleChoice, Text: "x", Options: []string{"a", "b"}, CorrectOptions: []int{2}},
+			{Type: domain.QuizQuestionMultipleChoice, Text: "x", Options: []string{"a", "b"}},
+			{Type: domain.QuizQuestionShortText, Text: "x", TextAnswers: []string{" "}},
+		} {
+			assert.ErrorIs(t, validateQuiz([]domain.QuizQuestion{q}), ErrInvalidArgument, q)
+		}
+	})
+}
+
+func TestGradeQuiz(t *testing.T) {
+	float := func(f float64) *float64 {
+		return &f
+	}
+	str := func(s string) *string {
+		return &s
+	}
+
+	quiz := []domain.QuizQuestion{
+		{Type: domain.QuizQuestionSingleChoice, Options: []string{"3", "4"}, CorrectOptions: []int{1}, Points: 1},
+		{Type: domain.QuizQuestionMultipleChoice, Options: []string{"a", "b", "c"}, CorrectOptions: []int{0, 2}, Points: 2},
+		{Type: domain.QuizQuestionNumeric, NumericAnswer: float(3.14), Tolerance: 0.01, Points: 1},
+		{Type: domain.QuizQuestionShortText, TextAnswers: []string{"New  York"}, Points: 1},
+	}
+
+	t.Run("scores answers", func(t *testing.T) {
+		answers := []domain.QuizAnswer{
+			{Question: 3, Text: str(" new york ")},
+			{Question: 1, Options: []int{2, 0}},
+			{Question: 0, Options: []int{0}},
+			{Question: 2, Number: float(3.145)},
+		}
+
+		feedback, err := gradeQuiz(quiz, answers)
+		require.NoError(t, err)
+		assert.Equal(t, 4.0, *feedback.Score)
+		assert.Equal(t, 5.0, *feedback.MaxScore)
+		assert.Equal(t, domain.FeedbackVerdictAccepted, feedback.Verdict)
+		assert.True(t, feedback.AutoGraded)
+
+		assert.Equal(t, 0, answers[0].Question)
+		assert.False(t, answers[0].Correct)
+		assert.Equal(t, 0.0, answers[0].Score)
+		assert.True(t, answers[1].Correct)
+		assert.Equal(t, 2.0, answers[1].Score)
+		assert.True(t, answers[2].Correct)
+		assert.True(t, answers[3].Correct)
+	})
+
+	t.Run("partial multiple choice is wrong", func(t *testing.T) {
+		answers := []domain.QuizAnswer{{Question: 1, Options: []int{0}}}
+
+		feedback, err := gradeQuiz(quiz, answers)
+		require.NoError(t, err)
+		assert.Equal(t, 0.0, *feedback.Score)
+		assert.False(t, answers[0].Correct)
+	})
+
+	t.Run("invalid answers", func(t *testing.T) {
+		for _, answers := range [][]domain.QuizAnswer{
+			{{Question: 4}},
+			{{Question: 0, Options: []int{1}}, {Question: 0, Options: []int{0}}},
+			{{Question: 0, Options: []int{5}}},
+			{{Question: 2, Options: []int{0}}},
+		} {
+			_, err := gradeQuiz(quiz, answers)
+			assert.ErrorIs(t, err, ErrInvalidArgument)
+		}
+	})
+}
+
+func TestHideQuizAnswers(t *testing.T) {
+	answer := 1.0
+	tutorID, studentID := uuid.New(), uuid.New()
+	newAssignment := func() *domain.Assignment {
+		return &domain.Assignment{
+			TutorID:   tutorID,
+			StudentID: studentID,
+			Quiz: []domain.QuizQuestion{
+				{Type: domain.QuizQuestionNumeric, Text: "x", NumericAnswer: &answer, Tolerance: 0.5},
+				{Type: domain.QuizQuestionSingleChoice, Text: "y", Options: []string{"a", "b"}, CorrectOptions: []int{0}},
+			},
+		}
+	}
+
+	tutorView := newAssignment()
+	hideQuizAnswers(tutorID.String(), tutorView)
+	assert.Equal(t, newAssignment(), tutorView)
+
+	studentView := newAssignment()
+	hideQuizAnswers(studentID.String(), studentView)
+	assert.Nil(t, studentView.Quiz[0].NumericAnswer)
+	assert.Zero(t, studentView.Quiz[0].Tolerance)
+	assert.Nil(t, studentView.Quiz[1].CorrectOptions)
+	assert.Equal(t, []string{"a", "b"}, studentView.Quiz[1].Options)
+}
@@ -0,0 +1,120 @@
+package service
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"html"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+
+	"homework_service/internal/domain"
+	"homework_service/internal/repository"
+)
+
+const (
+	defaultSearchPageSize = 20
+	maxSearchPageSize     = 100
+	// maxSearchQueryLength limits the query in characters.
+	maxSearchQueryLength = 200
+)
+
+type SearchServiceInterface interface {
+	SearchHomework(ctx context.Context, filter domain.SearchFilter, pageSize int, pageToken string) (*domain.SearchPage, error)
+}
+
+type searchService struct {
+	searchRepo *repository.SearchRepository
+}
+
+func NewSearchService(searchRepo *repository.SearchRepository) SearchServiceInterface {
+	return &searchService{searchRepo: searchRepo}
+}
+
+// SearchHomework searches texts of the caller's assignments, their submissions and
+// feedbacks. Hits are ordered by relevance; snippets are HTML with the matched words
+// wrapped in <b>.
+func (s *searchService) SearchHomework(ctx context.Context, filter domain.SearchFilter, pageSize int, pageToken string) (*domain.SearchPage, error) {
+	userID, err := uuid.Parse(callerID(ctx))
+	if err != nil {
+		return nil, ErrPermissionDenied
+	}
+	filter.UserID = userID
+
+	filter.Query = strings.TrimSpace(filter.Query)
+	if filter.Query == "" {
+		return nil, fmt.Errorf("%w: query is required", ErrInvalidArgument)
+	}
+	if utf8.RuneCountInString(filter.Query) > maxSearchQueryLength {
+		return nil, fmt.Errorf("%w: query is longer than %d characters", ErrInvalidArgument, maxSearchQueryLength)
+	}
+	for _, t := range filter.Types {
+		if !t.IsValid() {
+			return nil, fmt.Errorf("%w: unknown result type %q", ErrInvalidArgument, t)
+		}
+	}
+	if filter.From != nil && filter.To != nil && !filter.From.Before(*filter.To) {
+		return nil, fmt.Errorf("%w: from must be before to", ErrInvalidArgument)
+	}
+
+	switch {
+	case pageSize < 0:
+		return nil, fmt.Errorf("%w: page size must not be negative", ErrInvalidArgument)
+	case pageSize == 0:
+		pageSize = defaultSearchPageSize
+	case pageSize > maxSearchPageSize:
+		pageSize = maxSearchPageSize
+	}
+	offset, err := decodePageToken(pageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	// One more hit than requested tells whether there is a next page.
+	filter.Limit = pageSize + 1
+	filter.Offset = offset
+	hits, err := s.searchRepo.Search(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &domain.SearchPage{Hits: hits}
+	if len(hits) > pageSize {
+		page.Hits = hits[:pageSize]
+		page.NextPageToken = encodePageToken(offset + pageSize)
+	}
+	for i := range page.Hits {
+		page.Hits[i].Snippet = renderSnippet(page.Hits[i].Snippet)
+	}
+	return page, nil
+}
+
+// renderSnippet escapes the snippet text and turns the highlight markers into tags.
+func renderSnippet(snippet string) string {
+	snippet = html.EscapeString(snippet)
+	snippet = strings.ReplaceAll(snippet, repository.HighlightStart, "<b>")
+	return strings.ReplaceAll(snippet, repository.HighlightStop, "</b>")
+}
+
+// Page tokens are opaque to clients; they carry the offset of the page.
+func encodePageToken(offset int) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.Itoa(offset)))
+}
+
+func decodePageToken(token string) (int, error) {
+	if token == "" {
+		return 0, nil
+	}
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return 0, fmt.Errorf("%w: invalid page token", ErrInvalidArgument)
+	}
+	offset, err := strconv.Atoi(string(raw))
+	if err != nil || offset < 0 {
+		return 0, fmt.Errorf("%w: invalid page token", ErrInvalidArgument)
+	}
+	return offset, nil
+}
@@ -0,0 +1,64 @@
+package service
+
+import (
+	"common_library/ctxdata"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"homework_service/internal/domain"
+)
+
+func TestRenderSnippet(t *testing.T) {
+	snippet := renderSnippet("a <script> \x02геометрия\x03 & \x02triangles\x03")
+
+	assert.Equal(t, "a &lt;script&gt; <b>геометрия</b> &amp; <b>triangles</b>", snippet)
+}
+
+func TestPageToken(t *testing.T) {
+	offset, err := decodePageToken("")
+	require.NoError(t, err)
+	assert.Zero(t, offset)
+
+	offset, err = decodePageToken(encodePageToken(40))
+	require.NoError(t, err)
+	assert.Equal(t, 40, offset)
+
+	for _, token := range []string{"!!", encodePageToken(-1), "YWJj"} {
+		_, err := decodePageToken(token)
+		assert.ErrorIs(t, err, ErrInvalidArgument, token)
+	}
+}
+
+func TestSearchHomeworkValidation(t *testing.T) {
+	s := NewSearchService(nil)
+	ctx := ctxdata.WithUserID(context.Background(), uuid.New().String())
+	from := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 1, 0)
+
+	tests := []struct {
+		name     string
+		filter   domain.SearchFilter
+		pageSize int
+	}{
+		{name: "empty query", filter: domain.SearchFilter{Query: "  "}},
+		{name: "long query", filter: domain.SearchFilter{Query: string(make([]rune, maxSearchQueryLength+1))}},
+		{name: "unknown type", filter: domain.SearchFilter{Query: "geometry", Types: []domain.SearchHitType{"comment"}}},
+		{name: "reversed period", filter: domain.SearchFilter{Query: "geometry", From: &to, To: &from}},
+		{name: "negative page size", filter: domain.SearchFilter{Query: "geometry"}, pageSize: -1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := s.SearchHomework(ctx, tt.filter, tt.pageSize, "")
+			assert.ErrorIs(t, err, ErrInvalidArgument)
+		})
+	}
+
+	_, err := s.SearchHomework(context.Background(), domain.SearchFilter{Query: "geometry"}, 0, "")
+	assert.ErrorIs(t, err, ErrPermissionDenied)
+}
@@ -0,0 +1,78 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+
+	"homework_service/internal/domain"
+)
+
+// GetHomeworkStats returns completion and on-time rates of the tutor's students and
+// the tutor's review turnaround over assignments created within [from, to), plus the
+// current review backlog. Only the tutor may see them.
+func (s *feedbackService) GetHomeworkStats(ctx context.Context, tutorID uuid.UUID, from, to *time.Time) (*domain.HomeworkStats, error) {
+	if callerID(ctx) != tutorID.String() {
+		return nil, ErrPermissionDenied
+	}
+	if from != nil && to != nil && !from.Before(*to) {
+		return nil, fmt.Errorf("%w: from must be before to", ErrInvalidArgument)
+	}
+
+	students, err := s.feedbackRepo.ListStudentStats(ctx, tutorID, from, to)
+	if err != nil {
+		return nil, err
+	}
+	turnaround, reviewed, err := s.feedbackRepo.GetReviewTurnaround(ctx, tutorID, from, to)
+	if err != nil {
+		return nil, err
+	}
+	unreviewed, err := s.feedbackRepo.CountUnreviewed(ctx, tutorID)
+	if err != nil {
+		return nil, err
+	}
+
+	stats := buildHomeworkStats(students, unreviewed)
+	stats.TutorID = tutorID
+	stats.From = from
+	stats.To = to
+	stats.MedianReviewTurnaround = turnaround
+	stats.ReviewedCount = reviewed
+	return stats, nil
+}
+
+// buildHomeworkStats adds the unreviewed counts to the students, including students
+// without assignments in the period, and orders them by completion rate, lowest
+// first, and students without assignments last.
+func buildHomeworkStats(students []domain.StudentHomeworkStats, unreviewed map[uuid.UUID]int) *domain.HomeworkStats {
+	stats := &domain.HomeworkStats{}
+
+	seen := make(map[uuid.UUID]bool, len(students))
+	for _, st := range students {
+		st.Unreviewed = unreviewed[st.StudentID]
+		seen[st.StudentID] = true
+		stats.Students = append(stats.Students, st)
+	}
+	for studentID, count := range unreviewed {
+		if !seen[studentID] {
+			stats.Students = append(stats.Students, domain.StudentHomeworkStats{StudentID: studentID, Unreviewed: count})
+		}
+		stats.UnreviewedCount += count
+	}
+
+	sort.Slice(stats.Students, func(i, j int) bool {
+		a, b := stats.Students[i].CompletionRate(), stats.Students[j].CompletionRate()
+		switch {
+		case a != nil && b != nil && *a != *b:
+			return *a < *b
+		case (a == nil) != (b == nil):
+			return a != nil
+		default:
+			return stats.Students[i].StudentID.String() < stats.Students[j].StudentID.String()
+		}
+	})
+	return stats
+}
@@ -0,0 +1,55 @@
+package service
+
+import (
+	"common_library/ctxdata"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"homework_service/internal/domain"
+)
+
+func TestBuildHomeworkStats(t *testing.T) {
+	slipping := uuid.New()
+	steady := uuid.New()
+	idle := uuid.New()
+
+	stats := buildHomeworkStats([]domain.StudentHomeworkStats{
+		{StudentID: steady, Assigned: 4, Submitted: 4, Due: 4, OnTime: 3},
+		{StudentID: slipping, Assigned: 4, Submitted: 1, Due: 3, OnTime: 0},
+	}, map[uuid.UUID]int{steady: 2, idle: 1})
+
+	require.Len(t, stats.Students, 3)
+	assert.Equal(t, slipping, stats.Students[0].StudentID)
+	assert.InDelta(t, 0.25, *stats.Students[0].CompletionRate(), 1e-9)
+	assert.InDelta(t, 0.0, *stats.Students[0].OnTimeRate(), 1e-9)
+	assert.Zero(t, stats.Students[0].Unreviewed)
+
+	assert.Equal(t, steady, stats.Students[1].StudentID)
+	assert.InDelta(t, 1.0, *stats.Students[1].CompletionRate(), 1e-9)
+	assert.InDelta(t, 0.75, *stats.Students[1].OnTimeRate(), 1e-9)
+	assert.Equal(t, 2, stats.Students[1].Unreviewed)
+
+	assert.Equal(t, idle, stats.Students[2].StudentID)
+	assert.Nil(t, stats.Students[2].CompletionRate())
+	assert.Nil(t, stats.Students[2].OnTimeRate())
+	assert.Equal(t, 1, stats.Students[2].Unreviewed)
+
+	assert.Equal(t, 3, stats.UnreviewedCount)
+}
+
+func TestGetHomeworkStatsValidation(t *testing.T) {
+	s := &feedbackService{}
+	tutorID := uuid.New()
+
+	_, err := s.GetHomeworkStats(ctxdata.WithUserID(context.Background(), uuid.NewString()), tutorID, nil, nil)
+	assert.ErrorIs(t, err, ErrPermissionDenied)
+
+	now := time.Now()
+	_, err = s.GetHomeworkStats(ctxdata.WithUserID(context.Background(), tutorID.String()), tutorID, &now, &now)
+	assert.ErrorIs(t, err, ErrInvalidArgument)
+}
@@ -3,7 +3,10 @@ package service
 import (
 	"common_library/ctxdata"
 	"context"
+	"errors"
+	"fmt"
 	"github.com/google/uuid"
+	"time"
 
 	"homework_service/internal/domain"
 	"homework_service/internal/repository"
@@ -14,6 +17,7 @@ type SubmissionServiceInterface interface {
 	GetSubmission(ctx context.Context, id uuid.UUID) (*domain.Submission, error)
 	ListSubmissionsByAssignment(ctx context.Context, assignmentID uuid.UUID) ([]*domain.Submission, error)
 	GetSubmissionFileURL(ctx context.Context, id uuid.UUID) (string, error)
+	ListAttachmentFileURLs(ctx context.Context, id uuid.UUID) ([]domain.AttachmentFileURL, error)
 }
 
 type submissionService struct {
@@ -44,14 +48,62 @@ func (s *submissionService) CreateSubmission(ctx context.Context, submission *do
 	if !ok || userId != assignment.StudentID.String() {
 		return nil, ErrPermissionDenied
 	}
+	if !assignment.IsPublished() {
+		return nil, repository.ErrNotFound
+	}
+
+	submission.IsLate, err = checkDeadline(assignment, time.Now())
+	if err != nil {
+		return nil, err
+	}
 
-	if err := s.submissionRepo.Create(ctx, submission); err != nil {
+	submission.FileID, submission.Attachments, err = syncAttachments(submission.FileID, submission.Attachments)
+	if err != nil {
+		return nil, err
+	}
+
+	if !assignment.IsQuiz() {
+		if len(submission.Answers) > 0 {
+			return nil, fmt.Errorf("%w: answers are only accepted for quizzes", ErrInvalidArgument)
+		}
+		if err := s.submissionRepo.Create(ctx, submission); err != nil {
+			return nil, err
+		}
+		return submission, nil
+	}
+
+	if err := s.checkQuizOpen(ctx, assignment.ID); err != nil {
+		return nil, err
+	}
+	feedback, err := gradeQuiz(assignment.Quiz, submission.Answers)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.submissionRepo.CreateGraded(ctx, submission, feedback); err != nil {
+		if errors.Is(err, repository.ErrQuizSubmitted) {
+			return nil, fmt.Errorf("%w: quiz is already submitted", ErrFailedPrecondition)
+		}
 		return nil, err
 	}
 
 	return submission, nil
 }
 
+// checkQuizOpen rejects a quiz that no longer accepts answers before it is graded.
+// CreateGraded checks the status again under the assignment lock.
+func (s *submissionService) checkQuizOpen(ctx context.Context, assignmentID uuid.UUID) error {
+	status, err := s.assignmentRepo.GetStatus(ctx, assignmentID)
+	if err != nil {
+		return err
+	}
+
+	if !status.AcceptsQuizAnswers() {
+		return fmt.Errorf("%w: quiz is already submitted", ErrFailedPrecondition)
+	}
+	return nil
+}
+
 func (s *submissionService) GetSubmission(ctx context.Context, id uuid.UUID) (*domain.Submission, error) {
 	submission, err := s.submissionRepo.GetByID(ctx, id)
 	if err != nil {
@@ -111,3 +163,12 @@ func (s *submissionService) GetSubmissionFileURL(ctx context.Context, id uuid.UU
 	}
 	return url, nil
 }
+
+func (s *submissionService) ListAttachmentFileURLs(ctx context.Context, id uuid.UUID) ([]domain.AttachmentFileURL, error) {
+	submission, err := s.GetSubmission(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return resolveAttachmentURLs(ctx, s.fileClient, submission.Attachments)
+}
@@ -0,0 +1,253 @@
+package service
+
+import (
+	"common_library/ctxdata"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"homework_service/internal/domain"
+	"homework_service/internal/repository"
+)
+
+// maxBulkStudents limits the number of assignments created by one AssignFromTemplate call.
+const maxBulkStudents = 100
+
+type TemplateServiceInterface interface {
+	CreateTemplate(ctx context.Context, template *domain.AssignmentTemplate) (*domain.AssignmentTemplate, error)
+	GetTemplate(ctx context.Context, id uuid.UUID) (*domain.AssignmentTemplate, error)
+	UpdateTemplate(ctx context.Context, template *domain.AssignmentTemplate) error
+	DeleteTemplate(ctx context.Context, id uuid.UUID) error
+	ListTemplates(ctx context.Context, tutorID uuid.UUID) ([]*domain.AssignmentTemplate, error)
+	AssignFromTemplate(ctx context.Context, templateID uuid.UUID, studentIDs []uuid.UUID, dueDate *time.Time) ([]*domain.Assignment, error)
+	SetDefaultTemplate(ctx context.Context, tutorID, studentID uuid.UUID, templateID *uuid.UUID) error
+	GetDefaultTemplate(ctx context.Context, tutorID, studentID uuid.UUID) (*domain.AssignmentTemplate, error)
+}
+
+type templateService struct {
+	templateRepo   *repository.TemplateRepository
+	assignmentRepo *repository.AssignmentRepository
+	userClient     UserClient
+}
+
+func NewTemplateService(
+	templateRepo *repository.TemplateRepository,
+	assignmentRepo *repository.AssignmentRepository,
+	userClient UserClient,
+) TemplateServiceInterface {
+	return &templateService{
+		templateRepo:   templateRepo,
+		assignmentRepo: assignmentRepo,
+		userClient:     userClient,
+	}
+}
+
+func (s *templateService) CreateTemplate(ctx context.Context, template *domain.AssignmentTemplate) (*domain.AssignmentTemplate, error) {
+	userID, ok := ctxdata.GetUserID(ctx)
+	if !ok || template.TutorID.String() != userID {
+		return nil, ErrPermissionDenied
+	}
+	userRole, ok := ctxdata.GetUserRole(ctx)
+	if !ok || userRole != "tutor" {
+		return nil, ErrPermissionDenied
+	}
+
+	if err := validateTemplate(template); err != nil {
+		return nil, err
+	}
+
+	if err := s.templateRepo.Create(ctx, template); err != nil {
+		return nil, err
+	}
+
+	return template, nil
+}
+
+func (s *templateService) GetTemplate(ctx context.Context, id uuid.UUID) (*domain.AssignmentTemplate, error) {
+	template, err := s.templateRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	userID, ok := ctxdata.GetUserID(ctx)
+	if !ok || template.TutorID.String() != userID {
+		return nil, ErrPermissionDenied
+	}
+
+	return template, nil
+}
+
+func (s *templateService) UpdateTemplate(ctx context.Context, template *domain.AssignmentTemplate) error {
+	existing, err := s.GetTemplate(ctx, template.ID)
+	if err != nil {
+		return err
+	}
+	template.TutorID = existing.TutorID
+
+	if err := validateTemplate(template); err != nil {
+		return err
+	}
+
+	return s.templateRepo.Update(ctx, template)
+}
+
+func (s *templateService) DeleteTemplate(ctx context.Context, id uuid.UUID) error {
+	if _, err := s.GetTemplate(ctx, id); err != nil {
+		return err
+	}
+
+	return s.templateRepo.Delete(ctx, id)
+}
+
+func (s *templateService) ListTemplates(ctx context.Context, tutorID uuid.UUID) ([]*domain.AssignmentTemplate, error) {
+	userID, ok := ctxdata.GetUserID(ctx)
+	if !ok || tutorID.String() != userID {
+		return nil, ErrPermissionDenied
+	}
+
+	return s.templateRepo.ListByTutor(ctx, tutorID)
+}
+
+// AssignFromTemplate creates an assignment for every student in one transaction.
+// Without an explicit due date the template's due offset from now is used.
+func (s *templateService) AssignFromTemplate(ctx context.Context, templateID uuid.UUID, studentIDs []uuid.UUID, dueDate *time.Time) ([]*domain.Assignment, error) {
+	template, err := s.GetTemplate(ctx, templateID)
+	if err != nil {
+		return nil, err
+	}
+
+	studentIDs = uniqueIDs(studentIDs)
+	if len(studentIDs) == 0 {
+		return nil, fmt.Errorf("%w: at least one student is required", ErrInvalidArgument)
+	}
+	if len(studentIDs) > maxBulkStudents {
+		return nil, fmt.Errorf("%w: at most %d students are allowed", ErrInvalidArgument, maxBulkStudents)
+	}
+
+	for _, studentID := range studentIDs {
+		isPair, err := s.userClient.IsPair(ctx, template.TutorID, studentID)
+		if err != nil {
+			return nil, err
+		}
+		if !isPair {
+			return nil, fmt.Errorf("%w: student %s is not paired with the tutor", ErrPermissionDenied, studentID)
+		}
+	}
+
+	now := time.Now()
+	if dueDate == nil && template.DueOffset != nil {
+		due := now.Add(*template.DueOffset)
+		dueDate = &due
+	}
+
+	fileID, attachments, err := syncAttachments(nil, template.Attachments)
+	if err != nil {
+		return nil, err
+	}
+
+	assignments := make([]*domain.Assignment, 0, len(studentIDs))
+	for _, studentID := range studentIDs {
+		assignments = append(assignments, &domain.Assignment{
+			TutorID:     template.TutorID,
+			StudentID:   studentID,
+			Title:       template.Title,
+			Description: template.Description,
+			FileID:      fileID,
+			Attachments: copyAttachments(attachments),
+			DueDate:     dueDate,
+			LatePolicy:  domain.LatePolicyFlag,
+			State:       domain.AssignmentStatePublished,
+			PublishedAt: &now,
+			CreatedAt:   now,
+			EditedAt:    now,
+		})
+	}
+
+	if err := s.assignmentRepo.CreateBatch(ctx, assignments); err != nil {
+		return nil, err
+	}
+
+	return assignments, nil
+}
+
+// SetDefaultTemplate sets the template assigned to the student after each completed
+// lesson with the tutor. A nil template turns the follow-ups off.
+func (s *templateService) SetDefaultTemplate(ctx context.Context, tutorID, studentID uuid.UUID, templateID *uuid.UUID) error {
+	if err := s.checkPairTutor(ctx, tutorID, studentID); err != nil {
+		return err
+	}
+
+	if templateID != nil {
+		// GetTemplate also checks that the template belongs to the caller.
+		if _, err := s.GetTemplate(ctx, *templateID); err != nil {
+			return err
+		}
+	}
+
+	return s.templateRepo.SetPairDefault(ctx, tutorID, studentID, templateID)
+}
+
+func (s *templateService) GetDefaultTemplate(ctx context.Context, tutorID, studentID uuid.UUID) (*domain.AssignmentTemplate, error) {
+	if err := s.checkPairTutor(ctx, tutorID, studentID); err != nil {
+		return nil, err
+	}
+
+	return s.templateRepo.GetPairDefault(ctx, tutorID, studentID)
+}
+
+func (s *templateService) checkPairTutor(ctx context.Context, tutorID, studentID uuid.UUID) error {
+	userID, ok := ctxdata.GetUserID(ctx)
+	if !ok || tutorID.String() != userID {
+		return ErrPermissionDenied
+	}
+	userRole, ok := ctxdata.GetUserRole(ctx)
+	if !ok || userRole != "tutor" {
+		return ErrPermissionDenied
+	}
+
+	isPair, err := s.userClient.IsPair(ctx, tutorID, studentID)
+	if err != nil {
+		return err
+	}
+	if !isPair {
+		return fmt.Errorf("%w: student %s is not paired with the tutor", ErrPermissionDenied, studentID)
+	}
+	return nil
+}
+
+func validateTemplate(template *domain.AssignmentTemplate) error {
+	if template.DueOffset != nil && *template.DueOffset <= 0 {
+		return fmt.Errorf("%w: due offset must be positive", ErrInvalidArgument)
+	}
+
+	_, attachments, err := syncAttachments(nil, template.Attachments)
+	if err != nil {
+		return err
+	}
+	template.Attachments = attachments
+	return nil
+}
+
+// copyAttachments returns a copy without IDs, so that each owner gets its own rows.
+func copyAttachments(attachments []domain.Attachment) []domain.Attachment {
+	copied := make([]domain.Attachment, len(attachments))
+	for i, a := range attachments {
+		copied[i] = domain.Attachment{FileID: a.FileID, Caption: a.Caption}
+	}
+	return copied
+}
+
+func uniqueIDs(ids []uuid.UUID) []uuid.UUID {
+	seen := make(map[uuid.UUID]struct{}, len(ids))
+	unique := make([]uuid.UUID, 0, len(ids))
+	for _, id := range ids {
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		unique = append(unique, id)
+	}
+	return unique
+}
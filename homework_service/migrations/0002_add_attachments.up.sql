@@ -0,0 +1,25 @@
+CREATE TABLE attachments (
+    id UUID PRIMARY KEY,
+    assignment_id UUID REFERENCES assignments(id) ON DELETE CASCADE,
+    submission_id UUID REFERENCES submissions(id) ON DELETE CASCADE,
+    feedback_id UUID REFERENCES feedbacks(id) ON DELETE CASCADE,
+    file_id UUID NOT NULL,
+    caption TEXT,
+    position INT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    CHECK (num_nonnulls(assignment_id, submission_id, feedback_id) = 1),
+    CHECK (position >= 0)
+);
+
+CREATE UNIQUE INDEX idx_attachments_assignment_position ON attachments(assignment_id, position) WHERE assignment_id IS NOT NULL;
+CREATE UNIQUE INDEX idx_attachments_submission_position ON attachments(submission_id, position) WHERE submission_id IS NOT NULL;
+CREATE UNIQUE INDEX idx_attachments_feedback_position ON attachments(feedback_id, position) WHERE feedback_id IS NOT NULL;
+
+INSERT INTO attachments (id, assignment_id, file_id, position, created_at)
+SELECT gen_random_uuid(), id, file_id, 0, created_at FROM assignments WHERE file_id IS NOT NULL;
+
+INSERT INTO attachments (id, submission_id, file_id, position, created_at)
+SELECT gen_random_uuid(), id, file_id, 0, created_at FROM submissions WHERE file_id IS NOT NULL;
+
+INSERT INTO attachments (id, feedback_id, file_id, position, created_at)
+SELECT gen_random_uuid(), id, file_id, 0, created_at FROM feedbacks WHERE file_id IS NOT NULL;
@@ -0,0 +1,15 @@
+ALTER TABLE feedbacks
+    ADD COLUMN score DOUBLE PRECISION CHECK (score >= 0),
+    ADD COLUMN max_score DOUBLE PRECISION CHECK (max_score > 0),
+    ADD CONSTRAINT feedbacks_score_not_above_max CHECK (score <= max_score);
+
+CREATE TABLE feedback_rubric_criteria (
+    feedback_id UUID NOT NULL REFERENCES feedbacks(id) ON DELETE CASCADE,
+    position INT NOT NULL CHECK (position >= 0),
+    name TEXT NOT NULL,
+    score DOUBLE PRECISION NOT NULL CHECK (score >= 0),
+    max_score DOUBLE PRECISION NOT NULL CHECK (max_score > 0),
+    comment TEXT,
+    PRIMARY KEY (feedback_id, position),
+    CHECK (score <= max_score)
+);
@@ -0,0 +1,16 @@
+ALTER TABLE feedbacks
+    ADD COLUMN verdict TEXT NOT NULL DEFAULT 'accepted' CHECK (verdict IN ('accepted', 'needs_revision'));
+
+ALTER TABLE submissions ADD COLUMN version INT;
+
+UPDATE submissions s
+SET version = v.version
+FROM (
+    SELECT id, ROW_NUMBER() OVER (PARTITION BY assignment_id ORDER BY created_at, id) AS version
+    FROM submissions
+) v
+WHERE v.id = s.id;
+
+ALTER TABLE submissions
+    ALTER COLUMN version SET NOT NULL,
+    ADD CONSTRAINT submissions_assignment_version_key UNIQUE (assignment_id, version);
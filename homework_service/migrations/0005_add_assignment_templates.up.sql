@@ -0,0 +1,19 @@
+CREATE TABLE assignment_templates (
+    id UUID PRIMARY KEY,
+    tutor_id UUID NOT NULL,
+    title TEXT,
+    description TEXT,
+    due_offset_seconds BIGINT CHECK (due_offset_seconds > 0),
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    edited_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_assignment_templates_tutor_id ON assignment_templates(tutor_id);
+
+ALTER TABLE attachments
+    ADD COLUMN template_id UUID REFERENCES assignment_templates(id) ON DELETE CASCADE,
+    DROP CONSTRAINT attachments_check,
+    ADD CONSTRAINT attachments_single_owner_check
+        CHECK (num_nonnulls(assignment_id, submission_id, feedback_id, template_id) = 1);
+
+CREATE UNIQUE INDEX idx_attachments_template_position ON attachments(template_id, position) WHERE template_id IS NOT NULL;
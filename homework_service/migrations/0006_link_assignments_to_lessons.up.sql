@@ -0,0 +1,7 @@
+ALTER TABLE assignments
+    ADD COLUMN lesson_id UUID,
+    ADD COLUMN due_before_next_lesson BOOLEAN NOT NULL DEFAULT FALSE,
+    ADD COLUMN due_lesson_id UUID;
+
+CREATE INDEX idx_assignments_lesson_id ON assignments(lesson_id) WHERE lesson_id IS NOT NULL;
+CREATE INDEX idx_assignments_due_before_next_lesson ON assignments(due_date) WHERE due_before_next_lesson;
@@ -0,0 +1,22 @@
+CREATE TABLE comments (
+    id UUID PRIMARY KEY,
+    assignment_id UUID NOT NULL REFERENCES assignments(id) ON DELETE CASCADE,
+    submission_id UUID REFERENCES submissions(id) ON DELETE CASCADE,
+    parent_id UUID REFERENCES comments(id) ON DELETE CASCADE,
+    author_id UUID NOT NULL,
+    body TEXT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    edited_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    deleted_at TIMESTAMP
+);
+
+CREATE INDEX idx_comments_assignment_id ON comments(assignment_id, created_at);
+CREATE INDEX idx_comments_submission_id ON comments(submission_id) WHERE submission_id IS NOT NULL;
+
+ALTER TABLE attachments
+    ADD COLUMN comment_id UUID REFERENCES comments(id) ON DELETE CASCADE,
+    DROP CONSTRAINT attachments_single_owner_check,
+    ADD CONSTRAINT attachments_single_owner_check
+        CHECK (num_nonnulls(assignment_id, submission_id, feedback_id, template_id, comment_id) = 1);
+
+CREATE UNIQUE INDEX idx_attachments_comment_position ON attachments(comment_id, position) WHERE comment_id IS NOT NULL;
@@ -0,0 +1,24 @@
+CREATE TABLE quiz_questions (
+    assignment_id UUID NOT NULL REFERENCES assignments(id) ON DELETE CASCADE,
+    position INT NOT NULL CHECK (position >= 0),
+    type TEXT NOT NULL CHECK (type IN ('single_choice', 'multiple_choice', 'numeric', 'short_text')),
+    text TEXT NOT NULL,
+    options TEXT[] NOT NULL DEFAULT '{}',
+    points DOUBLE PRECISION NOT NULL CHECK (points > 0),
+    correct_options INT[] NOT NULL DEFAULT '{}',
+    numeric_answer DOUBLE PRECISION,
+    tolerance DOUBLE PRECISION NOT NULL DEFAULT 0 CHECK (tolerance >= 0),
+    text_answers TEXT[] NOT NULL DEFAULT '{}',
+    PRIMARY KEY (assignment_id, position)
+);
+
+CREATE TABLE quiz_answers (
+    submission_id UUID NOT NULL REFERENCES submissions(id) ON DELETE CASCADE,
+    question INT NOT NULL CHECK (question >= 0),
+    options INT[] NOT NULL DEFAULT '{}',
+    number DOUBLE PRECISION,
+    text TEXT,
+    correct BOOLEAN NOT NULL,
+    score DOUBLE PRECISION NOT NULL CHECK (score >= 0),
+    PRIMARY KEY (submission_id, question)
+);
@@ -0,0 +1,32 @@
+ALTER TABLE assignments
+    ADD COLUMN status TEXT NOT NULL DEFAULT 'UNSENT'
+        CHECK (status IN ('UNSENT', 'OVERDUE', 'UNREVIEWED', 'NEEDS_REVISION', 'REVIEWED'));
+
+WITH latest_submissions AS (
+    SELECT DISTINCT ON (assignment_id) id, assignment_id
+    FROM submissions
+    ORDER BY assignment_id, version DESC
+),
+latest_feedbacks AS (
+    SELECT DISTINCT ON (submission_id) submission_id, verdict
+    FROM feedbacks
+    ORDER BY submission_id, created_at DESC
+)
+UPDATE assignments a
+SET status = CASE
+    WHEN ls.id IS NULL AND a.due_date <= NOW() THEN 'OVERDUE'
+    WHEN ls.id IS NULL THEN 'UNSENT'
+    WHEN lf.verdict IS NULL THEN 'UNREVIEWED'
+    WHEN lf.verdict = 'needs_revision' THEN 'NEEDS_REVISION'
+    ELSE 'REVIEWED'
+END
+FROM assignments src
+LEFT JOIN latest_submissions ls ON ls.assignment_id = src.id
+LEFT JOIN latest_feedbacks lf ON lf.submission_id = ls.id
+WHERE src.id = a.id;
+
+DROP INDEX idx_assignments_tutor_id;
+DROP INDEX idx_assignments_student_id;
+CREATE INDEX idx_assignments_tutor_id_status ON assignments(tutor_id, status);
+CREATE INDEX idx_assignments_student_id_status ON assignments(student_id, status);
+CREATE INDEX idx_assignments_unsent_due_date ON assignments(due_date) WHERE status = 'UNSENT';
@@ -0,0 +1,14 @@
+ALTER TABLE assignments ADD COLUMN overdue_notified_at TIMESTAMP;
+
+-- Assignments that became overdue before the notifications existed are not announced.
+UPDATE assignments SET overdue_notified_at = NOW() WHERE status = 'OVERDUE';
+
+CREATE INDEX idx_assignments_overdue_unnotified ON assignments(id)
+    WHERE status = 'OVERDUE' AND overdue_notified_at IS NULL;
+
+CREATE TABLE overdue_digests (
+    tutor_id UUID NOT NULL,
+    digest_date DATE NOT NULL,
+    sent_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (tutor_id, digest_date)
+);
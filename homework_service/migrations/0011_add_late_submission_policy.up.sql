@@ -0,0 +1,23 @@
+ALTER TABLE assignments
+    ADD COLUMN late_policy TEXT NOT NULL DEFAULT 'flag'
+        CHECK (late_policy IN ('allow', 'flag', 'lock')),
+    ADD COLUMN grace_period_seconds BIGINT CHECK (grace_period_seconds > 0),
+    ADD COLUMN submitted_late BOOLEAN NOT NULL DEFAULT FALSE;
+
+ALTER TABLE submissions
+    ADD COLUMN is_late BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- Existing assignments get the flag policy, so their late submissions are flagged.
+UPDATE submissions s
+SET is_late = TRUE
+FROM assignments a
+WHERE a.id = s.assignment_id AND s.created_at > a.due_date;
+
+UPDATE assignments a
+SET submitted_late = TRUE
+FROM (
+    SELECT DISTINCT ON (assignment_id) assignment_id, is_late
+    FROM submissions
+    ORDER BY assignment_id, version DESC
+) src
+WHERE src.assignment_id = a.id AND src.is_late;
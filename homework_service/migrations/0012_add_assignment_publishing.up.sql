@@ -0,0 +1,16 @@
+ALTER TABLE assignments
+    ADD COLUMN state TEXT NOT NULL DEFAULT 'published'
+        CHECK (state IN ('draft', 'scheduled', 'published')),
+    ADD COLUMN publish_at TIMESTAMP,
+    ADD COLUMN published_at TIMESTAMP,
+    ADD COLUMN published_notified_at TIMESTAMP,
+    ADD CONSTRAINT assignments_publish_at_check CHECK (state <> 'scheduled' OR publish_at IS NOT NULL);
+
+-- Existing assignments were visible from the start and are not announced.
+UPDATE assignments SET published_at = created_at, published_notified_at = NOW();
+
+CREATE INDEX idx_assignments_scheduled_publish_at ON assignments(publish_at)
+    WHERE state = 'scheduled';
+
+CREATE INDEX idx_assignments_published_unnotified ON assignments(id)
+    WHERE state = 'published' AND published_notified_at IS NULL;
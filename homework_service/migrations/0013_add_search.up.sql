@@ -0,0 +1,25 @@
+-- Texts are indexed with both configurations, so that a query matches Russian and
+-- English word forms alike. Titles weigh more than descriptions.
+ALTER TABLE assignments
+    ADD COLUMN search_vector tsvector GENERATED ALWAYS AS (
+        setweight(to_tsvector('russian', coalesce(title, '')), 'A') ||
+        setweight(to_tsvector('english', coalesce(title, '')), 'A') ||
+        setweight(to_tsvector('russian', coalesce(description, '')), 'B') ||
+        setweight(to_tsvector('english', coalesce(description, '')), 'B')
+    ) STORED;
+
+ALTER TABLE submissions
+    ADD COLUMN search_vector tsvector GENERATED ALWAYS AS (
+        to_tsvector('russian', coalesce(comment, '')) ||
+        to_tsvector('english', coalesce(comment, ''))
+    ) STORED;
+
+ALTER TABLE feedbacks
+    ADD COLUMN search_vector tsvector GENERATED ALWAYS AS (
+        to_tsvector('russian', coalesce(comment, '')) ||
+        to_tsvector('english', coalesce(comment, ''))
+    ) STORED;
+
+CREATE INDEX idx_assignments_search_vector ON assignments USING GIN (search_vector);
+CREATE INDEX idx_submissions_search_vector ON submissions USING GIN (search_vector);
+CREATE INDEX idx_feedbacks_search_vector ON feedbacks USING GIN (search_vector);
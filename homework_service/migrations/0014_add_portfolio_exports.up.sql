@@ -0,0 +1,22 @@
+CREATE TABLE portfolio_exports (
+    id UUID PRIMARY KEY,
+    requested_by UUID NOT NULL,
+    tutor_id UUID NOT NULL,
+    student_id UUID NOT NULL,
+    range_from TIMESTAMP,
+    range_to TIMESTAMP,
+    status TEXT NOT NULL DEFAULT 'pending'
+        CHECK (status IN ('pending', 'running', 'done', 'failed')),
+    file_id UUID,
+    error TEXT,
+    created_at TIMESTAMP NOT NULL,
+    started_at TIMESTAMP,
+    finished_at TIMESTAMP,
+    CONSTRAINT portfolio_exports_range_check CHECK (range_from IS NULL OR range_to IS NULL OR range_from < range_to),
+    CONSTRAINT portfolio_exports_file_check CHECK (status <> 'done' OR file_id IS NOT NULL)
+);
+
+CREATE INDEX idx_portfolio_exports_queue ON portfolio_exports(created_at)
+    WHERE status IN ('pending', 'running');
+
+CREATE INDEX idx_portfolio_exports_requested_by ON portfolio_exports(requested_by, created_at);
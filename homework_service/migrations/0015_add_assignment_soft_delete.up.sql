@@ -0,0 +1,4 @@
+ALTER TABLE assignments ADD COLUMN deleted_at TIMESTAMP;
+
+CREATE INDEX idx_assignments_deleted_at ON assignments(deleted_at)
+    WHERE deleted_at IS NOT NULL;
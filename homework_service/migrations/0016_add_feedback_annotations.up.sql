@@ -0,0 +1,15 @@
+CREATE TABLE feedback_annotations (
+    feedback_id UUID NOT NULL REFERENCES feedbacks(id) ON DELETE CASCADE,
+    position INT NOT NULL CHECK (position >= 0),
+    file_id UUID NOT NULL,
+    page INT NOT NULL CHECK (page >= 1),
+    type TEXT NOT NULL CHECK (type IN ('highlight', 'pen', 'text')),
+    x DOUBLE PRECISION NOT NULL DEFAULT 0,
+    y DOUBLE PRECISION NOT NULL DEFAULT 0,
+    width DOUBLE PRECISION NOT NULL DEFAULT 0,
+    height DOUBLE PRECISION NOT NULL DEFAULT 0,
+    -- Pen strokes as x1, y1, x2, y2, ...
+    points DOUBLE PRECISION[] NOT NULL DEFAULT '{}',
+    comment TEXT,
+    PRIMARY KEY (feedback_id, position)
+);
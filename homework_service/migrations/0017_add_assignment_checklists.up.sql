@@ -0,0 +1,14 @@
+CREATE TABLE checklist_items (
+    assignment_id UUID NOT NULL REFERENCES assignments(id) ON DELETE CASCADE,
+    position INT NOT NULL CHECK (position >= 0),
+    text TEXT NOT NULL,
+    done BOOLEAN NOT NULL DEFAULT FALSE,
+    done_at TIMESTAMP,
+    review TEXT CHECK (review IN ('correct', 'incorrect')),
+    reviewed_at TIMESTAMP,
+    PRIMARY KEY (assignment_id, position)
+);
+
+-- Percentage of done checklist items, NULL for assignments without a checklist.
+ALTER TABLE assignments
+    ADD COLUMN checklist_progress INT CHECK (checklist_progress BETWEEN 0 AND 100);
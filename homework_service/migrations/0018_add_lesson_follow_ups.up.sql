@@ -0,0 +1,17 @@
+-- Template a tutor wants assigned to a student after each completed lesson.
+CREATE TABLE pair_default_templates (
+    tutor_id UUID NOT NULL,
+    student_id UUID NOT NULL,
+    template_id UUID NOT NULL REFERENCES assignment_templates(id) ON DELETE CASCADE,
+    PRIMARY KEY (tutor_id, student_id)
+);
+
+CREATE INDEX idx_pair_default_templates_template_id ON pair_default_templates(template_id);
+
+-- Completed lessons that have already been processed, so that a redelivered
+-- event does not create a second follow-up assignment.
+CREATE TABLE lesson_follow_ups (
+    lesson_id UUID PRIMARY KEY,
+    assignment_id UUID REFERENCES assignments(id) ON DELETE SET NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
@@ -0,0 +1,13 @@
+-- Feedback written by quiz auto-grading on submission, as opposed to a tutor review.
+ALTER TABLE feedbacks ADD COLUMN auto_graded BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- A quiz submission is graded in the transaction that creates it, so its first
+-- feedback is always the auto-graded one.
+UPDATE feedbacks f
+SET auto_graded = TRUE
+FROM submissions s
+WHERE f.submission_id = s.id
+    AND EXISTS (SELECT 1 FROM quiz_questions q WHERE q.assignment_id = s.assignment_id)
+    AND f.id = (
+        SELECT id FROM feedbacks WHERE submission_id = s.id ORDER BY created_at, id LIMIT 1
+    );
@@ -30,6 +30,8 @@ const (
 	AssignmentStatusFilter_UNREVIEWED                    AssignmentStatusFilter = 2
 	AssignmentStatusFilter_REVIEWED                      AssignmentStatusFilter = 3
 	AssignmentStatusFilter_OVERDUE                       AssignmentStatusFilter = 4
+	// The latest submission got a needs_revision verdict.
+	AssignmentStatusFilter_NEEDS_REVISION AssignmentStatusFilter = 5
 )
 
 // Enum value maps for AssignmentStatusFilter.
@@ -40,6 +42,7 @@ var (
 		2: "UNREVIEWED",
 		3: "REVIEWED",
 		4: "OVERDUE",
+		5: "NEEDS_REVISION",
 	}
 	AssignmentStatusFilter_value = map[string]int32{
 		"ASSIGNMENT_STATUS_UNSPECIFIED": 0,
@@ -47,6 +50,7 @@ var (
 		"UNREVIEWED":                    2,
 		"REVIEWED":                      3,
 		"OVERDUE":                       4,
+		"NEEDS_REVISION":                5,
 	}
 )
 
@@ -77,27 +81,3996 @@ func (AssignmentStatusFilter) EnumDescriptor() ([]byte, []int) {
 	return file_my_proto_homework_service_proto_rawDescGZIP(), []int{0}
 }
 
+type FeedbackVerdict int32
+
+const (
+	FeedbackVerdict_FEEDBACK_VERDICT_UNSPECIFIED    FeedbackVerdict = 0
+	FeedbackVerdict_FEEDBACK_VERDICT_ACCEPTED       FeedbackVerdict = 1
+	FeedbackVerdict_FEEDBACK_VERDICT_NEEDS_REVISION FeedbackVerdict = 2
+)
+
+// Enum value maps for FeedbackVerdict.
+var (
+	FeedbackVerdict_name = map[int32]string{
+		0: "FEEDBACK_VERDICT_UNSPECIFIED",
+		1: "FEEDBACK_VERDICT_ACCEPTED",
+		2: "FEEDBACK_VERDICT_NEEDS_REVISION",
+	}
+	FeedbackVerdict_value = map[string]int32{
+		"FEEDBACK_VERDICT_UNSPECIFIED":    0,
+		"FEEDBACK_VERDICT_ACCEPTED":       1,
+		"FEEDBACK_VERDICT_NEEDS_REVISION": 2,
+	}
+)
+
+func (x FeedbackVerdict) Enum() *FeedbackVerdict {
+	p := new(FeedbackVerdict)
+	*p = x
+	return p
+}
+
+func (x FeedbackVerdict) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FeedbackVerdict) Descriptor() protoreflect.EnumDescriptor {
+	return file_my_proto_homework_service_proto_enumTypes[1].Descriptor()
+}
+
+func (FeedbackVerdict) Type() protoreflect.EnumType {
+	return &file_my_proto_homework_service_proto_enumTypes[1]
+}
+
+func (x FeedbackVerdict) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FeedbackVerdict.Descriptor instead.
+func (FeedbackVerdict) EnumDescriptor() ([]byte, []int) {
+	return file_my_proto_homework_service_proto_rawDescGZIP(), []int{1}
+}
+
+type AttachmentOwnerType int32
+
+const (
+	AttachmentOwnerType_ATTACHMENT_OWNER_TYPE_UNSPECIFIED AttachmentOwnerType = 0
+	AttachmentOwnerType_ATTACHMENT_OWNER_ASSIGNMENT       AttachmentOwnerType = 1
+	AttachmentOwnerType_ATTACHMENT_OWNER_SUBMISSION       AttachmentOwnerType = 2
+	AttachmentOwnerType_ATTACHMENT_OWNER_FEEDBACK         AttachmentOwnerType = 3
+	AttachmentOwnerType_ATTACHMENT_OWNER_COMMENT          AttachmentOwnerType = 4
+)
+
+// Enum value maps for AttachmentOwnerType.
+var (
+	AttachmentOwnerType_name = map[int32]string{
+		0: "ATTACHMENT_OWNER_TYPE_UNSPECIFIED",
+		1: "ATTACHMENT_OWNER_ASSIGNMENT",
+		2: "ATTACHMENT_OWNER_SUBMISSION",
+		3: "ATTACHMENT_OWNER_FEEDBACK",
+		4: "ATTACHMENT_OWNER_COMMENT",
+	}
+	AttachmentOwnerType_value = map[string]int32{
+		"ATTACHMENT_OWNER_TYPE_UNSPECIFIED": 0,
+		"ATTACHMENT_OWNER_ASSIGNMENT":       1,
+		"ATTACHMENT_OWNER_SUBMISSION":       2,
+		"ATTACHMENT_OWNER_FEEDBACK":         3,
+		"ATTACHMENT_OWNER_COMMENT":          4,
+	}
+)
+
+func (x AttachmentOwnerType) Enum() *AttachmentOwnerType {
+	p := new(AttachmentOwnerType)
+	*p = x
+	return p
+}
+
+func (x AttachmentOwnerType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AttachmentOwnerType) Descriptor() protoreflect.EnumDescriptor {
+	return file_my_proto_homework_service_proto_enumTypes[2].Descriptor()
+}
+
+func (AttachmentOwnerType) Type() protoreflect.EnumType {
+	return &file_my_proto_homework_service_proto_enumTypes[2]
+}
+
+func (x AttachmentOwnerType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AttachmentOwnerType.Descriptor instead.
+func (AttachmentOwnerType) EnumDescriptor() ([]byte, []int) {
+	return file_my_proto_homework_service_proto_rawDescGZIP(), []int{2}
+}
+
+// Drafts and scheduled assignments are visible only to the tutor.
+type AssignmentState int32
+
+const (
+	// Defaults to ASSIGNMENT_STATE_PUBLISHED, or to ASSIGNMENT_STATE_SCHEDULED with publish_at.
+	AssignmentState_ASSIGNMENT_STATE_UNSPECIFIED AssignmentState = 0
+	AssignmentState_ASSIGNMENT_STATE_DRAFT       AssignmentState = 1
+	// Published by the service at publish_at.
+	AssignmentState_ASSIGNMENT_STATE_SCHEDULED AssignmentState = 2
+	AssignmentState_ASSIGNMENT_STATE_PUBLISHED AssignmentState = 3
+)
+
+// Enum value maps for AssignmentState.
+var (
+	AssignmentState_name = map[int32]string{
+		0: "ASSIGNMENT_STATE_UNSPECIFIED",
+		1: "ASSIGNMENT_STATE_DRAFT",
+		2: "ASSIGNMENT_STATE_SCHEDULED",
+		3: "ASSIGNMENT_STATE_PUBLISHED",
+	}
+	AssignmentState_value = map[string]int32{
+		"ASSIGNMENT_STATE_UNSPECIFIED": 0,
+		"ASSIGNMENT_STATE_DRAFT":       1,
+		"ASSIGNMENT_STATE_SCHEDULED":   2,
+		"ASSIGNMENT_STATE_PUBLISHED":   3,
+	}
+)
+
+func (x AssignmentState) Enum() *AssignmentState {
+	p := new(AssignmentState)
+	*p = x
+	return p
+}
+
+func (x AssignmentState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AssignmentState) Descriptor() protoreflect.EnumDescriptor {
+	return file_my_proto_homework_service_proto_enumTypes[3].Descriptor()
+}
+
+func (AssignmentState) Type() protoreflect.EnumType {
+	return &file_my_proto_homework_service_proto_enumTypes[3]
+}
+
+func (x AssignmentState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AssignmentState.Descriptor instead.
+func (AssignmentState) EnumDescriptor() ([]byte, []int) {
+	return file_my_proto_homework_service_proto_rawDescGZIP(), []int{3}
+}
+
+// How submissions after the due date plus the grace period are handled.
+type LatePolicy int32
+
+const (
+	// Defaults to LATE_POLICY_FLAG.
+	LatePolicy_LATE_POLICY_UNSPECIFIED LatePolicy = 0
+	// Late submissions are accepted and not flagged.
+	LatePolicy_LATE_POLICY_ALLOW LatePolicy = 1
+	// Late submissions are accepted and flagged with is_late.
+	LatePolicy_LATE_POLICY_FLAG LatePolicy = 2
+	// Late submissions are rejected.
+	LatePolicy_LATE_POLICY_LOCK LatePolicy = 3
+)
+
+// Enum value maps for LatePolicy.
+var (
+	LatePolicy_name = map[int32]string{
+		0: "LATE_POLICY_UNSPECIFIED",
+		1: "LATE_POLICY_ALLOW",
+		2: "LATE_POLICY_FLAG",
+		3: "LATE_POLICY_LOCK",
+	}
+	LatePolicy_value = map[string]int32{
+		"LATE_POLICY_UNSPECIFIED": 0,
+		"LATE_POLICY_ALLOW":       1,
+		"LATE_POLICY_FLAG":        2,
+		"LATE_POLICY_LOCK":        3,
+	}
+)
+
+func (x LatePolicy) Enum() *LatePolicy {
+	p := new(LatePolicy)
+	*p = x
+	return p
+}
+
+func (x LatePolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LatePolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_my_proto_homework_service_proto_enumTypes[4].Descriptor()
+}
+
+func (LatePolicy) Type() protoreflect.EnumType {
+	return &file_my_proto_homework_service_proto_enumTypes[4]
+}
+
+func (x LatePolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LatePolicy.Descriptor instead.
+func (LatePolicy) EnumDescriptor() ([]byte, []int) {
+	return file_my_proto_homework_service_proto_rawDescGZIP(), []int{4}
+}
+
+type ChecklistReview int32
+
+const (
+	// Not reviewed yet.
+	ChecklistReview_CHECKLIST_REVIEW_UNSPECIFIED ChecklistReview = 0
+	ChecklistReview_CHECKLIST_REVIEW_CORRECT     ChecklistReview = 1
+	ChecklistReview_CHECKLIST_REVIEW_INCORRECT   ChecklistReview = 2
+)
+
+// Enum value maps for ChecklistReview.
+var (
+	ChecklistReview_name = map[int32]string{
+		0: "CHECKLIST_REVIEW_UNSPECIFIED",
+		1: "CHECKLIST_REVIEW_CORRECT",
+		2: "CHECKLIST_REVIEW_INCORRECT",
+	}
+	ChecklistReview_value = map[string]int32{
+		"CHECKLIST_REVIEW_UNSPECIFIED": 0,
+		"CHECKLIST_REVIEW_CORRECT":     1,
+		"CHECKLIST_REVIEW_INCORRECT":   2,
+	}
+)
+
+func (x ChecklistReview) Enum() *ChecklistReview {
+	p := new(ChecklistReview)
+	*p = x
+	return p
+}
+
+func (x ChecklistReview) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ChecklistReview) Descriptor() protoreflect.EnumDescriptor {
+	return file_my_proto_homework_service_proto_enumTypes[5].Descriptor()
+}
+
+func (ChecklistReview) Type() protoreflect.EnumType {
+	return &file_my_proto_homework_service_proto_enumTypes[5]
+}
+
+func (x ChecklistReview) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ChecklistReview.Descriptor instead.
+func (ChecklistReview) EnumDescriptor() ([]byte, []int) {
+	return file_my_proto_homework_service_proto_rawDescGZIP(), []int{5}
+}
+
+type AnnotationType int32
+
+const (
+	AnnotationType_ANNOTATION_TYPE_UNSPECIFIED AnnotationType = 0
+	AnnotationType_ANNOTATION_HIGHLIGHT        AnnotationType = 1
+	AnnotationType_ANNOTATION_PEN              AnnotationType = 2
+	AnnotationType_ANNOTATION_TEXT             AnnotationType = 3
+)
+
+// Enum value maps for AnnotationType.
+var (
+	AnnotationType_name = map[int32]string{
+		0: "ANNOTATION_TYPE_UNSPECIFIED",
+		1: "ANNOTATION_HIGHLIGHT",
+		2: "ANNOTATION_PEN",
+		3: "ANNOTATION_TEXT",
+	}
+	AnnotationType_value = map[string]int32{
+		"ANNOTATION_TYPE_UNSPECIFIED": 0,
+		"ANNOTATION_HIGHLIGHT":        1,
+		"ANNOTATION_PEN":              2,
+		"ANNOTATION_TEXT":             3,
+	}
+)
+
+func (x AnnotationType) Enum() *AnnotationType {
+	p := new(AnnotationType)
+	*p = x
+	return p
+}
+
+func (x AnnotationType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AnnotationType) Descriptor() protoreflect.EnumDescriptor {
+	return file_my_proto_homework_service_proto_enumTypes[6].Descriptor()
+}
+
+func (AnnotationType) Type() protoreflect.EnumType {
+	return &file_my_proto_homework_service_proto_enumTypes[6]
+}
+
+func (x AnnotationType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AnnotationType.Descriptor instead.
+func (AnnotationType) EnumDescriptor() ([]byte, []int) {
+	return file_my_proto_homework_service_proto_rawDescGZIP(), []int{6}
+}
+
+type QuizQuestionType int32
+
+const (
+	QuizQuestionType_QUIZ_QUESTION_TYPE_UNSPECIFIED QuizQuestionType = 0
+	QuizQuestionType_QUIZ_QUESTION_SINGLE_CHOICE    QuizQuestionType = 1
+	QuizQuestionType_QUIZ_QUESTION_MULTIPLE_CHOICE  QuizQuestionType = 2
+	QuizQuestionType_QUIZ_QUESTION_NUMERIC          QuizQuestionType = 3
+	QuizQuestionType_QUIZ_QUESTION_SHORT_TEXT       QuizQuestionType = 4
+)
+
+// Enum value maps for QuizQuestionType.
+var (
+	QuizQuestionType_name = map[int32]string{
+		0: "QUIZ_QUESTION_TYPE_UNSPECIFIED",
+		1: "QUIZ_QUESTION_SINGLE_CHOICE",
+		2: "QUIZ_QUESTION_MULTIPLE_CHOICE",
+		3: "QUIZ_QUESTION_NUMERIC",
+		4: "QUIZ_QUESTION_SHORT_TEXT",
+	}
+	QuizQuestionType_value = map[string]int32{
+		"QUIZ_QUESTION_TYPE_UNSPECIFIED": 0,
+		"QUIZ_QUESTION_SINGLE_CHOICE":    1,
+		"QUIZ_QUESTION_MULTIPLE_CHOICE":  2,
+		"QUIZ_QUESTION_NUMERIC":          3,
+		"QUIZ_QUESTION_SHORT_TEXT":       4,
+	}
+)
+
+func (x QuizQuestionType) Enum() *QuizQuestionType {
+	p := new(QuizQuestionType)
+	*p = x
+	return p
+}
+
+func (x QuizQuestionType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (QuizQuestionType) Descriptor() protoreflect.EnumDescriptor {
+	return file_my_proto_homework_service_proto_enumTypes[7].Descriptor()
+}
+
+func (QuizQuestionType) Type() protoreflect.EnumType {
+	return &file_my_proto_homework_service_proto_enumTypes[7]
+}
+
+func (x QuizQuestionType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use QuizQuestionType.Descriptor instead.
+func (QuizQuestionType) EnumDescriptor() ([]byte, []int) {
+	return file_my_proto_homework_service_proto_rawDescGZIP(), []int{7}
+}
+
+type SearchHitType int32
+
+const (
+	SearchHitType_SEARCH_HIT_TYPE_UNSPECIFIED SearchHitType = 0
+	// Assignment title or description.
+	SearchHitType_SEARCH_HIT_ASSIGNMENT SearchHitType = 1
+	// Submission comment.
+	SearchHitType_SEARCH_HIT_SUBMISSION SearchHitType = 2
+	// Feedback comment.
+	SearchHitType_SEARCH_HIT_FEEDBACK SearchHitType = 3
+)
+
+// Enum value maps for SearchHitType.
+var (
+	SearchHitType_name = map[int32]string{
+		0: "SEARCH_HIT_TYPE_UNSPECIFIED",
+		1: "SEARCH_HIT_ASSIGNMENT",
+		2: "SEARCH_HIT_SUBMISSION",
+		3: "SEARCH_HIT_FEEDBACK",
+	}
+	SearchHitType_value = map[string]int32{
+		"SEARCH_HIT_TYPE_UNSPECIFIED": 0,
+		"SEARCH_HIT_ASSIGNMENT":       1,
+		"SEARCH_HIT_SUBMISSION":       2,
+		"SEARCH_HIT_FEEDBACK":         3,
+	}
+)
+
+func (x SearchHitType) Enum() *SearchHitType {
+	p := new(SearchHitType)
+	*p = x
+	return p
+}
+
+func (x SearchHitType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SearchHitType) Descriptor() protoreflect.EnumDescriptor {
+	return file_my_proto_homework_service_proto_enumTypes[8].Descriptor()
+}
+
+func (SearchHitType) Type() protoreflect.EnumType {
+	return &file_my_proto_homework_service_proto_enumTypes[8]
+}
+
+func (x SearchHitType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SearchHitType.Descriptor instead.
+func (SearchHitType) EnumDescriptor() ([]byte, []int) {
+	return file_my_proto_homework_service_proto_rawDescGZIP(), []int{8}
+}
+
+type PortfolioExportStatus int32
+
+const (
+	PortfolioExportStatus_PORTFOLIO_EXPORT_STATUS_UNSPECIFIED PortfolioExportStatus = 0
+	PortfolioExportStatus_PORTFOLIO_EXPORT_PENDING            PortfolioExportStatus = 1
+	PortfolioExportStatus_PORTFOLIO_EXPORT_RUNNING            PortfolioExportStatus = 2
+	PortfolioExportStatus_PORTFOLIO_EXPORT_DONE               PortfolioExportStatus = 3
+	PortfolioExportStatus_PORTFOLIO_EXPORT_FAILED             PortfolioExportStatus = 4
+)
+
+// Enum value maps for PortfolioExportStatus.
+var (
+	PortfolioExportStatus_name = map[int32]string{
+		0: "PORTFOLIO_EXPORT_STATUS_UNSPECIFIED",
+		1: "PORTFOLIO_EXPORT_PENDING",
+		2: "PORTFOLIO_EXPORT_RUNNING",
+		3: "PORTFOLIO_EXPORT_DONE",
+		4: "PORTFOLIO_EXPORT_FAILED",
+	}
+	PortfolioExportStatus_value = map[string]int32{
+		"PORTFOLIO_EXPORT_STATUS_UNSPECIFIED": 0,
+		"PORTFOLIO_EXPORT_PENDING":            1,
+		"PORTFOLIO_EXPORT_RUNNING":            2,
+		"PORTFOLIO_EXPORT_DONE":               3,
+		"PORTFOLIO_EXPORT_FAILED":             4,
+	}
+)
+
+func (x PortfolioExportStatus) Enum() *PortfolioExportStatus {
+	p := new(PortfolioExportStatus)
+	*p = x
+	return p
+}
+
+func (x PortfolioExportStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PortfolioExportStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_my_proto_homework_service_proto_enumTypes[9].Descriptor()
+}
+
+func (PortfolioExportStatus) Type() protoreflect.EnumType {
+	return &file_my_proto_homework_service_proto_enumTypes[9]
+}
+
+func (x PortfolioExportStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PortfolioExportStatus.Descriptor instead.
+func (PortfolioExportStatus) EnumDescriptor() ([]byte, []int) {
+	return file_my_proto_homework_service_proto_rawDescGZIP(), []int{9}
+}
+
 type Empty struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Empty) Reset() {
-	*x = Empty{}
-	mi := &file_my_proto_homework_service_proto_msgTypes[0]
+func (x *Empty) Reset() {
+	*x = Empty{}
+	mi := &file_my_proto_homework_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Empty) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Empty) ProtoMessage() {}
+
+func (x *Empty) ProtoReflect() protoreflect.Message {
+	mi := &file_my_proto_homework_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Empty.ProtoReflect.Descriptor instead.
+func (*Empty) Descriptor() ([]byte, []int) {
+	return file_my_proto_homework_service_proto_rawDescGZIP(), []int{0}
+}
+
+// Attachments are stored in the order they are given. file_id of the request
+// is kept for older clients and is equal to the first attachment.
+type AttachmentInput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FileId        string                 `protobuf:"bytes,1,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"`
+	Caption       *string                `protobuf:"bytes,2,opt,name=caption,proto3,oneof" json:"caption,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttachmentInput) Reset() {
+	*x = AttachmentInput{}
+	mi := &file_my_proto_homework_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttachmentInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttachmentInput) ProtoMessage() {}
+
+func (x *AttachmentInput) ProtoReflect() protoreflect.Message {
+	mi := &file_my_proto_homework_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttachmentInput.ProtoReflect.Descriptor instead.
+func (*AttachmentInput) Descriptor() ([]byte, []int) {
+	return file_my_proto_homework_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AttachmentInput) GetFileId() string {
+	if x != nil {
+		return x.FileId
+	}
+	return ""
+}
+
+func (x *AttachmentInput) GetCaption() string {
+	if x != nil && x.Caption != nil {
+		return *x.Caption
+	}
+	return ""
+}
+
+// Replaces all attachments on update; an empty list removes them.
+type AttachmentList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*AttachmentInput     `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttachmentList) Reset() {
+	*x = AttachmentList{}
+	mi := &file_my_proto_homework_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttachmentList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttachmentList) ProtoMessage() {}
+
+func (x *AttachmentList) ProtoReflect() protoreflect.Message {
+	mi := &file_my_proto_homework_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttachmentList.ProtoReflect.Descriptor instead.
+func (*AttachmentList) Descriptor() ([]byte, []int) {
+	return file_my_proto_homework_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AttachmentList) GetItems() []*AttachmentInput {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type RubricCriterion struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Score         float64                `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`
+	MaxScore      float64                `protobuf:"fixed64,3,opt,name=max_score,json=maxScore,proto3" json:"max_score,omitempty"`
+	Comment       *string                `protobuf:"bytes,4,opt,name=comment,proto3,oneof" json:"comment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RubricCriterion) Reset() {
+	*x = RubricCriterion{}
+	mi := &file_my_proto_homework_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RubricCriterion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RubricCriterion) ProtoMessage() {}
+
+func (x *RubricCriterion) ProtoReflect() protoreflect.Message {
+	mi := &file_my_proto_homework_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RubricCriterion.ProtoReflect.Descriptor instead.
+func (*RubricCriterion) Descriptor() ([]byte, []int) {
+	return file_my_proto_homework_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *RubricCriterion) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RubricCriterion) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *RubricCriterion) GetMaxScore() float64 {
+	if x != nil {
+		return x.MaxScore
+	}
+	return 0
+}
+
+func (x *RubricCriterion) GetComment() string {
+	if x != nil && x.Comment != nil {
+		return *x.Comment
+	}
+	return ""
+}
+
+// A question of an auto-graded quiz. The answer key (correct_options, numeric_answer,
+// tolerance, text_answers) is only returned to the tutor.
+type QuizQuestion struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Type  QuizQuestionType       `protobuf:"varint,1,opt,name=type,proto3,enum=homework.v1.QuizQuestionType" json:"type,omitempty"`
+	Text  string                 `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	// Options of choice questions.
+	Options []string `protobuf:"bytes,3,rep,name=options,proto3" json:"options,omitempty"`
+	// Defaults to 1.
+	Points float64 `protobuf:"fixed64,4,opt,name=points,proto3" json:"points,omitempty"`
+	// Indexes into options; exactly one for single choice questions.
+	CorrectOptions []int32 `protobuf:"varint,5,rep,packed,name=correct_options,json=correctOptions,proto3" json:"correct_options,omitempty"`
+	// A numeric answer is correct within tolerance of numeric_answer.
+	NumericAnswer *float64 `protobuf:"fixed64,6,opt,name=numeric_answer,json=numericAnswer,proto3,oneof" json:"numeric_answer,omitempty"`
+	Tolerance     float64  `protobuf:"fixed64,7,opt,name=tolerance,proto3" json:"tolerance,omitempty"`
+	// Accepted short text answers, compared ignoring case and extra spaces.
+	TextAnswers   []string `protobuf:"bytes,8,rep,name=text_answers,json=textAnswers,proto3" json:"text_answers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuizQuestion) Reset() {
+	*x = QuizQuestion{}
+	mi := &file_my_proto_homework_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuizQuestion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuizQuestion) ProtoMessage() {}
+
+func (x *QuizQuestion) ProtoReflect() protoreflect.Message {
+	mi := &file_my_proto_homework_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuizQuestion.ProtoReflect.Descriptor instead.
+func (*QuizQuestion) Descriptor() ([]byte, []int) {
+	return file_my_proto_homework_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *QuizQuestion) GetType() QuizQuestionType {
+	if x != nil {
+		return x.Type
+	}
+	return QuizQuestionType_QUIZ_QUESTION_TYPE_UNSPECIFIED
+}
+
+func (x *QuizQuestion) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *QuizQuestion) GetOptions() []string {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *QuizQuestion) GetPoints() float64 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+func (x *QuizQuestion) GetCorrectOptions() []int32 {
+	if x != nil {
+		return x.CorrectOptions
+	}
+	return nil
+}
+
+func (x *QuizQuestion) GetNumericAnswer() float64 {
+	if x != nil && x.NumericAnswer != nil {
+		return *x.NumericAnswer
+	}
+	return 0
+}
+
+func (x *QuizQuestion) GetTolerance() float64 {
+	if x != nil {
+		return x.Tolerance
+	}
+	return 0
+}
+
+func (x *QuizQuestion) GetTextAnswers() []string {
+	if x != nil {
+		return x.TextAnswers
+	}
+	return nil
+}
+
+// Replaces the whole quiz on update; an empty list turns the quiz off.
+type QuizQuestionList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*QuizQuestion        `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuizQuestionList) Reset() {
+	*x = QuizQuestionList{}
+	mi := &file_my_proto_homework_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuizQuestionList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuizQuestionList) ProtoMessage() {}
+
+func (x *QuizQuestionList) ProtoReflect() protoreflect.Message {
+	mi := &file_my_proto_homework_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuizQuestionList.ProtoReflect.Descriptor instead.
+func (*QuizQuestionList) Descriptor() ([]byte, []int) {
+	return file_my_proto_homework_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *QuizQuestionList) GetItems() []*QuizQuestion {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type ChecklistItem struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Text  string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	// Marked by the student.
+	Done   bool                   `protobuf:"varint,2,opt,name=done,proto3" json:"done,omitempty"`
+	DoneAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=done_at,json=doneAt,proto3,oneof" json:"done_at,omitempty"`
+	// Set by the tutor on done items.
+	Review        ChecklistReview        `protobuf:"varint,4,opt,name=review,proto3,enum=homework.v1.ChecklistReview" json:"review,omitempty"`
+	ReviewedAt    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=reviewed_at,json=reviewedAt,proto3,oneof" json:"reviewed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChecklistItem) Reset() {
+	*x = ChecklistItem{}
+	mi := &file_my_proto_homework_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChecklistItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChecklistItem) ProtoMessage() {}
+
+func (x *ChecklistItem) ProtoReflect() protoreflect.Message {
+	mi := &file_my_proto_homework_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChecklistItem.ProtoReflect.Descriptor instead.
+func (*ChecklistItem) Descriptor() ([]byte, []int) {
+	return file_my_proto_homework_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ChecklistItem) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *ChecklistItem) GetDone() bool {
+	if x != nil {
+		return x.Done
+	}
+	return false
+}
+
+func (x *ChecklistItem) GetDoneAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DoneAt
+	}
+	return nil
+}
+
+func (x *ChecklistItem) GetReview() ChecklistReview {
+	if x != nil {
+		return x.Review
+	}
+	return ChecklistReview_CHECKLIST_REVIEW_UNSPECIFIED
+}
+
+func (x *ChecklistItem) GetReviewedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReviewedAt
+	}
+	return nil
+}
+
+// Replaces the whole checklist on update; an empty list removes it.
+type ChecklistList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []string               `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChecklistList) Reset() {
+	*x = ChecklistList{}
+	mi := &file_my_proto_homework_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChecklistList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChecklistList) ProtoMessage() {}
+
+func (x *ChecklistList) ProtoReflect() protoreflect.Message {
+	mi := &file_my_proto_homework_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChecklistList.ProtoReflect.Descriptor instead.
+func (*ChecklistList) Descriptor() ([]byte, []int) {
+	return file_my_proto_homework_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ChecklistList) GetItems() []string {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// Answer to the quiz question with the given index. correct and score are set by grading.
+type QuizAnswer struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Question      int32                  `protobuf:"varint,1,opt,name=question,proto3" json:"question,omitempty"`
+	Options       []int32                `protobuf:"varint,2,rep,packed,name=options,proto3" json:"options,omitempty"`
+	Number        *float64               `protobuf:"fixed64,3,opt,name=number,proto3,oneof" json:"number,omitempty"`
+	Text          *string                `protobuf:"bytes,4,opt,name=text,proto3,oneof" json:"text,omitempty"`
+	Correct       bool                   `protobuf:"varint,5,opt,name=correct,proto3" json:"correct,omitempty"`
+	Score         float64                `protobuf:"fixed64,6,opt,name=score,proto3" json:"score,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuizAnswer) Reset() {
+	*x = QuizAnswer{}
+	mi := &file_my_proto_homework_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuizAnswer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuizAnswer) ProtoMessage() {}
+
+func (x *QuizAnswer) ProtoReflect() protoreflect.Message {
+	mi := &file_my_proto_homework_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuizAnswer.ProtoReflect.Descriptor instead.
+func (*QuizAnswer) Descriptor() ([]byte, []int) {
+	return file_my_proto_homework_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *QuizAnswer) GetQuestion() int32 {
+	if x != nil {
+		return x.Question
+	}
+	return 0
+}
+
+func (x *QuizAnswer) GetOptions() []int32 {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *QuizAnswer) GetNumber() float64 {
+	if x != nil && x.Number != nil {
+		return *x.Number
+	}
+	return 0
+}
+
+func (x *QuizAnswer) GetText() string {
+	if x != nil && x.Text != nil {
+		return *x.Text
+	}
+	return ""
+}
+
+func (x *QuizAnswer) GetCorrect() bool {
+	if x != nil {
+		return x.Correct
+	}
+	return false
+}
+
+func (x *QuizAnswer) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+// Without an explicit score a rubric scores the feedback with the sums of its criteria.
+// On update it replaces the whole rubric.
+type Rubric struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Criteria      []*RubricCriterion     `protobuf:"bytes,1,rep,name=criteria,proto3" json:"criteria,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Rubric) Reset() {
+	*x = Rubric{}
+	mi := &file_my_proto_homework_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Rubric) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Rubric) ProtoMessage() {}
+
+func (x *Rubric) ProtoReflect() protoreflect.Message {
+	mi := &file_my_proto_homework_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Rubric.ProtoReflect.Descriptor instead.
+func (*Rubric) Descriptor() ([]byte, []int) {
+	return file_my_proto_homework_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *Rubric) GetCriteria() []*RubricCriterion {
+	if x != nil {
+		return x.Criteria
+	}
+	return nil
+}
+
+type DeleteAssignmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AssignmentId  string                 `protobuf:"bytes,1,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAssignmentRequest) Reset() {
+	*x = DeleteAssignmentRequest{}
+	mi := &file_my_proto_homework_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAssignmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAssignmentRequest) ProtoMessage() {}
+
+func (x *DeleteAssignmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_my_proto_homework_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAssignmentRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAssignmentRequest) Descriptor() ([]byte, []int) {
+	return file_my_proto_homework_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DeleteAssignmentRequest) GetAssignmentId() string {
+	if x != nil {
+		return x.AssignmentId
+	}
+	return ""
+}
+
+type RestoreAssignmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AssignmentId  string                 `protobuf:"bytes,1,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreAssignmentRequest) Reset() {
+	*x = RestoreAssignmentRequest{}
+	mi := &file_my_proto_homework_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreAssignmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreAssignmentRequest) ProtoMessage() {}
+
+func (x *RestoreAssignmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_my_proto_homework_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreAssignmentRequest.ProtoReflect.Descriptor instead.
+func (*RestoreAssignmentRequest) Descriptor() ([]byte, []int) {
+	return file_my_proto_homework_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *RestoreAssignmentRequest) GetAssignmentId() string {
+	if x != nil {
+		return x.AssignmentId
+	}
+	return ""
+}
+
+// Called by the student of the assignment. Changing the mark clears the review.
+type MarkChecklistItemRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	AssignmentId string                 `protobuf:"bytes,1,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+	// 0-based index of the item in the checklist.
+	Position      int32 `protobuf:"varint,2,opt,name=position,proto3" json:"position,omitempty"`
+	Done          bool  `protobuf:"varint,3,opt,name=done,proto3" json:"done,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkChecklistItemRequest) Reset() {
+	*x = MarkChecklistItemRequest{}
+	mi := &file_my_proto_homework_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkChecklistItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkChecklistItemRequest) ProtoMessage() {}
+
+func (x *MarkChecklistItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_my_proto_homework_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkChecklistItemRequest.ProtoReflect.Descriptor instead.
+func (*MarkChecklistItemRequest) Descriptor() ([]byte, []int) {
+	return file_my_proto_homework_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *MarkChecklistItemRequest) GetAssignmentId() string {
+	if x != nil {
+		return x.AssignmentId
+	}
+	return ""
+}
+
+func (x *MarkChecklistItemRequest) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *MarkChecklistItemRequest) GetDone() bool {
+	if x != nil {
+		return x.Done
+	}
+	return false
+}
+
+// Called by the tutor of the assignment for a done item.
+type ReviewChecklistItemRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	AssignmentId string                 `protobuf:"bytes,1,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+	Position     int32                  `protobuf:"varint,2,opt,name=position,proto3" json:"position,omitempty"`
+	// CHECKLIST_REVIEW_UNSPECIFIED clears the review.
+	Review        ChecklistReview `protobuf:"varint,3,opt,name=review,proto3,enum=homework.v1.ChecklistReview" json:"review,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewChecklistItemRequest) Reset() {
+	*x = ReviewChecklistItemRequest{}
+	mi := &file_my_proto_homework_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewChecklistItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewChecklistItemRequest) ProtoMessage() {}
+
+func (x *ReviewChecklistItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_my_proto_homework_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewChecklistItemRequest.ProtoReflect.Descriptor instead.
+func (*ReviewChecklistItemRequest) Descriptor() ([]byte, []int) {
+	return file_my_proto_homework_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ReviewChecklistItemRequest) GetAssignmentId() string {
+	if x != nil {
+		return x.AssignmentId
+	}
+	return ""
+}
+
+func (x *ReviewChecklistItemRequest) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *ReviewChecklistItemRequest) GetReview() ChecklistReview {
+	if x != nil {
+		return x.Review
+	}
+	return ChecklistReview_CHECKLIST_REVIEW_UNSPECIFIED
+}
+
+type CreateAssignmentRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	TutorId     string                 `protobuf:"bytes,1,opt,name=tutor_id,json=tutorId,proto3" json:"tutor_id,omitempty"`
+	StudentId   string                 `protobuf:"bytes,2,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	Title       *string                `protobuf:"bytes,3,opt,name=title,proto3,oneof" json:"title,omitempty"`
+	Description *string                `protobuf:"bytes,4,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	FileId      *string                `protobuf:"bytes,5,opt,name=file_id,json=fileId,proto3,oneof" json:"file_id,omitempty"`
+	DueDate     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=due_date,json=dueDate,proto3,oneof" json:"due_date,omitempty"`
+	Attachments []*AttachmentInput     `protobuf:"bytes,7,rep,name=attachments,proto3" json:"attachments,omitempty"`
+	// Lesson of the pair in schedule_service the assignment is given at.
+	LessonId *string `protobuf:"bytes,8,opt,name=lesson_id,json=lessonId,proto3,oneof" json:"lesson_id,omitempty"`
+	// The due date follows the start of the pair's next booked lesson; due_date must be empty.
+	DueBeforeNextLesson bool `protobuf:"varint,9,opt,name=due_before_next_lesson,json=dueBeforeNextLesson,proto3" json:"due_before_next_lesson,omitempty"`
+	// Makes the assignment an auto-graded quiz.
+	Quiz       []*QuizQuestion `protobuf:"bytes,10,rep,name=quiz,proto3" json:"quiz,omitempty"`
+	LatePolicy LatePolicy      `protobuf:"varint,11,opt,name=late_policy,json=latePolicy,proto3,enum=homework.v1.LatePolicy" json:"late_policy,omitempty"`
+	// Time after the due date during which submissions are still on time.
+	GracePeriodSeconds *int64          `protobuf:"varint,12,opt,name=grace_period_seconds,json=gracePeriodSeconds,proto3,oneof" json:"grace_period_seconds,omitempty"`
+	State              AssignmentState `protobuf:"varint,13,opt,name=state,proto3,enum=homework.v1.AssignmentState" json:"state,omitempty"`
+	// Required for scheduled assignments, ignored otherwise.
+	PublishAt *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=publish_at,json=publishAt,proto3,oneof" json:"publish_at,omitempty"`
+	// Texts of the checklist items in order.
+	Checklist     []string `protobuf:"bytes,15,rep,name=checklist,proto3" json:"checklist,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAssignmentRequest) Reset() {
+	*x = CreateAssignmentRequest{}
+	mi := &file_my_proto_homework_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAssignmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAssignmentRequest) ProtoMessage() {}
+
+func (x *CreateAssignmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_my_proto_homework_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAssignmentRequest.ProtoReflect.Descriptor instead.
+func (*CreateAssignmentRequest) Descriptor() ([]byte, []int) {
+	return file_my_proto_homework_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CreateAssignmentRequest) GetTutorId() string {
+	if x != nil {
+		return x.TutorId
+	}
+	return ""
+}
+
+func (x *CreateAssignmentRequest) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *CreateAssignmentRequest) GetTitle() string {
+	if x != nil && x.Title != nil {
+		return *x.Title
+	}
+	return ""
+}
+
+func (x *CreateAssignmentRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *CreateAssignmentRequest) GetFileId() string {
+	if x != nil && x.FileId != nil {
+		return *x.FileId
+	}
+	return ""
+}
+
+func (x *CreateAssignmentRequest) GetDueDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueDate
+	}
+	return nil
+}
+
+func (x *CreateAssignmentRequest) GetAttachments() []*AttachmentInput {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
+}
+
+func (x *CreateAssignmentRequest) GetLessonId() string {
+	if x != nil && x.LessonId != nil {
+		return *x.LessonId
+	}
+	return ""
+}
+
+func (x *CreateAssignmentRequest) GetDueBeforeNextLesson() bool {
+	if x != nil {
+		return x.DueBeforeNextLesson
+	}
+	return false
+}
+
+func (x *CreateAssignmentRequest) GetQuiz() []*QuizQuestion {
+	if x != nil {
+		return x.Quiz
+	}
+	return nil
+}
+
+func (x *CreateAssignmentRequest) GetLatePolicy() LatePolicy {
+	if x != nil {
+		return x.LatePolicy
+	}
+	return LatePolicy_LATE_POLICY_UNSPECIFIED
+}
+
+func (x *CreateAssignmentRequest) GetGracePeriodSeconds() int64 {
+	if x != nil && x.GracePeriodSeconds != nil {
+		return *x.GracePeriodSeconds
+	}
+	return 0
+}
+
+func (x *CreateAssignmentRequest) GetState() AssignmentState {
+	if x != nil {
+		return x.State
+	}
+	return AssignmentState_ASSIGNMENT_STATE_UNSPECIFIED
+}
+
+func (x *CreateAssignmentRequest) GetPublishAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PublishAt
+	}
+	return nil
+}
+
+func (x *CreateAssignmentRequest) GetChecklist() []string {
+	if x != nil {
+		return x.Checklist
+	}
+	return nil
+}
+
+type UpdateAssignmentRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title       *string                `protobuf:"bytes,2,opt,name=title,proto3,oneof" json:"title,omitempty"`
+	Description *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	FileId      *string                `protobuf:"bytes,4,opt,name=file_id,json=fileId,proto3,oneof" json:"file_id,omitempty"`
+	DueDate     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=due_date,json=dueDate,proto3,oneof" json:"due_date,omitempty"`
+	Attachments *AttachmentList        `protobuf:"bytes,6,opt,name=attachments,proto3" json:"attachments,omitempty"`
+	// An empty string unlinks the lesson.
+	LessonId *string `protobuf:"bytes,7,opt,name=lesson_id,json=lessonId,proto3,oneof" json:"lesson_id,omitempty"`
+	// An explicit due_date turns the mode off.
+	DueBeforeNextLesson *bool `protobuf:"varint,8,opt,name=due_before_next_lesson,json=dueBeforeNextLesson,proto3,oneof" json:"due_before_next_lesson,omitempty"`
+	// Cannot be changed after the first submission.
+	Quiz       *QuizQuestionList `protobuf:"bytes,9,opt,name=quiz,proto3" json:"quiz,omitempty"`
+	LatePolicy *LatePolicy       `protobuf:"varint,10,opt,name=late_policy,json=latePolicy,proto3,enum=homework.v1.LatePolicy,oneof" json:"late_policy,omitempty"`
+	// Zero removes the grace period.
+	GracePeriodSeconds *int64 `protobuf:"varint,11,opt,name=grace_period_seconds,json=gracePeriodSeconds,proto3,oneof" json:"grace_period_seconds,omitempty"`
+	// A published assignment cannot be turned back into a draft or scheduled.
+	State *AssignmentState `protobuf:"varint,12,opt,name=state,proto3,enum=homework.v1.AssignmentState,oneof" json:"state,omitempty"`
+	// Schedules the assignment unless state is set.
+	PublishAt *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=publish_at,json=publishAt,proto3,oneof" json:"publish_at,omitempty"`
+	// Items whose text is kept keep their marks.
+	Checklist     *ChecklistList `protobuf:"bytes,14,opt,name=checklist,proto3" json:"checklist,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateAssignmentRequest) Reset() {
+	*x = UpdateAssignmentRequest{}
+	mi := &file_my_proto_homework_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAssignmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAssignmentRequest) ProtoMessage() {}
+
+func (x *UpdateAssignmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_my_proto_homework_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAssignmentRequest.ProtoReflect.Descriptor instead.
+func (*UpdateAssignmentRequest) Descriptor() ([]byte, []int) {
+	return file_my_proto_homework_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *UpdateAssignmentRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateAssignmentRequest) GetTitle() string {
+	if x != nil && x.Title != nil {
+		return *x.Title
+	}
+	return ""
+}
+
+func (x *UpdateAssignmentRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *UpdateAssignmentRequest) GetFileId() string {
+	if x != nil && x.FileId != nil {
+		return *x.FileId
+	}
+	return ""
+}
+
+func (x *UpdateAssignmentRequest) GetDueDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueDate
+	}
+	return nil
+}
+
+func (x *UpdateAssignmentRequest) GetAttachments() *AttachmentList {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
+}
+
+func (x *UpdateAssignmentRequest) GetLessonId() string {
+	if x != nil && x.LessonId != nil {
+		return *x.LessonId
+	}
+	return ""
+}
+
+func (x *UpdateAssignmentRequest) GetDueBeforeNextLesson() bool {
+	if x != nil && x.DueBeforeNextLesson != nil {
+		return *x.DueBeforeNextLesson
+	}
+	return false
+}
+
+func (x *UpdateAssignmentRequest) GetQuiz() *QuizQuestionList {
+	if x != nil {
+		return x.Quiz
+	}
+	return nil
+}
+
+func (x *UpdateAssignmentRequest) GetLatePolicy() LatePolicy {
+	if x != nil && x.LatePolicy != nil {
+		return *x.LatePolicy
+	}
+	return LatePolicy_LATE_POLICY_UNSPECIFIED
+}
+
+func (x *UpdateAssignmentRequest) GetGracePeriodSeconds() int64 {
+	if x != nil && x.GracePeriodSeconds != nil {
+		return *x.GracePeriodSeconds
+	}
+	return 0
+}
+
+func (x *UpdateAssignmentRequest) GetState() AssignmentState {
+	if x != nil && x.State != nil {
+		return *x.State
+	}
+	return AssignmentState_ASSIGNMENT_STATE_UNSPECIFIED
+}
+
+func (x *UpdateAssignmentRequest) GetPublishAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PublishAt
+	}
+	return nil
+}
+
+func (x *UpdateAssignmentRequest) GetChecklist() *ChecklistList {
+	if x != nil {
+		return x.Checklist
+	}
+	return nil
+}
+
+type ListAssignmentsByTutorRequest struct {
+	state             protoimpl.MessageState   `protogen:"open.v1"`
+	TutorId           string                   `protobuf:"bytes,1,opt,name=tutor_id,json=tutorId,proto3" json:"tutor_id,omitempty"`
+	StatusFilter      []AssignmentStatusFilter `protobuf:"varint,2,rep,packed,name=status_filter,json=statusFilter,proto3,enum=homework.v1.AssignmentStatusFilter" json:"status_filter,omitempty"`
+	ChecklistProgress *ChecklistProgressFilter `protobuf:"bytes,3,opt,name=checklist_progress,json=checklistProgress,proto3" json:"checklist_progress,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ListAssignmentsByTutorRequest) Reset() {
+	*x = ListAssignmentsByTutorRequest{}
+	mi := &file_my_proto_homework_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAssignmentsByTutorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAssignmentsByTutorRequest) ProtoMessage() {}
+
+func (x *ListAssignmentsByTutorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_my_proto_homework_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAssignmentsByTutorRequest.ProtoReflect.Descriptor instead.
+func (*ListAssignmentsByTutorRequest) Descriptor() ([]byte, []int) {
+	return file_my_proto_homework_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListAssignmentsByTutorRequest) GetTutorId() string {
+	if x != nil {
+		return x.TutorId
+	}
+	return ""
+}
+
+func (x *ListAssignmentsByTutorRequest) GetStatusFilter() []AssignmentStatusFilter {
+	if x != nil {
+		return x.StatusFilter
+	}
+	return nil
+}
+
+func (x *ListAssignmentsByTutorRequest) GetChecklistProgress() *ChecklistProgressFilter {
+	if x != nil {
+		return x.ChecklistProgress
+	}
+	return nil
+}
+
+type ListAssignmentsByStudentRequest struct {
+	state             protoimpl.MessageState   `protogen:"open.v1"`
+	StudentId         string                   `protobuf:"bytes,1,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	StatusFilter      []AssignmentStatusFilter `protobuf:"varint,2,rep,packed,name=status_filter,json=statusFilter,proto3,enum=homework.v1.AssignmentStatusFilter" json:"status_filter,omitempty"`
+	ChecklistProgress *ChecklistProgressFilter `protobuf:"bytes,3,opt,name=checklist_progress,json=checklistProgress,proto3" json:"checklist_progress,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ListAssignmentsByStudentRequest) Reset() {
+	*x = ListAssignmentsByStudentRequest{}
+	mi := &file_my_proto_homework_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAssignmentsByStudentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAssignmentsByStudentRequest) ProtoMessage() {}
+
+func (x *ListAssignmentsByStudentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_my_proto_homework_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAssignmentsByStudentRequest.ProtoReflect.Descriptor instead.
+func (*ListAssignmentsByStudentRequest) Descriptor() ([]byte, []int) {
+	return file_my_proto_homework_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListAssignmentsByStudentRequest) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *ListAssignmentsByStudentRequest) GetStatusFilter() []AssignmentStatusFilter {
+	if x != nil {
+		return x.StatusFilter
+	}
+	return nil
+}
+
+func (x *ListAssignmentsByStudentRequest) GetChecklistProgress() *ChecklistProgressFilter {
+	if x != nil {
+		return x.ChecklistProgress
+	}
+	return nil
+}
+
+type ListAssignmentsByPairRequest struct {
+	state             protoimpl.MessageState   `protogen:"open.v1"`
+	TutorId           string                   `protobuf:"bytes,1,opt,name=tutor_id,json=tutorId,proto3" json:"tutor_id,omitempty"`
+	StudentId         string                   `protobuf:"bytes,2,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	StatusFilter      []AssignmentStatusFilter `protobuf:"varint,3,rep,packed,name=status_filter,json=statusFilter,proto3,enum=homework.v1.AssignmentStatusFilter" json:"status_filter,omitempty"`
+	ChecklistProgress *ChecklistProgressFilter `protobuf:"bytes,4,opt,name=checklist_progress,json=checklistProgress,proto3" json:"checklist_progress,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ListAssignmentsByPairRequest) Reset() {
+	*x = ListAssignmentsByPairRequest{}
+	mi := &file_my_proto_homework_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAssignmentsByPairRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAssignmentsByPairRequest) ProtoMessage() {}
+
+func (x *ListAssignmentsByPairRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_my_proto_homework_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAssignmentsByPairRequest.ProtoReflect.Descriptor instead.
+func (*ListAssignmentsByPairRequest) Descriptor() ([]byte, []int) {
+	return file_my_proto_homework_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ListAssignmentsByPairRequest) GetTutorId() string {
+	if x != nil {
+		return x.TutorId
+	}
+	return ""
+}
+
+func (x *ListAssignmentsByPairRequest) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *ListAssignmentsByPairRequest) GetStatusFilter() []AssignmentStatusFilter {
+	if x != nil {
+		return x.StatusFilter
+	}
+	return nil
+}
+
+func (x *ListAssignmentsByPairRequest) GetChecklistProgress() *ChecklistProgressFilter {
+	if x != nil {
+		return x.ChecklistProgress
+	}
+	return nil
+}
+
+type ListAssignmentsByLessonRequest struct {
+	state             protoimpl.MessageState   `protogen:"open.v1"`
+	LessonId          string                   `protobuf:"bytes,1,opt,name=lesson_id,json=lessonId,proto3" json:"lesson_id,omitempty"`
+	StatusFilter      []AssignmentStatusFilter `protobuf:"varint,2,rep,packed,name=status_filter,json=statusFilter,proto3,enum=homework.v1.AssignmentStatusFilter" json:"status_filter,omitempty"`
+	ChecklistProgress *ChecklistProgressFilter `protobuf:"bytes,3,opt,name=checklist_progress,json=checklistProgress,proto3" json:"checklist_progress,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ListAssignmentsByLessonRequest) Reset() {
+	*x = ListAssignmentsByLessonRequest{}
+	mi := &file_my_proto_homework_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAssignmentsByLessonRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAssignmentsByLessonRequest) ProtoMessage() {}
+
+func (x *ListAssignmentsByLessonRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_my_proto_homework_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAssignmentsByLessonRequest.ProtoReflect.Descriptor instead.
+func (*ListAssignmentsByLessonRequest) Descriptor() ([]byte, []int) {
+	return file_my_proto_homework_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ListAssignmentsByLessonRequest) GetLessonId() string {
+	if x != nil {
+		return x.LessonId
+	}
+	return ""
+}
+
+func (x *ListAssignmentsByLessonRequest) GetStatusFilter() []AssignmentStatusFilter {
+	if x != nil {
+		return x.StatusFilter
+	}
+	return nil
+}
+
+func (x *ListAssignmentsByLessonRequest) GetChecklistProgress() *ChecklistProgressFilter {
+	if x != nil {
+		return x.ChecklistProgress
+	}
+	return nil
+}
+
+// Keeps only assignments with a checklist whose progress is within the bounds, in percent.
+type ChecklistProgressFilter struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Min           *int32                 `protobuf:"varint,1,opt,name=min,proto3,oneof" json:"min,omitempty"`
+	Max           *int32                 `protobuf:"varint,2,opt,name=max,proto3,oneof" json:"max,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChecklistProgressFilter) Reset() {
+	*x = ChecklistProgressFilter{}
+	mi := &file_my_proto_homework_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChecklistProgressFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChecklistProgressFilter) ProtoMessage() {}
+
+func (x *ChecklistProgressFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_my_proto_homework_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChecklistProgressFilter.ProtoReflect.Descriptor instead.
+func (*ChecklistProgressFilter) Descriptor() ([]byte, []int) {
+	return file_my_proto_homework_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ChecklistProgressFilter) GetMin() int32 {
+	if x != nil && x.Min != nil {
+		return *x.Min
+	}
+	return 0
+}
+
+func (x *ChecklistProgressFilter) GetMax() int32 {
+	if x != nil && x.Max != nil {
+		return *x.Max
+	}
+	return 0
+}
+
+type ListAssignmentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Assignments   []*Assignment          `protobuf:"bytes,1,rep,name=assignments,proto3" json:"assignments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAssignmentsResponse) Reset() {
+	*x = ListAssignmentsResponse{}
+	mi := &file_my_proto_homework_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAssignmentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAssignmentsResponse) ProtoMessage() {}
+
+func (x *ListAssignmentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_my_proto_homework_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAssignmentsResponse.ProtoReflect.Descriptor instead.
+func (*ListAssignmentsResponse) Descriptor() ([]byte, []int) {
+	return file_my_proto_homework_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ListAssignmentsResponse) GetAssignments() []*Assignment {
+	if x != nil {
+		return x.Assignments
+	}
+	return nil
+}
+
+type CreateAssignmentTemplateRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	TutorId     string                 `protobuf:"bytes,1,opt,name=tutor_id,json=tutorId,proto3" json:"tutor_id,omitempty"`
+	Title       *string                `protobuf:"bytes,2,opt,name=title,proto3,oneof" json:"title,omitempty"`
+	Description *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	Attachments []*AttachmentInput     `protobuf:"bytes,4,rep,name=attachments,proto3" json:"attachments,omitempty"`
+	// Seconds from the assignment time to the due date.
+	DueOffsetSeconds *int64 `protobuf:"varint,5,opt,name=due_offset_seconds,json=dueOffsetSeconds,proto3,oneof" json:"due_offset_seconds,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CreateAssignmentTemplateRequest) Reset() {
+	*x = CreateAssignmentTemplateRequest{}
+	mi := &file_my_proto_homework_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAssignmentTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAssignmentTemplateRequest) ProtoMessage() {}
+
+func (x *CreateAssignmentTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_my_proto_homework_service_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAssignmentTemplateRequest.ProtoReflect.Descriptor instead.
+func (*CreateAssignmentTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_my_proto_homework_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *CreateAssignmentTemplateRequest) GetTutorId() string {
+	if x != nil {
+		return x.TutorId
+	}
+	return ""
+}
+
+func (x *CreateAssignmentTemplateRequest) GetTitle() string {
+	if x != nil && x.Title != nil {
+		return *x.Title
+	}
+	return ""
+}
+
+func (x *CreateAssignmentTemplateRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *CreateAssignmentTemplateRequest) GetAttachments() []*AttachmentInput {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
+}
+
+func (x *CreateAssignmentTemplateRequest) GetDueOffsetSeconds() int64 {
+	if x != nil && x.DueOffsetSeconds != nil {
+		return *x.DueOffsetSeconds
+	}
+	return 0
+}
+
+type UpdateAssignmentTemplateRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title            *string                `protobuf:"bytes,2,opt,name=title,proto3,oneof" json:"title,omitempty"`
+	Description      *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	Attachments      *AttachmentList        `protobuf:"bytes,4,opt,name=attachments,proto3" json:"attachments,omitempty"`
+	DueOffsetSeconds *int64                 `protobuf:"varint,5,opt,name=due_offset_seconds,json=dueOffsetSeconds,proto3,oneof" json:"due_offset_seconds,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *UpdateAssignmentTemplateRequest) Reset() {
+	*x = UpdateAssignmentTemplateRequest{}
+	mi := &file_my_proto_homework_service_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAssignmentTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAssignmentTemplateRequest) ProtoMessage() {}
+
+func (x *UpdateAssignmentTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_my_proto_homework_service_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAssignmentTemplateRequest.ProtoReflect.Descriptor instead.
+func (*UpdateAssignmentTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_my_proto_homework_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *UpdateAssignmentTemplateRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateAssignmentTemplateRequest) GetTitle() string {
+	if x != nil && x.Title != nil {
+		return *x.Title
+	}
+	return ""
+}
+
+func (x *UpdateAssignmentTemplateRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *UpdateAssignmentTemplateRequest) GetAttachments() *AttachmentList {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
+}
+
+func (x *UpdateAssignmentTemplateRequest) GetDueOffsetSeconds() int64 {
+	if x != nil && x.DueOffsetSeconds != nil {
+		return *x.DueOffsetSeconds
+	}
+	return 0
+}
+
+type DeleteAssignmentTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TemplateId    string                 `protobuf:"bytes,1,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAssignmentTemplateRequest) Reset() {
+	*x = DeleteAssignmentTemplateRequest{}
+	mi := &file_my_proto_homework_service_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAssignmentTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAssignmentTemplateRequest) ProtoMessage() {}
+
+func (x *DeleteAssignmentTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_my_proto_homework_service_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAssignmentTemplateRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAssignmentTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_my_proto_homework_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *DeleteAssignmentTemplateRequest) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+type ListAssignmentTemplatesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TutorId       string                 `protobuf:"bytes,1,opt,name=tutor_id,json=tutorId,proto3" json:"tutor_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAssignmentTemplatesRequest) Reset() {
+	*x = ListAssignmentTemplatesRequest{}
+	mi := &file_my_proto_homework_service_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAssignmentTemplatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAssignmentTemplatesRequest) ProtoMessage() {}
+
+func (x *ListAssignmentTemplatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_my_proto_homework_service_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAssignmentTemplatesRequest.ProtoReflect.Descriptor instead.
+func (*ListAssignmentTemplatesRequest) Descriptor() ([]byte, []int) {
+	return file_my_proto_homework_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListAssignmentTemplatesRequest) GetTutorId() string {
+	if x != nil {
+		return x.TutorId
+	}
+	return ""
+}
+
+type ListAssignmentTemplatesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Templates     []*AssignmentTemplate  `protobuf:"bytes,1,rep,name=templates,proto3" json:"templates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAssignmentTemplatesResponse) Reset() {
+	*x = ListAssignmentTemplatesResponse{}
+	mi := &file_my_proto_homework_service_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAssignmentTemplatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAssignmentTemplatesResponse) ProtoMessage() {}
+
+func (x *ListAssignmentTemplatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_my_proto_homework_service_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAssignmentTemplatesResponse.ProtoReflect.Descriptor instead.
+func (*ListAssignmentTemplatesResponse) Descriptor() ([]byte, []int) {
+	return file_my_proto_homework_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ListAssignmentTemplatesResponse) GetTemplates() []*AssignmentTemplate {
+	if x != nil {
+		return x.Templates
+	}
+	return nil
+}
+
+// Creates an assignment for every student in one transaction.
+// Without due_date the template's due offset from now is used.
+type AssignFromTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TemplateId    string                 `protobuf:"bytes,1,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	StudentIds    []string               `protobuf:"bytes,2,rep,name=student_ids,json=studentIds,proto3" json:"student_ids,omitempty"`
+	DueDate       *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=due_date,json=dueDate,proto3,oneof" json:"due_date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssignFromTemplateRequest) Reset() {
+	*x = AssignFromTemplateRequest{}
+	mi := &file_my_proto_homework_service_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignFromTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignFromTemplateRequest) ProtoMessage() {}
+
+func (x *AssignFromTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_my_proto_homework_service_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignFromTemplateRequest.ProtoReflect.Descriptor instead.
+func (*AssignFromTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_my_proto_homework_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *AssignFromTemplateRequest) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+func (x *AssignFromTemplateRequest) GetStudentIds() []string {
+	if x != nil {
+		return x.StudentIds
+	}
+	return nil
+}
+
+func (x *AssignFromTemplateRequest) GetDueDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueDate
+	}
+	return nil
+}
+
+// The default template is assigned to the student after each completed lesson
+// with the tutor, due before the pair's next lesson. An empty template_id turns
+// the follow-ups off.
+type SetDefaultTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TutorId       string                 `protobuf:"bytes,1,opt,name=tutor_id,json=tutorId,proto3" json:"tutor_id,omitempty"`
+	StudentId     string                 `protobuf:"bytes,2,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	TemplateId    string                 `protobuf:"bytes,3,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetDefaultTemplateRequest) Reset() {
+	*x = SetDefaultTemplateRequest{}
+	mi := &file_my_proto_homework_service_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetDefaultTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDefaultTemplateRequest) ProtoMessage() {}
+
+func (x *SetDefaultTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_my_proto_homework_service_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDefaultTemplateRequest.ProtoReflect.Descriptor instead.
+func (*SetDefaultTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_my_proto_homework_service_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *SetDefaultTemplateRequest) GetTutorId() string {
+	if x != nil {
+		return x.TutorId
+	}
+	return ""
+}
+
+func (x *SetDefaultTemplateRequest) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *SetDefaultTemplateRequest) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+type GetDefaultTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TutorId       string                 `protobuf:"bytes,1,opt,name=tutor_id,json=tutorId,proto3" json:"tutor_id,omitempty"`
+	StudentId     string                 `protobuf:"bytes,2,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDefaultTemplateRequest) Reset() {
+	*x = GetDefaultTemplateRequest{}
+	mi := &file_my_proto_homework_service_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDefaultTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDefaultTemplateRequest) ProtoMessage() {}
+
+func (x *GetDefaultTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_my_proto_homework_service_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDefaultTemplateRequest.ProtoReflect.Descriptor instead.
+func (*GetDefaultTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_my_proto_homework_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetDefaultTemplateRequest) GetTutorId() string {
+	if x != nil {
+		return x.TutorId
+	}
+	return ""
+}
+
+func (x *GetDefaultTemplateRequest) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+// The author is the current user. Without submission_id the comment goes to the
+// thread of the assignment itself.
+type CreateCommentRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	AssignmentId string                 `protobuf:"bytes,1,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+	SubmissionId *string                `protobuf:"bytes,2,opt,name=submission_id,json=submissionId,proto3,oneof" json:"submission_id,omitempty"`
+	// Comment in the same thread to reply to.
+	ParentId      *string            `protobuf:"bytes,3,opt,name=parent_id,json=parentId,proto3,oneof" json:"parent_id,omitempty"`
+	Body          string             `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"`
+	Attachments   []*AttachmentInput `protobuf:"bytes,5,rep,name=attachments,proto3" json:"attachments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCommentRequest) Reset() {
+	*x = CreateCommentRequest{}
+	mi := &file_my_proto_homework_service_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCommentRequest) ProtoMessage() {}
+
+func (x *CreateCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_my_proto_homework_service_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCommentRequest.ProtoReflect.Descriptor instead.
+func (*CreateCommentRequest) Descriptor() ([]byte, []int) {
+	return file_my_proto_homework_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *CreateCommentRequest) GetAssignmentId() string {
+	if x != nil {
+		return x.AssignmentId
+	}
+	return ""
+}
+
+func (x *CreateCommentRequest) GetSubmissionId() string {
+	if x != nil && x.SubmissionId != nil {
+		return *x.SubmissionId
+	}
+	return ""
+}
+
+func (x *CreateCommentRequest) GetParentId() string {
+	if x != nil && x.ParentId != nil {
+		return *x.ParentId
+	}
+	return ""
+}
+
+func (x *CreateCommentRequest) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *CreateCommentRequest) GetAttachments() []*AttachmentInput {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
+}
+
+type UpdateCommentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Body          *string                `protobuf:"bytes,2,opt,name=body,proto3,oneof" json:"body,omitempty"`
+	Attachments   *AttachmentList        `protobuf:"bytes,3,opt,name=attachments,proto3" json:"attachments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCommentRequest) Reset() {
+	*x = UpdateCommentRequest{}
+	mi := &file_my_proto_homework_service_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCommentRequest) ProtoMessage() {}
+
+func (x *UpdateCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_my_proto_homework_service_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCommentRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCommentRequest) Descriptor() ([]byte, []int) {
+	return file_my_proto_homework_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *UpdateCommentRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateCommentRequest) GetBody() string {
+	if x != nil && x.Body != nil {
+		return *x.Body
+	}
+	return ""
+}
+
+func (x *UpdateCommentRequest) GetAttachments() *AttachmentList {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
+}
+
+type DeleteCommentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CommentId     string                 `protobuf:"bytes,1,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCommentRequest) Reset() {
+	*x = DeleteCommentRequest{}
+	mi := &file_my_proto_homework_service_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCommentRequest) ProtoMessage() {}
+
+func (x *DeleteCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_my_proto_homework_service_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCommentRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCommentRequest) Descriptor() ([]byte, []int) {
+	return file_my_proto_homework_service_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *DeleteCommentRequest) GetCommentId() string {
+	if x != nil {
+		return x.CommentId
+	}
+	return ""
+}
+
+type ListCommentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AssignmentId  string                 `protobuf:"bytes,1,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+	SubmissionId  *string                `protobuf:"bytes,2,opt,name=submission_id,json=submissionId,proto3,oneof" json:"submission_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCommentsRequest) Reset() {
+	*x = ListCommentsRequest{}
+	mi := &file_my_proto_homework_service_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCommentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCommentsRequest) ProtoMessage() {}
+
+func (x *ListCommentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_my_proto_homework_service_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCommentsRequest.ProtoReflect.Descriptor instead.
+func (*ListCommentsRequest) Descriptor() ([]byte, []int) {
+	return file_my_proto_homework_service_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ListCommentsRequest) GetAssignmentId() string {
+	if x != nil {
+		return x.AssignmentId
+	}
+	return ""
+}
+
+func (x *ListCommentsRequest) GetSubmissionId() string {
+	if x != nil && x.SubmissionId != nil {
+		return *x.SubmissionId
+	}
+	return ""
+}
+
+type ListCommentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Comments      []*Comment             `protobuf:"bytes,1,rep,name=comments,proto3" json:"comments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCommentsResponse) Reset() {
+	*x = ListCommentsResponse{}
+	mi := &file_my_proto_homework_service_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCommentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCommentsResponse) ProtoMessage() {}
+
+func (x *ListCommentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_my_proto_homework_service_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCommentsResponse.ProtoReflect.Descriptor instead.
+func (*ListCommentsResponse) Descriptor() ([]byte, []int) {
+	return file_my_proto_homework_service_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ListCommentsResponse) GetComments() []*Comment {
+	if x != nil {
+		return x.Comments
+	}
+	return nil
+}
+
+type CreateSubmissionRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	AssignmentId string                 `protobuf:"bytes,1,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+	FileId       *string                `protobuf:"bytes,2,opt,name=file_id,json=fileId,proto3,oneof" json:"file_id,omitempty"`
+	Comment      *string                `protobuf:"bytes,3,opt,name=comment,proto3,oneof" json:"comment,omitempty"`
+	Attachments  []*AttachmentInput     `protobuf:"bytes,4,rep,name=attachments,proto3" json:"attachments,omitempty"`
+	// Answers to a quiz; the submission is graded right away.
+	Answers       []*QuizAnswer `protobuf:"bytes,5,rep,name=answers,proto3" json:"answers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSubmissionRequest) Reset() {
+	*x = CreateSubmissionRequest{}
+	mi := &file_my_proto_homework_service_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSubmissionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSubmissionRequest) ProtoMessage() {}
+
+func (x *CreateSubmissionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_my_proto_homework_service_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSubmissionRequest.ProtoReflect.Descriptor instead.
+func (*CreateSubmissionRequest) Descriptor() ([]byte, []int) {
+	return file_my_proto_homework_service_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *CreateSubmissionRequest) GetAssignmentId() string {
+	if x != nil {
+		return x.AssignmentId
+	}
+	return ""
+}
+
+func (x *CreateSubmissionRequest) GetFileId() string {
+	if x != nil && x.FileId != nil {
+		return *x.FileId
+	}
+	return ""
+}
+
+func (x *CreateSubmissionRequest) GetComment() string {
+	if x != nil && x.Comment != nil {
+		return *x.Comment
+	}
+	return ""
+}
+
+func (x *CreateSubmissionRequest) GetAttachments() []*AttachmentInput {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
+}
+
+func (x *CreateSubmissionRequest) GetAnswers() []*QuizAnswer {
+	if x != nil {
+		return x.Answers
+	}
+	return nil
+}
+
+type ListSubmissionsByAssignmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AssignmentId  string                 `protobuf:"bytes,1,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSubmissionsByAssignmentRequest) Reset() {
+	*x = ListSubmissionsByAssignmentRequest{}
+	mi := &file_my_proto_homework_service_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSubmissionsByAssignmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSubmissionsByAssignmentRequest) ProtoMessage() {}
+
+func (x *ListSubmissionsByAssignmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_my_proto_homework_service_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSubmissionsByAssignmentRequest.ProtoReflect.Descriptor instead.
+func (*ListSubmissionsByAssignmentRequest) Descriptor() ([]byte, []int) {
+	return file_my_proto_homework_service_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ListSubmissionsByAssignmentRequest) GetAssignmentId() string {
+	if x != nil {
+		return x.AssignmentId
+	}
+	return ""
+}
+
+type ListSubmissionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Submissions   []*Submission          `protobuf:"bytes,1,rep,name=submissions,proto3" json:"submissions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSubmissionsResponse) Reset() {
+	*x = ListSubmissionsResponse{}
+	mi := &file_my_proto_homework_service_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSubmissionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSubmissionsResponse) ProtoMessage() {}
+
+func (x *ListSubmissionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_my_proto_homework_service_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSubmissionsResponse.ProtoReflect.Descriptor instead.
+func (*ListSubmissionsResponse) Descriptor() ([]byte, []int) {
+	return file_my_proto_homework_service_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ListSubmissionsResponse) GetSubmissions() []*Submission {
+	if x != nil {
+		return x.Submissions
+	}
+	return nil
+}
+
+type CreateFeedbackRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	SubmissionId string                 `protobuf:"bytes,1,opt,name=submission_id,json=submissionId,proto3" json:"submission_id,omitempty"`
+	FileId       *string                `protobuf:"bytes,2,opt,name=file_id,json=fileId,proto3,oneof" json:"file_id,omitempty"`
+	Comment      *string                `protobuf:"bytes,3,opt,name=comment,proto3,oneof" json:"comment,omitempty"`
+	Attachments  []*AttachmentInput     `protobuf:"bytes,4,rep,name=attachments,proto3" json:"attachments,omitempty"`
+	Score        *float64               `protobuf:"fixed64,5,opt,name=score,proto3,oneof" json:"score,omitempty"`
+	MaxScore     *float64               `protobuf:"fixed64,6,opt,name=max_score,json=maxScore,proto3,oneof" json:"max_score,omitempty"`
+	Rubric       *Rubric                `protobuf:"bytes,7,opt,name=rubric,proto3" json:"rubric,omitempty"`
+	// Defaults to accepted.
+	Verdict       FeedbackVerdict `protobuf:"varint,8,opt,name=verdict,proto3,enum=homework.v1.FeedbackVerdict" json:"verdict,omitempty"`
+	Annotations   []*Annotation   `protobuf:"bytes,9,rep,name=annotations,proto3" json:"annotations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateFeedbackRequest) Reset() {
+	*x = CreateFeedbackRequest{}
+	mi := &file_my_proto_homework_service_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateFeedbackRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateFeedbackRequest) ProtoMessage() {}
+
+func (x *CreateFeedbackRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_my_proto_homework_service_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateFeedbackRequest.ProtoReflect.Descriptor instead.
+func (*CreateFeedbackRequest) Descriptor() ([]byte, []int) {
+	return file_my_proto_homework_service_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *CreateFeedbackRequest) GetSubmissionId() string {
+	if x != nil {
+		return x.SubmissionId
+	}
+	return ""
+}
+
+func (x *CreateFeedbackRequest) GetFileId() string {
+	if x != nil && x.FileId != nil {
+		return *x.FileId
+	}
+	return ""
+}
+
+func (x *CreateFeedbackRequest) GetComment() string {
+	if x != nil && x.Comment != nil {
+		return *x.Comment
+	}
+	return ""
+}
+
+func (x *CreateFeedbackRequest) GetAttachments() []*AttachmentInput {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
+}
+
+func (x *CreateFeedbackRequest) GetScore() float64 {
+	if x != nil && x.Score != nil {
+		return *x.Score
+	}
+	return 0
+}
+
+func (x *CreateFeedbackRequest) GetMaxScore() float64 {
+	if x != nil && x.MaxScore != nil {
+		return *x.MaxScore
+	}
+	return 0
+}
+
+func (x *CreateFeedbackRequest) GetRubric() *Rubric {
+	if x != nil {
+		return x.Rubric
+	}
+	return nil
+}
+
+func (x *CreateFeedbackRequest) GetVerdict() FeedbackVerdict {
+	if x != nil {
+		return x.Verdict
+	}
+	return FeedbackVerdict_FEEDBACK_VERDICT_UNSPECIFIED
+}
+
+func (x *CreateFeedbackRequest) GetAnnotations() []*Annotation {
+	if x != nil {
+		return x.Annotations
+	}
+	return nil
+}
+
+type UpdateFeedbackRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	FileId      *string                `protobuf:"bytes,2,opt,name=file_id,json=fileId,proto3,oneof" json:"file_id,omitempty"`
+	Comment     *string                `protobuf:"bytes,3,opt,name=comment,proto3,oneof" json:"comment,omitempty"`
+	Attachments *AttachmentList        `protobuf:"bytes,4,opt,name=attachments,proto3" json:"attachments,omitempty"`
+	Score       *float64               `protobuf:"fixed64,5,opt,name=score,proto3,oneof" json:"score,omitempty"`
+	MaxScore    *float64               `protobuf:"fixed64,6,opt,name=max_score,json=maxScore,proto3,oneof" json:"max_score,omitempty"`
+	Rubric      *Rubric                `protobuf:"bytes,7,opt,name=rubric,proto3" json:"rubric,omitempty"`
+	// Unspecified keeps the current verdict.
+	Verdict       FeedbackVerdict `protobuf:"varint,8,opt,name=verdict,proto3,enum=homework.v1.FeedbackVerdict" json:"verdict,omitempty"`
+	Annotations   *AnnotationList `protobuf:"bytes,9,opt,name=annotations,proto3" json:"annotations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateFeedbackRequest) Reset() {
+	*x = UpdateFeedbackRequest{}
+	mi := &file_my_proto_homework_service_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateFeedbackRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateFeedbackRequest) ProtoMessage() {}
+
+func (x *UpdateFeedbackRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_my_proto_homework_service_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateFeedbackRequest.ProtoReflect.Descriptor instead.
+func (*UpdateFeedbackRequest) Descriptor() ([]byte, []int) {
+	return file_my_proto_homework_service_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *UpdateFeedbackRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateFeedbackRequest) GetFileId() string {
+	if x != nil && x.FileId != nil {
+		return *x.FileId
+	}
+	return ""
+}
+
+func (x *UpdateFeedbackRequest) GetComment() string {
+	if x != nil && x.Comment != nil {
+		return *x.Comment
+	}
+	return ""
+}
+
+func (x *UpdateFeedbackRequest) GetAttachments() *AttachmentList {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
+}
+
+func (x *UpdateFeedbackRequest) GetScore() float64 {
+	if x != nil && x.Score != nil {
+		return *x.Score
+	}
+	return 0
+}
+
+func (x *UpdateFeedbackRequest) GetMaxScore() float64 {
+	if x != nil && x.MaxScore != nil {
+		return *x.MaxScore
+	}
+	return 0
+}
+
+func (x *UpdateFeedbackRequest) GetRubric() *Rubric {
+	if x != nil {
+		return x.Rubric
+	}
+	return nil
+}
+
+func (x *UpdateFeedbackRequest) GetVerdict() FeedbackVerdict {
+	if x != nil {
+		return x.Verdict
+	}
+	return FeedbackVerdict_FEEDBACK_VERDICT_UNSPECIFIED
+}
+
+func (x *UpdateFeedbackRequest) GetAnnotations() *AnnotationList {
+	if x != nil {
+		return x.Annotations
+	}
+	return nil
+}
+
+// Draws the annotations of the feedback onto a copy of a submission file: a PNG for
+// images, a PDF for PDF files. The copy is stored as a file of the caller.
+type FlattenFeedbackAnnotationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FeedbackId    string                 `protobuf:"bytes,1,opt,name=feedback_id,json=feedbackId,proto3" json:"feedback_id,omitempty"`
+	FileId        string                 `protobuf:"bytes,2,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FlattenFeedbackAnnotationsRequest) Reset() {
+	*x = FlattenFeedbackAnnotationsRequest{}
+	mi := &file_my_proto_homework_service_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FlattenFeedbackAnnotationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlattenFeedbackAnnotationsRequest) ProtoMessage() {}
+
+func (x *FlattenFeedbackAnnotationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_my_proto_homework_service_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlattenFeedbackAnnotationsRequest.ProtoReflect.Descriptor instead.
+func (*FlattenFeedbackAnnotationsRequest) Descriptor() ([]byte, []int) {
+	return file_my_proto_homework_service_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *FlattenFeedbackAnnotationsRequest) GetFeedbackId() string {
+	if x != nil {
+		return x.FeedbackId
+	}
+	return ""
+}
+
+func (x *FlattenFeedbackAnnotationsRequest) GetFileId() string {
+	if x != nil {
+		return x.FileId
+	}
+	return ""
+}
+
+type ListFeedbacksByAssignmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AssignmentId  string                 `protobuf:"bytes,1,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFeedbacksByAssignmentRequest) Reset() {
+	*x = ListFeedbacksByAssignmentRequest{}
+	mi := &file_my_proto_homework_service_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFeedbacksByAssignmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFeedbacksByAssignmentRequest) ProtoMessage() {}
+
+func (x *ListFeedbacksByAssignmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_my_proto_homework_service_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFeedbacksByAssignmentRequest.ProtoReflect.Descriptor instead.
+func (*ListFeedbacksByAssignmentRequest) Descriptor() ([]byte, []int) {
+	return file_my_proto_homework_service_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ListFeedbacksByAssignmentRequest) GetAssignmentId() string {
+	if x != nil {
+		return x.AssignmentId
+	}
+	return ""
+}
+
+type ListFeedbacksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Feedbacks     []*Feedback            `protobuf:"bytes,1,rep,name=feedbacks,proto3" json:"feedbacks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFeedbacksResponse) Reset() {
+	*x = ListFeedbacksResponse{}
+	mi := &file_my_proto_homework_service_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFeedbacksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFeedbacksResponse) ProtoMessage() {}
+
+func (x *ListFeedbacksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_my_proto_homework_service_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFeedbacksResponse.ProtoReflect.Descriptor instead.
+func (*ListFeedbacksResponse) Descriptor() ([]byte, []int) {
+	return file_my_proto_homework_service_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ListFeedbacksResponse) GetFeedbacks() []*Feedback {
+	if x != nil {
+		return x.Feedbacks
+	}
+	return nil
+}
+
+type GetGradebookRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TutorId       string                 `protobuf:"bytes,1,opt,name=tutor_id,json=tutorId,proto3" json:"tutor_id,omitempty"`
+	StudentId     string                 `protobuf:"bytes,2,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	From          *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=from,proto3,oneof" json:"from,omitempty"`
+	To            *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=to,proto3,oneof" json:"to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGradebookRequest) Reset() {
+	*x = GetGradebookRequest{}
+	mi := &file_my_proto_homework_service_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGradebookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGradebookRequest) ProtoMessage() {}
+
+func (x *GetGradebookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_my_proto_homework_service_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGradebookRequest.ProtoReflect.Descriptor instead.
+func (*GetGradebookRequest) Descriptor() ([]byte, []int) {
+	return file_my_proto_homework_service_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GetGradebookRequest) GetTutorId() string {
+	if x != nil {
+		return x.TutorId
+	}
+	return ""
+}
+
+func (x *GetGradebookRequest) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *GetGradebookRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *GetGradebookRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+// The latest scored feedback of an assignment.
+type GradebookEntry struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	AssignmentId string                 `protobuf:"bytes,1,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+	Title        *string                `protobuf:"bytes,2,opt,name=title,proto3,oneof" json:"title,omitempty"`
+	DueDate      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=due_date,json=dueDate,proto3,oneof" json:"due_date,omitempty"`
+	FeedbackId   string                 `protobuf:"bytes,4,opt,name=feedback_id,json=feedbackId,proto3" json:"feedback_id,omitempty"`
+	GradedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=graded_at,json=gradedAt,proto3" json:"graded_at,omitempty"`
+	Score        float64                `protobuf:"fixed64,6,opt,name=score,proto3" json:"score,omitempty"`
+	MaxScore     *float64               `protobuf:"fixed64,7,opt,name=max_score,json=maxScore,proto3,oneof" json:"max_score,omitempty"`
+	Percent      *float64               `protobuf:"fixed64,8,opt,name=percent,proto3,oneof" json:"percent,omitempty"`
+	Rubric       []*RubricCriterion     `protobuf:"bytes,9,rep,name=rubric,proto3" json:"rubric,omitempty"`
+	// The graded submission was flagged as late.
+	IsLate        bool `protobuf:"varint,10,opt,name=is_late,json=isLate,proto3" json:"is_late,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GradebookEntry) Reset() {
+	*x = GradebookEntry{}
+	mi := &file_my_proto_homework_service_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GradebookEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GradebookEntry) ProtoMessage() {}
+
+func (x *GradebookEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_my_proto_homework_service_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GradebookEntry.ProtoReflect.Descriptor instead.
+func (*GradebookEntry) Descriptor() ([]byte, []int) {
+	return file_my_proto_homework_service_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *GradebookEntry) GetAssignmentId() string {
+	if x != nil {
+		return x.AssignmentId
+	}
+	return ""
+}
+
+func (x *GradebookEntry) GetTitle() string {
+	if x != nil && x.Title != nil {
+		return *x.Title
+	}
+	return ""
+}
+
+func (x *GradebookEntry) GetDueDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueDate
+	}
+	return nil
+}
+
+func (x *GradebookEntry) GetFeedbackId() string {
+	if x != nil {
+		return x.FeedbackId
+	}
+	return ""
+}
+
+func (x *GradebookEntry) GetGradedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.GradedAt
+	}
+	return nil
+}
+
+func (x *GradebookEntry) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *GradebookEntry) GetMaxScore() float64 {
+	if x != nil && x.MaxScore != nil {
+		return *x.MaxScore
+	}
+	return 0
+}
+
+func (x *GradebookEntry) GetPercent() float64 {
+	if x != nil && x.Percent != nil {
+		return *x.Percent
+	}
+	return 0
+}
+
+func (x *GradebookEntry) GetRubric() []*RubricCriterion {
+	if x != nil {
+		return x.Rubric
+	}
+	return nil
+}
+
+func (x *GradebookEntry) GetIsLate() bool {
+	if x != nil {
+		return x.IsLate
+	}
+	return false
+}
+
+type CriterionAverage struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Name           string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Count          int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	AverageScore   float64                `protobuf:"fixed64,3,opt,name=average_score,json=averageScore,proto3" json:"average_score,omitempty"`
+	AveragePercent float64                `protobuf:"fixed64,4,opt,name=average_percent,json=averagePercent,proto3" json:"average_percent,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CriterionAverage) Reset() {
+	*x = CriterionAverage{}
+	mi := &file_my_proto_homework_service_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CriterionAverage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CriterionAverage) ProtoMessage() {}
+
+func (x *CriterionAverage) ProtoReflect() protoreflect.Message {
+	mi := &file_my_proto_homework_service_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CriterionAverage.ProtoReflect.Descriptor instead.
+func (*CriterionAverage) Descriptor() ([]byte, []int) {
+	return file_my_proto_homework_service_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *CriterionAverage) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CriterionAverage) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *CriterionAverage) GetAverageScore() float64 {
+	if x != nil {
+		return x.AverageScore
+	}
+	return 0
+}
+
+func (x *CriterionAverage) GetAveragePercent() float64 {
+	if x != nil {
+		return x.AveragePercent
+	}
+	return 0
+}
+
+type Gradebook struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	TutorId        string                 `protobuf:"bytes,1,opt,name=tutor_id,json=tutorId,proto3" json:"tutor_id,omitempty"`
+	StudentId      string                 `protobuf:"bytes,2,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	Entries        []*GradebookEntry      `protobuf:"bytes,3,rep,name=entries,proto3" json:"entries,omitempty"`
+	AverageScore   *float64               `protobuf:"fixed64,4,opt,name=average_score,json=averageScore,proto3,oneof" json:"average_score,omitempty"`
+	AveragePercent *float64               `protobuf:"fixed64,5,opt,name=average_percent,json=averagePercent,proto3,oneof" json:"average_percent,omitempty"`
+	// Least squares slope of the percentage over graded assignments,
+	// in percentage points per assignment.
+	Trend    *float64            `protobuf:"fixed64,6,opt,name=trend,proto3,oneof" json:"trend,omitempty"`
+	Criteria []*CriterionAverage `protobuf:"bytes,7,rep,name=criteria,proto3" json:"criteria,omitempty"`
+	// Number of entries whose graded submission was late.
+	LateCount     int32 `protobuf:"varint,8,opt,name=late_count,json=lateCount,proto3" json:"late_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Gradebook) Reset() {
+	*x = Gradebook{}
+	mi := &file_my_proto_homework_service_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Gradebook) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Gradebook) ProtoMessage() {}
+
+func (x *Gradebook) ProtoReflect() protoreflect.Message {
+	mi := &file_my_proto_homework_service_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Gradebook.ProtoReflect.Descriptor instead.
+func (*Gradebook) Descriptor() ([]byte, []int) {
+	return file_my_proto_homework_service_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *Gradebook) GetTutorId() string {
+	if x != nil {
+		return x.TutorId
+	}
+	return ""
+}
+
+func (x *Gradebook) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *Gradebook) GetEntries() []*GradebookEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *Gradebook) GetAverageScore() float64 {
+	if x != nil && x.AverageScore != nil {
+		return *x.AverageScore
+	}
+	return 0
+}
+
+func (x *Gradebook) GetAveragePercent() float64 {
+	if x != nil && x.AveragePercent != nil {
+		return *x.AveragePercent
+	}
+	return 0
+}
+
+func (x *Gradebook) GetTrend() float64 {
+	if x != nil && x.Trend != nil {
+		return *x.Trend
+	}
+	return 0
+}
+
+func (x *Gradebook) GetCriteria() []*CriterionAverage {
+	if x != nil {
+		return x.Criteria
+	}
+	return nil
+}
+
+func (x *Gradebook) GetLateCount() int32 {
+	if x != nil {
+		return x.LateCount
+	}
+	return 0
+}
+
+// Stats over the tutor's published assignments created in [from, to).
+type GetHomeworkStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TutorId       string                 `protobuf:"bytes,1,opt,name=tutor_id,json=tutorId,proto3" json:"tutor_id,omitempty"`
+	From          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=from,proto3,oneof" json:"from,omitempty"`
+	To            *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=to,proto3,oneof" json:"to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHomeworkStatsRequest) Reset() {
+	*x = GetHomeworkStatsRequest{}
+	mi := &file_my_proto_homework_service_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHomeworkStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHomeworkStatsRequest) ProtoMessage() {}
+
+func (x *GetHomeworkStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_my_proto_homework_service_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHomeworkStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetHomeworkStatsRequest) Descriptor() ([]byte, []int) {
+	return file_my_proto_homework_service_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *GetHomeworkStatsRequest) GetTutorId() string {
+	if x != nil {
+		return x.TutorId
+	}
+	return ""
+}
+
+func (x *GetHomeworkStatsRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *GetHomeworkStatsRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+type StudentHomeworkStats struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	StudentId string                 `protobuf:"bytes,1,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	Assigned  int32                  `protobuf:"varint,2,opt,name=assigned,proto3" json:"assigned,omitempty"`
+	// Assignments with at least one submission.
+	Submitted int32 `protobuf:"varint,3,opt,name=submitted,proto3" json:"submitted,omitempty"`
+	// Assignments that are submitted or whose deadline has passed.
+	Due int32 `protobuf:"varint,4,opt,name=due,proto3" json:"due,omitempty"`
+	// Assignments first submitted before the due date plus the grace period, or without a due date.
+	OnTime int32 `protobuf:"varint,5,opt,name=on_time,json=onTime,proto3" json:"on_time,omitempty"`
+	// submitted / assigned, unset without assignments.
+	CompletionRate *float64 `protobuf:"fixed64,6,opt,name=completion_rate,json=completionRate,proto3,oneof" json:"completion_rate,omitempty"`
+	// on_time / due, unset without due assignments.
+	OnTimeRate *float64 `protobuf:"fixed64,7,opt,name=on_time_rate,json=onTimeRate,proto3,oneof" json:"on_time_rate,omitempty"`
+	// Current UNREVIEWED assignments, regardless of the period.
+	Unreviewed    int32 `protobuf:"varint,8,opt,name=unreviewed,proto3" json:"unreviewed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StudentHomeworkStats) Reset() {
+	*x = StudentHomeworkStats{}
+	mi := &file_my_proto_homework_service_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StudentHomeworkStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StudentHomeworkStats) ProtoMessage() {}
+
+func (x *StudentHomeworkStats) ProtoReflect() protoreflect.Message {
+	mi := &file_my_proto_homework_service_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StudentHomeworkStats.ProtoReflect.Descriptor instead.
+func (*StudentHomeworkStats) Descriptor() ([]byte, []int) {
+	return file_my_proto_homework_service_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *StudentHomeworkStats) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *StudentHomeworkStats) GetAssigned() int32 {
+	if x != nil {
+		return x.Assigned
+	}
+	return 0
+}
+
+func (x *StudentHomeworkStats) GetSubmitted() int32 {
+	if x != nil {
+		return x.Submitted
+	}
+	return 0
+}
+
+func (x *StudentHomeworkStats) GetDue() int32 {
+	if x != nil {
+		return x.Due
+	}
+	return 0
+}
+
+func (x *StudentHomeworkStats) GetOnTime() int32 {
+	if x != nil {
+		return x.OnTime
+	}
+	return 0
+}
+
+func (x *StudentHomeworkStats) GetCompletionRate() float64 {
+	if x != nil && x.CompletionRate != nil {
+		return *x.CompletionRate
+	}
+	return 0
+}
+
+func (x *StudentHomeworkStats) GetOnTimeRate() float64 {
+	if x != nil && x.OnTimeRate != nil {
+		return *x.OnTimeRate
+	}
+	return 0
+}
+
+func (x *StudentHomeworkStats) GetUnreviewed() int32 {
+	if x != nil {
+		return x.Unreviewed
+	}
+	return 0
+}
+
+type HomeworkStats struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	TutorId string                 `protobuf:"bytes,1,opt,name=tutor_id,json=tutorId,proto3" json:"tutor_id,omitempty"`
+	// Ordered by completion rate, lowest first.
+	Students []*StudentHomeworkStats `protobuf:"bytes,2,rep,name=students,proto3" json:"students,omitempty"`
+	// Median time from a submission to its first feedback, unset if nothing is reviewed.
+	MedianReviewTurnaroundSeconds *int64 `protobuf:"varint,3,opt,name=median_review_turnaround_seconds,json=medianReviewTurnaroundSeconds,proto3,oneof" json:"median_review_turnaround_seconds,omitempty"`
+	// Number of reviewed submissions the median is taken over.
+	ReviewedCount int32 `protobuf:"varint,4,opt,name=reviewed_count,json=reviewedCount,proto3" json:"reviewed_count,omitempty"`
+	// Current UNREVIEWED assignments of the tutor, regardless of the period.
+	UnreviewedCount int32 `protobuf:"varint,5,opt,name=unreviewed_count,json=unreviewedCount,proto3" json:"unreviewed_count,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *HomeworkStats) Reset() {
+	*x = HomeworkStats{}
+	mi := &file_my_proto_homework_service_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HomeworkStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HomeworkStats) ProtoMessage() {}
+
+func (x *HomeworkStats) ProtoReflect() protoreflect.Message {
+	mi := &file_my_proto_homework_service_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HomeworkStats.ProtoReflect.Descriptor instead.
+func (*HomeworkStats) Descriptor() ([]byte, []int) {
+	return file_my_proto_homework_service_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *HomeworkStats) GetTutorId() string {
+	if x != nil {
+		return x.TutorId
+	}
+	return ""
+}
+
+func (x *HomeworkStats) GetStudents() []*StudentHomeworkStats {
+	if x != nil {
+		return x.Students
+	}
+	return nil
+}
+
+func (x *HomeworkStats) GetMedianReviewTurnaroundSeconds() int64 {
+	if x != nil && x.MedianReviewTurnaroundSeconds != nil {
+		return *x.MedianReviewTurnaroundSeconds
+	}
+	return 0
+}
+
+func (x *HomeworkStats) GetReviewedCount() int32 {
+	if x != nil {
+		return x.ReviewedCount
+	}
+	return 0
+}
+
+func (x *HomeworkStats) GetUnreviewedCount() int32 {
+	if x != nil {
+		return x.UnreviewedCount
+	}
+	return 0
+}
+
+type GetAssignmentFileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AssignmentId  string                 `protobuf:"bytes,1,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAssignmentFileRequest) Reset() {
+	*x = GetAssignmentFileRequest{}
+	mi := &file_my_proto_homework_service_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAssignmentFileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAssignmentFileRequest) ProtoMessage() {}
+
+func (x *GetAssignmentFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_my_proto_homework_service_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAssignmentFileRequest.ProtoReflect.Descriptor instead.
+func (*GetAssignmentFileRequest) Descriptor() ([]byte, []int) {
+	return file_my_proto_homework_service_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *GetAssignmentFileRequest) GetAssignmentId() string {
+	if x != nil {
+		return x.AssignmentId
+	}
+	return ""
+}
+
+type GetSubmissionFileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SubmissionId  string                 `protobuf:"bytes,1,opt,name=submission_id,json=submissionId,proto3" json:"submission_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSubmissionFileRequest) Reset() {
+	*x = GetSubmissionFileRequest{}
+	mi := &file_my_proto_homework_service_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSubmissionFileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSubmissionFileRequest) ProtoMessage() {}
+
+func (x *GetSubmissionFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_my_proto_homework_service_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSubmissionFileRequest.ProtoReflect.Descriptor instead.
+func (*GetSubmissionFileRequest) Descriptor() ([]byte, []int) {
+	return file_my_proto_homework_service_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *GetSubmissionFileRequest) GetSubmissionId() string {
+	if x != nil {
+		return x.SubmissionId
+	}
+	return ""
+}
+
+type GetFeedbackFileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FeedbackId    string                 `protobuf:"bytes,1,opt,name=feedback_id,json=feedbackId,proto3" json:"feedback_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFeedbackFileRequest) Reset() {
+	*x = GetFeedbackFileRequest{}
+	mi := &file_my_proto_homework_service_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFeedbackFileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFeedbackFileRequest) ProtoMessage() {}
+
+func (x *GetFeedbackFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_my_proto_homework_service_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFeedbackFileRequest.ProtoReflect.Descriptor instead.
+func (*GetFeedbackFileRequest) Descriptor() ([]byte, []int) {
+	return file_my_proto_homework_service_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *GetFeedbackFileRequest) GetFeedbackId() string {
+	if x != nil {
+		return x.FeedbackId
+	}
+	return ""
+}
+
+type HomeworkFileURL struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HomeworkFileURL) Reset() {
+	*x = HomeworkFileURL{}
+	mi := &file_my_proto_homework_service_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Empty) String() string {
+func (x *HomeworkFileURL) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Empty) ProtoMessage() {}
+func (*HomeworkFileURL) ProtoMessage() {}
 
-func (x *Empty) ProtoReflect() protoreflect.Message {
-	mi := &file_my_proto_homework_service_proto_msgTypes[0]
+func (x *HomeworkFileURL) ProtoReflect() protoreflect.Message {
+	mi := &file_my_proto_homework_service_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -108,33 +4081,41 @@ func (x *Empty) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Empty.ProtoReflect.Descriptor instead.
-func (*Empty) Descriptor() ([]byte, []int) {
-	return file_my_proto_homework_service_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use HomeworkFileURL.ProtoReflect.Descriptor instead.
+func (*HomeworkFileURL) Descriptor() ([]byte, []int) {
+	return file_my_proto_homework_service_proto_rawDescGZIP(), []int{53}
 }
 
-type DeleteAssignmentRequest struct {
+func (x *HomeworkFileURL) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+type ListAttachmentFileURLsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	AssignmentId  string                 `protobuf:"bytes,1,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+	OwnerType     AttachmentOwnerType    `protobuf:"varint,1,opt,name=owner_type,json=ownerType,proto3,enum=homework.v1.AttachmentOwnerType" json:"owner_type,omitempty"`
+	OwnerId       string                 `protobuf:"bytes,2,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteAssignmentRequest) Reset() {
-	*x = DeleteAssignmentRequest{}
-	mi := &file_my_proto_homework_service_proto_msgTypes[1]
+func (x *ListAttachmentFileURLsRequest) Reset() {
+	*x = ListAttachmentFileURLsRequest{}
+	mi := &file_my_proto_homework_service_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteAssignmentRequest) String() string {
+func (x *ListAttachmentFileURLsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteAssignmentRequest) ProtoMessage() {}
+func (*ListAttachmentFileURLsRequest) ProtoMessage() {}
 
-func (x *DeleteAssignmentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_my_proto_homework_service_proto_msgTypes[1]
+func (x *ListAttachmentFileURLsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_my_proto_homework_service_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -145,45 +4126,50 @@ func (x *DeleteAssignmentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteAssignmentRequest.ProtoReflect.Descriptor instead.
-func (*DeleteAssignmentRequest) Descriptor() ([]byte, []int) {
-	return file_my_proto_homework_service_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use ListAttachmentFileURLsRequest.ProtoReflect.Descriptor instead.
+func (*ListAttachmentFileURLsRequest) Descriptor() ([]byte, []int) {
+	return file_my_proto_homework_service_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *DeleteAssignmentRequest) GetAssignmentId() string {
+func (x *ListAttachmentFileURLsRequest) GetOwnerType() AttachmentOwnerType {
 	if x != nil {
-		return x.AssignmentId
+		return x.OwnerType
+	}
+	return AttachmentOwnerType_ATTACHMENT_OWNER_TYPE_UNSPECIFIED
+}
+
+func (x *ListAttachmentFileURLsRequest) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
 	}
 	return ""
 }
 
-type CreateAssignmentRequest struct {
+type AttachmentFileURL struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	TutorId       string                 `protobuf:"bytes,1,opt,name=tutor_id,json=tutorId,proto3" json:"tutor_id,omitempty"`
-	StudentId     string                 `protobuf:"bytes,2,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
-	Title         *string                `protobuf:"bytes,3,opt,name=title,proto3,oneof" json:"title,omitempty"`
-	Description   *string                `protobuf:"bytes,4,opt,name=description,proto3,oneof" json:"description,omitempty"`
-	FileId        *string                `protobuf:"bytes,5,opt,name=file_id,json=fileId,proto3,oneof" json:"file_id,omitempty"`
-	DueDate       *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=due_date,json=dueDate,proto3,oneof" json:"due_date,omitempty"`
+	FileId        string                 `protobuf:"bytes,1,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"`
+	Caption       *string                `protobuf:"bytes,2,opt,name=caption,proto3,oneof" json:"caption,omitempty"`
+	Position      int32                  `protobuf:"varint,3,opt,name=position,proto3" json:"position,omitempty"`
+	Url           string                 `protobuf:"bytes,4,opt,name=url,proto3" json:"url,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateAssignmentRequest) Reset() {
-	*x = CreateAssignmentRequest{}
-	mi := &file_my_proto_homework_service_proto_msgTypes[2]
+func (x *AttachmentFileURL) Reset() {
+	*x = AttachmentFileURL{}
+	mi := &file_my_proto_homework_service_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateAssignmentRequest) String() string {
+func (x *AttachmentFileURL) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateAssignmentRequest) ProtoMessage() {}
+func (*AttachmentFileURL) ProtoMessage() {}
 
-func (x *CreateAssignmentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_my_proto_homework_service_proto_msgTypes[2]
+func (x *AttachmentFileURL) ProtoReflect() protoreflect.Message {
+	mi := &file_my_proto_homework_service_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -194,79 +4180,109 @@ func (x *CreateAssignmentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateAssignmentRequest.ProtoReflect.Descriptor instead.
-func (*CreateAssignmentRequest) Descriptor() ([]byte, []int) {
-	return file_my_proto_homework_service_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use AttachmentFileURL.ProtoReflect.Descriptor instead.
+func (*AttachmentFileURL) Descriptor() ([]byte, []int) {
+	return file_my_proto_homework_service_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *CreateAssignmentRequest) GetTutorId() string {
+func (x *AttachmentFileURL) GetFileId() string {
 	if x != nil {
-		return x.TutorId
+		return x.FileId
 	}
 	return ""
 }
 
-func (x *CreateAssignmentRequest) GetStudentId() string {
-	if x != nil {
-		return x.StudentId
+func (x *AttachmentFileURL) GetCaption() string {
+	if x != nil && x.Caption != nil {
+		return *x.Caption
 	}
 	return ""
 }
 
-func (x *CreateAssignmentRequest) GetTitle() string {
-	if x != nil && x.Title != nil {
-		return *x.Title
+func (x *AttachmentFileURL) GetPosition() int32 {
+	if x != nil {
+		return x.Position
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateAssignmentRequest) GetDescription() string {
-	if x != nil && x.Description != nil {
-		return *x.Description
+func (x *AttachmentFileURL) GetUrl() string {
+	if x != nil {
+		return x.Url
 	}
 	return ""
 }
 
-func (x *CreateAssignmentRequest) GetFileId() string {
-	if x != nil && x.FileId != nil {
-		return *x.FileId
+type ListAttachmentFileURLsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Attachments   []*AttachmentFileURL   `protobuf:"bytes,1,rep,name=attachments,proto3" json:"attachments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAttachmentFileURLsResponse) Reset() {
+	*x = ListAttachmentFileURLsResponse{}
+	mi := &file_my_proto_homework_service_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAttachmentFileURLsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAttachmentFileURLsResponse) ProtoMessage() {}
+
+func (x *ListAttachmentFileURLsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_my_proto_homework_service_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *CreateAssignmentRequest) GetDueDate() *timestamppb.Timestamp {
+// Deprecated: Use ListAttachmentFileURLsResponse.ProtoReflect.Descriptor instead.
+func (*ListAttachmentFileURLsResponse) Descriptor() ([]byte, []int) {
+	return file_my_proto_homework_service_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ListAttachmentFileURLsResponse) GetAttachments() []*AttachmentFileURL {
 	if x != nil {
-		return x.DueDate
+		return x.Attachments
 	}
 	return nil
 }
 
-type UpdateAssignmentRequest struct {
+type Attachment struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title         *string                `protobuf:"bytes,2,opt,name=title,proto3,oneof" json:"title,omitempty"`
-	Description   *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
-	FileId        *string                `protobuf:"bytes,4,opt,name=file_id,json=fileId,proto3,oneof" json:"file_id,omitempty"`
-	DueDate       *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=due_date,json=dueDate,proto3,oneof" json:"due_date,omitempty"`
+	FileId        string                 `protobuf:"bytes,2,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"`
+	Caption       *string                `protobuf:"bytes,3,opt,name=caption,proto3,oneof" json:"caption,omitempty"`
+	Position      int32                  `protobuf:"varint,4,opt,name=position,proto3" json:"position,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateAssignmentRequest) Reset() {
-	*x = UpdateAssignmentRequest{}
-	mi := &file_my_proto_homework_service_proto_msgTypes[3]
+func (x *Attachment) Reset() {
+	*x = Attachment{}
+	mi := &file_my_proto_homework_service_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateAssignmentRequest) String() string {
+func (x *Attachment) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateAssignmentRequest) ProtoMessage() {}
+func (*Attachment) ProtoMessage() {}
 
-func (x *UpdateAssignmentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_my_proto_homework_service_proto_msgTypes[3]
+func (x *Attachment) ProtoReflect() protoreflect.Message {
+	mi := &file_my_proto_homework_service_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -277,69 +4293,293 @@ func (x *UpdateAssignmentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateAssignmentRequest.ProtoReflect.Descriptor instead.
-func (*UpdateAssignmentRequest) Descriptor() ([]byte, []int) {
-	return file_my_proto_homework_service_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use Attachment.ProtoReflect.Descriptor instead.
+func (*Attachment) Descriptor() ([]byte, []int) {
+	return file_my_proto_homework_service_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *UpdateAssignmentRequest) GetId() string {
+func (x *Attachment) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *UpdateAssignmentRequest) GetTitle() string {
+func (x *Attachment) GetFileId() string {
+	if x != nil {
+		return x.FileId
+	}
+	return ""
+}
+
+func (x *Attachment) GetCaption() string {
+	if x != nil && x.Caption != nil {
+		return *x.Caption
+	}
+	return ""
+}
+
+func (x *Attachment) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *Attachment) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type Assignment struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Id                  string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TutorId             string                 `protobuf:"bytes,2,opt,name=tutor_id,json=tutorId,proto3" json:"tutor_id,omitempty"`
+	StudentId           string                 `protobuf:"bytes,3,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	Title               *string                `protobuf:"bytes,4,opt,name=title,proto3,oneof" json:"title,omitempty"`
+	Description         *string                `protobuf:"bytes,5,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	FileId              *string                `protobuf:"bytes,6,opt,name=file_id,json=fileId,proto3,oneof" json:"file_id,omitempty"`
+	DueDate             *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=due_date,json=dueDate,proto3,oneof" json:"due_date,omitempty"`
+	CreatedAt           *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	EditedAt            *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=edited_at,json=editedAt,proto3" json:"edited_at,omitempty"`
+	Attachments         []*Attachment          `protobuf:"bytes,10,rep,name=attachments,proto3" json:"attachments,omitempty"`
+	LessonId            *string                `protobuf:"bytes,11,opt,name=lesson_id,json=lessonId,proto3,oneof" json:"lesson_id,omitempty"`
+	DueBeforeNextLesson bool                   `protobuf:"varint,12,opt,name=due_before_next_lesson,json=dueBeforeNextLesson,proto3" json:"due_before_next_lesson,omitempty"`
+	// Lesson whose start is the current due date.
+	DueLessonId        *string         `protobuf:"bytes,13,opt,name=due_lesson_id,json=dueLessonId,proto3,oneof" json:"due_lesson_id,omitempty"`
+	Quiz               []*QuizQuestion `protobuf:"bytes,14,rep,name=quiz,proto3" json:"quiz,omitempty"`
+	LatePolicy         LatePolicy      `protobuf:"varint,15,opt,name=late_policy,json=latePolicy,proto3,enum=homework.v1.LatePolicy" json:"late_policy,omitempty"`
+	GracePeriodSeconds *int64          `protobuf:"varint,16,opt,name=grace_period_seconds,json=gracePeriodSeconds,proto3,oneof" json:"grace_period_seconds,omitempty"`
+	// The latest submission was flagged as late.
+	SubmittedLate bool                   `protobuf:"varint,17,opt,name=submitted_late,json=submittedLate,proto3" json:"submitted_late,omitempty"`
+	State         AssignmentState        `protobuf:"varint,18,opt,name=state,proto3,enum=homework.v1.AssignmentState" json:"state,omitempty"`
+	PublishAt     *timestamppb.Timestamp `protobuf:"bytes,19,opt,name=publish_at,json=publishAt,proto3,oneof" json:"publish_at,omitempty"`
+	PublishedAt   *timestamppb.Timestamp `protobuf:"bytes,20,opt,name=published_at,json=publishedAt,proto3,oneof" json:"published_at,omitempty"`
+	Checklist     []*ChecklistItem       `protobuf:"bytes,21,rep,name=checklist,proto3" json:"checklist,omitempty"`
+	// Percentage of done checklist items, unset without a checklist.
+	ChecklistProgress *int32 `protobuf:"varint,22,opt,name=checklist_progress,json=checklistProgress,proto3,oneof" json:"checklist_progress,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Assignment) Reset() {
+	*x = Assignment{}
+	mi := &file_my_proto_homework_service_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Assignment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Assignment) ProtoMessage() {}
+
+func (x *Assignment) ProtoReflect() protoreflect.Message {
+	mi := &file_my_proto_homework_service_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Assignment.ProtoReflect.Descriptor instead.
+func (*Assignment) Descriptor() ([]byte, []int) {
+	return file_my_proto_homework_service_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *Assignment) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Assignment) GetTutorId() string {
+	if x != nil {
+		return x.TutorId
+	}
+	return ""
+}
+
+func (x *Assignment) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *Assignment) GetTitle() string {
 	if x != nil && x.Title != nil {
 		return *x.Title
 	}
 	return ""
 }
 
-func (x *UpdateAssignmentRequest) GetDescription() string {
+func (x *Assignment) GetDescription() string {
 	if x != nil && x.Description != nil {
 		return *x.Description
 	}
 	return ""
 }
 
-func (x *UpdateAssignmentRequest) GetFileId() string {
-	if x != nil && x.FileId != nil {
-		return *x.FileId
+func (x *Assignment) GetFileId() string {
+	if x != nil && x.FileId != nil {
+		return *x.FileId
+	}
+	return ""
+}
+
+func (x *Assignment) GetDueDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueDate
+	}
+	return nil
+}
+
+func (x *Assignment) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Assignment) GetEditedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EditedAt
+	}
+	return nil
+}
+
+func (x *Assignment) GetAttachments() []*Attachment {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
+}
+
+func (x *Assignment) GetLessonId() string {
+	if x != nil && x.LessonId != nil {
+		return *x.LessonId
+	}
+	return ""
+}
+
+func (x *Assignment) GetDueBeforeNextLesson() bool {
+	if x != nil {
+		return x.DueBeforeNextLesson
+	}
+	return false
+}
+
+func (x *Assignment) GetDueLessonId() string {
+	if x != nil && x.DueLessonId != nil {
+		return *x.DueLessonId
+	}
+	return ""
+}
+
+func (x *Assignment) GetQuiz() []*QuizQuestion {
+	if x != nil {
+		return x.Quiz
+	}
+	return nil
+}
+
+func (x *Assignment) GetLatePolicy() LatePolicy {
+	if x != nil {
+		return x.LatePolicy
+	}
+	return LatePolicy_LATE_POLICY_UNSPECIFIED
+}
+
+func (x *Assignment) GetGracePeriodSeconds() int64 {
+	if x != nil && x.GracePeriodSeconds != nil {
+		return *x.GracePeriodSeconds
+	}
+	return 0
+}
+
+func (x *Assignment) GetSubmittedLate() bool {
+	if x != nil {
+		return x.SubmittedLate
+	}
+	return false
+}
+
+func (x *Assignment) GetState() AssignmentState {
+	if x != nil {
+		return x.State
+	}
+	return AssignmentState_ASSIGNMENT_STATE_UNSPECIFIED
+}
+
+func (x *Assignment) GetPublishAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PublishAt
+	}
+	return nil
+}
+
+func (x *Assignment) GetPublishedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PublishedAt
 	}
-	return ""
+	return nil
 }
 
-func (x *UpdateAssignmentRequest) GetDueDate() *timestamppb.Timestamp {
+func (x *Assignment) GetChecklist() []*ChecklistItem {
 	if x != nil {
-		return x.DueDate
+		return x.Checklist
 	}
 	return nil
 }
 
-type ListAssignmentsByTutorRequest struct {
-	state         protoimpl.MessageState   `protogen:"open.v1"`
-	TutorId       string                   `protobuf:"bytes,1,opt,name=tutor_id,json=tutorId,proto3" json:"tutor_id,omitempty"`
-	StatusFilter  []AssignmentStatusFilter `protobuf:"varint,2,rep,packed,name=status_filter,json=statusFilter,proto3,enum=homework.v1.AssignmentStatusFilter" json:"status_filter,omitempty"`
+func (x *Assignment) GetChecklistProgress() int32 {
+	if x != nil && x.ChecklistProgress != nil {
+		return *x.ChecklistProgress
+	}
+	return 0
+}
+
+// A deleted comment is returned without body and attachments to keep its replies in place.
+type Comment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AssignmentId  string                 `protobuf:"bytes,2,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+	SubmissionId  *string                `protobuf:"bytes,3,opt,name=submission_id,json=submissionId,proto3,oneof" json:"submission_id,omitempty"`
+	ParentId      *string                `protobuf:"bytes,4,opt,name=parent_id,json=parentId,proto3,oneof" json:"parent_id,omitempty"`
+	AuthorId      string                 `protobuf:"bytes,5,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	Body          string                 `protobuf:"bytes,6,opt,name=body,proto3" json:"body,omitempty"`
+	Attachments   []*Attachment          `protobuf:"bytes,7,rep,name=attachments,proto3" json:"attachments,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	EditedAt      *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=edited_at,json=editedAt,proto3" json:"edited_at,omitempty"`
+	Deleted       bool                   `protobuf:"varint,10,opt,name=deleted,proto3" json:"deleted,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListAssignmentsByTutorRequest) Reset() {
-	*x = ListAssignmentsByTutorRequest{}
-	mi := &file_my_proto_homework_service_proto_msgTypes[4]
+func (x *Comment) Reset() {
+	*x = Comment{}
+	mi := &file_my_proto_homework_service_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListAssignmentsByTutorRequest) String() string {
+func (x *Comment) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListAssignmentsByTutorRequest) ProtoMessage() {}
+func (*Comment) ProtoMessage() {}
 
-func (x *ListAssignmentsByTutorRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_my_proto_homework_service_proto_msgTypes[4]
+func (x *Comment) ProtoReflect() protoreflect.Message {
+	mi := &file_my_proto_homework_service_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -350,101 +4590,110 @@ func (x *ListAssignmentsByTutorRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListAssignmentsByTutorRequest.ProtoReflect.Descriptor instead.
-func (*ListAssignmentsByTutorRequest) Descriptor() ([]byte, []int) {
-	return file_my_proto_homework_service_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use Comment.ProtoReflect.Descriptor instead.
+func (*Comment) Descriptor() ([]byte, []int) {
+	return file_my_proto_homework_service_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *ListAssignmentsByTutorRequest) GetTutorId() string {
+func (x *Comment) GetId() string {
 	if x != nil {
-		return x.TutorId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *ListAssignmentsByTutorRequest) GetStatusFilter() []AssignmentStatusFilter {
+func (x *Comment) GetAssignmentId() string {
 	if x != nil {
-		return x.StatusFilter
+		return x.AssignmentId
 	}
-	return nil
-}
-
-type ListAssignmentsByStudentRequest struct {
-	state         protoimpl.MessageState   `protogen:"open.v1"`
-	StudentId     string                   `protobuf:"bytes,1,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
-	StatusFilter  []AssignmentStatusFilter `protobuf:"varint,2,rep,packed,name=status_filter,json=statusFilter,proto3,enum=homework.v1.AssignmentStatusFilter" json:"status_filter,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	return ""
 }
 
-func (x *ListAssignmentsByStudentRequest) Reset() {
-	*x = ListAssignmentsByStudentRequest{}
-	mi := &file_my_proto_homework_service_proto_msgTypes[5]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *Comment) GetSubmissionId() string {
+	if x != nil && x.SubmissionId != nil {
+		return *x.SubmissionId
+	}
+	return ""
 }
 
-func (x *ListAssignmentsByStudentRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Comment) GetParentId() string {
+	if x != nil && x.ParentId != nil {
+		return *x.ParentId
+	}
+	return ""
 }
 
-func (*ListAssignmentsByStudentRequest) ProtoMessage() {}
-
-func (x *ListAssignmentsByStudentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_my_proto_homework_service_proto_msgTypes[5]
+func (x *Comment) GetAuthorId() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.AuthorId
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use ListAssignmentsByStudentRequest.ProtoReflect.Descriptor instead.
-func (*ListAssignmentsByStudentRequest) Descriptor() ([]byte, []int) {
-	return file_my_proto_homework_service_proto_rawDescGZIP(), []int{5}
+func (x *Comment) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
 }
 
-func (x *ListAssignmentsByStudentRequest) GetStudentId() string {
+func (x *Comment) GetAttachments() []*Attachment {
 	if x != nil {
-		return x.StudentId
+		return x.Attachments
 	}
-	return ""
+	return nil
 }
 
-func (x *ListAssignmentsByStudentRequest) GetStatusFilter() []AssignmentStatusFilter {
+func (x *Comment) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.StatusFilter
+		return x.CreatedAt
 	}
 	return nil
 }
 
-type ListAssignmentsByPairRequest struct {
-	state         protoimpl.MessageState   `protogen:"open.v1"`
-	TutorId       string                   `protobuf:"bytes,1,opt,name=tutor_id,json=tutorId,proto3" json:"tutor_id,omitempty"`
-	StudentId     string                   `protobuf:"bytes,2,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
-	StatusFilter  []AssignmentStatusFilter `protobuf:"varint,3,rep,packed,name=status_filter,json=statusFilter,proto3,enum=homework.v1.AssignmentStatusFilter" json:"status_filter,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *Comment) GetEditedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EditedAt
+	}
+	return nil
 }
 
-func (x *ListAssignmentsByPairRequest) Reset() {
-	*x = ListAssignmentsByPairRequest{}
-	mi := &file_my_proto_homework_service_proto_msgTypes[6]
+func (x *Comment) GetDeleted() bool {
+	if x != nil {
+		return x.Deleted
+	}
+	return false
+}
+
+type AssignmentTemplate struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TutorId          string                 `protobuf:"bytes,2,opt,name=tutor_id,json=tutorId,proto3" json:"tutor_id,omitempty"`
+	Title            *string                `protobuf:"bytes,3,opt,name=title,proto3,oneof" json:"title,omitempty"`
+	Description      *string                `protobuf:"bytes,4,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	Attachments      []*Attachment          `protobuf:"bytes,5,rep,name=attachments,proto3" json:"attachments,omitempty"`
+	DueOffsetSeconds *int64                 `protobuf:"varint,6,opt,name=due_offset_seconds,json=dueOffsetSeconds,proto3,oneof" json:"due_offset_seconds,omitempty"`
+	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	EditedAt         *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=edited_at,json=editedAt,proto3" json:"edited_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *AssignmentTemplate) Reset() {
+	*x = AssignmentTemplate{}
+	mi := &file_my_proto_homework_service_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListAssignmentsByPairRequest) String() string {
+func (x *AssignmentTemplate) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListAssignmentsByPairRequest) ProtoMessage() {}
+func (*AssignmentTemplate) ProtoMessage() {}
 
-func (x *ListAssignmentsByPairRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_my_proto_homework_service_proto_msgTypes[6]
+func (x *AssignmentTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_my_proto_homework_service_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -455,100 +4704,101 @@ func (x *ListAssignmentsByPairRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListAssignmentsByPairRequest.ProtoReflect.Descriptor instead.
-func (*ListAssignmentsByPairRequest) Descriptor() ([]byte, []int) {
-	return file_my_proto_homework_service_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use AssignmentTemplate.ProtoReflect.Descriptor instead.
+func (*AssignmentTemplate) Descriptor() ([]byte, []int) {
+	return file_my_proto_homework_service_proto_rawDescGZIP(), []int{60}
 }
 
-func (x *ListAssignmentsByPairRequest) GetTutorId() string {
+func (x *AssignmentTemplate) GetId() string {
 	if x != nil {
-		return x.TutorId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *ListAssignmentsByPairRequest) GetStudentId() string {
+func (x *AssignmentTemplate) GetTutorId() string {
 	if x != nil {
-		return x.StudentId
+		return x.TutorId
 	}
 	return ""
 }
 
-func (x *ListAssignmentsByPairRequest) GetStatusFilter() []AssignmentStatusFilter {
-	if x != nil {
-		return x.StatusFilter
+func (x *AssignmentTemplate) GetTitle() string {
+	if x != nil && x.Title != nil {
+		return *x.Title
 	}
-	return nil
+	return ""
 }
 
-type ListAssignmentsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Assignments   []*Assignment          `protobuf:"bytes,1,rep,name=assignments,proto3" json:"assignments,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *AssignmentTemplate) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
 }
 
-func (x *ListAssignmentsResponse) Reset() {
-	*x = ListAssignmentsResponse{}
-	mi := &file_my_proto_homework_service_proto_msgTypes[7]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *AssignmentTemplate) GetAttachments() []*Attachment {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
 }
 
-func (x *ListAssignmentsResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *AssignmentTemplate) GetDueOffsetSeconds() int64 {
+	if x != nil && x.DueOffsetSeconds != nil {
+		return *x.DueOffsetSeconds
+	}
+	return 0
 }
 
-func (*ListAssignmentsResponse) ProtoMessage() {}
-
-func (x *ListAssignmentsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_my_proto_homework_service_proto_msgTypes[7]
+func (x *AssignmentTemplate) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.CreatedAt
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ListAssignmentsResponse.ProtoReflect.Descriptor instead.
-func (*ListAssignmentsResponse) Descriptor() ([]byte, []int) {
-	return file_my_proto_homework_service_proto_rawDescGZIP(), []int{7}
+	return nil
 }
 
-func (x *ListAssignmentsResponse) GetAssignments() []*Assignment {
+func (x *AssignmentTemplate) GetEditedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Assignments
+		return x.EditedAt
 	}
 	return nil
 }
 
-type CreateSubmissionRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	AssignmentId  string                 `protobuf:"bytes,1,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
-	FileId        *string                `protobuf:"bytes,2,opt,name=file_id,json=fileId,proto3,oneof" json:"file_id,omitempty"`
-	Comment       *string                `protobuf:"bytes,3,opt,name=comment,proto3,oneof" json:"comment,omitempty"`
+type Submission struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Id           string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AssignmentId string                 `protobuf:"bytes,2,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+	FileId       *string                `protobuf:"bytes,3,opt,name=file_id,json=fileId,proto3,oneof" json:"file_id,omitempty"`
+	Comment      *string                `protobuf:"bytes,4,opt,name=comment,proto3,oneof" json:"comment,omitempty"`
+	CreatedAt    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	EditedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=edited_at,json=editedAt,proto3" json:"edited_at,omitempty"`
+	Attachments  []*Attachment          `protobuf:"bytes,8,rep,name=attachments,proto3" json:"attachments,omitempty"`
+	// Attempt number within the assignment, starting from 1.
+	Version int32 `protobuf:"varint,9,opt,name=version,proto3" json:"version,omitempty"`
+	// Graded quiz answers ordered by question.
+	Answers []*QuizAnswer `protobuf:"bytes,10,rep,name=answers,proto3" json:"answers,omitempty"`
+	// Submitted after the deadline under LATE_POLICY_FLAG.
+	IsLate        bool `protobuf:"varint,11,opt,name=is_late,json=isLate,proto3" json:"is_late,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateSubmissionRequest) Reset() {
-	*x = CreateSubmissionRequest{}
-	mi := &file_my_proto_homework_service_proto_msgTypes[8]
+func (x *Submission) Reset() {
+	*x = Submission{}
+	mi := &file_my_proto_homework_service_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateSubmissionRequest) String() string {
+func (x *Submission) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateSubmissionRequest) ProtoMessage() {}
+func (*Submission) ProtoMessage() {}
 
-func (x *CreateSubmissionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_my_proto_homework_service_proto_msgTypes[8]
+func (x *Submission) ProtoReflect() protoreflect.Message {
+	mi := &file_my_proto_homework_service_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -559,144 +4809,114 @@ func (x *CreateSubmissionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateSubmissionRequest.ProtoReflect.Descriptor instead.
-func (*CreateSubmissionRequest) Descriptor() ([]byte, []int) {
-	return file_my_proto_homework_service_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use Submission.ProtoReflect.Descriptor instead.
+func (*Submission) Descriptor() ([]byte, []int) {
+	return file_my_proto_homework_service_proto_rawDescGZIP(), []int{61}
 }
 
-func (x *CreateSubmissionRequest) GetAssignmentId() string {
+func (x *Submission) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Submission) GetAssignmentId() string {
 	if x != nil {
 		return x.AssignmentId
 	}
 	return ""
 }
 
-func (x *CreateSubmissionRequest) GetFileId() string {
+func (x *Submission) GetFileId() string {
 	if x != nil && x.FileId != nil {
 		return *x.FileId
 	}
 	return ""
 }
 
-func (x *CreateSubmissionRequest) GetComment() string {
+func (x *Submission) GetComment() string {
 	if x != nil && x.Comment != nil {
 		return *x.Comment
 	}
 	return ""
 }
 
-type ListSubmissionsByAssignmentRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	AssignmentId  string                 `protobuf:"bytes,1,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *ListSubmissionsByAssignmentRequest) Reset() {
-	*x = ListSubmissionsByAssignmentRequest{}
-	mi := &file_my_proto_homework_service_proto_msgTypes[9]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *ListSubmissionsByAssignmentRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*ListSubmissionsByAssignmentRequest) ProtoMessage() {}
-
-func (x *ListSubmissionsByAssignmentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_my_proto_homework_service_proto_msgTypes[9]
+func (x *Submission) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.CreatedAt
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ListSubmissionsByAssignmentRequest.ProtoReflect.Descriptor instead.
-func (*ListSubmissionsByAssignmentRequest) Descriptor() ([]byte, []int) {
-	return file_my_proto_homework_service_proto_rawDescGZIP(), []int{9}
+	return nil
 }
 
-func (x *ListSubmissionsByAssignmentRequest) GetAssignmentId() string {
+func (x *Submission) GetEditedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.AssignmentId
+		return x.EditedAt
 	}
-	return ""
-}
-
-type ListSubmissionsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Submissions   []*Submission          `protobuf:"bytes,1,rep,name=submissions,proto3" json:"submissions,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *ListSubmissionsResponse) Reset() {
-	*x = ListSubmissionsResponse{}
-	mi := &file_my_proto_homework_service_proto_msgTypes[10]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *ListSubmissionsResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+	return nil
 }
 
-func (*ListSubmissionsResponse) ProtoMessage() {}
-
-func (x *ListSubmissionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_my_proto_homework_service_proto_msgTypes[10]
+func (x *Submission) GetAttachments() []*Attachment {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Attachments
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use ListSubmissionsResponse.ProtoReflect.Descriptor instead.
-func (*ListSubmissionsResponse) Descriptor() ([]byte, []int) {
-	return file_my_proto_homework_service_proto_rawDescGZIP(), []int{10}
+func (x *Submission) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
 }
 
-func (x *ListSubmissionsResponse) GetSubmissions() []*Submission {
+func (x *Submission) GetAnswers() []*QuizAnswer {
 	if x != nil {
-		return x.Submissions
+		return x.Answers
 	}
 	return nil
 }
 
-type CreateFeedbackRequest struct {
+func (x *Submission) GetIsLate() bool {
+	if x != nil {
+		return x.IsLate
+	}
+	return false
+}
+
+type Feedback struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	SubmissionId  string                 `protobuf:"bytes,1,opt,name=submission_id,json=submissionId,proto3" json:"submission_id,omitempty"`
-	FileId        *string                `protobuf:"bytes,2,opt,name=file_id,json=fileId,proto3,oneof" json:"file_id,omitempty"`
-	Comment       *string                `protobuf:"bytes,3,opt,name=comment,proto3,oneof" json:"comment,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	SubmissionId  string                 `protobuf:"bytes,2,opt,name=submission_id,json=submissionId,proto3" json:"submission_id,omitempty"`
+	FileId        *string                `protobuf:"bytes,3,opt,name=file_id,json=fileId,proto3,oneof" json:"file_id,omitempty"`
+	Comment       *string                `protobuf:"bytes,4,opt,name=comment,proto3,oneof" json:"comment,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	EditedAt      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=edited_at,json=editedAt,proto3" json:"edited_at,omitempty"`
+	Attachments   []*Attachment          `protobuf:"bytes,7,rep,name=attachments,proto3" json:"attachments,omitempty"`
+	Score         *float64               `protobuf:"fixed64,8,opt,name=score,proto3,oneof" json:"score,omitempty"`
+	MaxScore      *float64               `protobuf:"fixed64,9,opt,name=max_score,json=maxScore,proto3,oneof" json:"max_score,omitempty"`
+	Rubric        []*RubricCriterion     `protobuf:"bytes,10,rep,name=rubric,proto3" json:"rubric,omitempty"`
+	Verdict       FeedbackVerdict        `protobuf:"varint,11,opt,name=verdict,proto3,enum=homework.v1.FeedbackVerdict" json:"verdict,omitempty"`
+	Annotations   []*Annotation          `protobuf:"bytes,12,rep,name=annotations,proto3" json:"annotations,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateFeedbackRequest) Reset() {
-	*x = CreateFeedbackRequest{}
-	mi := &file_my_proto_homework_service_proto_msgTypes[11]
+func (x *Feedback) Reset() {
+	*x = Feedback{}
+	mi := &file_my_proto_homework_service_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateFeedbackRequest) String() string {
+func (x *Feedback) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateFeedbackRequest) ProtoMessage() {}
+func (*Feedback) ProtoMessage() {}
 
-func (x *CreateFeedbackRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_my_proto_homework_service_proto_msgTypes[11]
+func (x *Feedback) ProtoReflect() protoreflect.Message {
+	mi := &file_my_proto_homework_service_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -707,56 +4927,132 @@ func (x *CreateFeedbackRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateFeedbackRequest.ProtoReflect.Descriptor instead.
-func (*CreateFeedbackRequest) Descriptor() ([]byte, []int) {
-	return file_my_proto_homework_service_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use Feedback.ProtoReflect.Descriptor instead.
+func (*Feedback) Descriptor() ([]byte, []int) {
+	return file_my_proto_homework_service_proto_rawDescGZIP(), []int{62}
 }
 
-func (x *CreateFeedbackRequest) GetSubmissionId() string {
+func (x *Feedback) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Feedback) GetSubmissionId() string {
 	if x != nil {
 		return x.SubmissionId
 	}
 	return ""
 }
 
-func (x *CreateFeedbackRequest) GetFileId() string {
+func (x *Feedback) GetFileId() string {
 	if x != nil && x.FileId != nil {
 		return *x.FileId
 	}
 	return ""
 }
 
-func (x *CreateFeedbackRequest) GetComment() string {
+func (x *Feedback) GetComment() string {
 	if x != nil && x.Comment != nil {
 		return *x.Comment
 	}
 	return ""
 }
 
-type UpdateFeedbackRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	FileId        *string                `protobuf:"bytes,2,opt,name=file_id,json=fileId,proto3,oneof" json:"file_id,omitempty"`
-	Comment       *string                `protobuf:"bytes,3,opt,name=comment,proto3,oneof" json:"comment,omitempty"`
+func (x *Feedback) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Feedback) GetEditedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EditedAt
+	}
+	return nil
+}
+
+func (x *Feedback) GetAttachments() []*Attachment {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
+}
+
+func (x *Feedback) GetScore() float64 {
+	if x != nil && x.Score != nil {
+		return *x.Score
+	}
+	return 0
+}
+
+func (x *Feedback) GetMaxScore() float64 {
+	if x != nil && x.MaxScore != nil {
+		return *x.MaxScore
+	}
+	return 0
+}
+
+func (x *Feedback) GetRubric() []*RubricCriterion {
+	if x != nil {
+		return x.Rubric
+	}
+	return nil
+}
+
+func (x *Feedback) GetVerdict() FeedbackVerdict {
+	if x != nil {
+		return x.Verdict
+	}
+	return FeedbackVerdict_FEEDBACK_VERDICT_UNSPECIFIED
+}
+
+func (x *Feedback) GetAnnotations() []*Annotation {
+	if x != nil {
+		return x.Annotations
+	}
+	return nil
+}
+
+// A shape drawn over a page of a submission file. Coordinates are fractions of the
+// page width and height from its top left corner.
+type Annotation struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// A file of the feedback's submission.
+	FileId string `protobuf:"bytes,1,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"`
+	// 1-based; images have a single page.
+	Page int32          `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	Type AnnotationType `protobuf:"varint,3,opt,name=type,proto3,enum=homework.v1.AnnotationType" json:"type,omitempty"`
+	// The rectangle of a highlight. A text starts at x, y and is wrapped at width if it is set.
+	X      float64 `protobuf:"fixed64,4,opt,name=x,proto3" json:"x,omitempty"`
+	Y      float64 `protobuf:"fixed64,5,opt,name=y,proto3" json:"y,omitempty"`
+	Width  float64 `protobuf:"fixed64,6,opt,name=width,proto3" json:"width,omitempty"`
+	Height float64 `protobuf:"fixed64,7,opt,name=height,proto3" json:"height,omitempty"`
+	// The stroke of a pen.
+	Points []*AnnotationPoint `protobuf:"bytes,8,rep,name=points,proto3" json:"points,omitempty"`
+	// The text of a text annotation, a note to the other shapes.
+	Comment       *string `protobuf:"bytes,9,opt,name=comment,proto3,oneof" json:"comment,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateFeedbackRequest) Reset() {
-	*x = UpdateFeedbackRequest{}
-	mi := &file_my_proto_homework_service_proto_msgTypes[12]
+func (x *Annotation) Reset() {
+	*x = Annotation{}
+	mi := &file_my_proto_homework_service_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateFeedbackRequest) String() string {
+func (x *Annotation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateFeedbackRequest) ProtoMessage() {}
+func (*Annotation) ProtoMessage() {}
 
-func (x *UpdateFeedbackRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_my_proto_homework_service_proto_msgTypes[12]
+func (x *Annotation) ProtoReflect() protoreflect.Message {
+	mi := &file_my_proto_homework_service_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -767,98 +5063,97 @@ func (x *UpdateFeedbackRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateFeedbackRequest.ProtoReflect.Descriptor instead.
-func (*UpdateFeedbackRequest) Descriptor() ([]byte, []int) {
-	return file_my_proto_homework_service_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use Annotation.ProtoReflect.Descriptor instead.
+func (*Annotation) Descriptor() ([]byte, []int) {
+	return file_my_proto_homework_service_proto_rawDescGZIP(), []int{63}
 }
 
-func (x *UpdateFeedbackRequest) GetId() string {
+func (x *Annotation) GetFileId() string {
 	if x != nil {
-		return x.Id
+		return x.FileId
 	}
 	return ""
 }
 
-func (x *UpdateFeedbackRequest) GetFileId() string {
-	if x != nil && x.FileId != nil {
-		return *x.FileId
+func (x *Annotation) GetPage() int32 {
+	if x != nil {
+		return x.Page
 	}
-	return ""
+	return 0
 }
 
-func (x *UpdateFeedbackRequest) GetComment() string {
-	if x != nil && x.Comment != nil {
-		return *x.Comment
+func (x *Annotation) GetType() AnnotationType {
+	if x != nil {
+		return x.Type
 	}
-	return ""
+	return AnnotationType_ANNOTATION_TYPE_UNSPECIFIED
 }
 
-type ListFeedbacksByAssignmentRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	AssignmentId  string                 `protobuf:"bytes,1,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *Annotation) GetX() float64 {
+	if x != nil {
+		return x.X
+	}
+	return 0
 }
 
-func (x *ListFeedbacksByAssignmentRequest) Reset() {
-	*x = ListFeedbacksByAssignmentRequest{}
-	mi := &file_my_proto_homework_service_proto_msgTypes[13]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *Annotation) GetY() float64 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
 }
 
-func (x *ListFeedbacksByAssignmentRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Annotation) GetWidth() float64 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
 }
 
-func (*ListFeedbacksByAssignmentRequest) ProtoMessage() {}
-
-func (x *ListFeedbacksByAssignmentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_my_proto_homework_service_proto_msgTypes[13]
+func (x *Annotation) GetHeight() float64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Height
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use ListFeedbacksByAssignmentRequest.ProtoReflect.Descriptor instead.
-func (*ListFeedbacksByAssignmentRequest) Descriptor() ([]byte, []int) {
-	return file_my_proto_homework_service_proto_rawDescGZIP(), []int{13}
+func (x *Annotation) GetPoints() []*AnnotationPoint {
+	if x != nil {
+		return x.Points
+	}
+	return nil
 }
 
-func (x *ListFeedbacksByAssignmentRequest) GetAssignmentId() string {
-	if x != nil {
-		return x.AssignmentId
+func (x *Annotation) GetComment() string {
+	if x != nil && x.Comment != nil {
+		return *x.Comment
 	}
 	return ""
 }
 
-type ListFeedbacksResponse struct {
+type AnnotationPoint struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Feedbacks     []*Feedback            `protobuf:"bytes,1,rep,name=feedbacks,proto3" json:"feedbacks,omitempty"`
+	X             float64                `protobuf:"fixed64,1,opt,name=x,proto3" json:"x,omitempty"`
+	Y             float64                `protobuf:"fixed64,2,opt,name=y,proto3" json:"y,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListFeedbacksResponse) Reset() {
-	*x = ListFeedbacksResponse{}
-	mi := &file_my_proto_homework_service_proto_msgTypes[14]
+func (x *AnnotationPoint) Reset() {
+	*x = AnnotationPoint{}
+	mi := &file_my_proto_homework_service_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListFeedbacksResponse) String() string {
+func (x *AnnotationPoint) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListFeedbacksResponse) ProtoMessage() {}
+func (*AnnotationPoint) ProtoMessage() {}
 
-func (x *ListFeedbacksResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_my_proto_homework_service_proto_msgTypes[14]
+func (x *AnnotationPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_my_proto_homework_service_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -869,40 +5164,48 @@ func (x *ListFeedbacksResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListFeedbacksResponse.ProtoReflect.Descriptor instead.
-func (*ListFeedbacksResponse) Descriptor() ([]byte, []int) {
-	return file_my_proto_homework_service_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use AnnotationPoint.ProtoReflect.Descriptor instead.
+func (*AnnotationPoint) Descriptor() ([]byte, []int) {
+	return file_my_proto_homework_service_proto_rawDescGZIP(), []int{64}
 }
 
-func (x *ListFeedbacksResponse) GetFeedbacks() []*Feedback {
+func (x *AnnotationPoint) GetX() float64 {
 	if x != nil {
-		return x.Feedbacks
+		return x.X
 	}
-	return nil
+	return 0
 }
 
-type GetAssignmentFileRequest struct {
+func (x *AnnotationPoint) GetY() float64 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+// Replaces all annotations on update; an empty list removes them.
+type AnnotationList struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	AssignmentId  string                 `protobuf:"bytes,1,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+	Items         []*Annotation          `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAssignmentFileRequest) Reset() {
-	*x = GetAssignmentFileRequest{}
-	mi := &file_my_proto_homework_service_proto_msgTypes[15]
+func (x *AnnotationList) Reset() {
+	*x = AnnotationList{}
+	mi := &file_my_proto_homework_service_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAssignmentFileRequest) String() string {
+func (x *AnnotationList) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAssignmentFileRequest) ProtoMessage() {}
+func (*AnnotationList) ProtoMessage() {}
 
-func (x *GetAssignmentFileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_my_proto_homework_service_proto_msgTypes[15]
+func (x *AnnotationList) ProtoReflect() protoreflect.Message {
+	mi := &file_my_proto_homework_service_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -913,40 +5216,41 @@ func (x *GetAssignmentFileRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAssignmentFileRequest.ProtoReflect.Descriptor instead.
-func (*GetAssignmentFileRequest) Descriptor() ([]byte, []int) {
-	return file_my_proto_homework_service_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use AnnotationList.ProtoReflect.Descriptor instead.
+func (*AnnotationList) Descriptor() ([]byte, []int) {
+	return file_my_proto_homework_service_proto_rawDescGZIP(), []int{65}
 }
 
-func (x *GetAssignmentFileRequest) GetAssignmentId() string {
+func (x *AnnotationList) GetItems() []*Annotation {
 	if x != nil {
-		return x.AssignmentId
+		return x.Items
 	}
-	return ""
+	return nil
 }
 
-type GetSubmissionFileRequest struct {
+type AnnotatedFile struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	SubmissionId  string                 `protobuf:"bytes,1,opt,name=submission_id,json=submissionId,proto3" json:"submission_id,omitempty"`
+	FileId        string                 `protobuf:"bytes,1,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"`
+	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetSubmissionFileRequest) Reset() {
-	*x = GetSubmissionFileRequest{}
-	mi := &file_my_proto_homework_service_proto_msgTypes[16]
+func (x *AnnotatedFile) Reset() {
+	*x = AnnotatedFile{}
+	mi := &file_my_proto_homework_service_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSubmissionFileRequest) String() string {
+func (x *AnnotatedFile) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSubmissionFileRequest) ProtoMessage() {}
+func (*AnnotatedFile) ProtoMessage() {}
 
-func (x *GetSubmissionFileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_my_proto_homework_service_proto_msgTypes[16]
+func (x *AnnotatedFile) ProtoReflect() protoreflect.Message {
+	mi := &file_my_proto_homework_service_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -957,40 +5261,61 @@ func (x *GetSubmissionFileRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSubmissionFileRequest.ProtoReflect.Descriptor instead.
-func (*GetSubmissionFileRequest) Descriptor() ([]byte, []int) {
-	return file_my_proto_homework_service_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use AnnotatedFile.ProtoReflect.Descriptor instead.
+func (*AnnotatedFile) Descriptor() ([]byte, []int) {
+	return file_my_proto_homework_service_proto_rawDescGZIP(), []int{66}
 }
 
-func (x *GetSubmissionFileRequest) GetSubmissionId() string {
+func (x *AnnotatedFile) GetFileId() string {
 	if x != nil {
-		return x.SubmissionId
+		return x.FileId
 	}
 	return ""
 }
 
-type GetFeedbackFileRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	FeedbackId    string                 `protobuf:"bytes,1,opt,name=feedback_id,json=feedbackId,proto3" json:"feedback_id,omitempty"`
+func (x *AnnotatedFile) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+// Searches the caller's assignments, their submissions and feedbacks.
+type SearchHomeworkRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Web search syntax: quoted phrases, OR and -word are supported.
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	// Narrow the search to one tutor-student pair.
+	TutorId   *string `protobuf:"bytes,2,opt,name=tutor_id,json=tutorId,proto3,oneof" json:"tutor_id,omitempty"`
+	StudentId *string `protobuf:"bytes,3,opt,name=student_id,json=studentId,proto3,oneof" json:"student_id,omitempty"`
+	// All types if empty.
+	Types []SearchHitType `protobuf:"varint,4,rep,packed,name=types,proto3,enum=homework.v1.SearchHitType" json:"types,omitempty"`
+	// Creation time of the matched text, [from, to).
+	From *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=from,proto3,oneof" json:"from,omitempty"`
+	To   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=to,proto3,oneof" json:"to,omitempty"`
+	// Defaults to 20, at most 100.
+	PageSize int32 `protobuf:"varint,7,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// next_page_token of the previous page.
+	PageToken     string `protobuf:"bytes,8,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetFeedbackFileRequest) Reset() {
-	*x = GetFeedbackFileRequest{}
-	mi := &file_my_proto_homework_service_proto_msgTypes[17]
+func (x *SearchHomeworkRequest) Reset() {
+	*x = SearchHomeworkRequest{}
+	mi := &file_my_proto_homework_service_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetFeedbackFileRequest) String() string {
+func (x *SearchHomeworkRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetFeedbackFileRequest) ProtoMessage() {}
+func (*SearchHomeworkRequest) ProtoMessage() {}
 
-func (x *GetFeedbackFileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_my_proto_homework_service_proto_msgTypes[17]
+func (x *SearchHomeworkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_my_proto_homework_service_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1001,92 +5326,99 @@ func (x *GetFeedbackFileRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetFeedbackFileRequest.ProtoReflect.Descriptor instead.
-func (*GetFeedbackFileRequest) Descriptor() ([]byte, []int) {
-	return file_my_proto_homework_service_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use SearchHomeworkRequest.ProtoReflect.Descriptor instead.
+func (*SearchHomeworkRequest) Descriptor() ([]byte, []int) {
+	return file_my_proto_homework_service_proto_rawDescGZIP(), []int{67}
 }
 
-func (x *GetFeedbackFileRequest) GetFeedbackId() string {
+func (x *SearchHomeworkRequest) GetQuery() string {
 	if x != nil {
-		return x.FeedbackId
+		return x.Query
 	}
 	return ""
 }
 
-type HomeworkFileURL struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *SearchHomeworkRequest) GetTutorId() string {
+	if x != nil && x.TutorId != nil {
+		return *x.TutorId
+	}
+	return ""
 }
 
-func (x *HomeworkFileURL) Reset() {
-	*x = HomeworkFileURL{}
-	mi := &file_my_proto_homework_service_proto_msgTypes[18]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *SearchHomeworkRequest) GetStudentId() string {
+	if x != nil && x.StudentId != nil {
+		return *x.StudentId
+	}
+	return ""
 }
 
-func (x *HomeworkFileURL) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *SearchHomeworkRequest) GetTypes() []SearchHitType {
+	if x != nil {
+		return x.Types
+	}
+	return nil
 }
 
-func (*HomeworkFileURL) ProtoMessage() {}
+func (x *SearchHomeworkRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
 
-func (x *HomeworkFileURL) ProtoReflect() protoreflect.Message {
-	mi := &file_my_proto_homework_service_proto_msgTypes[18]
+func (x *SearchHomeworkRequest) GetTo() *timestamppb.Timestamp {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.To
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use HomeworkFileURL.ProtoReflect.Descriptor instead.
-func (*HomeworkFileURL) Descriptor() ([]byte, []int) {
-	return file_my_proto_homework_service_proto_rawDescGZIP(), []int{18}
+func (x *SearchHomeworkRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
 }
 
-func (x *HomeworkFileURL) GetUrl() string {
+func (x *SearchHomeworkRequest) GetPageToken() string {
 	if x != nil {
-		return x.Url
+		return x.PageToken
 	}
 	return ""
 }
 
-type Assignment struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	TutorId       string                 `protobuf:"bytes,2,opt,name=tutor_id,json=tutorId,proto3" json:"tutor_id,omitempty"`
-	StudentId     string                 `protobuf:"bytes,3,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
-	Title         *string                `protobuf:"bytes,4,opt,name=title,proto3,oneof" json:"title,omitempty"`
-	Description   *string                `protobuf:"bytes,5,opt,name=description,proto3,oneof" json:"description,omitempty"`
-	FileId        *string                `protobuf:"bytes,6,opt,name=file_id,json=fileId,proto3,oneof" json:"file_id,omitempty"`
-	DueDate       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=due_date,json=dueDate,proto3,oneof" json:"due_date,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	EditedAt      *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=edited_at,json=editedAt,proto3" json:"edited_at,omitempty"`
+type SearchHit struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Type  SearchHitType          `protobuf:"varint,1,opt,name=type,proto3,enum=homework.v1.SearchHitType" json:"type,omitempty"`
+	// ID of the matched assignment, submission or feedback.
+	Id              string  `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	AssignmentId    string  `protobuf:"bytes,3,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+	TutorId         string  `protobuf:"bytes,4,opt,name=tutor_id,json=tutorId,proto3" json:"tutor_id,omitempty"`
+	StudentId       string  `protobuf:"bytes,5,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	AssignmentTitle *string `protobuf:"bytes,6,opt,name=assignment_title,json=assignmentTitle,proto3,oneof" json:"assignment_title,omitempty"`
+	// HTML-escaped fragment of the text with the matched words wrapped in <b>.
+	Snippet       string                 `protobuf:"bytes,7,opt,name=snippet,proto3" json:"snippet,omitempty"`
+	Rank          float64                `protobuf:"fixed64,8,opt,name=rank,proto3" json:"rank,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Assignment) Reset() {
-	*x = Assignment{}
-	mi := &file_my_proto_homework_service_proto_msgTypes[19]
+func (x *SearchHit) Reset() {
+	*x = SearchHit{}
+	mi := &file_my_proto_homework_service_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Assignment) String() string {
+func (x *SearchHit) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Assignment) ProtoMessage() {}
+func (*SearchHit) ProtoMessage() {}
 
-func (x *Assignment) ProtoReflect() protoreflect.Message {
-	mi := &file_my_proto_homework_service_proto_msgTypes[19]
+func (x *SearchHit) ProtoReflect() protoreflect.Message {
+	mi := &file_my_proto_homework_service_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1097,101 +5429,99 @@ func (x *Assignment) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Assignment.ProtoReflect.Descriptor instead.
-func (*Assignment) Descriptor() ([]byte, []int) {
-	return file_my_proto_homework_service_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use SearchHit.ProtoReflect.Descriptor instead.
+func (*SearchHit) Descriptor() ([]byte, []int) {
+	return file_my_proto_homework_service_proto_rawDescGZIP(), []int{68}
 }
 
-func (x *Assignment) GetId() string {
+func (x *SearchHit) GetType() SearchHitType {
 	if x != nil {
-		return x.Id
+		return x.Type
 	}
-	return ""
+	return SearchHitType_SEARCH_HIT_TYPE_UNSPECIFIED
 }
 
-func (x *Assignment) GetTutorId() string {
+func (x *SearchHit) GetId() string {
 	if x != nil {
-		return x.TutorId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *Assignment) GetStudentId() string {
+func (x *SearchHit) GetAssignmentId() string {
 	if x != nil {
-		return x.StudentId
+		return x.AssignmentId
 	}
 	return ""
 }
 
-func (x *Assignment) GetTitle() string {
-	if x != nil && x.Title != nil {
-		return *x.Title
+func (x *SearchHit) GetTutorId() string {
+	if x != nil {
+		return x.TutorId
 	}
 	return ""
 }
 
-func (x *Assignment) GetDescription() string {
-	if x != nil && x.Description != nil {
-		return *x.Description
+func (x *SearchHit) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
 	}
 	return ""
 }
 
-func (x *Assignment) GetFileId() string {
-	if x != nil && x.FileId != nil {
-		return *x.FileId
+func (x *SearchHit) GetAssignmentTitle() string {
+	if x != nil && x.AssignmentTitle != nil {
+		return *x.AssignmentTitle
 	}
 	return ""
 }
 
-func (x *Assignment) GetDueDate() *timestamppb.Timestamp {
+func (x *SearchHit) GetSnippet() string {
 	if x != nil {
-		return x.DueDate
+		return x.Snippet
 	}
-	return nil
+	return ""
 }
 
-func (x *Assignment) GetCreatedAt() *timestamppb.Timestamp {
+func (x *SearchHit) GetRank() float64 {
 	if x != nil {
-		return x.CreatedAt
+		return x.Rank
 	}
-	return nil
+	return 0
 }
 
-func (x *Assignment) GetEditedAt() *timestamppb.Timestamp {
+func (x *SearchHit) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.EditedAt
+		return x.CreatedAt
 	}
 	return nil
 }
 
-type Submission struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	AssignmentId  string                 `protobuf:"bytes,2,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
-	FileId        *string                `protobuf:"bytes,3,opt,name=file_id,json=fileId,proto3,oneof" json:"file_id,omitempty"`
-	Comment       *string                `protobuf:"bytes,4,opt,name=comment,proto3,oneof" json:"comment,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	EditedAt      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=edited_at,json=editedAt,proto3" json:"edited_at,omitempty"`
+type SearchHomeworkResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Ordered by relevance.
+	Hits []*SearchHit `protobuf:"bytes,1,rep,name=hits,proto3" json:"hits,omitempty"`
+	// Empty on the last page.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Submission) Reset() {
-	*x = Submission{}
-	mi := &file_my_proto_homework_service_proto_msgTypes[20]
+func (x *SearchHomeworkResponse) Reset() {
+	*x = SearchHomeworkResponse{}
+	mi := &file_my_proto_homework_service_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Submission) String() string {
+func (x *SearchHomeworkResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Submission) ProtoMessage() {}
+func (*SearchHomeworkResponse) ProtoMessage() {}
 
-func (x *Submission) ProtoReflect() protoreflect.Message {
-	mi := &file_my_proto_homework_service_proto_msgTypes[20]
+func (x *SearchHomeworkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_my_proto_homework_service_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1202,80 +5532,118 @@ func (x *Submission) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Submission.ProtoReflect.Descriptor instead.
-func (*Submission) Descriptor() ([]byte, []int) {
-	return file_my_proto_homework_service_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use SearchHomeworkResponse.ProtoReflect.Descriptor instead.
+func (*SearchHomeworkResponse) Descriptor() ([]byte, []int) {
+	return file_my_proto_homework_service_proto_rawDescGZIP(), []int{69}
 }
 
-func (x *Submission) GetId() string {
+func (x *SearchHomeworkResponse) GetHits() []*SearchHit {
 	if x != nil {
-		return x.Id
+		return x.Hits
 	}
-	return ""
+	return nil
 }
 
-func (x *Submission) GetAssignmentId() string {
+func (x *SearchHomeworkResponse) GetNextPageToken() string {
 	if x != nil {
-		return x.AssignmentId
+		return x.NextPageToken
 	}
 	return ""
 }
 
-func (x *Submission) GetFileId() string {
-	if x != nil && x.FileId != nil {
-		return *x.FileId
+// Queues a ZIP archive of the pair's assignments, submissions and feedbacks
+// with their files, index.html and manifest.json.
+type CreatePortfolioExportRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	TutorId   string                 `protobuf:"bytes,1,opt,name=tutor_id,json=tutorId,proto3" json:"tutor_id,omitempty"`
+	StudentId string                 `protobuf:"bytes,2,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	// Creation time of the assignments, [from, to).
+	From          *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=from,proto3,oneof" json:"from,omitempty"`
+	To            *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=to,proto3,oneof" json:"to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePortfolioExportRequest) Reset() {
+	*x = CreatePortfolioExportRequest{}
+	mi := &file_my_proto_homework_service_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePortfolioExportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePortfolioExportRequest) ProtoMessage() {}
+
+func (x *CreatePortfolioExportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_my_proto_homework_service_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePortfolioExportRequest.ProtoReflect.Descriptor instead.
+func (*CreatePortfolioExportRequest) Descriptor() ([]byte, []int) {
+	return file_my_proto_homework_service_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *CreatePortfolioExportRequest) GetTutorId() string {
+	if x != nil {
+		return x.TutorId
 	}
 	return ""
 }
 
-func (x *Submission) GetComment() string {
-	if x != nil && x.Comment != nil {
-		return *x.Comment
+func (x *CreatePortfolioExportRequest) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
 	}
 	return ""
 }
 
-func (x *Submission) GetCreatedAt() *timestamppb.Timestamp {
+func (x *CreatePortfolioExportRequest) GetFrom() *timestamppb.Timestamp {
 	if x != nil {
-		return x.CreatedAt
+		return x.From
 	}
 	return nil
 }
 
-func (x *Submission) GetEditedAt() *timestamppb.Timestamp {
+func (x *CreatePortfolioExportRequest) GetTo() *timestamppb.Timestamp {
 	if x != nil {
-		return x.EditedAt
+		return x.To
 	}
 	return nil
 }
 
-type Feedback struct {
+type GetPortfolioExportRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	SubmissionId  string                 `protobuf:"bytes,2,opt,name=submission_id,json=submissionId,proto3" json:"submission_id,omitempty"`
-	FileId        *string                `protobuf:"bytes,3,opt,name=file_id,json=fileId,proto3,oneof" json:"file_id,omitempty"`
-	Comment       *string                `protobuf:"bytes,4,opt,name=comment,proto3,oneof" json:"comment,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	EditedAt      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=edited_at,json=editedAt,proto3" json:"edited_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Feedback) Reset() {
-	*x = Feedback{}
-	mi := &file_my_proto_homework_service_proto_msgTypes[21]
+func (x *GetPortfolioExportRequest) Reset() {
+	*x = GetPortfolioExportRequest{}
+	mi := &file_my_proto_homework_service_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Feedback) String() string {
+func (x *GetPortfolioExportRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Feedback) ProtoMessage() {}
+func (*GetPortfolioExportRequest) ProtoMessage() {}
 
-func (x *Feedback) ProtoReflect() protoreflect.Message {
-	mi := &file_my_proto_homework_service_proto_msgTypes[21]
+func (x *GetPortfolioExportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_my_proto_homework_service_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1286,49 +5654,149 @@ func (x *Feedback) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Feedback.ProtoReflect.Descriptor instead.
-func (*Feedback) Descriptor() ([]byte, []int) {
-	return file_my_proto_homework_service_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use GetPortfolioExportRequest.ProtoReflect.Descriptor instead.
+func (*GetPortfolioExportRequest) Descriptor() ([]byte, []int) {
+	return file_my_proto_homework_service_proto_rawDescGZIP(), []int{71}
 }
 
-func (x *Feedback) GetId() string {
+func (x *GetPortfolioExportRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *Feedback) GetSubmissionId() string {
+type PortfolioExport struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	RequestedBy string                 `protobuf:"bytes,2,opt,name=requested_by,json=requestedBy,proto3" json:"requested_by,omitempty"`
+	TutorId     string                 `protobuf:"bytes,3,opt,name=tutor_id,json=tutorId,proto3" json:"tutor_id,omitempty"`
+	StudentId   string                 `protobuf:"bytes,4,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	From        *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=from,proto3,oneof" json:"from,omitempty"`
+	To          *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=to,proto3,oneof" json:"to,omitempty"`
+	Status      PortfolioExportStatus  `protobuf:"varint,7,opt,name=status,proto3,enum=homework.v1.PortfolioExportStatus" json:"status,omitempty"`
+	// The stored archive, set when the export is done.
+	FileId *string `protobuf:"bytes,8,opt,name=file_id,json=fileId,proto3,oneof" json:"file_id,omitempty"`
+	// Short-lived URL of the archive, set when the export is done.
+	DownloadUrl *string `protobuf:"bytes,9,opt,name=download_url,json=downloadUrl,proto3,oneof" json:"download_url,omitempty"`
+	// Why the export failed.
+	Error         *string                `protobuf:"bytes,10,opt,name=error,proto3,oneof" json:"error,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	FinishedAt    *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=finished_at,json=finishedAt,proto3,oneof" json:"finished_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PortfolioExport) Reset() {
+	*x = PortfolioExport{}
+	mi := &file_my_proto_homework_service_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PortfolioExport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PortfolioExport) ProtoMessage() {}
+
+func (x *PortfolioExport) ProtoReflect() protoreflect.Message {
+	mi := &file_my_proto_homework_service_proto_msgTypes[72]
 	if x != nil {
-		return x.SubmissionId
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PortfolioExport.ProtoReflect.Descriptor instead.
+func (*PortfolioExport) Descriptor() ([]byte, []int) {
+	return file_my_proto_homework_service_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *PortfolioExport) GetId() string {
+	if x != nil {
+		return x.Id
 	}
 	return ""
 }
 
-func (x *Feedback) GetFileId() string {
+func (x *PortfolioExport) GetRequestedBy() string {
+	if x != nil {
+		return x.RequestedBy
+	}
+	return ""
+}
+
+func (x *PortfolioExport) GetTutorId() string {
+	if x != nil {
+		return x.TutorId
+	}
+	return ""
+}
+
+func (x *PortfolioExport) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *PortfolioExport) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *PortfolioExport) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *PortfolioExport) GetStatus() PortfolioExportStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PortfolioExportStatus_PORTFOLIO_EXPORT_STATUS_UNSPECIFIED
+}
+
+func (x *PortfolioExport) GetFileId() string {
 	if x != nil && x.FileId != nil {
 		return *x.FileId
 	}
 	return ""
 }
 
-func (x *Feedback) GetComment() string {
-	if x != nil && x.Comment != nil {
-		return *x.Comment
+func (x *PortfolioExport) GetDownloadUrl() string {
+	if x != nil && x.DownloadUrl != nil {
+		return *x.DownloadUrl
 	}
 	return ""
 }
 
-func (x *Feedback) GetCreatedAt() *timestamppb.Timestamp {
+func (x *PortfolioExport) GetError() string {
+	if x != nil && x.Error != nil {
+		return *x.Error
+	}
+	return ""
+}
+
+func (x *PortfolioExport) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
 		return x.CreatedAt
 	}
 	return nil
 }
 
-func (x *Feedback) GetEditedAt() *timestamppb.Timestamp {
+func (x *PortfolioExport) GetFinishedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.EditedAt
+		return x.FinishedAt
 	}
 	return nil
 }
@@ -1338,9 +5806,69 @@ var File_my_proto_homework_service_proto protoreflect.FileDescriptor
 const file_my_proto_homework_service_proto_rawDesc = "" +
 	"\n" +
 	"\x1fmy_proto/homework_service.proto\x12\vhomework.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\a\n" +
-	"\x05Empty\">\n" +
+	"\x05Empty\"U\n" +
+	"\x0fAttachmentInput\x12\x17\n" +
+	"\afile_id\x18\x01 \x01(\tR\x06fileId\x12\x1d\n" +
+	"\acaption\x18\x02 \x01(\tH\x00R\acaption\x88\x01\x01B\n" +
+	"\n" +
+	"\b_caption\"D\n" +
+	"\x0eAttachmentList\x122\n" +
+	"\x05items\x18\x01 \x03(\v2\x1c.homework.v1.AttachmentInputR\x05items\"\x83\x01\n" +
+	"\x0fRubricCriterion\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05score\x18\x02 \x01(\x01R\x05score\x12\x1b\n" +
+	"\tmax_score\x18\x03 \x01(\x01R\bmaxScore\x12\x1d\n" +
+	"\acomment\x18\x04 \x01(\tH\x00R\acomment\x88\x01\x01B\n" +
+	"\n" +
+	"\b_comment\"\xb0\x02\n" +
+	"\fQuizQuestion\x121\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x1d.homework.v1.QuizQuestionTypeR\x04type\x12\x12\n" +
+	"\x04text\x18\x02 \x01(\tR\x04text\x12\x18\n" +
+	"\aoptions\x18\x03 \x03(\tR\aoptions\x12\x16\n" +
+	"\x06points\x18\x04 \x01(\x01R\x06points\x12'\n" +
+	"\x0fcorrect_options\x18\x05 \x03(\x05R\x0ecorrectOptions\x12*\n" +
+	"\x0enumeric_answer\x18\x06 \x01(\x01H\x00R\rnumericAnswer\x88\x01\x01\x12\x1c\n" +
+	"\ttolerance\x18\a \x01(\x01R\ttolerance\x12!\n" +
+	"\ftext_answers\x18\b \x03(\tR\vtextAnswersB\x11\n" +
+	"\x0f_numeric_answer\"C\n" +
+	"\x10QuizQuestionList\x12/\n" +
+	"\x05items\x18\x01 \x03(\v2\x19.homework.v1.QuizQuestionR\x05items\"\x85\x02\n" +
+	"\rChecklistItem\x12\x12\n" +
+	"\x04text\x18\x01 \x01(\tR\x04text\x12\x12\n" +
+	"\x04done\x18\x02 \x01(\bR\x04done\x128\n" +
+	"\adone_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\x06doneAt\x88\x01\x01\x124\n" +
+	"\x06review\x18\x04 \x01(\x0e2\x1c.homework.v1.ChecklistReviewR\x06review\x12@\n" +
+	"\vreviewed_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampH\x01R\n" +
+	"reviewedAt\x88\x01\x01B\n" +
+	"\n" +
+	"\b_done_atB\x0e\n" +
+	"\f_reviewed_at\"%\n" +
+	"\rChecklistList\x12\x14\n" +
+	"\x05items\x18\x01 \x03(\tR\x05items\"\xbc\x01\n" +
+	"\n" +
+	"QuizAnswer\x12\x1a\n" +
+	"\bquestion\x18\x01 \x01(\x05R\bquestion\x12\x18\n" +
+	"\aoptions\x18\x02 \x03(\x05R\aoptions\x12\x1b\n" +
+	"\x06number\x18\x03 \x01(\x01H\x00R\x06number\x88\x01\x01\x12\x17\n" +
+	"\x04text\x18\x04 \x01(\tH\x01R\x04text\x88\x01\x01\x12\x18\n" +
+	"\acorrect\x18\x05 \x01(\bR\acorrect\x12\x14\n" +
+	"\x05score\x18\x06 \x01(\x01R\x05scoreB\t\n" +
+	"\a_numberB\a\n" +
+	"\x05_text\"B\n" +
+	"\x06Rubric\x128\n" +
+	"\bcriteria\x18\x01 \x03(\v2\x1c.homework.v1.RubricCriterionR\bcriteria\">\n" +
 	"\x17DeleteAssignmentRequest\x12#\n" +
-	"\rassignment_id\x18\x01 \x01(\tR\fassignmentId\"\xa2\x02\n" +
+	"\rassignment_id\x18\x01 \x01(\tR\fassignmentId\"?\n" +
+	"\x18RestoreAssignmentRequest\x12#\n" +
+	"\rassignment_id\x18\x01 \x01(\tR\fassignmentId\"o\n" +
+	"\x18MarkChecklistItemRequest\x12#\n" +
+	"\rassignment_id\x18\x01 \x01(\tR\fassignmentId\x12\x1a\n" +
+	"\bposition\x18\x02 \x01(\x05R\bposition\x12\x12\n" +
+	"\x04done\x18\x03 \x01(\bR\x04done\"\x93\x01\n" +
+	"\x1aReviewChecklistItemRequest\x12#\n" +
+	"\rassignment_id\x18\x01 \x01(\tR\fassignmentId\x12\x1a\n" +
+	"\bposition\x18\x02 \x01(\x05R\bposition\x124\n" +
+	"\x06review\x18\x03 \x01(\x0e2\x1c.homework.v1.ChecklistReviewR\x06review\"\xa1\x06\n" +
 	"\x17CreateAssignmentRequest\x12\x19\n" +
 	"\btutor_id\x18\x01 \x01(\tR\atutorId\x12\x1d\n" +
 	"\n" +
@@ -1348,41 +5876,155 @@ const file_my_proto_homework_service_proto_rawDesc = "" +
 	"\x05title\x18\x03 \x01(\tH\x00R\x05title\x88\x01\x01\x12%\n" +
 	"\vdescription\x18\x04 \x01(\tH\x01R\vdescription\x88\x01\x01\x12\x1c\n" +
 	"\afile_id\x18\x05 \x01(\tH\x02R\x06fileId\x88\x01\x01\x12:\n" +
-	"\bdue_date\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampH\x03R\adueDate\x88\x01\x01B\b\n" +
+	"\bdue_date\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampH\x03R\adueDate\x88\x01\x01\x12>\n" +
+	"\vattachments\x18\a \x03(\v2\x1c.homework.v1.AttachmentInputR\vattachments\x12 \n" +
+	"\tlesson_id\x18\b \x01(\tH\x04R\blessonId\x88\x01\x01\x123\n" +
+	"\x16due_before_next_lesson\x18\t \x01(\bR\x13dueBeforeNextLesson\x12-\n" +
+	"\x04quiz\x18\n" +
+	" \x03(\v2\x19.homework.v1.QuizQuestionR\x04quiz\x128\n" +
+	"\vlate_policy\x18\v \x01(\x0e2\x17.homework.v1.LatePolicyR\n" +
+	"latePolicy\x125\n" +
+	"\x14grace_period_seconds\x18\f \x01(\x03H\x05R\x12gracePeriodSeconds\x88\x01\x01\x122\n" +
+	"\x05state\x18\r \x01(\x0e2\x1c.homework.v1.AssignmentStateR\x05state\x12>\n" +
+	"\n" +
+	"publish_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampH\x06R\tpublishAt\x88\x01\x01\x12\x1c\n" +
+	"\tchecklist\x18\x0f \x03(\tR\tchecklistB\b\n" +
 	"\x06_titleB\x0e\n" +
 	"\f_descriptionB\n" +
 	"\n" +
 	"\b_file_idB\v\n" +
-	"\t_due_date\"\xf8\x01\n" +
+	"\t_due_dateB\f\n" +
+	"\n" +
+	"_lesson_idB\x17\n" +
+	"\x15_grace_period_secondsB\r\n" +
+	"\v_publish_at\"\xda\x06\n" +
 	"\x17UpdateAssignmentRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
 	"\x05title\x18\x02 \x01(\tH\x00R\x05title\x88\x01\x01\x12%\n" +
 	"\vdescription\x18\x03 \x01(\tH\x01R\vdescription\x88\x01\x01\x12\x1c\n" +
 	"\afile_id\x18\x04 \x01(\tH\x02R\x06fileId\x88\x01\x01\x12:\n" +
-	"\bdue_date\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampH\x03R\adueDate\x88\x01\x01B\b\n" +
+	"\bdue_date\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampH\x03R\adueDate\x88\x01\x01\x12=\n" +
+	"\vattachments\x18\x06 \x01(\v2\x1b.homework.v1.AttachmentListR\vattachments\x12 \n" +
+	"\tlesson_id\x18\a \x01(\tH\x04R\blessonId\x88\x01\x01\x128\n" +
+	"\x16due_before_next_lesson\x18\b \x01(\bH\x05R\x13dueBeforeNextLesson\x88\x01\x01\x121\n" +
+	"\x04quiz\x18\t \x01(\v2\x1d.homework.v1.QuizQuestionListR\x04quiz\x12=\n" +
+	"\vlate_policy\x18\n" +
+	" \x01(\x0e2\x17.homework.v1.LatePolicyH\x06R\n" +
+	"latePolicy\x88\x01\x01\x125\n" +
+	"\x14grace_period_seconds\x18\v \x01(\x03H\aR\x12gracePeriodSeconds\x88\x01\x01\x127\n" +
+	"\x05state\x18\f \x01(\x0e2\x1c.homework.v1.AssignmentStateH\bR\x05state\x88\x01\x01\x12>\n" +
+	"\n" +
+	"publish_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampH\tR\tpublishAt\x88\x01\x01\x128\n" +
+	"\tchecklist\x18\x0e \x01(\v2\x1a.homework.v1.ChecklistListR\tchecklistB\b\n" +
 	"\x06_titleB\x0e\n" +
 	"\f_descriptionB\n" +
 	"\n" +
 	"\b_file_idB\v\n" +
-	"\t_due_date\"\x84\x01\n" +
+	"\t_due_dateB\f\n" +
+	"\n" +
+	"_lesson_idB\x19\n" +
+	"\x17_due_before_next_lessonB\x0e\n" +
+	"\f_late_policyB\x17\n" +
+	"\x15_grace_period_secondsB\b\n" +
+	"\x06_stateB\r\n" +
+	"\v_publish_at\"\xd9\x01\n" +
 	"\x1dListAssignmentsByTutorRequest\x12\x19\n" +
 	"\btutor_id\x18\x01 \x01(\tR\atutorId\x12H\n" +
-	"\rstatus_filter\x18\x02 \x03(\x0e2#.homework.v1.AssignmentStatusFilterR\fstatusFilter\"\x8a\x01\n" +
+	"\rstatus_filter\x18\x02 \x03(\x0e2#.homework.v1.AssignmentStatusFilterR\fstatusFilter\x12S\n" +
+	"\x12checklist_progress\x18\x03 \x01(\v2$.homework.v1.ChecklistProgressFilterR\x11checklistProgress\"\xdf\x01\n" +
 	"\x1fListAssignmentsByStudentRequest\x12\x1d\n" +
 	"\n" +
 	"student_id\x18\x01 \x01(\tR\tstudentId\x12H\n" +
-	"\rstatus_filter\x18\x02 \x03(\x0e2#.homework.v1.AssignmentStatusFilterR\fstatusFilter\"\xa2\x01\n" +
+	"\rstatus_filter\x18\x02 \x03(\x0e2#.homework.v1.AssignmentStatusFilterR\fstatusFilter\x12S\n" +
+	"\x12checklist_progress\x18\x03 \x01(\v2$.homework.v1.ChecklistProgressFilterR\x11checklistProgress\"\xf7\x01\n" +
 	"\x1cListAssignmentsByPairRequest\x12\x19\n" +
 	"\btutor_id\x18\x01 \x01(\tR\atutorId\x12\x1d\n" +
 	"\n" +
 	"student_id\x18\x02 \x01(\tR\tstudentId\x12H\n" +
-	"\rstatus_filter\x18\x03 \x03(\x0e2#.homework.v1.AssignmentStatusFilterR\fstatusFilter\"T\n" +
+	"\rstatus_filter\x18\x03 \x03(\x0e2#.homework.v1.AssignmentStatusFilterR\fstatusFilter\x12S\n" +
+	"\x12checklist_progress\x18\x04 \x01(\v2$.homework.v1.ChecklistProgressFilterR\x11checklistProgress\"\xdc\x01\n" +
+	"\x1eListAssignmentsByLessonRequest\x12\x1b\n" +
+	"\tlesson_id\x18\x01 \x01(\tR\blessonId\x12H\n" +
+	"\rstatus_filter\x18\x02 \x03(\x0e2#.homework.v1.AssignmentStatusFilterR\fstatusFilter\x12S\n" +
+	"\x12checklist_progress\x18\x03 \x01(\v2$.homework.v1.ChecklistProgressFilterR\x11checklistProgress\"W\n" +
+	"\x17ChecklistProgressFilter\x12\x15\n" +
+	"\x03min\x18\x01 \x01(\x05H\x00R\x03min\x88\x01\x01\x12\x15\n" +
+	"\x03max\x18\x02 \x01(\x05H\x01R\x03max\x88\x01\x01B\x06\n" +
+	"\x04_minB\x06\n" +
+	"\x04_max\"T\n" +
 	"\x17ListAssignmentsResponse\x129\n" +
-	"\vassignments\x18\x01 \x03(\v2\x17.homework.v1.AssignmentR\vassignments\"\x93\x01\n" +
+	"\vassignments\x18\x01 \x03(\v2\x17.homework.v1.AssignmentR\vassignments\"\xa2\x02\n" +
+	"\x1fCreateAssignmentTemplateRequest\x12\x19\n" +
+	"\btutor_id\x18\x01 \x01(\tR\atutorId\x12\x19\n" +
+	"\x05title\x18\x02 \x01(\tH\x00R\x05title\x88\x01\x01\x12%\n" +
+	"\vdescription\x18\x03 \x01(\tH\x01R\vdescription\x88\x01\x01\x12>\n" +
+	"\vattachments\x18\x04 \x03(\v2\x1c.homework.v1.AttachmentInputR\vattachments\x121\n" +
+	"\x12due_offset_seconds\x18\x05 \x01(\x03H\x02R\x10dueOffsetSeconds\x88\x01\x01B\b\n" +
+	"\x06_titleB\x0e\n" +
+	"\f_descriptionB\x15\n" +
+	"\x13_due_offset_seconds\"\x96\x02\n" +
+	"\x1fUpdateAssignmentTemplateRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\x05title\x18\x02 \x01(\tH\x00R\x05title\x88\x01\x01\x12%\n" +
+	"\vdescription\x18\x03 \x01(\tH\x01R\vdescription\x88\x01\x01\x12=\n" +
+	"\vattachments\x18\x04 \x01(\v2\x1b.homework.v1.AttachmentListR\vattachments\x121\n" +
+	"\x12due_offset_seconds\x18\x05 \x01(\x03H\x02R\x10dueOffsetSeconds\x88\x01\x01B\b\n" +
+	"\x06_titleB\x0e\n" +
+	"\f_descriptionB\x15\n" +
+	"\x13_due_offset_seconds\"B\n" +
+	"\x1fDeleteAssignmentTemplateRequest\x12\x1f\n" +
+	"\vtemplate_id\x18\x01 \x01(\tR\n" +
+	"templateId\";\n" +
+	"\x1eListAssignmentTemplatesRequest\x12\x19\n" +
+	"\btutor_id\x18\x01 \x01(\tR\atutorId\"`\n" +
+	"\x1fListAssignmentTemplatesResponse\x12=\n" +
+	"\ttemplates\x18\x01 \x03(\v2\x1f.homework.v1.AssignmentTemplateR\ttemplates\"\xa6\x01\n" +
+	"\x19AssignFromTemplateRequest\x12\x1f\n" +
+	"\vtemplate_id\x18\x01 \x01(\tR\n" +
+	"templateId\x12\x1f\n" +
+	"\vstudent_ids\x18\x02 \x03(\tR\n" +
+	"studentIds\x12:\n" +
+	"\bdue_date\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\adueDate\x88\x01\x01B\v\n" +
+	"\t_due_date\"v\n" +
+	"\x19SetDefaultTemplateRequest\x12\x19\n" +
+	"\btutor_id\x18\x01 \x01(\tR\atutorId\x12\x1d\n" +
+	"\n" +
+	"student_id\x18\x02 \x01(\tR\tstudentId\x12\x1f\n" +
+	"\vtemplate_id\x18\x03 \x01(\tR\n" +
+	"templateId\"U\n" +
+	"\x19GetDefaultTemplateRequest\x12\x19\n" +
+	"\btutor_id\x18\x01 \x01(\tR\atutorId\x12\x1d\n" +
+	"\n" +
+	"student_id\x18\x02 \x01(\tR\tstudentId\"\xfb\x01\n" +
+	"\x14CreateCommentRequest\x12#\n" +
+	"\rassignment_id\x18\x01 \x01(\tR\fassignmentId\x12(\n" +
+	"\rsubmission_id\x18\x02 \x01(\tH\x00R\fsubmissionId\x88\x01\x01\x12 \n" +
+	"\tparent_id\x18\x03 \x01(\tH\x01R\bparentId\x88\x01\x01\x12\x12\n" +
+	"\x04body\x18\x04 \x01(\tR\x04body\x12>\n" +
+	"\vattachments\x18\x05 \x03(\v2\x1c.homework.v1.AttachmentInputR\vattachmentsB\x10\n" +
+	"\x0e_submission_idB\f\n" +
+	"\n" +
+	"_parent_id\"\x87\x01\n" +
+	"\x14UpdateCommentRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\x04body\x18\x02 \x01(\tH\x00R\x04body\x88\x01\x01\x12=\n" +
+	"\vattachments\x18\x03 \x01(\v2\x1b.homework.v1.AttachmentListR\vattachmentsB\a\n" +
+	"\x05_body\"5\n" +
+	"\x14DeleteCommentRequest\x12\x1d\n" +
+	"\n" +
+	"comment_id\x18\x01 \x01(\tR\tcommentId\"v\n" +
+	"\x13ListCommentsRequest\x12#\n" +
+	"\rassignment_id\x18\x01 \x01(\tR\fassignmentId\x12(\n" +
+	"\rsubmission_id\x18\x02 \x01(\tH\x00R\fsubmissionId\x88\x01\x01B\x10\n" +
+	"\x0e_submission_id\"H\n" +
+	"\x14ListCommentsResponse\x120\n" +
+	"\bcomments\x18\x01 \x03(\v2\x14.homework.v1.CommentR\bcomments\"\x86\x02\n" +
 	"\x17CreateSubmissionRequest\x12#\n" +
 	"\rassignment_id\x18\x01 \x01(\tR\fassignmentId\x12\x1c\n" +
 	"\afile_id\x18\x02 \x01(\tH\x00R\x06fileId\x88\x01\x01\x12\x1d\n" +
-	"\acomment\x18\x03 \x01(\tH\x01R\acomment\x88\x01\x01B\n" +
+	"\acomment\x18\x03 \x01(\tH\x01R\acomment\x88\x01\x01\x12>\n" +
+	"\vattachments\x18\x04 \x03(\v2\x1c.homework.v1.AttachmentInputR\vattachments\x121\n" +
+	"\aanswers\x18\x05 \x03(\v2\x17.homework.v1.QuizAnswerR\aanswersB\n" +
 	"\n" +
 	"\b_file_idB\n" +
 	"\n" +
@@ -1390,27 +6032,123 @@ const file_my_proto_homework_service_proto_rawDesc = "" +
 	"\"ListSubmissionsByAssignmentRequest\x12#\n" +
 	"\rassignment_id\x18\x01 \x01(\tR\fassignmentId\"T\n" +
 	"\x17ListSubmissionsResponse\x129\n" +
-	"\vsubmissions\x18\x01 \x03(\v2\x17.homework.v1.SubmissionR\vsubmissions\"\x91\x01\n" +
+	"\vsubmissions\x18\x01 \x03(\v2\x17.homework.v1.SubmissionR\vsubmissions\"\xc6\x03\n" +
 	"\x15CreateFeedbackRequest\x12#\n" +
 	"\rsubmission_id\x18\x01 \x01(\tR\fsubmissionId\x12\x1c\n" +
 	"\afile_id\x18\x02 \x01(\tH\x00R\x06fileId\x88\x01\x01\x12\x1d\n" +
-	"\acomment\x18\x03 \x01(\tH\x01R\acomment\x88\x01\x01B\n" +
+	"\acomment\x18\x03 \x01(\tH\x01R\acomment\x88\x01\x01\x12>\n" +
+	"\vattachments\x18\x04 \x03(\v2\x1c.homework.v1.AttachmentInputR\vattachments\x12\x19\n" +
+	"\x05score\x18\x05 \x01(\x01H\x02R\x05score\x88\x01\x01\x12 \n" +
+	"\tmax_score\x18\x06 \x01(\x01H\x03R\bmaxScore\x88\x01\x01\x12+\n" +
+	"\x06rubric\x18\a \x01(\v2\x13.homework.v1.RubricR\x06rubric\x126\n" +
+	"\averdict\x18\b \x01(\x0e2\x1c.homework.v1.FeedbackVerdictR\averdict\x129\n" +
+	"\vannotations\x18\t \x03(\v2\x17.homework.v1.AnnotationR\vannotationsB\n" +
 	"\n" +
 	"\b_file_idB\n" +
 	"\n" +
-	"\b_comment\"|\n" +
+	"\b_commentB\b\n" +
+	"\x06_scoreB\f\n" +
+	"\n" +
+	"_max_score\"\xb4\x03\n" +
 	"\x15UpdateFeedbackRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1c\n" +
 	"\afile_id\x18\x02 \x01(\tH\x00R\x06fileId\x88\x01\x01\x12\x1d\n" +
-	"\acomment\x18\x03 \x01(\tH\x01R\acomment\x88\x01\x01B\n" +
+	"\acomment\x18\x03 \x01(\tH\x01R\acomment\x88\x01\x01\x12=\n" +
+	"\vattachments\x18\x04 \x01(\v2\x1b.homework.v1.AttachmentListR\vattachments\x12\x19\n" +
+	"\x05score\x18\x05 \x01(\x01H\x02R\x05score\x88\x01\x01\x12 \n" +
+	"\tmax_score\x18\x06 \x01(\x01H\x03R\bmaxScore\x88\x01\x01\x12+\n" +
+	"\x06rubric\x18\a \x01(\v2\x13.homework.v1.RubricR\x06rubric\x126\n" +
+	"\averdict\x18\b \x01(\x0e2\x1c.homework.v1.FeedbackVerdictR\averdict\x12=\n" +
+	"\vannotations\x18\t \x01(\v2\x1b.homework.v1.AnnotationListR\vannotationsB\n" +
 	"\n" +
 	"\b_file_idB\n" +
 	"\n" +
-	"\b_comment\"G\n" +
+	"\b_commentB\b\n" +
+	"\x06_scoreB\f\n" +
+	"\n" +
+	"_max_score\"]\n" +
+	"!FlattenFeedbackAnnotationsRequest\x12\x1f\n" +
+	"\vfeedback_id\x18\x01 \x01(\tR\n" +
+	"feedbackId\x12\x17\n" +
+	"\afile_id\x18\x02 \x01(\tR\x06fileId\"G\n" +
 	" ListFeedbacksByAssignmentRequest\x12#\n" +
 	"\rassignment_id\x18\x01 \x01(\tR\fassignmentId\"L\n" +
 	"\x15ListFeedbacksResponse\x123\n" +
-	"\tfeedbacks\x18\x01 \x03(\v2\x15.homework.v1.FeedbackR\tfeedbacks\"?\n" +
+	"\tfeedbacks\x18\x01 \x03(\v2\x15.homework.v1.FeedbackR\tfeedbacks\"\xc5\x01\n" +
+	"\x13GetGradebookRequest\x12\x19\n" +
+	"\btutor_id\x18\x01 \x01(\tR\atutorId\x12\x1d\n" +
+	"\n" +
+	"student_id\x18\x02 \x01(\tR\tstudentId\x123\n" +
+	"\x04from\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\x04from\x88\x01\x01\x12/\n" +
+	"\x02to\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampH\x01R\x02to\x88\x01\x01B\a\n" +
+	"\x05_fromB\x05\n" +
+	"\x03_to\"\xbd\x03\n" +
+	"\x0eGradebookEntry\x12#\n" +
+	"\rassignment_id\x18\x01 \x01(\tR\fassignmentId\x12\x19\n" +
+	"\x05title\x18\x02 \x01(\tH\x00R\x05title\x88\x01\x01\x12:\n" +
+	"\bdue_date\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampH\x01R\adueDate\x88\x01\x01\x12\x1f\n" +
+	"\vfeedback_id\x18\x04 \x01(\tR\n" +
+	"feedbackId\x127\n" +
+	"\tgraded_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\bgradedAt\x12\x14\n" +
+	"\x05score\x18\x06 \x01(\x01R\x05score\x12 \n" +
+	"\tmax_score\x18\a \x01(\x01H\x02R\bmaxScore\x88\x01\x01\x12\x1d\n" +
+	"\apercent\x18\b \x01(\x01H\x03R\apercent\x88\x01\x01\x124\n" +
+	"\x06rubric\x18\t \x03(\v2\x1c.homework.v1.RubricCriterionR\x06rubric\x12\x17\n" +
+	"\ais_late\x18\n" +
+	" \x01(\bR\x06isLateB\b\n" +
+	"\x06_titleB\v\n" +
+	"\t_due_dateB\f\n" +
+	"\n" +
+	"_max_scoreB\n" +
+	"\n" +
+	"\b_percent\"\x8a\x01\n" +
+	"\x10CriterionAverage\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\x12#\n" +
+	"\raverage_score\x18\x03 \x01(\x01R\faverageScore\x12'\n" +
+	"\x0faverage_percent\x18\x04 \x01(\x01R\x0eaveragePercent\"\xf9\x02\n" +
+	"\tGradebook\x12\x19\n" +
+	"\btutor_id\x18\x01 \x01(\tR\atutorId\x12\x1d\n" +
+	"\n" +
+	"student_id\x18\x02 \x01(\tR\tstudentId\x125\n" +
+	"\aentries\x18\x03 \x03(\v2\x1b.homework.v1.GradebookEntryR\aentries\x12(\n" +
+	"\raverage_score\x18\x04 \x01(\x01H\x00R\faverageScore\x88\x01\x01\x12,\n" +
+	"\x0faverage_percent\x18\x05 \x01(\x01H\x01R\x0eaveragePercent\x88\x01\x01\x12\x19\n" +
+	"\x05trend\x18\x06 \x01(\x01H\x02R\x05trend\x88\x01\x01\x129\n" +
+	"\bcriteria\x18\a \x03(\v2\x1d.homework.v1.CriterionAverageR\bcriteria\x12\x1d\n" +
+	"\n" +
+	"late_count\x18\b \x01(\x05R\tlateCountB\x10\n" +
+	"\x0e_average_scoreB\x12\n" +
+	"\x10_average_percentB\b\n" +
+	"\x06_trend\"\xaa\x01\n" +
+	"\x17GetHomeworkStatsRequest\x12\x19\n" +
+	"\btutor_id\x18\x01 \x01(\tR\atutorId\x123\n" +
+	"\x04from\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\x04from\x88\x01\x01\x12/\n" +
+	"\x02to\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampH\x01R\x02to\x88\x01\x01B\a\n" +
+	"\x05_fromB\x05\n" +
+	"\x03_to\"\xb4\x02\n" +
+	"\x14StudentHomeworkStats\x12\x1d\n" +
+	"\n" +
+	"student_id\x18\x01 \x01(\tR\tstudentId\x12\x1a\n" +
+	"\bassigned\x18\x02 \x01(\x05R\bassigned\x12\x1c\n" +
+	"\tsubmitted\x18\x03 \x01(\x05R\tsubmitted\x12\x10\n" +
+	"\x03due\x18\x04 \x01(\x05R\x03due\x12\x17\n" +
+	"\aon_time\x18\x05 \x01(\x05R\x06onTime\x12,\n" +
+	"\x0fcompletion_rate\x18\x06 \x01(\x01H\x00R\x0ecompletionRate\x88\x01\x01\x12%\n" +
+	"\fon_time_rate\x18\a \x01(\x01H\x01R\n" +
+	"onTimeRate\x88\x01\x01\x12\x1e\n" +
+	"\n" +
+	"unreviewed\x18\b \x01(\x05R\n" +
+	"unreviewedB\x12\n" +
+	"\x10_completion_rateB\x0f\n" +
+	"\r_on_time_rate\"\xae\x02\n" +
+	"\rHomeworkStats\x12\x19\n" +
+	"\btutor_id\x18\x01 \x01(\tR\atutorId\x12=\n" +
+	"\bstudents\x18\x02 \x03(\v2!.homework.v1.StudentHomeworkStatsR\bstudents\x12L\n" +
+	" median_review_turnaround_seconds\x18\x03 \x01(\x03H\x00R\x1dmedianReviewTurnaroundSeconds\x88\x01\x01\x12%\n" +
+	"\x0ereviewed_count\x18\x04 \x01(\x05R\rreviewedCount\x12)\n" +
+	"\x10unreviewed_count\x18\x05 \x01(\x05R\x0funreviewedCountB#\n" +
+	"!_median_review_turnaround_seconds\"?\n" +
 	"\x18GetAssignmentFileRequest\x12#\n" +
 	"\rassignment_id\x18\x01 \x01(\tR\fassignmentId\"?\n" +
 	"\x18GetSubmissionFileRequest\x12#\n" +
@@ -1419,7 +6157,30 @@ const file_my_proto_homework_service_proto_rawDesc = "" +
 	"\vfeedback_id\x18\x01 \x01(\tR\n" +
 	"feedbackId\"#\n" +
 	"\x0fHomeworkFileURL\x12\x10\n" +
-	"\x03url\x18\x01 \x01(\tR\x03url\"\x99\x03\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\"{\n" +
+	"\x1dListAttachmentFileURLsRequest\x12?\n" +
+	"\n" +
+	"owner_type\x18\x01 \x01(\x0e2 .homework.v1.AttachmentOwnerTypeR\townerType\x12\x19\n" +
+	"\bowner_id\x18\x02 \x01(\tR\aownerId\"\x85\x01\n" +
+	"\x11AttachmentFileURL\x12\x17\n" +
+	"\afile_id\x18\x01 \x01(\tR\x06fileId\x12\x1d\n" +
+	"\acaption\x18\x02 \x01(\tH\x00R\acaption\x88\x01\x01\x12\x1a\n" +
+	"\bposition\x18\x03 \x01(\x05R\bposition\x12\x10\n" +
+	"\x03url\x18\x04 \x01(\tR\x03urlB\n" +
+	"\n" +
+	"\b_caption\"b\n" +
+	"\x1eListAttachmentFileURLsResponse\x12@\n" +
+	"\vattachments\x18\x01 \x03(\v2\x1e.homework.v1.AttachmentFileURLR\vattachments\"\xb7\x01\n" +
+	"\n" +
+	"Attachment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\afile_id\x18\x02 \x01(\tR\x06fileId\x12\x1d\n" +
+	"\acaption\x18\x03 \x01(\tH\x00R\acaption\x88\x01\x01\x12\x1a\n" +
+	"\bposition\x18\x04 \x01(\x05R\bposition\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAtB\n" +
+	"\n" +
+	"\b_caption\"\xb1\t\n" +
 	"\n" +
 	"Assignment\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
@@ -1432,12 +6193,64 @@ const file_my_proto_homework_service_proto_rawDesc = "" +
 	"\bdue_date\x18\a \x01(\v2\x1a.google.protobuf.TimestampH\x03R\adueDate\x88\x01\x01\x129\n" +
 	"\n" +
 	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x127\n" +
-	"\tedited_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\beditedAtB\b\n" +
+	"\tedited_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\beditedAt\x129\n" +
+	"\vattachments\x18\n" +
+	" \x03(\v2\x17.homework.v1.AttachmentR\vattachments\x12 \n" +
+	"\tlesson_id\x18\v \x01(\tH\x04R\blessonId\x88\x01\x01\x123\n" +
+	"\x16due_before_next_lesson\x18\f \x01(\bR\x13dueBeforeNextLesson\x12'\n" +
+	"\rdue_lesson_id\x18\r \x01(\tH\x05R\vdueLessonId\x88\x01\x01\x12-\n" +
+	"\x04quiz\x18\x0e \x03(\v2\x19.homework.v1.QuizQuestionR\x04quiz\x128\n" +
+	"\vlate_policy\x18\x0f \x01(\x0e2\x17.homework.v1.LatePolicyR\n" +
+	"latePolicy\x125\n" +
+	"\x14grace_period_seconds\x18\x10 \x01(\x03H\x06R\x12gracePeriodSeconds\x88\x01\x01\x12%\n" +
+	"\x0esubmitted_late\x18\x11 \x01(\bR\rsubmittedLate\x122\n" +
+	"\x05state\x18\x12 \x01(\x0e2\x1c.homework.v1.AssignmentStateR\x05state\x12>\n" +
+	"\n" +
+	"publish_at\x18\x13 \x01(\v2\x1a.google.protobuf.TimestampH\aR\tpublishAt\x88\x01\x01\x12B\n" +
+	"\fpublished_at\x18\x14 \x01(\v2\x1a.google.protobuf.TimestampH\bR\vpublishedAt\x88\x01\x01\x128\n" +
+	"\tchecklist\x18\x15 \x03(\v2\x1a.homework.v1.ChecklistItemR\tchecklist\x122\n" +
+	"\x12checklist_progress\x18\x16 \x01(\x05H\tR\x11checklistProgress\x88\x01\x01B\b\n" +
 	"\x06_titleB\x0e\n" +
 	"\f_descriptionB\n" +
 	"\n" +
 	"\b_file_idB\v\n" +
-	"\t_due_date\"\x8a\x02\n" +
+	"\t_due_dateB\f\n" +
+	"\n" +
+	"_lesson_idB\x10\n" +
+	"\x0e_due_lesson_idB\x17\n" +
+	"\x15_grace_period_secondsB\r\n" +
+	"\v_publish_atB\x0f\n" +
+	"\r_published_atB\x15\n" +
+	"\x13_checklist_progress\"\xa4\x03\n" +
+	"\aComment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12#\n" +
+	"\rassignment_id\x18\x02 \x01(\tR\fassignmentId\x12(\n" +
+	"\rsubmission_id\x18\x03 \x01(\tH\x00R\fsubmissionId\x88\x01\x01\x12 \n" +
+	"\tparent_id\x18\x04 \x01(\tH\x01R\bparentId\x88\x01\x01\x12\x1b\n" +
+	"\tauthor_id\x18\x05 \x01(\tR\bauthorId\x12\x12\n" +
+	"\x04body\x18\x06 \x01(\tR\x04body\x129\n" +
+	"\vattachments\x18\a \x03(\v2\x17.homework.v1.AttachmentR\vattachments\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x127\n" +
+	"\tedited_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\beditedAt\x12\x18\n" +
+	"\adeleted\x18\n" +
+	" \x01(\bR\adeletedB\x10\n" +
+	"\x0e_submission_idB\f\n" +
+	"\n" +
+	"_parent_id\"\x94\x03\n" +
+	"\x12AssignmentTemplate\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\btutor_id\x18\x02 \x01(\tR\atutorId\x12\x19\n" +
+	"\x05title\x18\x03 \x01(\tH\x00R\x05title\x88\x01\x01\x12%\n" +
+	"\vdescription\x18\x04 \x01(\tH\x01R\vdescription\x88\x01\x01\x129\n" +
+	"\vattachments\x18\x05 \x03(\v2\x17.homework.v1.AttachmentR\vattachments\x121\n" +
+	"\x12due_offset_seconds\x18\x06 \x01(\x03H\x02R\x10dueOffsetSeconds\x88\x01\x01\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x127\n" +
+	"\tedited_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\beditedAtB\b\n" +
+	"\x06_titleB\x0e\n" +
+	"\f_descriptionB\x15\n" +
+	"\x13_due_offset_seconds\"\xab\x03\n" +
 	"\n" +
 	"Submission\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12#\n" +
@@ -1446,11 +6259,16 @@ const file_my_proto_homework_service_proto_rawDesc = "" +
 	"\acomment\x18\x04 \x01(\tH\x01R\acomment\x88\x01\x01\x129\n" +
 	"\n" +
 	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x127\n" +
-	"\tedited_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\beditedAtB\n" +
+	"\tedited_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\beditedAt\x129\n" +
+	"\vattachments\x18\b \x03(\v2\x17.homework.v1.AttachmentR\vattachments\x12\x18\n" +
+	"\aversion\x18\t \x01(\x05R\aversion\x121\n" +
+	"\aanswers\x18\n" +
+	" \x03(\v2\x17.homework.v1.QuizAnswerR\aanswers\x12\x17\n" +
+	"\ais_late\x18\v \x01(\bR\x06isLateB\n" +
 	"\n" +
 	"\b_file_idB\n" +
 	"\n" +
-	"\b_comment\"\x88\x02\n" +
+	"\b_comment\"\xc1\x04\n" +
 	"\bFeedback\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12#\n" +
 	"\rsubmission_id\x18\x02 \x01(\tR\fsubmissionId\x12\x1c\n" +
@@ -1458,11 +6276,107 @@ const file_my_proto_homework_service_proto_rawDesc = "" +
 	"\acomment\x18\x04 \x01(\tH\x01R\acomment\x88\x01\x01\x129\n" +
 	"\n" +
 	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x127\n" +
-	"\tedited_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\beditedAtB\n" +
+	"\tedited_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\beditedAt\x129\n" +
+	"\vattachments\x18\a \x03(\v2\x17.homework.v1.AttachmentR\vattachments\x12\x19\n" +
+	"\x05score\x18\b \x01(\x01H\x02R\x05score\x88\x01\x01\x12 \n" +
+	"\tmax_score\x18\t \x01(\x01H\x03R\bmaxScore\x88\x01\x01\x124\n" +
+	"\x06rubric\x18\n" +
+	" \x03(\v2\x1c.homework.v1.RubricCriterionR\x06rubric\x126\n" +
+	"\averdict\x18\v \x01(\x0e2\x1c.homework.v1.FeedbackVerdictR\averdict\x129\n" +
+	"\vannotations\x18\f \x03(\v2\x17.homework.v1.AnnotationR\vannotationsB\n" +
 	"\n" +
 	"\b_file_idB\n" +
 	"\n" +
-	"\b_comment*r\n" +
+	"\b_commentB\b\n" +
+	"\x06_scoreB\f\n" +
+	"\n" +
+	"_max_score\"\x95\x02\n" +
+	"\n" +
+	"Annotation\x12\x17\n" +
+	"\afile_id\x18\x01 \x01(\tR\x06fileId\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12/\n" +
+	"\x04type\x18\x03 \x01(\x0e2\x1b.homework.v1.AnnotationTypeR\x04type\x12\f\n" +
+	"\x01x\x18\x04 \x01(\x01R\x01x\x12\f\n" +
+	"\x01y\x18\x05 \x01(\x01R\x01y\x12\x14\n" +
+	"\x05width\x18\x06 \x01(\x01R\x05width\x12\x16\n" +
+	"\x06height\x18\a \x01(\x01R\x06height\x124\n" +
+	"\x06points\x18\b \x03(\v2\x1c.homework.v1.AnnotationPointR\x06points\x12\x1d\n" +
+	"\acomment\x18\t \x01(\tH\x00R\acomment\x88\x01\x01B\n" +
+	"\n" +
+	"\b_comment\"-\n" +
+	"\x0fAnnotationPoint\x12\f\n" +
+	"\x01x\x18\x01 \x01(\x01R\x01x\x12\f\n" +
+	"\x01y\x18\x02 \x01(\x01R\x01y\"?\n" +
+	"\x0eAnnotationList\x12-\n" +
+	"\x05items\x18\x01 \x03(\v2\x17.homework.v1.AnnotationR\x05items\":\n" +
+	"\rAnnotatedFile\x12\x17\n" +
+	"\afile_id\x18\x01 \x01(\tR\x06fileId\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\"\xf1\x02\n" +
+	"\x15SearchHomeworkRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\x1e\n" +
+	"\btutor_id\x18\x02 \x01(\tH\x00R\atutorId\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"student_id\x18\x03 \x01(\tH\x01R\tstudentId\x88\x01\x01\x120\n" +
+	"\x05types\x18\x04 \x03(\x0e2\x1a.homework.v1.SearchHitTypeR\x05types\x123\n" +
+	"\x04from\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampH\x02R\x04from\x88\x01\x01\x12/\n" +
+	"\x02to\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampH\x03R\x02to\x88\x01\x01\x12\x1b\n" +
+	"\tpage_size\x18\a \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\b \x01(\tR\tpageTokenB\v\n" +
+	"\t_tutor_idB\r\n" +
+	"\v_student_idB\a\n" +
+	"\x05_fromB\x05\n" +
+	"\x03_to\"\xd8\x02\n" +
+	"\tSearchHit\x12.\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x1a.homework.v1.SearchHitTypeR\x04type\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x12#\n" +
+	"\rassignment_id\x18\x03 \x01(\tR\fassignmentId\x12\x19\n" +
+	"\btutor_id\x18\x04 \x01(\tR\atutorId\x12\x1d\n" +
+	"\n" +
+	"student_id\x18\x05 \x01(\tR\tstudentId\x12.\n" +
+	"\x10assignment_title\x18\x06 \x01(\tH\x00R\x0fassignmentTitle\x88\x01\x01\x12\x18\n" +
+	"\asnippet\x18\a \x01(\tR\asnippet\x12\x12\n" +
+	"\x04rank\x18\b \x01(\x01R\x04rank\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAtB\x13\n" +
+	"\x11_assignment_title\"l\n" +
+	"\x16SearchHomeworkResponse\x12*\n" +
+	"\x04hits\x18\x01 \x03(\v2\x16.homework.v1.SearchHitR\x04hits\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xce\x01\n" +
+	"\x1cCreatePortfolioExportRequest\x12\x19\n" +
+	"\btutor_id\x18\x01 \x01(\tR\atutorId\x12\x1d\n" +
+	"\n" +
+	"student_id\x18\x02 \x01(\tR\tstudentId\x123\n" +
+	"\x04from\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\x04from\x88\x01\x01\x12/\n" +
+	"\x02to\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampH\x01R\x02to\x88\x01\x01B\a\n" +
+	"\x05_fromB\x05\n" +
+	"\x03_to\"+\n" +
+	"\x19GetPortfolioExportRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xc5\x04\n" +
+	"\x0fPortfolioExport\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12!\n" +
+	"\frequested_by\x18\x02 \x01(\tR\vrequestedBy\x12\x19\n" +
+	"\btutor_id\x18\x03 \x01(\tR\atutorId\x12\x1d\n" +
+	"\n" +
+	"student_id\x18\x04 \x01(\tR\tstudentId\x123\n" +
+	"\x04from\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\x04from\x88\x01\x01\x12/\n" +
+	"\x02to\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampH\x01R\x02to\x88\x01\x01\x12:\n" +
+	"\x06status\x18\a \x01(\x0e2\".homework.v1.PortfolioExportStatusR\x06status\x12\x1c\n" +
+	"\afile_id\x18\b \x01(\tH\x02R\x06fileId\x88\x01\x01\x12&\n" +
+	"\fdownload_url\x18\t \x01(\tH\x03R\vdownloadUrl\x88\x01\x01\x12\x19\n" +
+	"\x05error\x18\n" +
+	" \x01(\tH\x04R\x05error\x88\x01\x01\x129\n" +
+	"\n" +
+	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12@\n" +
+	"\vfinished_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampH\x05R\n" +
+	"finishedAt\x88\x01\x01B\a\n" +
+	"\x05_fromB\x05\n" +
+	"\x03_toB\n" +
+	"\n" +
+	"\b_file_idB\x0f\n" +
+	"\r_download_urlB\b\n" +
+	"\x06_errorB\x0e\n" +
+	"\f_finished_at*\x86\x01\n" +
 	"\x16AssignmentStatusFilter\x12!\n" +
 	"\x1dASSIGNMENT_STATUS_UNSPECIFIED\x10\x00\x12\n" +
 	"\n" +
@@ -1470,23 +6384,92 @@ const file_my_proto_homework_service_proto_rawDesc = "" +
 	"\n" +
 	"UNREVIEWED\x10\x02\x12\f\n" +
 	"\bREVIEWED\x10\x03\x12\v\n" +
-	"\aOVERDUE\x10\x042\xa8\n" +
+	"\aOVERDUE\x10\x04\x12\x12\n" +
+	"\x0eNEEDS_REVISION\x10\x05*w\n" +
+	"\x0fFeedbackVerdict\x12 \n" +
+	"\x1cFEEDBACK_VERDICT_UNSPECIFIED\x10\x00\x12\x1d\n" +
+	"\x19FEEDBACK_VERDICT_ACCEPTED\x10\x01\x12#\n" +
+	"\x1fFEEDBACK_VERDICT_NEEDS_REVISION\x10\x02*\xbb\x01\n" +
+	"\x13AttachmentOwnerType\x12%\n" +
+	"!ATTACHMENT_OWNER_TYPE_UNSPECIFIED\x10\x00\x12\x1f\n" +
+	"\x1bATTACHMENT_OWNER_ASSIGNMENT\x10\x01\x12\x1f\n" +
+	"\x1bATTACHMENT_OWNER_SUBMISSION\x10\x02\x12\x1d\n" +
+	"\x19ATTACHMENT_OWNER_FEEDBACK\x10\x03\x12\x1c\n" +
+	"\x18ATTACHMENT_OWNER_COMMENT\x10\x04*\x8f\x01\n" +
+	"\x0fAssignmentState\x12 \n" +
+	"\x1cASSIGNMENT_STATE_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16ASSIGNMENT_STATE_DRAFT\x10\x01\x12\x1e\n" +
+	"\x1aASSIGNMENT_STATE_SCHEDULED\x10\x02\x12\x1e\n" +
+	"\x1aASSIGNMENT_STATE_PUBLISHED\x10\x03*l\n" +
 	"\n" +
+	"LatePolicy\x12\x1b\n" +
+	"\x17LATE_POLICY_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11LATE_POLICY_ALLOW\x10\x01\x12\x14\n" +
+	"\x10LATE_POLICY_FLAG\x10\x02\x12\x14\n" +
+	"\x10LATE_POLICY_LOCK\x10\x03*q\n" +
+	"\x0fChecklistReview\x12 \n" +
+	"\x1cCHECKLIST_REVIEW_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18CHECKLIST_REVIEW_CORRECT\x10\x01\x12\x1e\n" +
+	"\x1aCHECKLIST_REVIEW_INCORRECT\x10\x02*t\n" +
+	"\x0eAnnotationType\x12\x1f\n" +
+	"\x1bANNOTATION_TYPE_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14ANNOTATION_HIGHLIGHT\x10\x01\x12\x12\n" +
+	"\x0eANNOTATION_PEN\x10\x02\x12\x13\n" +
+	"\x0fANNOTATION_TEXT\x10\x03*\xb3\x01\n" +
+	"\x10QuizQuestionType\x12\"\n" +
+	"\x1eQUIZ_QUESTION_TYPE_UNSPECIFIED\x10\x00\x12\x1f\n" +
+	"\x1bQUIZ_QUESTION_SINGLE_CHOICE\x10\x01\x12!\n" +
+	"\x1dQUIZ_QUESTION_MULTIPLE_CHOICE\x10\x02\x12\x19\n" +
+	"\x15QUIZ_QUESTION_NUMERIC\x10\x03\x12\x1c\n" +
+	"\x18QUIZ_QUESTION_SHORT_TEXT\x10\x04*\x7f\n" +
+	"\rSearchHitType\x12\x1f\n" +
+	"\x1bSEARCH_HIT_TYPE_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15SEARCH_HIT_ASSIGNMENT\x10\x01\x12\x19\n" +
+	"\x15SEARCH_HIT_SUBMISSION\x10\x02\x12\x17\n" +
+	"\x13SEARCH_HIT_FEEDBACK\x10\x03*\xb4\x01\n" +
+	"\x15PortfolioExportStatus\x12'\n" +
+	"#PORTFOLIO_EXPORT_STATUS_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18PORTFOLIO_EXPORT_PENDING\x10\x01\x12\x1c\n" +
+	"\x18PORTFOLIO_EXPORT_RUNNING\x10\x02\x12\x19\n" +
+	"\x15PORTFOLIO_EXPORT_DONE\x10\x03\x12\x1b\n" +
+	"\x17PORTFOLIO_EXPORT_FAILED\x10\x042\x9f\x1a\n" +
 	"\x0fHomeworkService\x12Q\n" +
 	"\x10CreateAssignment\x12$.homework.v1.CreateAssignmentRequest\x1a\x17.homework.v1.Assignment\x12Q\n" +
 	"\x10UpdateAssignment\x12$.homework.v1.UpdateAssignmentRequest\x1a\x17.homework.v1.Assignment\x12L\n" +
-	"\x10DeleteAssignment\x12$.homework.v1.DeleteAssignmentRequest\x1a\x12.homework.v1.Empty\x12j\n" +
+	"\x10DeleteAssignment\x12$.homework.v1.DeleteAssignmentRequest\x1a\x12.homework.v1.Empty\x12S\n" +
+	"\x11RestoreAssignment\x12%.homework.v1.RestoreAssignmentRequest\x1a\x17.homework.v1.Assignment\x12S\n" +
+	"\x11MarkChecklistItem\x12%.homework.v1.MarkChecklistItemRequest\x1a\x17.homework.v1.Assignment\x12W\n" +
+	"\x13ReviewChecklistItem\x12'.homework.v1.ReviewChecklistItemRequest\x1a\x17.homework.v1.Assignment\x12j\n" +
 	"\x16ListAssignmentsByTutor\x12*.homework.v1.ListAssignmentsByTutorRequest\x1a$.homework.v1.ListAssignmentsResponse\x12n\n" +
 	"\x18ListAssignmentsByStudent\x12,.homework.v1.ListAssignmentsByStudentRequest\x1a$.homework.v1.ListAssignmentsResponse\x12h\n" +
-	"\x15ListAssignmentsByPair\x12).homework.v1.ListAssignmentsByPairRequest\x1a$.homework.v1.ListAssignmentsResponse\x12Q\n" +
+	"\x15ListAssignmentsByPair\x12).homework.v1.ListAssignmentsByPairRequest\x1a$.homework.v1.ListAssignmentsResponse\x12l\n" +
+	"\x17ListAssignmentsByLesson\x12+.homework.v1.ListAssignmentsByLessonRequest\x1a$.homework.v1.ListAssignmentsResponse\x12i\n" +
+	"\x18CreateAssignmentTemplate\x12,.homework.v1.CreateAssignmentTemplateRequest\x1a\x1f.homework.v1.AssignmentTemplate\x12i\n" +
+	"\x18UpdateAssignmentTemplate\x12,.homework.v1.UpdateAssignmentTemplateRequest\x1a\x1f.homework.v1.AssignmentTemplate\x12\\\n" +
+	"\x18DeleteAssignmentTemplate\x12,.homework.v1.DeleteAssignmentTemplateRequest\x1a\x12.homework.v1.Empty\x12t\n" +
+	"\x17ListAssignmentTemplates\x12+.homework.v1.ListAssignmentTemplatesRequest\x1a,.homework.v1.ListAssignmentTemplatesResponse\x12b\n" +
+	"\x12AssignFromTemplate\x12&.homework.v1.AssignFromTemplateRequest\x1a$.homework.v1.ListAssignmentsResponse\x12P\n" +
+	"\x12SetDefaultTemplate\x12&.homework.v1.SetDefaultTemplateRequest\x1a\x12.homework.v1.Empty\x12]\n" +
+	"\x12GetDefaultTemplate\x12&.homework.v1.GetDefaultTemplateRequest\x1a\x1f.homework.v1.AssignmentTemplate\x12Q\n" +
 	"\x10CreateSubmission\x12$.homework.v1.CreateSubmissionRequest\x1a\x17.homework.v1.Submission\x12t\n" +
 	"\x1bListSubmissionsByAssignment\x12/.homework.v1.ListSubmissionsByAssignmentRequest\x1a$.homework.v1.ListSubmissionsResponse\x12K\n" +
 	"\x0eCreateFeedback\x12\".homework.v1.CreateFeedbackRequest\x1a\x15.homework.v1.Feedback\x12K\n" +
 	"\x0eUpdateFeedback\x12\".homework.v1.UpdateFeedbackRequest\x1a\x15.homework.v1.Feedback\x12n\n" +
-	"\x19ListFeedbacksByAssignment\x12-.homework.v1.ListFeedbacksByAssignmentRequest\x1a\".homework.v1.ListFeedbacksResponse\x12X\n" +
+	"\x19ListFeedbacksByAssignment\x12-.homework.v1.ListFeedbacksByAssignmentRequest\x1a\".homework.v1.ListFeedbacksResponse\x12h\n" +
+	"\x1aFlattenFeedbackAnnotations\x12..homework.v1.FlattenFeedbackAnnotationsRequest\x1a\x1a.homework.v1.AnnotatedFile\x12H\n" +
+	"\rCreateComment\x12!.homework.v1.CreateCommentRequest\x1a\x14.homework.v1.Comment\x12H\n" +
+	"\rUpdateComment\x12!.homework.v1.UpdateCommentRequest\x1a\x14.homework.v1.Comment\x12F\n" +
+	"\rDeleteComment\x12!.homework.v1.DeleteCommentRequest\x1a\x12.homework.v1.Empty\x12S\n" +
+	"\fListComments\x12 .homework.v1.ListCommentsRequest\x1a!.homework.v1.ListCommentsResponse\x12H\n" +
+	"\fGetGradebook\x12 .homework.v1.GetGradebookRequest\x1a\x16.homework.v1.Gradebook\x12T\n" +
+	"\x10GetHomeworkStats\x12$.homework.v1.GetHomeworkStatsRequest\x1a\x1a.homework.v1.HomeworkStats\x12Y\n" +
+	"\x0eSearchHomework\x12\".homework.v1.SearchHomeworkRequest\x1a#.homework.v1.SearchHomeworkResponse\x12`\n" +
+	"\x15CreatePortfolioExport\x12).homework.v1.CreatePortfolioExportRequest\x1a\x1c.homework.v1.PortfolioExport\x12Z\n" +
+	"\x12GetPortfolioExport\x12&.homework.v1.GetPortfolioExportRequest\x1a\x1c.homework.v1.PortfolioExport\x12X\n" +
 	"\x11GetAssignmentFile\x12%.homework.v1.GetAssignmentFileRequest\x1a\x1c.homework.v1.HomeworkFileURL\x12X\n" +
 	"\x11GetSubmissionFile\x12%.homework.v1.GetSubmissionFileRequest\x1a\x1c.homework.v1.HomeworkFileURL\x12T\n" +
-	"\x0fGetFeedbackFile\x12#.homework.v1.GetFeedbackFileRequest\x1a\x1c.homework.v1.HomeworkFileURLB\vZ\t./pkg/apib\x06proto3"
+	"\x0fGetFeedbackFile\x12#.homework.v1.GetFeedbackFileRequest\x1a\x1c.homework.v1.HomeworkFileURL\x12q\n" +
+	"\x16ListAttachmentFileURLs\x12*.homework.v1.ListAttachmentFileURLsRequest\x1a+.homework.v1.ListAttachmentFileURLsResponseB\vZ\t./pkg/apib\x06proto3"
 
 var (
 	file_my_proto_homework_service_proto_rawDescOnce sync.Once
@@ -1500,83 +6483,276 @@ func file_my_proto_homework_service_proto_rawDescGZIP() []byte {
 	return file_my_proto_homework_service_proto_rawDescData
 }
 
-var file_my_proto_homework_service_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_my_proto_homework_service_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
+var file_my_proto_homework_service_proto_enumTypes = make([]protoimpl.EnumInfo, 10)
+var file_my_proto_homework_service_proto_msgTypes = make([]protoimpl.MessageInfo, 73)
 var file_my_proto_homework_service_proto_goTypes = []any{
 	(AssignmentStatusFilter)(0),                // 0: homework.v1.AssignmentStatusFilter
-	(*Empty)(nil),                              // 1: homework.v1.Empty
-	(*DeleteAssignmentRequest)(nil),            // 2: homework.v1.DeleteAssignmentRequest
-	(*CreateAssignmentRequest)(nil),            // 3: homework.v1.CreateAssignmentRequest
-	(*UpdateAssignmentRequest)(nil),            // 4: homework.v1.UpdateAssignmentRequest
-	(*ListAssignmentsByTutorRequest)(nil),      // 5: homework.v1.ListAssignmentsByTutorRequest
-	(*ListAssignmentsByStudentRequest)(nil),    // 6: homework.v1.ListAssignmentsByStudentRequest
-	(*ListAssignmentsByPairRequest)(nil),       // 7: homework.v1.ListAssignmentsByPairRequest
-	(*ListAssignmentsResponse)(nil),            // 8: homework.v1.ListAssignmentsResponse
-	(*CreateSubmissionRequest)(nil),            // 9: homework.v1.CreateSubmissionRequest
-	(*ListSubmissionsByAssignmentRequest)(nil), // 10: homework.v1.ListSubmissionsByAssignmentRequest
-	(*ListSubmissionsResponse)(nil),            // 11: homework.v1.ListSubmissionsResponse
-	(*CreateFeedbackRequest)(nil),              // 12: homework.v1.CreateFeedbackRequest
-	(*UpdateFeedbackRequest)(nil),              // 13: homework.v1.UpdateFeedbackRequest
-	(*ListFeedbacksByAssignmentRequest)(nil),   // 14: homework.v1.ListFeedbacksByAssignmentRequest
-	(*ListFeedbacksResponse)(nil),              // 15: homework.v1.ListFeedbacksResponse
-	(*GetAssignmentFileRequest)(nil),           // 16: homework.v1.GetAssignmentFileRequest
-	(*GetSubmissionFileRequest)(nil),           // 17: homework.v1.GetSubmissionFileRequest
-	(*GetFeedbackFileRequest)(nil),             // 18: homework.v1.GetFeedbackFileRequest
-	(*HomeworkFileURL)(nil),                    // 19: homework.v1.HomeworkFileURL
-	(*Assignment)(nil),                         // 20: homework.v1.Assignment
-	(*Submission)(nil),                         // 21: homework.v1.Submission
-	(*Feedback)(nil),                           // 22: homework.v1.Feedback
-	(*timestamppb.Timestamp)(nil),              // 23: google.protobuf.Timestamp
+	(FeedbackVerdict)(0),                       // 1: homework.v1.FeedbackVerdict
+	(AttachmentOwnerType)(0),                   // 2: homework.v1.AttachmentOwnerType
+	(AssignmentState)(0),                       // 3: homework.v1.AssignmentState
+	(LatePolicy)(0),                            // 4: homework.v1.LatePolicy
+	(ChecklistReview)(0),                       // 5: homework.v1.ChecklistReview
+	(AnnotationType)(0),                        // 6: homework.v1.AnnotationType
+	(QuizQuestionType)(0),                      // 7: homework.v1.QuizQuestionType
+	(SearchHitType)(0),                         // 8: homework.v1.SearchHitType
+	(PortfolioExportStatus)(0),                 // 9: homework.v1.PortfolioExportStatus
+	(*Empty)(nil),                              // 10: homework.v1.Empty
+	(*AttachmentInput)(nil),                    // 11: homework.v1.AttachmentInput
+	(*AttachmentList)(nil),                     // 12: homework.v1.AttachmentList
+	(*RubricCriterion)(nil),                    // 13: homework.v1.RubricCriterion
+	(*QuizQuestion)(nil),                       // 14: homework.v1.QuizQuestion
+	(*QuizQuestionList)(nil),                   // 15: homework.v1.QuizQuestionList
+	(*ChecklistItem)(nil),                      // 16: homework.v1.ChecklistItem
+	(*ChecklistList)(nil),                      // 17: homework.v1.ChecklistList
+	(*QuizAnswer)(nil),                         // 18: homework.v1.QuizAnswer
+	(*Rubric)(nil),                             // 19: homework.v1.Rubric
+	(*DeleteAssignmentRequest)(nil),            // 20: homework.v1.DeleteAssignmentRequest
+	(*RestoreAssignmentRequest)(nil),           // 21: homework.v1.RestoreAssignmentRequest
+	(*MarkChecklistItemRequest)(nil),           // 22: homework.v1.MarkChecklistItemRequest
+	(*ReviewChecklistItemRequest)(nil),         // 23: homework.v1.ReviewChecklistItemRequest
+	(*CreateAssignmentRequest)(nil),            // 24: homework.v1.CreateAssignmentRequest
+	(*UpdateAssignmentRequest)(nil),            // 25: homework.v1.UpdateAssignmentRequest
+	(*ListAssignmentsByTutorRequest)(nil),      // 26: homework.v1.ListAssignmentsByTutorRequest
+	(*ListAssignmentsByStudentRequest)(nil),    // 27: homework.v1.ListAssignmentsByStudentRequest
+	(*ListAssignmentsByPairRequest)(nil),       // 28: homework.v1.ListAssignmentsByPairRequest
+	(*ListAssignmentsByLessonRequest)(nil),     // 29: homework.v1.ListAssignmentsByLessonRequest
+	(*ChecklistProgressFilter)(nil),            // 30: homework.v1.ChecklistProgressFilter
+	(*ListAssignmentsResponse)(nil),            // 31: homework.v1.ListAssignmentsResponse
+	(*CreateAssignmentTemplateRequest)(nil),    // 32: homework.v1.CreateAssignmentTemplateRequest
+	(*UpdateAssignmentTemplateRequest)(nil),    // 33: homework.v1.UpdateAssignmentTemplateRequest
+	(*DeleteAssignmentTemplateRequest)(nil),    // 34: homework.v1.DeleteAssignmentTemplateRequest
+	(*ListAssignmentTemplatesRequest)(nil),     // 35: homework.v1.ListAssignmentTemplatesRequest
+	(*ListAssignmentTemplatesResponse)(nil),    // 36: homework.v1.ListAssignmentTemplatesResponse
+	(*AssignFromTemplateRequest)(nil),          // 37: homework.v1.AssignFromTemplateRequest
+	(*SetDefaultTemplateRequest)(nil),          // 38: homework.v1.SetDefaultTemplateRequest
+	(*GetDefaultTemplateRequest)(nil),          // 39: homework.v1.GetDefaultTemplateRequest
+	(*CreateCommentRequest)(nil),               // 40: homework.v1.CreateCommentRequest
+	(*UpdateCommentRequest)(nil),               // 41: homework.v1.UpdateCommentRequest
+	(*DeleteCommentRequest)(nil),               // 42: homework.v1.DeleteCommentRequest
+	(*ListCommentsRequest)(nil),                // 43: homework.v1.ListCommentsRequest
+	(*ListCommentsResponse)(nil),               // 44: homework.v1.ListCommentsResponse
+	(*CreateSubmissionRequest)(nil),            // 45: homework.v1.CreateSubmissionRequest
+	(*ListSubmissionsByAssignmentRequest)(nil), // 46: homework.v1.ListSubmissionsByAssignmentRequest
+	(*ListSubmissionsResponse)(nil),            // 47: homework.v1.ListSubmissionsResponse
+	(*CreateFeedbackRequest)(nil),              // 48: homework.v1.CreateFeedbackRequest
+	(*UpdateFeedbackRequest)(nil),              // 49: homework.v1.UpdateFeedbackRequest
+	(*FlattenFeedbackAnnotationsRequest)(nil),  // 50: homework.v1.FlattenFeedbackAnnotationsRequest
+	(*ListFeedbacksByAssignmentRequest)(nil),   // 51: homework.v1.ListFeedbacksByAssignmentRequest
+	(*ListFeedbacksResponse)(nil),              // 52: homework.v1.ListFeedbacksResponse
+	(*GetGradebookRequest)(nil),                // 53: homework.v1.GetGradebookRequest
+	(*GradebookEntry)(nil),                     // 54: homework.v1.GradebookEntry
+	(*CriterionAverage)(nil),                   // 55: homework.v1.CriterionAverage
+	(*Gradebook)(nil),                          // 56: homework.v1.Gradebook
+	(*GetHomeworkStatsRequest)(nil),            // 57: homework.v1.GetHomeworkStatsRequest
+	(*StudentHomeworkStats)(nil),               // 58: homework.v1.StudentHomeworkStats
+	(*HomeworkStats)(nil),                      // 59: homework.v1.HomeworkStats
+	(*GetAssignmentFileRequest)(nil),           // 60: homework.v1.GetAssignmentFileRequest
+	(*GetSubmissionFileRequest)(nil),           // 61: homework.v1.GetSubmissionFileRequest
+	(*GetFeedbackFileRequest)(nil),             // 62: homework.v1.GetFeedbackFileRequest
+	(*HomeworkFileURL)(nil),                    // 63: homework.v1.HomeworkFileURL
+	(*ListAttachmentFileURLsRequest)(nil),      // 64: homework.v1.ListAttachmentFileURLsRequest
+	(*AttachmentFileURL)(nil),                  // 65: homework.v1.AttachmentFileURL
+	(*ListAttachmentFileURLsResponse)(nil),     // 66: homework.v1.ListAttachmentFileURLsResponse
+	(*Attachment)(nil),                         // 67: homework.v1.Attachment
+	(*Assignment)(nil),                         // 68: homework.v1.Assignment
+	(*Comment)(nil),                            // 69: homework.v1.Comment
+	(*AssignmentTemplate)(nil),                 // 70: homework.v1.AssignmentTemplate
+	(*Submission)(nil),                         // 71: homework.v1.Submission
+	(*Feedback)(nil),                           // 72: homework.v1.Feedback
+	(*Annotation)(nil),                         // 73: homework.v1.Annotation
+	(*AnnotationPoint)(nil),                    // 74: homework.v1.AnnotationPoint
+	(*AnnotationList)(nil),                     // 75: homework.v1.AnnotationList
+	(*AnnotatedFile)(nil),                      // 76: homework.v1.AnnotatedFile
+	(*SearchHomeworkRequest)(nil),              // 77: homework.v1.SearchHomeworkRequest
+	(*SearchHit)(nil),                          // 78: homework.v1.SearchHit
+	(*SearchHomeworkResponse)(nil),             // 79: homework.v1.SearchHomeworkResponse
+	(*CreatePortfolioExportRequest)(nil),       // 80: homework.v1.CreatePortfolioExportRequest
+	(*GetPortfolioExportRequest)(nil),          // 81: homework.v1.GetPortfolioExportRequest
+	(*PortfolioExport)(nil),                    // 82: homework.v1.PortfolioExport
+	(*timestamppb.Timestamp)(nil),              // 83: google.protobuf.Timestamp
 }
 var file_my_proto_homework_service_proto_depIdxs = []int32{
-	23, // 0: homework.v1.CreateAssignmentRequest.due_date:type_name -> google.protobuf.Timestamp
-	23, // 1: homework.v1.UpdateAssignmentRequest.due_date:type_name -> google.protobuf.Timestamp
-	0,  // 2: homework.v1.ListAssignmentsByTutorRequest.status_filter:type_name -> homework.v1.AssignmentStatusFilter
-	0,  // 3: homework.v1.ListAssignmentsByStudentRequest.status_filter:type_name -> homework.v1.AssignmentStatusFilter
-	0,  // 4: homework.v1.ListAssignmentsByPairRequest.status_filter:type_name -> homework.v1.AssignmentStatusFilter
-	20, // 5: homework.v1.ListAssignmentsResponse.assignments:type_name -> homework.v1.Assignment
-	21, // 6: homework.v1.ListSubmissionsResponse.submissions:type_name -> homework.v1.Submission
-	22, // 7: homework.v1.ListFeedbacksResponse.feedbacks:type_name -> homework.v1.Feedback
-	23, // 8: homework.v1.Assignment.due_date:type_name -> google.protobuf.Timestamp
-	23, // 9: homework.v1.Assignment.created_at:type_name -> google.protobuf.Timestamp
-	23, // 10: homework.v1.Assignment.edited_at:type_name -> google.protobuf.Timestamp
-	23, // 11: homework.v1.Submission.created_at:type_name -> google.protobuf.Timestamp
-	23, // 12: homework.v1.Submission.edited_at:type_name -> google.protobuf.Timestamp
-	23, // 13: homework.v1.Feedback.created_at:type_name -> google.protobuf.Timestamp
-	23, // 14: homework.v1.Feedback.edited_at:type_name -> google.protobuf.Timestamp
-	3,  // 15: homework.v1.HomeworkService.CreateAssignment:input_type -> homework.v1.CreateAssignmentRequest
-	4,  // 16: homework.v1.HomeworkService.UpdateAssignment:input_type -> homework.v1.UpdateAssignmentRequest
-	2,  // 17: homework.v1.HomeworkService.DeleteAssignment:input_type -> homework.v1.DeleteAssignmentRequest
-	5,  // 18: homework.v1.HomeworkService.ListAssignmentsByTutor:input_type -> homework.v1.ListAssignmentsByTutorRequest
-	6,  // 19: homework.v1.HomeworkService.ListAssignmentsByStudent:input_type -> homework.v1.ListAssignmentsByStudentRequest
-	7,  // 20: homework.v1.HomeworkService.ListAssignmentsByPair:input_type -> homework.v1.ListAssignmentsByPairRequest
-	9,  // 21: homework.v1.HomeworkService.CreateSubmission:input_type -> homework.v1.CreateSubmissionRequest
-	10, // 22: homework.v1.HomeworkService.ListSubmissionsByAssignment:input_type -> homework.v1.ListSubmissionsByAssignmentRequest
-	12, // 23: homework.v1.HomeworkService.CreateFeedback:input_type -> homework.v1.CreateFeedbackRequest
-	13, // 24: homework.v1.HomeworkService.UpdateFeedback:input_type -> homework.v1.UpdateFeedbackRequest
-	14, // 25: homework.v1.HomeworkService.ListFeedbacksByAssignment:input_type -> homework.v1.ListFeedbacksByAssignmentRequest
-	16, // 26: homework.v1.HomeworkService.GetAssignmentFile:input_type -> homework.v1.GetAssignmentFileRequest
-	17, // 27: homework.v1.HomeworkService.GetSubmissionFile:input_type -> homework.v1.GetSubmissionFileRequest
-	18, // 28: homework.v1.HomeworkService.GetFeedbackFile:input_type -> homework.v1.GetFeedbackFileRequest
-	20, // 29: homework.v1.HomeworkService.CreateAssignment:output_type -> homework.v1.Assignment
-	20, // 30: homework.v1.HomeworkService.UpdateAssignment:output_type -> homework.v1.Assignment
-	1,  // 31: homework.v1.HomeworkService.DeleteAssignment:output_type -> homework.v1.Empty
-	8,  // 32: homework.v1.HomeworkService.ListAssignmentsByTutor:output_type -> homework.v1.ListAssignmentsResponse
-	8,  // 33: homework.v1.HomeworkService.ListAssignmentsByStudent:output_type -> homework.v1.ListAssignmentsResponse
-	8,  // 34: homework.v1.HomeworkService.ListAssignmentsByPair:output_type -> homework.v1.ListAssignmentsResponse
-	21, // 35: homework.v1.HomeworkService.CreateSubmission:output_type -> homework.v1.Submission
-	11, // 36: homework.v1.HomeworkService.ListSubmissionsByAssignment:output_type -> homework.v1.ListSubmissionsResponse
-	22, // 37: homework.v1.HomeworkService.CreateFeedback:output_type -> homework.v1.Feedback
-	22, // 38: homework.v1.HomeworkService.UpdateFeedback:output_type -> homework.v1.Feedback
-	15, // 39: homework.v1.HomeworkService.ListFeedbacksByAssignment:output_type -> homework.v1.ListFeedbacksResponse
-	19, // 40: homework.v1.HomeworkService.GetAssignmentFile:output_type -> homework.v1.HomeworkFileURL
-	19, // 41: homework.v1.HomeworkService.GetSubmissionFile:output_type -> homework.v1.HomeworkFileURL
-	19, // 42: homework.v1.HomeworkService.GetFeedbackFile:output_type -> homework.v1.HomeworkFileURL
-	29, // [29:43] is the sub-list for method output_type
-	15, // [15:29] is the sub-list for method input_type
-	15, // [15:15] is the sub-list for extension type_name
-	15, // [15:15] is the sub-list for extension extendee
-	0,  // [0:15] is the sub-list for field type_name
+	11,  // 0: homework.v1.AttachmentList.items:type_name -> homework.v1.AttachmentInput
+	7,   // 1: homework.v1.QuizQuestion.type:type_name -> homework.v1.QuizQuestionType
+	14,  // 2: homework.v1.QuizQuestionList.items:type_name -> homework.v1.QuizQuestion
+	83,  // 3: homework.v1.ChecklistItem.done_at:type_name -> google.protobuf.Timestamp
+	5,   // 4: homework.v1.ChecklistItem.review:type_name -> homework.v1.ChecklistReview
+	83,  // 5: homework.v1.ChecklistItem.reviewed_at:type_name -> google.protobuf.Timestamp
+	13,  // 6: homework.v1.Rubric.criteria:type_name -> homework.v1.RubricCriterion
+	5,   // 7: homework.v1.ReviewChecklistItemRequest.review:type_name -> homework.v1.ChecklistReview
+	83,  // 8: homework.v1.CreateAssignmentRequest.due_date:type_name -> google.protobuf.Timestamp
+	11,  // 9: homework.v1.CreateAssignmentRequest.attachments:type_name -> homework.v1.AttachmentInput
+	14,  // 10: homework.v1.CreateAssignmentRequest.quiz:type_name -> homework.v1.QuizQuestion
+	4,   // 11: homework.v1.CreateAssignmentRequest.late_policy:type_name -> homework.v1.LatePolicy
+	3,   // 12: homework.v1.CreateAssignmentRequest.state:type_name -> homework.v1.AssignmentState
+	83,  // 13: homework.v1.CreateAssignmentRequest.publish_at:type_name -> google.protobuf.Timestamp
+	83,  // 14: homework.v1.UpdateAssignmentRequest.due_date:type_name -> google.protobuf.Timestamp
+	12,  // 15: homework.v1.UpdateAssignmentRequest.attachments:type_name -> homework.v1.AttachmentList
+	15,  // 16: homework.v1.UpdateAssignmentRequest.quiz:type_name -> homework.v1.QuizQuestionList
+	4,   // 17: homework.v1.UpdateAssignmentRequest.late_policy:type_name -> homework.v1.LatePolicy
+	3,   // 18: homework.v1.UpdateAssignmentRequest.state:type_name -> homework.v1.AssignmentState
+	83,  // 19: homework.v1.UpdateAssignmentRequest.publish_at:type_name -> google.protobuf.Timestamp
+	17,  // 20: homework.v1.UpdateAssignmentRequest.checklist:type_name -> homework.v1.ChecklistList
+	0,   // 21: homework.v1.ListAssignmentsByTutorRequest.status_filter:type_name -> homework.v1.AssignmentStatusFilter
+	30,  // 22: homework.v1.ListAssignmentsByTutorRequest.checklist_progress:type_name -> homework.v1.ChecklistProgressFilter
+	0,   // 23: homework.v1.ListAssignmentsByStudentRequest.status_filter:type_name -> homework.v1.AssignmentStatusFilter
+	30,  // 24: homework.v1.ListAssignmentsByStudentRequest.checklist_progress:type_name -> homework.v1.ChecklistProgressFilter
+	0,   // 25: homework.v1.ListAssignmentsByPairRequest.status_filter:type_name -> homework.v1.AssignmentStatusFilter
+	30,  // 26: homework.v1.ListAssignmentsByPairRequest.checklist_progress:type_name -> homework.v1.ChecklistProgressFilter
+	0,   // 27: homework.v1.ListAssignmentsByLessonRequest.status_filter:type_name -> homework.v1.AssignmentStatusFilter
+	30,  // 28: homework.v1.ListAssignmentsByLessonRequest.checklist_progress:type_name -> homework.v1.ChecklistProgressFilter
+	68,  // 29: homework.v1.ListAssignmentsResponse.assignments:type_name -> homework.v1.Assignment
+	11,  // 30: homework.v1.CreateAssignmentTemplateRequest.attachments:type_name -> homework.v1.AttachmentInput
+	12,  // 31: homework.v1.UpdateAssignmentTemplateRequest.attachments:type_name -> homework.v1.AttachmentList
+	70,  // 32: homework.v1.ListAssignmentTemplatesResponse.templates:type_name -> homework.v1.AssignmentTemplate
+	83,  // 33: homework.v1.AssignFromTemplateRequest.due_date:type_name -> google.protobuf.Timestamp
+	11,  // 34: homework.v1.CreateCommentRequest.attachments:type_name -> homework.v1.AttachmentInput
+	12,  // 35: homework.v1.UpdateCommentRequest.attachments:type_name -> homework.v1.AttachmentList
+	69,  // 36: homework.v1.ListCommentsResponse.comments:type_name -> homework.v1.Comment
+	11,  // 37: homework.v1.CreateSubmissionRequest.attachments:type_name -> homework.v1.AttachmentInput
+	18,  // 38: homework.v1.CreateSubmissionRequest.answers:type_name -> homework.v1.QuizAnswer
+	71,  // 39: homework.v1.ListSubmissionsResponse.submissions:type_name -> homework.v1.Submission
+	11,  // 40: homework.v1.CreateFeedbackRequest.attachments:type_name -> homework.v1.AttachmentInput
+	19,  // 41: homework.v1.CreateFeedbackRequest.rubric:type_name -> homework.v1.Rubric
+	1,   // 42: homework.v1.CreateFeedbackRequest.verdict:type_name -> homework.v1.FeedbackVerdict
+	73,  // 43: homework.v1.CreateFeedbackRequest.annotations:type_name -> homework.v1.Annotation
+	12,  // 44: homework.v1.UpdateFeedbackRequest.attachments:type_name -> homework.v1.AttachmentList
+	19,  // 45: homework.v1.UpdateFeedbackRequest.rubric:type_name -> homework.v1.Rubric
+	1,   // 46: homework.v1.UpdateFeedbackRequest.verdict:type_name -> homework.v1.FeedbackVerdict
+	75,  // 47: homework.v1.UpdateFeedbackRequest.annotations:type_name -> homework.v1.AnnotationList
+	72,  // 48: homework.v1.ListFeedbacksResponse.feedbacks:type_name -> homework.v1.Feedback
+	83,  // 49: homework.v1.GetGradebookRequest.from:type_name -> google.protobuf.Timestamp
+	83,  // 50: homework.v1.GetGradebookRequest.to:type_name -> google.protobuf.Timestamp
+	83,  // 51: homework.v1.GradebookEntry.due_date:type_name -> google.protobuf.Timestamp
+	83,  // 52: homework.v1.GradebookEntry.graded_at:type_name -> google.protobuf.Timestamp
+	13,  // 53: homework.v1.GradebookEntry.rubric:type_name -> homework.v1.RubricCriterion
+	54,  // 54: homework.v1.Gradebook.entries:type_name -> homework.v1.GradebookEntry
+	55,  // 55: homework.v1.Gradebook.criteria:type_name -> homework.v1.CriterionAverage
+	83,  // 56: homework.v1.GetHomeworkStatsRequest.from:type_name -> google.protobuf.Timestamp
+	83,  // 57: homework.v1.GetHomeworkStatsRequest.to:type_name -> google.protobuf.Timestamp
+	58,  // 58: homework.v1.HomeworkStats.students:type_name -> homework.v1.StudentHomeworkStats
+	2,   // 59: homework.v1.ListAttachmentFileURLsRequest.owner_type:type_name -> homework.v1.AttachmentOwnerType
+	65,  // 60: homework.v1.ListAttachmentFileURLsResponse.attachments:type_name -> homework.v1.AttachmentFileURL
+	83,  // 61: homework.v1.Attachment.created_at:type_name -> google.protobuf.Timestamp
+	83,  // 62: homework.v1.Assignment.due_date:type_name -> google.protobuf.Timestamp
+	83,  // 63: homework.v1.Assignment.created_at:type_name -> google.protobuf.Timestamp
+	83,  // 64: homework.v1.Assignment.edited_at:type_name -> google.protobuf.Timestamp
+	67,  // 65: homework.v1.Assignment.attachments:type_name -> homework.v1.Attachment
+	14,  // 66: homework.v1.Assignment.quiz:type_name -> homework.v1.QuizQuestion
+	4,   // 67: homework.v1.Assignment.late_policy:type_name -> homework.v1.LatePolicy
+	3,   // 68: homework.v1.Assignment.state:type_name -> homework.v1.AssignmentState
+	83,  // 69: homework.v1.Assignment.publish_at:type_name -> google.protobuf.Timestamp
+	83,  // 70: homework.v1.Assignment.published_at:type_name -> google.protobuf.Timestamp
+	16,  // 71: homework.v1.Assignment.checklist:type_name -> homework.v1.ChecklistItem
+	67,  // 72: homework.v1.Comment.attachments:type_name -> homework.v1.Attachment
+	83,  // 73: homework.v1.Comment.created_at:type_name -> google.protobuf.Timestamp
+	83,  // 74: homework.v1.Comment.edited_at:type_name -> google.protobuf.Timestamp
+	67,  // 75: homework.v1.AssignmentTemplate.attachments:type_name -> homework.v1.Attachment
+	83,  // 76: homework.v1.AssignmentTemplate.created_at:type_name -> google.protobuf.Timestamp
+	83,  // 77: homework.v1.AssignmentTemplate.edited_at:type_name -> google.protobuf.Timestamp
+	83,  // 78: homework.v1.Submission.created_at:type_name -> google.protobuf.Timestamp
+	83,  // 79: homework.v1.Submission.edited_at:type_name -> google.protobuf.Timestamp
+	67,  // 80: homework.v1.Submission.attachments:type_name -> homework.v1.Attachment
+	18,  // 81: homework.v1.Submission.answers:type_name -> homework.v1.QuizAnswer
+	83,  // 82: homework.v1.Feedback.created_at:type_name -> google.protobuf.Timestamp
+	83,  // 83: homework.v1.Feedback.edited_at:type_name -> google.protobuf.Timestamp
+	67,  // 84: homework.v1.Feedback.attachments:type_name -> homework.v1.Attachment
+	13,  // 85: homework.v1.Feedback.rubric:type_name -> homework.v1.RubricCriterion
+	1,   // 86: homework.v1.Feedback.verdict:type_name -> homework.v1.FeedbackVerdict
+	73,  // 87: homework.v1.Feedback.annotations:type_name -> homework.v1.Annotation
+	6,   // 88: homework.v1.Annotation.type:type_name -> homework.v1.AnnotationType
+	74,  // 89: homework.v1.Annotation.points:type_name -> homework.v1.AnnotationPoint
+	73,  // 90: homework.v1.AnnotationList.items:type_name -> homework.v1.Annotation
+	8,   // 91: homework.v1.SearchHomeworkRequest.types:type_name -> homework.v1.SearchHitType
+	83,  // 92: homework.v1.SearchHomeworkRequest.from:type_name -> google.protobuf.Timestamp
+	83,  // 93: homework.v1.SearchHomeworkRequest.to:type_name -> google.protobuf.Timestamp
+	8,   // 94: homework.v1.SearchHit.type:type_name -> homework.v1.SearchHitType
+	83,  // 95: homework.v1.SearchHit.created_at:type_name -> google.protobuf.Timestamp
+	78,  // 96: homework.v1.SearchHomeworkResponse.hits:type_name -> homework.v1.SearchHit
+	83,  // 97: homework.v1.CreatePortfolioExportRequest.from:type_name -> google.protobuf.Timestamp
+	83,  // 98: homework.v1.CreatePortfolioExportRequest.to:type_name -> google.protobuf.Timestamp
+	83,  // 99: homework.v1.PortfolioExport.from:type_name -> google.protobuf.Timestamp
+	83,  // 100: homework.v1.PortfolioExport.to:type_name -> google.protobuf.Timestamp
+	9,   // 101: homework.v1.PortfolioExport.status:type_name -> homework.v1.PortfolioExportStatus
+	83,  // 102: homework.v1.PortfolioExport.created_at:type_name -> google.protobuf.Timestamp
+	83,  // 103: homework.v1.PortfolioExport.finished_at:type_name -> google.protobuf.Timestamp
+	24,  // 104: homework.v1.HomeworkService.CreateAssignment:input_type -> homework.v1.CreateAssignmentRequest
+	25,  // 105: homework.v1.HomeworkService.UpdateAssignment:input_type -> homework.v1.UpdateAssignmentRequest
+	20,  // 106: homework.v1.HomeworkService.DeleteAssignment:input_type -> homework.v1.DeleteAssignmentRequest
+	21,  // 107: homework.v1.HomeworkService.RestoreAssignment:input_type -> homework.v1.RestoreAssignmentRequest
+	22,  // 108: homework.v1.HomeworkService.MarkChecklistItem:input_type -> homework.v1.MarkChecklistItemRequest
+	23,  // 109: homework.v1.HomeworkService.ReviewChecklistItem:input_type -> homework.v1.ReviewChecklistItemRequest
+	26,  // 110: homework.v1.HomeworkService.ListAssignmentsByTutor:input_type -> homework.v1.ListAssignmentsByTutorRequest
+	27,  // 111: homework.v1.HomeworkService.ListAssignmentsByStudent:input_type -> homework.v1.ListAssignmentsByStudentRequest
+	28,  // 112: homework.v1.HomeworkService.ListAssignmentsByPair:input_type -> homework.v1.ListAssignmentsByPairRequest
+	29,  // 113: homework.v1.HomeworkService.ListAssignmentsByLesson:input_type -> homework.v1.ListAssignmentsByLessonRequest
+	32,  // 114: homework.v1.HomeworkService.CreateAssignmentTemplate:input_type -> homework.v1.CreateAssignmentTemplateRequest
+	33,  // 115: homework.v1.HomeworkService.UpdateAssignmentTemplate:input_type -> homework.v1.UpdateAssignmentTemplateRequest
+	34,  // 116: homework.v1.HomeworkService.DeleteAssignmentTemplate:input_type -> homework.v1.DeleteAssignmentTemplateRequest
+	35,  // 117: homework.v1.HomeworkService.ListAssignmentTemplates:input_type -> homework.v1.ListAssignmentTemplatesRequest
+	37,  // 118: homework.v1.HomeworkService.AssignFromTemplate:input_type -> homework.v1.AssignFromTemplateRequest
+	38,  // 119: homework.v1.HomeworkService.SetDefaultTemplate:input_type -> homework.v1.SetDefaultTemplateRequest
+	39,  // 120: homework.v1.HomeworkService.GetDefaultTemplate:input_type -> homework.v1.GetDefaultTemplateRequest
+	45,  // 121: homework.v1.HomeworkService.CreateSubmission:input_type -> homework.v1.CreateSubmissionRequest
+	46,  // 122: homework.v1.HomeworkService.ListSubmissionsByAssignment:input_type -> homework.v1.ListSubmissionsByAssignmentRequest
+	48,  // 123: homework.v1.HomeworkService.CreateFeedback:input_type -> homework.v1.CreateFeedbackRequest
+	49,  // 124: homework.v1.HomeworkService.UpdateFeedback:input_type -> homework.v1.UpdateFeedbackRequest
+	51,  // 125: homework.v1.HomeworkService.ListFeedbacksByAssignment:input_type -> homework.v1.ListFeedbacksByAssignmentRequest
+	50,  // 126: homework.v1.HomeworkService.FlattenFeedbackAnnotations:input_type -> homework.v1.FlattenFeedbackAnnotationsRequest
+	40,  // 127: homework.v1.HomeworkService.CreateComment:input_type -> homework.v1.CreateCommentRequest
+	41,  // 128: homework.v1.HomeworkService.UpdateComment:input_type -> homework.v1.UpdateCommentRequest
+	42,  // 129: homework.v1.HomeworkService.DeleteComment:input_type -> homework.v1.DeleteCommentRequest
+	43,  // 130: homework.v1.HomeworkService.ListComments:input_type -> homework.v1.ListCommentsRequest
+	53,  // 131: homework.v1.HomeworkService.GetGradebook:input_type -> homework.v1.GetGradebookRequest
+	57,  // 132: homework.v1.HomeworkService.GetHomeworkStats:input_type -> homework.v1.GetHomeworkStatsRequest
+	77,  // 133: homework.v1.HomeworkService.SearchHomework:input_type -> homework.v1.SearchHomeworkRequest
+	80,  // 134: homework.v1.HomeworkService.CreatePortfolioExport:input_type -> homework.v1.CreatePortfolioExportRequest
+	81,  // 135: homework.v1.HomeworkService.GetPortfolioExport:input_type -> homework.v1.GetPortfolioExportRequest
+	60,  // 136: homework.v1.HomeworkService.GetAssignmentFile:input_type -> homework.v1.GetAssignmentFileRequest
+	61,  // 137: homework.v1.HomeworkService.GetSubmissionFile:input_type -> homework.v1.GetSubmissionFileRequest
+	62,  // 138: homework.v1.HomeworkService.GetFeedbackFile:input_type -> homework.v1.GetFeedbackFileRequest
+	64,  // 139: homework.v1.HomeworkService.ListAttachmentFileURLs:input_type -> homework.v1.ListAttachmentFileURLsRequest
+	68,  // 140: homework.v1.HomeworkService.CreateAssignment:output_type -> homework.v1.Assignment
+	68,  // 141: homework.v1.HomeworkService.UpdateAssignment:output_type -> homework.v1.Assignment
+	10,  // 142: homework.v1.HomeworkService.DeleteAssignment:output_type -> homework.v1.Empty
+	68,  // 143: homework.v1.HomeworkService.RestoreAssignment:output_type -> homework.v1.Assignment
+	68,  // 144: homework.v1.HomeworkService.MarkChecklistItem:output_type -> homework.v1.Assignment
+	68,  // 145: homework.v1.HomeworkService.ReviewChecklistItem:output_type -> homework.v1.Assignment
+	31,  // 146: homework.v1.HomeworkService.ListAssignmentsByTutor:output_type -> homework.v1.ListAssignmentsResponse
+	31,  // 147: homework.v1.HomeworkService.ListAssignmentsByStudent:output_type -> homework.v1.ListAssignmentsResponse
+	31,  // 148: homework.v1.HomeworkService.ListAssignmentsByPair:output_type -> homework.v1.ListAssignmentsResponse
+	31,  // 149: homework.v1.HomeworkService.ListAssignmentsByLesson:output_type -> homework.v1.ListAssignmentsResponse
+	70,  // 150: homework.v1.HomeworkService.CreateAssignmentTemplate:output_type -> homework.v1.AssignmentTemplate
+	70,  // 151: homework.v1.HomeworkService.UpdateAssignmentTemplate:output_type -> homework.v1.AssignmentTemplate
+	10,  // 152: homework.v1.HomeworkService.DeleteAssignmentTemplate:output_type -> homework.v1.Empty
+	36,  // 153: homework.v1.HomeworkService.ListAssignmentTemplates:output_type -> homework.v1.ListAssignmentTemplatesResponse
+	31,  // 154: homework.v1.HomeworkService.AssignFromTemplate:output_type -> homework.v1.ListAssignmentsResponse
+	10,  // 155: homework.v1.HomeworkService.SetDefaultTemplate:output_type -> homework.v1.Empty
+	70,  // 156: homework.v1.HomeworkService.GetDefaultTemplate:output_type -> homework.v1.AssignmentTemplate
+	71,  // 157: homework.v1.HomeworkService.CreateSubmission:output_type -> homework.v1.Submission
+	47,  // 158: homework.v1.HomeworkService.ListSubmissionsByAssignment:output_type -> homework.v1.ListSubmissionsResponse
+	72,  // 159: homework.v1.HomeworkService.CreateFeedback:output_type -> homework.v1.Feedback
+	72,  // 160: homework.v1.HomeworkService.UpdateFeedback:output_type -> homework.v1.Feedback
+	52,  // 161: homework.v1.HomeworkService.ListFeedbacksByAssignment:output_type -> homework.v1.ListFeedbacksResponse
+	76,  // 162: homework.v1.HomeworkService.FlattenFeedbackAnnotations:output_type -> homework.v1.AnnotatedFile
+	69,  // 163: homework.v1.HomeworkService.CreateComment:output_type -> homework.v1.Comment
+	69,  // 164: homework.v1.HomeworkService.UpdateComment:output_type -> homework.v1.Comment
+	10,  // 165: homework.v1.HomeworkService.DeleteComment:output_type -> homework.v1.Empty
+	44,  // 166: homework.v1.HomeworkService.ListComments:output_type -> homework.v1.ListCommentsResponse
+	56,  // 167: homework.v1.HomeworkService.GetGradebook:output_type -> homework.v1.Gradebook
+	59,  // 168: homework.v1.HomeworkService.GetHomeworkStats:output_type -> homework.v1.HomeworkStats
+	79,  // 169: homework.v1.HomeworkService.SearchHomework:output_type -> homework.v1.SearchHomeworkResponse
+	82,  // 170: homework.v1.HomeworkService.CreatePortfolioExport:output_type -> homework.v1.PortfolioExport
+	82,  // 171: homework.v1.HomeworkService.GetPortfolioExport:output_type -> homework.v1.PortfolioExport
+	63,  // 172: homework.v1.HomeworkService.GetAssignmentFile:output_type -> homework.v1.HomeworkFileURL
+	63,  // 173: homework.v1.HomeworkService.GetSubmissionFile:output_type -> homework.v1.HomeworkFileURL
+	63,  // 174: homework.v1.HomeworkService.GetFeedbackFile:output_type -> homework.v1.HomeworkFileURL
+	66,  // 175: homework.v1.HomeworkService.ListAttachmentFileURLs:output_type -> homework.v1.ListAttachmentFileURLsResponse
+	140, // [140:176] is the sub-list for method output_type
+	104, // [104:140] is the sub-list for method input_type
+	104, // [104:104] is the sub-list for extension type_name
+	104, // [104:104] is the sub-list for extension extendee
+	0,   // [0:104] is the sub-list for field type_name
 }
 
 func init() { file_my_proto_homework_service_proto_init() }
@@ -1584,21 +6760,48 @@ func file_my_proto_homework_service_proto_init() {
 	if File_my_proto_homework_service_proto != nil {
 		return
 	}
-	file_my_proto_homework_service_proto_msgTypes[2].OneofWrappers = []any{}
+	file_my_proto_homework_service_proto_msgTypes[1].OneofWrappers = []any{}
 	file_my_proto_homework_service_proto_msgTypes[3].OneofWrappers = []any{}
+	file_my_proto_homework_service_proto_msgTypes[4].OneofWrappers = []any{}
+	file_my_proto_homework_service_proto_msgTypes[6].OneofWrappers = []any{}
 	file_my_proto_homework_service_proto_msgTypes[8].OneofWrappers = []any{}
-	file_my_proto_homework_service_proto_msgTypes[11].OneofWrappers = []any{}
-	file_my_proto_homework_service_proto_msgTypes[12].OneofWrappers = []any{}
-	file_my_proto_homework_service_proto_msgTypes[19].OneofWrappers = []any{}
+	file_my_proto_homework_service_proto_msgTypes[14].OneofWrappers = []any{}
+	file_my_proto_homework_service_proto_msgTypes[15].OneofWrappers = []any{}
 	file_my_proto_homework_service_proto_msgTypes[20].OneofWrappers = []any{}
-	file_my_proto_homework_service_proto_msgTypes[21].OneofWrappers = []any{}
+	file_my_proto_homework_service_proto_msgTypes[22].OneofWrappers = []any{}
+	file_my_proto_homework_service_proto_msgTypes[23].OneofWrappers = []any{}
+	file_my_proto_homework_service_proto_msgTypes[27].OneofWrappers = []any{}
+	file_my_proto_homework_service_proto_msgTypes[30].OneofWrappers = []any{}
+	file_my_proto_homework_service_proto_msgTypes[31].OneofWrappers = []any{}
+	file_my_proto_homework_service_proto_msgTypes[33].OneofWrappers = []any{}
+	file_my_proto_homework_service_proto_msgTypes[35].OneofWrappers = []any{}
+	file_my_proto_homework_service_proto_msgTypes[38].OneofWrappers = []any{}
+	file_my_proto_homework_service_proto_msgTypes[39].OneofWrappers = []any{}
+	file_my_proto_homework_service_proto_msgTypes[43].OneofWrappers = []any{}
+	file_my_proto_homework_service_proto_msgTypes[44].OneofWrappers = []any{}
+	file_my_proto_homework_service_proto_msgTypes[46].OneofWrappers = []any{}
+	file_my_proto_homework_service_proto_msgTypes[47].OneofWrappers = []any{}
+	file_my_proto_homework_service_proto_msgTypes[48].OneofWrappers = []any{}
+	file_my_proto_homework_service_proto_msgTypes[49].OneofWrappers = []any{}
+	file_my_proto_homework_service_proto_msgTypes[55].OneofWrappers = []any{}
+	file_my_proto_homework_service_proto_msgTypes[57].OneofWrappers = []any{}
+	file_my_proto_homework_service_proto_msgTypes[58].OneofWrappers = []any{}
+	file_my_proto_homework_service_proto_msgTypes[59].OneofWrappers = []any{}
+	file_my_proto_homework_service_proto_msgTypes[60].OneofWrappers = []any{}
+	file_my_proto_homework_service_proto_msgTypes[61].OneofWrappers = []any{}
+	file_my_proto_homework_service_proto_msgTypes[62].OneofWrappers = []any{}
+	file_my_proto_homework_service_proto_msgTypes[63].OneofWrappers = []any{}
+	file_my_proto_homework_service_proto_msgTypes[67].OneofWrappers = []any{}
+	file_my_proto_homework_service_proto_msgTypes[68].OneofWrappers = []any{}
+	file_my_proto_homework_service_proto_msgTypes[70].OneofWrappers = []any{}
+	file_my_proto_homework_service_proto_msgTypes[72].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_my_proto_homework_service_proto_rawDesc), len(file_my_proto_homework_service_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   22,
+			NumEnums:      10,
+			NumMessages:   73,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
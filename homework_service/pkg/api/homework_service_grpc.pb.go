@@ -22,17 +22,39 @@ const (
 	HomeworkService_CreateAssignment_FullMethodName            = "/homework.v1.HomeworkService/CreateAssignment"
 	HomeworkService_UpdateAssignment_FullMethodName            = "/homework.v1.HomeworkService/UpdateAssignment"
 	HomeworkService_DeleteAssignment_FullMethodName            = "/homework.v1.HomeworkService/DeleteAssignment"
+	HomeworkService_RestoreAssignment_FullMethodName           = "/homework.v1.HomeworkService/RestoreAssignment"
+	HomeworkService_MarkChecklistItem_FullMethodName           = "/homework.v1.HomeworkService/MarkChecklistItem"
+	HomeworkService_ReviewChecklistItem_FullMethodName         = "/homework.v1.HomeworkService/ReviewChecklistItem"
 	HomeworkService_ListAssignmentsByTutor_FullMethodName      = "/homework.v1.HomeworkService/ListAssignmentsByTutor"
 	HomeworkService_ListAssignmentsByStudent_FullMethodName    = "/homework.v1.HomeworkService/ListAssignmentsByStudent"
 	HomeworkService_ListAssignmentsByPair_FullMethodName       = "/homework.v1.HomeworkService/ListAssignmentsByPair"
+	HomeworkService_ListAssignmentsByLesson_FullMethodName     = "/homework.v1.HomeworkService/ListAssignmentsByLesson"
+	HomeworkService_CreateAssignmentTemplate_FullMethodName    = "/homework.v1.HomeworkService/CreateAssignmentTemplate"
+	HomeworkService_UpdateAssignmentTemplate_FullMethodName    = "/homework.v1.HomeworkService/UpdateAssignmentTemplate"
+	HomeworkService_DeleteAssignmentTemplate_FullMethodName    = "/homework.v1.HomeworkService/DeleteAssignmentTemplate"
+	HomeworkService_ListAssignmentTemplates_FullMethodName     = "/homework.v1.HomeworkService/ListAssignmentTemplates"
+	HomeworkService_AssignFromTemplate_FullMethodName          = "/homework.v1.HomeworkService/AssignFromTemplate"
+	HomeworkService_SetDefaultTemplate_FullMethodName          = "/homework.v1.HomeworkService/SetDefaultTemplate"
+	HomeworkService_GetDefaultTemplate_FullMethodName          = "/homework.v1.HomeworkService/GetDefaultTemplate"
 	HomeworkService_CreateSubmission_FullMethodName            = "/homework.v1.HomeworkService/CreateSubmission"
 	HomeworkService_ListSubmissionsByAssignment_FullMethodName = "/homework.v1.HomeworkService/ListSubmissionsByAssignment"
 	HomeworkService_CreateFeedback_FullMethodName              = "/homework.v1.HomeworkService/CreateFeedback"
 	HomeworkService_UpdateFeedback_FullMethodName              = "/homework.v1.HomeworkService/UpdateFeedback"
 	HomeworkService_ListFeedbacksByAssignment_FullMethodName   = "/homework.v1.HomeworkService/ListFeedbacksByAssignment"
+	HomeworkService_FlattenFeedbackAnnotations_FullMethodName  = "/homework.v1.HomeworkService/FlattenFeedbackAnnotations"
+	HomeworkService_CreateComment_FullMethodName               = "/homework.v1.HomeworkService/CreateComment"
+	HomeworkService_UpdateComment_FullMethodName               = "/homework.v1.HomeworkService/UpdateComment"
+	HomeworkService_DeleteComment_FullMethodName               = "/homework.v1.HomeworkService/DeleteComment"
+	HomeworkService_ListComments_FullMethodName                = "/homework.v1.HomeworkService/ListComments"
+	HomeworkService_GetGradebook_FullMethodName                = "/homework.v1.HomeworkService/GetGradebook"
+	HomeworkService_GetHomeworkStats_FullMethodName            = "/homework.v1.HomeworkService/GetHomeworkStats"
+	HomeworkService_SearchHomework_FullMethodName              = "/homework.v1.HomeworkService/SearchHomework"
+	HomeworkService_CreatePortfolioExport_FullMethodName       = "/homework.v1.HomeworkService/CreatePortfolioExport"
+	HomeworkService_GetPortfolioExport_FullMethodName          = "/homework.v1.HomeworkService/GetPortfolioExport"
 	HomeworkService_GetAssignmentFile_FullMethodName           = "/homework.v1.HomeworkService/GetAssignmentFile"
 	HomeworkService_GetSubmissionFile_FullMethodName           = "/homework.v1.HomeworkService/GetSubmissionFile"
 	HomeworkService_GetFeedbackFile_FullMethodName             = "/homework.v1.HomeworkService/GetFeedbackFile"
+	HomeworkService_ListAttachmentFileURLs_FullMethodName      = "/homework.v1.HomeworkService/ListAttachmentFileURLs"
 )
 
 // HomeworkServiceClient is the client API for HomeworkService service.
@@ -43,9 +65,21 @@ type HomeworkServiceClient interface {
 	CreateAssignment(ctx context.Context, in *CreateAssignmentRequest, opts ...grpc.CallOption) (*Assignment, error)
 	UpdateAssignment(ctx context.Context, in *UpdateAssignmentRequest, opts ...grpc.CallOption) (*Assignment, error)
 	DeleteAssignment(ctx context.Context, in *DeleteAssignmentRequest, opts ...grpc.CallOption) (*Empty, error)
+	RestoreAssignment(ctx context.Context, in *RestoreAssignmentRequest, opts ...grpc.CallOption) (*Assignment, error)
+	MarkChecklistItem(ctx context.Context, in *MarkChecklistItemRequest, opts ...grpc.CallOption) (*Assignment, error)
+	ReviewChecklistItem(ctx context.Context, in *ReviewChecklistItemRequest, opts ...grpc.CallOption) (*Assignment, error)
 	ListAssignmentsByTutor(ctx context.Context, in *ListAssignmentsByTutorRequest, opts ...grpc.CallOption) (*ListAssignmentsResponse, error)
 	ListAssignmentsByStudent(ctx context.Context, in *ListAssignmentsByStudentRequest, opts ...grpc.CallOption) (*ListAssignmentsResponse, error)
 	ListAssignmentsByPair(ctx context.Context, in *ListAssignmentsByPairRequest, opts ...grpc.CallOption) (*ListAssignmentsResponse, error)
+	ListAssignmentsByLesson(ctx context.Context, in *ListAssignmentsByLessonRequest, opts ...grpc.CallOption) (*ListAssignmentsResponse, error)
+	// --- TEMPLATE ---
+	CreateAssignmentTemplate(ctx context.Context, in *CreateAssignmentTemplateRequest, opts ...grpc.CallOption) (*AssignmentTemplate, error)
+	UpdateAssignmentTemplate(ctx context.Context, in *UpdateAssignmentTemplateRequest, opts ...grpc.CallOption) (*AssignmentTemplate, error)
+	DeleteAssignmentTemplate(ctx context.Context, in *DeleteAssignmentTemplateRequest, opts ...grpc.CallOption) (*Empty, error)
+	ListAssignmentTemplates(ctx context.Context, in *ListAssignmentTemplatesRequest, opts ...grpc.CallOption) (*ListAssignmentTemplatesResponse, error)
+	AssignFromTemplate(ctx context.Context, in *AssignFromTemplateRequest, opts ...grpc.CallOption) (*ListAssignmentsResponse, error)
+	SetDefaultTemplate(ctx context.Context, in *SetDefaultTemplateRequest, opts ...grpc.CallOption) (*Empty, error)
+	GetDefaultTemplate(ctx context.Context, in *GetDefaultTemplateRequest, opts ...grpc.CallOption) (*AssignmentTemplate, error)
 	// --- SUBMISSION ---
 	CreateSubmission(ctx context.Context, in *CreateSubmissionRequest, opts ...grpc.CallOption) (*Submission, error)
 	ListSubmissionsByAssignment(ctx context.Context, in *ListSubmissionsByAssignmentRequest, opts ...grpc.CallOption) (*ListSubmissionsResponse, error)
@@ -53,10 +87,25 @@ type HomeworkServiceClient interface {
 	CreateFeedback(ctx context.Context, in *CreateFeedbackRequest, opts ...grpc.CallOption) (*Feedback, error)
 	UpdateFeedback(ctx context.Context, in *UpdateFeedbackRequest, opts ...grpc.CallOption) (*Feedback, error)
 	ListFeedbacksByAssignment(ctx context.Context, in *ListFeedbacksByAssignmentRequest, opts ...grpc.CallOption) (*ListFeedbacksResponse, error)
+	FlattenFeedbackAnnotations(ctx context.Context, in *FlattenFeedbackAnnotationsRequest, opts ...grpc.CallOption) (*AnnotatedFile, error)
+	// --- COMMENT ---
+	CreateComment(ctx context.Context, in *CreateCommentRequest, opts ...grpc.CallOption) (*Comment, error)
+	UpdateComment(ctx context.Context, in *UpdateCommentRequest, opts ...grpc.CallOption) (*Comment, error)
+	DeleteComment(ctx context.Context, in *DeleteCommentRequest, opts ...grpc.CallOption) (*Empty, error)
+	ListComments(ctx context.Context, in *ListCommentsRequest, opts ...grpc.CallOption) (*ListCommentsResponse, error)
+	// --- GRADES ---
+	GetGradebook(ctx context.Context, in *GetGradebookRequest, opts ...grpc.CallOption) (*Gradebook, error)
+	GetHomeworkStats(ctx context.Context, in *GetHomeworkStatsRequest, opts ...grpc.CallOption) (*HomeworkStats, error)
+	// --- SEARCH ---
+	SearchHomework(ctx context.Context, in *SearchHomeworkRequest, opts ...grpc.CallOption) (*SearchHomeworkResponse, error)
+	// --- EXPORT ---
+	CreatePortfolioExport(ctx context.Context, in *CreatePortfolioExportRequest, opts ...grpc.CallOption) (*PortfolioExport, error)
+	GetPortfolioExport(ctx context.Context, in *GetPortfolioExportRequest, opts ...grpc.CallOption) (*PortfolioExport, error)
 	// --- FILES ---
 	GetAssignmentFile(ctx context.Context, in *GetAssignmentFileRequest, opts ...grpc.CallOption) (*HomeworkFileURL, error)
 	GetSubmissionFile(ctx context.Context, in *GetSubmissionFileRequest, opts ...grpc.CallOption) (*HomeworkFileURL, error)
 	GetFeedbackFile(ctx context.Context, in *GetFeedbackFileRequest, opts ...grpc.CallOption) (*HomeworkFileURL, error)
+	ListAttachmentFileURLs(ctx context.Context, in *ListAttachmentFileURLsRequest, opts ...grpc.CallOption) (*ListAttachmentFileURLsResponse, error)
 }
 
 type homeworkServiceClient struct {
@@ -97,6 +146,36 @@ func (c *homeworkServiceClient) DeleteAssignment(ctx context.Context, in *Delete
 	return out, nil
 }
 
+func (c *homeworkServiceClient) RestoreAssignment(ctx context.Context, in *RestoreAssignmentRequest, opts ...grpc.CallOption) (*Assignment, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Assignment)
+	err := c.cc.Invoke(ctx, HomeworkService_RestoreAssignment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *homeworkServiceClient) MarkChecklistItem(ctx context.Context, in *MarkChecklistItemRequest, opts ...grpc.CallOption) (*Assignment, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Assignment)
+	err := c.cc.Invoke(ctx, HomeworkService_MarkChecklistItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *homeworkServiceClient) ReviewChecklistItem(ctx context.Context, in *ReviewChecklistItemRequest, opts ...grpc.CallOption) (*Assignment, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Assignment)
+	err := c.cc.Invoke(ctx, HomeworkService_ReviewChecklistItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *homeworkServiceClient) ListAssignmentsByTutor(ctx context.Context, in *ListAssignmentsByTutorRequest, opts ...grpc.CallOption) (*ListAssignmentsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListAssignmentsResponse)
@@ -127,6 +206,86 @@ func (c *homeworkServiceClient) ListAssignmentsByPair(ctx context.Context, in *L
 	return out, nil
 }
 
+func (c *homeworkServiceClient) ListAssignmentsByLesson(ctx context.Context, in *ListAssignmentsByLessonRequest, opts ...grpc.CallOption) (*ListAssignmentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAssignmentsResponse)
+	err := c.cc.Invoke(ctx, HomeworkService_ListAssignmentsByLesson_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *homeworkServiceClient) CreateAssignmentTemplate(ctx context.Context, in *CreateAssignmentTemplateRequest, opts ...grpc.CallOption) (*AssignmentTemplate, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AssignmentTemplate)
+	err := c.cc.Invoke(ctx, HomeworkService_CreateAssignmentTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *homeworkServiceClient) UpdateAssignmentTemplate(ctx context.Context, in *UpdateAssignmentTemplateRequest, opts ...grpc.CallOption) (*AssignmentTemplate, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AssignmentTemplate)
+	err := c.cc.Invoke(ctx, HomeworkService_UpdateAssignmentTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *homeworkServiceClient) DeleteAssignmentTemplate(ctx context.Context, in *DeleteAssignmentTemplateRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, HomeworkService_DeleteAssignmentTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *homeworkServiceClient) ListAssignmentTemplates(ctx context.Context, in *ListAssignmentTemplatesRequest, opts ...grpc.CallOption) (*ListAssignmentTemplatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAssignmentTemplatesResponse)
+	err := c.cc.Invoke(ctx, HomeworkService_ListAssignmentTemplates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *homeworkServiceClient) AssignFromTemplate(ctx context.Context, in *AssignFromTemplateRequest, opts ...grpc.CallOption) (*ListAssignmentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAssignmentsResponse)
+	err := c.cc.Invoke(ctx, HomeworkService_AssignFromTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *homeworkServiceClient) SetDefaultTemplate(ctx context.Context, in *SetDefaultTemplateRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, HomeworkService_SetDefaultTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *homeworkServiceClient) GetDefaultTemplate(ctx context.Context, in *GetDefaultTemplateRequest, opts ...grpc.CallOption) (*AssignmentTemplate, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AssignmentTemplate)
+	err := c.cc.Invoke(ctx, HomeworkService_GetDefaultTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *homeworkServiceClient) CreateSubmission(ctx context.Context, in *CreateSubmissionRequest, opts ...grpc.CallOption) (*Submission, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(Submission)
@@ -177,6 +336,106 @@ func (c *homeworkServiceClient) ListFeedbacksByAssignment(ctx context.Context, i
 	return out, nil
 }
 
+func (c *homeworkServiceClient) FlattenFeedbackAnnotations(ctx context.Context, in *FlattenFeedbackAnnotationsRequest, opts ...grpc.CallOption) (*AnnotatedFile, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AnnotatedFile)
+	err := c.cc.Invoke(ctx, HomeworkService_FlattenFeedbackAnnotations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *homeworkServiceClient) CreateComment(ctx context.Context, in *CreateCommentRequest, opts ...grpc.CallOption) (*Comment, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Comment)
+	err := c.cc.Invoke(ctx, HomeworkService_CreateComment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *homeworkServiceClient) UpdateComment(ctx context.Context, in *UpdateCommentRequest, opts ...grpc.CallOption) (*Comment, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Comment)
+	err := c.cc.Invoke(ctx, HomeworkService_UpdateComment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *homeworkServiceClient) DeleteComment(ctx context.Context, in *DeleteCommentRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, HomeworkService_DeleteComment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *homeworkServiceClient) ListComments(ctx context.Context, in *ListCommentsRequest, opts ...grpc.CallOption) (*ListCommentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCommentsResponse)
+	err := c.cc.Invoke(ctx, HomeworkService_ListComments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *homeworkServiceClient) GetGradebook(ctx context.Context, in *GetGradebookRequest, opts ...grpc.CallOption) (*Gradebook, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Gradebook)
+	err := c.cc.Invoke(ctx, HomeworkService_GetGradebook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *homeworkServiceClient) GetHomeworkStats(ctx context.Context, in *GetHomeworkStatsRequest, opts ...grpc.CallOption) (*HomeworkStats, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HomeworkStats)
+	err := c.cc.Invoke(ctx, HomeworkService_GetHomeworkStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *homeworkServiceClient) SearchHomework(ctx context.Context, in *SearchHomeworkRequest, opts ...grpc.CallOption) (*SearchHomeworkResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchHomeworkResponse)
+	err := c.cc.Invoke(ctx, HomeworkService_SearchHomework_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *homeworkServiceClient) CreatePortfolioExport(ctx context.Context, in *CreatePortfolioExportRequest, opts ...grpc.CallOption) (*PortfolioExport, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PortfolioExport)
+	err := c.cc.Invoke(ctx, HomeworkService_CreatePortfolioExport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *homeworkServiceClient) GetPortfolioExport(ctx context.Context, in *GetPortfolioExportRequest, opts ...grpc.CallOption) (*PortfolioExport, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PortfolioExport)
+	err := c.cc.Invoke(ctx, HomeworkService_GetPortfolioExport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *homeworkServiceClient) GetAssignmentFile(ctx context.Context, in *GetAssignmentFileRequest, opts ...grpc.CallOption) (*HomeworkFileURL, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(HomeworkFileURL)
@@ -207,6 +466,16 @@ func (c *homeworkServiceClient) GetFeedbackFile(ctx context.Context, in *GetFeed
 	return out, nil
 }
 
+func (c *homeworkServiceClient) ListAttachmentFileURLs(ctx context.Context, in *ListAttachmentFileURLsRequest, opts ...grpc.CallOption) (*ListAttachmentFileURLsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAttachmentFileURLsResponse)
+	err := c.cc.Invoke(ctx, HomeworkService_ListAttachmentFileURLs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // HomeworkServiceServer is the server API for HomeworkService service.
 // All implementations must embed UnimplementedHomeworkServiceServer
 // for forward compatibility.
@@ -215,9 +484,21 @@ type HomeworkServiceServer interface {
 	CreateAssignment(context.Context, *CreateAssignmentRequest) (*Assignment, error)
 	UpdateAssignment(context.Context, *UpdateAssignmentRequest) (*Assignment, error)
 	DeleteAssignment(context.Context, *DeleteAssignmentRequest) (*Empty, error)
+	RestoreAssignment(context.Context, *RestoreAssignmentRequest) (*Assignment, error)
+	MarkChecklistItem(context.Context, *MarkChecklistItemRequest) (*Assignment, error)
+	ReviewChecklistItem(context.Context, *ReviewChecklistItemRequest) (*Assignment, error)
 	ListAssignmentsByTutor(context.Context, *ListAssignmentsByTutorRequest) (*ListAssignmentsResponse, error)
 	ListAssignmentsByStudent(context.Context, *ListAssignmentsByStudentRequest) (*ListAssignmentsResponse, error)
 	ListAssignmentsByPair(context.Context, *ListAssignmentsByPairRequest) (*ListAssignmentsResponse, error)
+	ListAssignmentsByLesson(context.Context, *ListAssignmentsByLessonRequest) (*ListAssignmentsResponse, error)
+	// --- TEMPLATE ---
+	CreateAssignmentTemplate(context.Context, *CreateAssignmentTemplateRequest) (*AssignmentTemplate, error)
+	UpdateAssignmentTemplate(context.Context, *UpdateAssignmentTemplateRequest) (*AssignmentTemplate, error)
+	DeleteAssignmentTemplate(context.Context, *DeleteAssignmentTemplateRequest) (*Empty, error)
+	ListAssignmentTemplates(context.Context, *ListAssignmentTemplatesRequest) (*ListAssignmentTemplatesResponse, error)
+	AssignFromTemplate(context.Context, *AssignFromTemplateRequest) (*ListAssignmentsResponse, error)
+	SetDefaultTemplate(context.Context, *SetDefaultTemplateRequest) (*Empty, error)
+	GetDefaultTemplate(context.Context, *GetDefaultTemplateRequest) (*AssignmentTemplate, error)
 	// --- SUBMISSION ---
 	CreateSubmission(context.Context, *CreateSubmissionRequest) (*Submission, error)
 	ListSubmissionsByAssignment(context.Context, *ListSubmissionsByAssignmentRequest) (*ListSubmissionsResponse, error)
@@ -225,10 +506,25 @@ type HomeworkServiceServer interface {
 	CreateFeedback(context.Context, *CreateFeedbackRequest) (*Feedback, error)
 	UpdateFeedback(context.Context, *UpdateFeedbackRequest) (*Feedback, error)
 	ListFeedbacksByAssignment(context.Context, *ListFeedbacksByAssignmentRequest) (*ListFeedbacksResponse, error)
+	FlattenFeedbackAnnotations(context.Context, *FlattenFeedbackAnnotationsRequest) (*AnnotatedFile, error)
+	// --- COMMENT ---
+	CreateComment(context.Context, *CreateCommentRequest) (*Comment, error)
+	UpdateComment(context.Context, *UpdateCommentRequest) (*Comment, error)
+	DeleteComment(context.Context, *DeleteCommentRequest) (*Empty, error)
+	ListComments(context.Context, *ListCommentsRequest) (*ListCommentsResponse, error)
+	// --- GRADES ---
+	GetGradebook(context.Context, *GetGradebookRequest) (*Gradebook, error)
+	GetHomeworkStats(context.Context, *GetHomeworkStatsRequest) (*HomeworkStats, error)
+	// --- SEARCH ---
+	SearchHomework(context.Context, *SearchHomeworkRequest) (*SearchHomeworkResponse, error)
+	// --- EXPORT ---
+	CreatePortfolioExport(context.Context, *CreatePortfolioExportRequest) (*PortfolioExport, error)
+	GetPortfolioExport(context.Context, *GetPortfolioExportRequest) (*PortfolioExport, error)
 	// --- FILES ---
 	GetAssignmentFile(context.Context, *GetAssignmentFileRequest) (*HomeworkFileURL, error)
 	GetSubmissionFile(context.Context, *GetSubmissionFileRequest) (*HomeworkFileURL, error)
 	GetFeedbackFile(context.Context, *GetFeedbackFileRequest) (*HomeworkFileURL, error)
+	ListAttachmentFileURLs(context.Context, *ListAttachmentFileURLsRequest) (*ListAttachmentFileURLsResponse, error)
 	mustEmbedUnimplementedHomeworkServiceServer()
 }
 
@@ -248,6 +544,15 @@ func (UnimplementedHomeworkServiceServer) UpdateAssignment(context.Context, *Upd
 func (UnimplementedHomeworkServiceServer) DeleteAssignment(context.Context, *DeleteAssignmentRequest) (*Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteAssignment not implemented")
 }
+func (UnimplementedHomeworkServiceServer) RestoreAssignment(context.Context, *RestoreAssignmentRequest) (*Assignment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreAssignment not implemented")
+}
+func (UnimplementedHomeworkServiceServer) MarkChecklistItem(context.Context, *MarkChecklistItemRequest) (*Assignment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkChecklistItem not implemented")
+}
+func (UnimplementedHomeworkServiceServer) ReviewChecklistItem(context.Context, *ReviewChecklistItemRequest) (*Assignment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReviewChecklistItem not implemented")
+}
 func (UnimplementedHomeworkServiceServer) ListAssignmentsByTutor(context.Context, *ListAssignmentsByTutorRequest) (*ListAssignmentsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListAssignmentsByTutor not implemented")
 }
@@ -257,6 +562,30 @@ func (UnimplementedHomeworkServiceServer) ListAssignmentsByStudent(context.Conte
 func (UnimplementedHomeworkServiceServer) ListAssignmentsByPair(context.Context, *ListAssignmentsByPairRequest) (*ListAssignmentsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListAssignmentsByPair not implemented")
 }
+func (UnimplementedHomeworkServiceServer) ListAssignmentsByLesson(context.Context, *ListAssignmentsByLessonRequest) (*ListAssignmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAssignmentsByLesson not implemented")
+}
+func (UnimplementedHomeworkServiceServer) CreateAssignmentTemplate(context.Context, *CreateAssignmentTemplateRequest) (*AssignmentTemplate, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAssignmentTemplate not implemented")
+}
+func (UnimplementedHomeworkServiceServer) UpdateAssignmentTemplate(context.Context, *UpdateAssignmentTemplateRequest) (*AssignmentTemplate, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAssignmentTemplate not implemented")
+}
+func (UnimplementedHomeworkServiceServer) DeleteAssignmentTemplate(context.Context, *DeleteAssignmentTemplateRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAssignmentTemplate not implemented")
+}
+func (UnimplementedHomeworkServiceServer) ListAssignmentTemplates(context.Context, *ListAssignmentTemplatesRequest) (*ListAssignmentTemplatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAssignmentTemplates not implemented")
+}
+func (UnimplementedHomeworkServiceServer) AssignFromTemplate(context.Context, *AssignFromTemplateRequest) (*ListAssignmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssignFromTemplate not implemented")
+}
+func (UnimplementedHomeworkServiceServer) SetDefaultTemplate(context.Context, *SetDefaultTemplateRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDefaultTemplate not implemented")
+}
+func (UnimplementedHomeworkServiceServer) GetDefaultTemplate(context.Context, *GetDefaultTemplateRequest) (*AssignmentTemplate, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDefaultTemplate not implemented")
+}
 func (UnimplementedHomeworkServiceServer) CreateSubmission(context.Context, *CreateSubmissionRequest) (*Submission, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateSubmission not implemented")
 }
@@ -272,6 +601,36 @@ func (UnimplementedHomeworkServiceServer) UpdateFeedback(context.Context, *Updat
 func (UnimplementedHomeworkServiceServer) ListFeedbacksByAssignment(context.Context, *ListFeedbacksByAssignmentRequest) (*ListFeedbacksResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListFeedbacksByAssignment not implemented")
 }
+func (UnimplementedHomeworkServiceServer) FlattenFeedbackAnnotations(context.Context, *FlattenFeedbackAnnotationsRequest) (*AnnotatedFile, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FlattenFeedbackAnnotations not implemented")
+}
+func (UnimplementedHomeworkServiceServer) CreateComment(context.Context, *CreateCommentRequest) (*Comment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateComment not implemented")
+}
+func (UnimplementedHomeworkServiceServer) UpdateComment(context.Context, *UpdateCommentRequest) (*Comment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateComment not implemented")
+}
+func (UnimplementedHomeworkServiceServer) DeleteComment(context.Context, *DeleteCommentRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteComment not implemented")
+}
+func (UnimplementedHomeworkServiceServer) ListComments(context.Context, *ListCommentsRequest) (*ListCommentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListComments not implemented")
+}
+func (UnimplementedHomeworkServiceServer) GetGradebook(context.Context, *GetGradebookRequest) (*Gradebook, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGradebook not implemented")
+}
+func (UnimplementedHomeworkServiceServer) GetHomeworkStats(context.Context, *GetHomeworkStatsRequest) (*HomeworkStats, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHomeworkStats not implemented")
+}
+func (UnimplementedHomeworkServiceServer) SearchHomework(context.Context, *SearchHomeworkRequest) (*SearchHomeworkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchHomework not implemented")
+}
+func (UnimplementedHomeworkServiceServer) CreatePortfolioExport(context.Context, *CreatePortfolioExportRequest) (*PortfolioExport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePortfolioExport not implemented")
+}
+func (UnimplementedHomeworkServiceServer) GetPortfolioExport(context.Context, *GetPortfolioExportRequest) (*PortfolioExport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPortfolioExport not implemented")
+}
 func (UnimplementedHomeworkServiceServer) GetAssignmentFile(context.Context, *GetAssignmentFileRequest) (*HomeworkFileURL, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAssignmentFile not implemented")
 }
@@ -281,6 +640,9 @@ func (UnimplementedHomeworkServiceServer) GetSubmissionFile(context.Context, *Ge
 func (UnimplementedHomeworkServiceServer) GetFeedbackFile(context.Context, *GetFeedbackFileRequest) (*HomeworkFileURL, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetFeedbackFile not implemented")
 }
+func (UnimplementedHomeworkServiceServer) ListAttachmentFileURLs(context.Context, *ListAttachmentFileURLsRequest) (*ListAttachmentFileURLsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAttachmentFileURLs not implemented")
+}
 func (UnimplementedHomeworkServiceServer) mustEmbedUnimplementedHomeworkServiceServer() {}
 func (UnimplementedHomeworkServiceServer) testEmbeddedByValue()                         {}
 
@@ -356,206 +718,602 @@ func _HomeworkService_DeleteAssignment_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
-func _HomeworkService_ListAssignmentsByTutor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListAssignmentsByTutorRequest)
+func _HomeworkService_RestoreAssignment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreAssignmentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(HomeworkServiceServer).ListAssignmentsByTutor(ctx, in)
+		return srv.(HomeworkServiceServer).RestoreAssignment(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: HomeworkService_ListAssignmentsByTutor_FullMethodName,
+		FullMethod: HomeworkService_RestoreAssignment_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(HomeworkServiceServer).ListAssignmentsByTutor(ctx, req.(*ListAssignmentsByTutorRequest))
+		return srv.(HomeworkServiceServer).RestoreAssignment(ctx, req.(*RestoreAssignmentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _HomeworkService_ListAssignmentsByStudent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListAssignmentsByStudentRequest)
+func _HomeworkService_MarkChecklistItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkChecklistItemRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(HomeworkServiceServer).ListAssignmentsByStudent(ctx, in)
+		return srv.(HomeworkServiceServer).MarkChecklistItem(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: HomeworkService_ListAssignmentsByStudent_FullMethodName,
+		FullMethod: HomeworkService_MarkChecklistItem_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(HomeworkServiceServer).ListAssignmentsByStudent(ctx, req.(*ListAssignmentsByStudentRequest))
+		return srv.(HomeworkServiceServer).MarkChecklistItem(ctx, req.(*MarkChecklistItemRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _HomeworkService_ListAssignmentsByPair_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListAssignmentsByPairRequest)
+func _HomeworkService_ReviewChecklistItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewChecklistItemRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(HomeworkServiceServer).ListAssignmentsByPair(ctx, in)
+		return srv.(HomeworkServiceServer).ReviewChecklistItem(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: HomeworkService_ListAssignmentsByPair_FullMethodName,
+		FullMethod: HomeworkService_ReviewChecklistItem_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(HomeworkServiceServer).ListAssignmentsByPair(ctx, req.(*ListAssignmentsByPairRequest))
+		return srv.(HomeworkServiceServer).ReviewChecklistItem(ctx, req.(*ReviewChecklistItemRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _HomeworkService_CreateSubmission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateSubmissionRequest)
+func _HomeworkService_ListAssignmentsByTutor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAssignmentsByTutorRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(HomeworkServiceServer).CreateSubmission(ctx, in)
+		return srv.(HomeworkServiceServer).ListAssignmentsByTutor(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: HomeworkService_CreateSubmission_FullMethodName,
+		FullMethod: HomeworkService_ListAssignmentsByTutor_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(HomeworkServiceServer).CreateSubmission(ctx, req.(*CreateSubmissionRequest))
+		return srv.(HomeworkServiceServer).ListAssignmentsByTutor(ctx, req.(*ListAssignmentsByTutorRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _HomeworkService_ListSubmissionsByAssignment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListSubmissionsByAssignmentRequest)
+func _HomeworkService_ListAssignmentsByStudent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAssignmentsByStudentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(HomeworkServiceServer).ListSubmissionsByAssignment(ctx, in)
+		return srv.(HomeworkServiceServer).ListAssignmentsByStudent(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: HomeworkService_ListSubmissionsByAssignment_FullMethodName,
+		FullMethod: HomeworkService_ListAssignmentsByStudent_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(HomeworkServiceServer).ListSubmissionsByAssignment(ctx, req.(*ListSubmissionsByAssignmentRequest))
+		return srv.(HomeworkServiceServer).ListAssignmentsByStudent(ctx, req.(*ListAssignmentsByStudentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _HomeworkService_CreateFeedback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateFeedbackRequest)
+func _HomeworkService_ListAssignmentsByPair_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAssignmentsByPairRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(HomeworkServiceServer).CreateFeedback(ctx, in)
+		return srv.(HomeworkServiceServer).ListAssignmentsByPair(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: HomeworkService_CreateFeedback_FullMethodName,
+		FullMethod: HomeworkService_ListAssignmentsByPair_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(HomeworkServiceServer).CreateFeedback(ctx, req.(*CreateFeedbackRequest))
+		return srv.(HomeworkServiceServer).ListAssignmentsByPair(ctx, req.(*ListAssignmentsByPairRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _HomeworkService_UpdateFeedback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateFeedbackRequest)
+func _HomeworkService_ListAssignmentsByLesson_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAssignmentsByLessonRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(HomeworkServiceServer).UpdateFeedback(ctx, in)
+		return srv.(HomeworkServiceServer).ListAssignmentsByLesson(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: HomeworkService_UpdateFeedback_FullMethodName,
+		FullMethod: HomeworkService_ListAssignmentsByLesson_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(HomeworkServiceServer).UpdateFeedback(ctx, req.(*UpdateFeedbackRequest))
+		return srv.(HomeworkServiceServer).ListAssignmentsByLesson(ctx, req.(*ListAssignmentsByLessonRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _HomeworkService_ListFeedbacksByAssignment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListFeedbacksByAssignmentRequest)
+func _HomeworkService_CreateAssignmentTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAssignmentTemplateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(HomeworkServiceServer).ListFeedbacksByAssignment(ctx, in)
+		return srv.(HomeworkServiceServer).CreateAssignmentTemplate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: HomeworkService_ListFeedbacksByAssignment_FullMethodName,
+		FullMethod: HomeworkService_CreateAssignmentTemplate_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(HomeworkServiceServer).ListFeedbacksByAssignment(ctx, req.(*ListFeedbacksByAssignmentRequest))
+		return srv.(HomeworkServiceServer).CreateAssignmentTemplate(ctx, req.(*CreateAssignmentTemplateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _HomeworkService_GetAssignmentFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAssignmentFileRequest)
+func _HomeworkService_UpdateAssignmentTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAssignmentTemplateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(HomeworkServiceServer).GetAssignmentFile(ctx, in)
+		return srv.(HomeworkServiceServer).UpdateAssignmentTemplate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: HomeworkService_GetAssignmentFile_FullMethodName,
+		FullMethod: HomeworkService_UpdateAssignmentTemplate_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(HomeworkServiceServer).GetAssignmentFile(ctx, req.(*GetAssignmentFileRequest))
+		return srv.(HomeworkServiceServer).UpdateAssignmentTemplate(ctx, req.(*UpdateAssignmentTemplateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _HomeworkService_GetSubmissionFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetSubmissionFileRequest)
+func _HomeworkService_DeleteAssignmentTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAssignmentTemplateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(HomeworkServiceServer).GetSubmissionFile(ctx, in)
+		return srv.(HomeworkServiceServer).DeleteAssignmentTemplate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: HomeworkService_GetSubmissionFile_FullMethodName,
+		FullMethod: HomeworkService_DeleteAssignmentTemplate_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(HomeworkServiceServer).GetSubmissionFile(ctx, req.(*GetSubmissionFileRequest))
+		return srv.(HomeworkServiceServer).DeleteAssignmentTemplate(ctx, req.(*DeleteAssignmentTemplateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _HomeworkService_GetFeedbackFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetFeedbackFileRequest)
+func _HomeworkService_ListAssignmentTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAssignmentTemplatesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(HomeworkServiceServer).GetFeedbackFile(ctx, in)
+		return srv.(HomeworkServiceServer).ListAssignmentTemplates(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: HomeworkService_GetFeedbackFile_FullMethodName,
+		FullMethod: HomeworkService_ListAssignmentTemplates_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(HomeworkServiceServer).GetFeedbackFile(ctx, req.(*GetFeedbackFileRequest))
+		return srv.(HomeworkServiceServer).ListAssignmentTemplates(ctx, req.(*ListAssignmentTemplatesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-// HomeworkService_ServiceDesc is the grpc.ServiceDesc for HomeworkService service.
-// It's only intended for direct use with grpc.RegisterService,
+func _HomeworkService_AssignFromTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignFromTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HomeworkServiceServer).AssignFromTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HomeworkService_AssignFromTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HomeworkServiceServer).AssignFromTemplate(ctx, req.(*AssignFromTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HomeworkService_SetDefaultTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetDefaultTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HomeworkServiceServer).SetDefaultTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HomeworkService_SetDefaultTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HomeworkServiceServer).SetDefaultTemplate(ctx, req.(*SetDefaultTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HomeworkService_GetDefaultTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDefaultTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HomeworkServiceServer).GetDefaultTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HomeworkService_GetDefaultTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HomeworkServiceServer).GetDefaultTemplate(ctx, req.(*GetDefaultTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HomeworkService_CreateSubmission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSubmissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HomeworkServiceServer).CreateSubmission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HomeworkService_CreateSubmission_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HomeworkServiceServer).CreateSubmission(ctx, req.(*CreateSubmissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HomeworkService_ListSubmissionsByAssignment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSubmissionsByAssignmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HomeworkServiceServer).ListSubmissionsByAssignment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HomeworkService_ListSubmissionsByAssignment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HomeworkServiceServer).ListSubmissionsByAssignment(ctx, req.(*ListSubmissionsByAssignmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HomeworkService_CreateFeedback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateFeedbackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HomeworkServiceServer).CreateFeedback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HomeworkService_CreateFeedback_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HomeworkServiceServer).CreateFeedback(ctx, req.(*CreateFeedbackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HomeworkService_UpdateFeedback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateFeedbackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HomeworkServiceServer).UpdateFeedback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HomeworkService_UpdateFeedback_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HomeworkServiceServer).UpdateFeedback(ctx, req.(*UpdateFeedbackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HomeworkService_ListFeedbacksByAssignment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFeedbacksByAssignmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HomeworkServiceServer).ListFeedbacksByAssignment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HomeworkService_ListFeedbacksByAssignment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HomeworkServiceServer).ListFeedbacksByAssignment(ctx, req.(*ListFeedbacksByAssignmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HomeworkService_FlattenFeedbackAnnotations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FlattenFeedbackAnnotationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HomeworkServiceServer).FlattenFeedbackAnnotations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HomeworkService_FlattenFeedbackAnnotations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HomeworkServiceServer).FlattenFeedbackAnnotations(ctx, req.(*FlattenFeedbackAnnotationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HomeworkService_CreateComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HomeworkServiceServer).CreateComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HomeworkService_CreateComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HomeworkServiceServer).CreateComment(ctx, req.(*CreateCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HomeworkService_UpdateComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HomeworkServiceServer).UpdateComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HomeworkService_UpdateComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HomeworkServiceServer).UpdateComment(ctx, req.(*UpdateCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HomeworkService_DeleteComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HomeworkServiceServer).DeleteComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HomeworkService_DeleteComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HomeworkServiceServer).DeleteComment(ctx, req.(*DeleteCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HomeworkService_ListComments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCommentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HomeworkServiceServer).ListComments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HomeworkService_ListComments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HomeworkServiceServer).ListComments(ctx, req.(*ListCommentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HomeworkService_GetGradebook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGradebookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HomeworkServiceServer).GetGradebook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HomeworkService_GetGradebook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HomeworkServiceServer).GetGradebook(ctx, req.(*GetGradebookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HomeworkService_GetHomeworkStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHomeworkStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HomeworkServiceServer).GetHomeworkStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HomeworkService_GetHomeworkStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HomeworkServiceServer).GetHomeworkStats(ctx, req.(*GetHomeworkStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HomeworkService_SearchHomework_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchHomeworkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HomeworkServiceServer).SearchHomework(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HomeworkService_SearchHomework_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HomeworkServiceServer).SearchHomework(ctx, req.(*SearchHomeworkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HomeworkService_CreatePortfolioExport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePortfolioExportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HomeworkServiceServer).CreatePortfolioExport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HomeworkService_CreatePortfolioExport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HomeworkServiceServer).CreatePortfolioExport(ctx, req.(*CreatePortfolioExportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HomeworkService_GetPortfolioExport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPortfolioExportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HomeworkServiceServer).GetPortfolioExport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HomeworkService_GetPortfolioExport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HomeworkServiceServer).GetPortfolioExport(ctx, req.(*GetPortfolioExportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HomeworkService_GetAssignmentFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAssignmentFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HomeworkServiceServer).GetAssignmentFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HomeworkService_GetAssignmentFile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HomeworkServiceServer).GetAssignmentFile(ctx, req.(*GetAssignmentFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HomeworkService_GetSubmissionFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSubmissionFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HomeworkServiceServer).GetSubmissionFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HomeworkService_GetSubmissionFile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HomeworkServiceServer).GetSubmissionFile(ctx, req.(*GetSubmissionFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HomeworkService_GetFeedbackFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFeedbackFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HomeworkServiceServer).GetFeedbackFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HomeworkService_GetFeedbackFile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HomeworkServiceServer).GetFeedbackFile(ctx, req.(*GetFeedbackFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HomeworkService_ListAttachmentFileURLs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAttachmentFileURLsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HomeworkServiceServer).ListAttachmentFileURLs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HomeworkService_ListAttachmentFileURLs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HomeworkServiceServer).ListAttachmentFileURLs(ctx, req.(*ListAttachmentFileURLsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// HomeworkService_ServiceDesc is the grpc.ServiceDesc for HomeworkService service.
+// It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
 var HomeworkService_ServiceDesc = grpc.ServiceDesc{
 	ServiceName: "homework.v1.HomeworkService",
@@ -573,6 +1331,18 @@ var HomeworkService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteAssignment",
 			Handler:    _HomeworkService_DeleteAssignment_Handler,
 		},
+		{
+			MethodName: "RestoreAssignment",
+			Handler:    _HomeworkService_RestoreAssignment_Handler,
+		},
+		{
+			MethodName: "MarkChecklistItem",
+			Handler:    _HomeworkService_MarkChecklistItem_Handler,
+		},
+		{
+			MethodName: "ReviewChecklistItem",
+			Handler:    _HomeworkService_ReviewChecklistItem_Handler,
+		},
 		{
 			MethodName: "ListAssignmentsByTutor",
 			Handler:    _HomeworkService_ListAssignmentsByTutor_Handler,
@@ -585,6 +1355,38 @@ var HomeworkService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListAssignmentsByPair",
 			Handler:    _HomeworkService_ListAssignmentsByPair_Handler,
 		},
+		{
+			MethodName: "ListAssignmentsByLesson",
+			Handler:    _HomeworkService_ListAssignmentsByLesson_Handler,
+		},
+		{
+			MethodName: "CreateAssignmentTemplate",
+			Handler:    _HomeworkService_CreateAssignmentTemplate_Handler,
+		},
+		{
+			MethodName: "UpdateAssignmentTemplate",
+			Handler:    _HomeworkService_UpdateAssignmentTemplate_Handler,
+		},
+		{
+			MethodName: "DeleteAssignmentTemplate",
+			Handler:    _HomeworkService_DeleteAssignmentTemplate_Handler,
+		},
+		{
+			MethodName: "ListAssignmentTemplates",
+			Handler:    _HomeworkService_ListAssignmentTemplates_Handler,
+		},
+		{
+			MethodName: "AssignFromTemplate",
+			Handler:    _HomeworkService_AssignFromTemplate_Handler,
+		},
+		{
+			MethodName: "SetDefaultTemplate",
+			Handler:    _HomeworkService_SetDefaultTemplate_Handler,
+		},
+		{
+			MethodName: "GetDefaultTemplate",
+			Handler:    _HomeworkService_GetDefaultTemplate_Handler,
+		},
 		{
 			MethodName: "CreateSubmission",
 			Handler:    _HomeworkService_CreateSubmission_Handler,
@@ -605,6 +1407,46 @@ var HomeworkService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListFeedbacksByAssignment",
 			Handler:    _HomeworkService_ListFeedbacksByAssignment_Handler,
 		},
+		{
+			MethodName: "FlattenFeedbackAnnotations",
+			Handler:    _HomeworkService_FlattenFeedbackAnnotations_Handler,
+		},
+		{
+			MethodName: "CreateComment",
+			Handler:    _HomeworkService_CreateComment_Handler,
+		},
+		{
+			MethodName: "UpdateComment",
+			Handler:    _HomeworkService_UpdateComment_Handler,
+		},
+		{
+			MethodName: "DeleteComment",
+			Handler:    _HomeworkService_DeleteComment_Handler,
+		},
+		{
+			MethodName: "ListComments",
+			Handler:    _HomeworkService_ListComments_Handler,
+		},
+		{
+			MethodName: "GetGradebook",
+			Handler:    _HomeworkService_GetGradebook_Handler,
+		},
+		{
+			MethodName: "GetHomeworkStats",
+			Handler:    _HomeworkService_GetHomeworkStats_Handler,
+		},
+		{
+			MethodName: "SearchHomework",
+			Handler:    _HomeworkService_SearchHomework_Handler,
+		},
+		{
+			MethodName: "CreatePortfolioExport",
+			Handler:    _HomeworkService_CreatePortfolioExport_Handler,
+		},
+		{
+			MethodName: "GetPortfolioExport",
+			Handler:    _HomeworkService_GetPortfolioExport_Handler,
+		},
 		{
 			MethodName: "GetAssignmentFile",
 			Handler:    _HomeworkService_GetAssignmentFile_Handler,
@@ -617,6 +1459,10 @@ var HomeworkService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetFeedbackFile",
 			Handler:    _HomeworkService_GetFeedbackFile_Handler,
 		},
+		{
+			MethodName: "ListAttachmentFileURLs",
+			Handler:    _HomeworkService_ListAttachmentFileURLs_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "my_proto/homework_service.proto",
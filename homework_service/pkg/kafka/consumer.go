@@ -0,0 +1,50 @@
+package kafka
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/segmentio/kafka-go"
+)
+
+type ConsumerConfig struct {
+	Brokers []string
+	Topic   string
+	GroupID string
+}
+
+// Consumer reads a topic as a member of a consumer group. Offsets are committed
+// explicitly, so a message is redelivered if it was not committed before a restart.
+type Consumer struct {
+	reader *kafka.Reader
+}
+
+func NewConsumer(cfg ConsumerConfig) *Consumer {
+	reader := kafka.NewReader(kafka.ReaderConfig{
+		Brokers: cfg.Brokers,
+		Topic:   cfg.Topic,
+		GroupID: cfg.GroupID,
+	})
+
+	return &Consumer{reader: reader}
+}
+
+// Fetch blocks until the next message is available or ctx is done.
+func (c *Consumer) Fetch(ctx context.Context) (kafka.Message, error) {
+	msg, err := c.reader.FetchMessage(ctx)
+	if err != nil {
+		return kafka.Message{}, fmt.Errorf("failed to fetch message: %w", err)
+	}
+	return msg, nil
+}
+
+func (c *Consumer) Commit(ctx context.Context, msg kafka.Message) error {
+	if err := c.reader.CommitMessages(ctx, msg); err != nil {
+		return fmt.Errorf("failed to commit message: %w", err)
+	}
+	return nil
+}
+
+func (c *Consumer) Close() error {
+	return c.reader.Close()
+}
@@ -202,6 +202,26 @@ func (mr *MockUserServiceClientMockRecorder) GetUser(ctx, in any, opts ...any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUser", reflect.TypeOf((*MockUserServiceClient)(nil).GetUser), varargs...)
 }
 
+// GetUserTimezones mocks base method.
+func (m *MockUserServiceClient) GetUserTimezones(ctx context.Context, in *api.GetUserTimezonesRequest, opts ...grpc.CallOption) (*api.GetUserTimezonesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetUserTimezones", varargs...)
+	ret0, _ := ret[0].(*api.GetUserTimezonesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserTimezones indicates an expected call of GetUserTimezones.
+func (mr *MockUserServiceClientMockRecorder) GetUserTimezones(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserTimezones", reflect.TypeOf((*MockUserServiceClient)(nil).GetUserTimezones), varargs...)
+}
+
 // ListTutorStudents mocks base method.
 func (m *MockUserServiceClient) ListTutorStudents(ctx context.Context, in *api.ListTutorStudentsRequest, opts ...grpc.CallOption) (*api.ListTutorStudentsResponse, error) {
 	m.ctrl.T.Helper()
@@ -21,7 +21,7 @@ func main() {
 	defer func() { _ = logger.Sync() }()
 
 	brokers := getEnv("KAFKA_BROKERS", "kafka:9092")
-	topics := getEnv("KAFKA_TOPICS", "lesson-reminders,assignment-reminders")
+	topics := getEnv("KAFKA_TOPICS", "lesson-reminders,assignment-reminders,homework-events")
 	groupID := getEnv("KAFKA_GROUP_ID", "notification-service")
 
 	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
@@ -79,6 +79,8 @@ func main() {
 			logger.Fatal(ctx, "failed to serve", zap.Error(err))
 		}
 	}()
+	go runPeriodically(ctx, packageExpiryCheckInterval, schedule_service.NotifyExpiredPackages, logger, "failed to notify expired packages")
+	go runPeriodically(ctx, lessonCompletionInterval, schedule_service.CompleteEndedLessons, logger, "failed to complete ended lessons")
 
 	<-ctx.Done()
 
@@ -101,3 +103,26 @@ func main() {
 	userClient.Close()
 	logger.Info(ctx, "Server Stopped")
 }
+
+const (
+	// packageExpiryCheckInterval is how often expired lesson packages are reported.
+	packageExpiryCheckInterval = time.Hour
+	// lessonCompletionInterval is how often ended lessons are completed automatically.
+	lessonCompletionInterval = 10 * time.Minute
+)
+
+func runPeriodically(ctx context.Context, interval time.Duration, job func(context.Context) error, logger *logging.Logger, errMsg string) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := job(ctx); err != nil {
+				logger.Error(ctx, errMsg, zap.Error(err))
+			}
+		}
+	}
+}
@@ -7,7 +7,9 @@ import (
 	"strings"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
 
@@ -19,6 +21,115 @@ type PostgresRepository struct {
 	pool *pgxpool.Pool
 }
 
+// lessonColumns is the column list shared by lesson queries, read back by scanLesson.
+// The queries must join the lesson's slot as s.
+const lessonColumns = `l.id, l.slot_id, l.student_id, l.status, l.is_paid, l.connection_link, l.price_rub, l.payment_info, l.created_at, l.edited_at, l.cancel_reason, l.series_id, l.actual_starts_at, l.actual_ends_at, l.package_id, l.cancelled_by, s.tutor_id, s.starts_at, s.ends_at`
+
+// billableStatuses are the lesson statuses the student is charged for: the lesson
+// took place, or the student did not show up without cancelling it.
+const billableStatuses = `('completed', 'no_show_student')`
+
+const slotColumns = `s.id, s.tutor_id, s.starts_at, s.ends_at, s.is_booked, s.created_at, s.edited_at`
+
+// scanLesson scans a row selected with lessonColumns. Extra destinations are
+// scanned after the lesson columns.
+func scanLesson(row pgx.Row, extra ...interface{}) (repo.Lesson, error) {
+	var lesson repo.Lesson
+	var connectionLink, paymentInfo, cancelReason, seriesID, packageID, cancelledBy pgtype.Text
+	var priceRub pgtype.Int4
+	var actualStartsAt, actualEndsAt pgtype.Timestamptz
+
+	dest := []interface{}{
+		&lesson.ID,
+		&lesson.SlotID,
+		&lesson.StudentID,
+		&lesson.Status,
+		&lesson.IsPaid,
+		&connectionLink,
+		&priceRub,
+		&paymentInfo,
+		&lesson.CreatedAt,
+		&lesson.EditedAt,
+		&cancelReason,
+		&seriesID,
+		&actualStartsAt,
+		&actualEndsAt,
+		&packageID,
+		&cancelledBy,
+		&lesson.TutorID,
+		&lesson.StartsAt,
+		&lesson.EndsAt,
+	}
+	if err := row.Scan(append(dest, extra...)...); err != nil {
+		return lesson, err
+	}
+
+	if connectionLink.Valid {
+		lesson.ConnectionLink = &connectionLink.String
+	}
+
+	if priceRub.Valid {
+		val := int32(priceRub.Int32)
+		lesson.PriceRub = &val
+	}
+
+	if paymentInfo.Valid {
+		lesson.PaymentInfo = &paymentInfo.String
+	}
+
+	if cancelReason.Valid {
+		lesson.CancelReason = &cancelReason.String
+	}
+
+	if seriesID.Valid {
+		lesson.SeriesID = &seriesID.String
+	}
+
+	if actualStartsAt.Valid {
+		lesson.ActualStartsAt = &actualStartsAt.Time
+	}
+
+	if actualEndsAt.Valid {
+		lesson.ActualEndsAt = &actualEndsAt.Time
+	}
+
+	if packageID.Valid {
+		lesson.PackageID = &packageID.String
+	}
+
+	if cancelledBy.Valid {
+		lesson.CancelledBy = &cancelledBy.String
+	}
+
+	return lesson, nil
+}
+
+// scanLessonWithSlot scans a row selected with lessonColumns followed by slotColumns.
+func scanLessonWithSlot(row pgx.Row) (repo.LessonWithSlot, error) {
+	var result repo.LessonWithSlot
+	var slotEditedAt pgtype.Timestamptz
+
+	lesson, err := scanLesson(row,
+		&result.Slot.ID,
+		&result.Slot.TutorID,
+		&result.Slot.StartsAt,
+		&result.Slot.EndsAt,
+		&result.Slot.IsBooked,
+		&result.Slot.CreatedAt,
+		&slotEditedAt,
+	)
+	if err != nil {
+		return result, err
+	}
+	result.Lesson = lesson
+
+	if slotEditedAt.Valid {
+		result.Slot.EditedAt = &slotEditedAt.Time
+	}
+
+	return result, nil
+}
+
 func (r *PostgresRepository) GetSlot(ctx context.Context, id string) (*repo.Slot, error) {
 	query := `
 		SELECT id, tutor_id, starts_at, ends_at, is_booked, created_at, edited_at
@@ -179,28 +290,13 @@ func (r *PostgresRepository) ListSlotsByTutor(ctx context.Context, tutorID strin
 
 func (r *PostgresRepository) GetLesson(ctx context.Context, id string) (*repo.Lesson, error) {
 	query := `
-		SELECT id, slot_id, student_id, status, is_paid, connection_link, price_rub, payment_info, created_at, edited_at
-		FROM lessons
-		WHERE id = $1
+		SELECT ` + lessonColumns + `
+		FROM lessons l
+		JOIN slots s ON l.slot_id = s.id
+		WHERE l.id = $1
 	`
 
-	var lesson repo.Lesson
-	var connectionLink, paymentInfo pgtype.Text
-	var priceRub pgtype.Int4
-
-	err := r.pool.QueryRow(ctx, query, id).Scan(
-		&lesson.ID,
-		&lesson.SlotID,
-		&lesson.StudentID,
-		&lesson.Status,
-		&lesson.IsPaid,
-		&connectionLink,
-		&priceRub,
-		&paymentInfo,
-		&lesson.CreatedAt,
-		&lesson.EditedAt,
-	)
-
+	lesson, err := scanLesson(r.pool.QueryRow(ctx, query, id))
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, service.ErrLessonNotFound
@@ -208,45 +304,33 @@ func (r *PostgresRepository) GetLesson(ctx context.Context, id string) (*repo.Le
 		return nil, fmt.Errorf("failed to get lesson: %w", err)
 	}
 
-	if connectionLink.Valid {
-		lesson.ConnectionLink = &connectionLink.String
-	}
-
-	if priceRub.Valid {
-		val := int32(priceRub.Int32)
-		lesson.PriceRub = &val
-	}
-
-	if paymentInfo.Valid {
-		lesson.PaymentInfo = &paymentInfo.String
-	}
-
 	return &lesson, nil
 }
 
-func (r *PostgresRepository) CreateLessonAndBookSlot(ctx context.Context, lesson repo.Lesson, slotID string) error {
+func (r *PostgresRepository) CreateLessonAndBookSlot(ctx context.Context, lesson repo.Lesson, slotID string) (*repo.LessonPackage, error) {
 	tx, err := r.pool.Begin(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to begin transaction: %w", err)
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
 	}
 	defer func() { _ = tx.Rollback(ctx) }()
 
 	var isBooked bool
-	err = tx.QueryRow(ctx, "SELECT is_booked FROM slots WHERE id = $1 FOR UPDATE", slotID).Scan(&isBooked)
+	err = tx.QueryRow(ctx, "SELECT is_booked, tutor_id, starts_at FROM slots WHERE id = $1 FOR UPDATE", slotID).
+		Scan(&isBooked, &lesson.TutorID, &lesson.StartsAt)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return service.ErrSlotNotFound
+			return nil, service.ErrSlotNotFound
 		}
-		return fmt.Errorf("failed to check slot availability: %w", err)
+		return nil, fmt.Errorf("failed to check slot availability: %w", err)
 	}
 
 	if isBooked {
-		return service.ErrSlotBooked
+		return nil, service.ErrSlotBooked
 	}
 
 	_, err = tx.Exec(ctx, "UPDATE slots SET is_booked = true WHERE id = $1", slotID)
 	if err != nil {
-		return fmt.Errorf("failed to mark slot as booked: %w", err)
+		return nil, fmt.Errorf("failed to mark slot as booked: %w", err)
 	}
 
 	query := `
@@ -264,21 +348,27 @@ func (r *PostgresRepository) CreateLessonAndBookSlot(ctx context.Context, lesson
 		lesson.EditedAt,
 	)
 	if err != nil {
-		return fmt.Errorf("failed to create lesson: %w", err)
+		return nil, fmt.Errorf("failed to create lesson: %w", err)
+	}
+
+	pkg, err := consumePackageCredit(ctx, tx, lesson)
+	if err != nil {
+		return nil, err
 	}
 
 	if err := tx.Commit(ctx); err != nil {
-		return fmt.Errorf("failed to commit transaction: %w", err)
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
 	}
 
-	return nil
+	return pkg, nil
 }
 
 func (r *PostgresRepository) UpdateLesson(ctx context.Context, lesson repo.Lesson) error {
 	query := `
 		UPDATE lessons
-		SET status = $1, is_paid = $2, connection_link = $3, price_rub = $4, payment_info = $5, edited_at = $6
-		WHERE id = $7
+		SET status = $1, is_paid = $2, connection_link = $3, price_rub = $4, payment_info = $5, edited_at = $6,
+			actual_starts_at = $7, actual_ends_at = $8
+		WHERE id = $9
 	`
 
 	res, err := r.pool.Exec(ctx, query,
@@ -288,6 +378,8 @@ func (r *PostgresRepository) UpdateLesson(ctx context.Context, lesson repo.Lesso
 		lesson.PriceRub,
 		lesson.PaymentInfo,
 		lesson.EditedAt,
+		lesson.ActualStartsAt,
+		lesson.ActualEndsAt,
 		lesson.ID,
 	)
 
@@ -302,6 +394,52 @@ func (r *PostgresRepository) UpdateLesson(ctx context.Context, lesson repo.Lesso
 	return nil
 }
 
+// UpdateAttendance saves the lesson's status and actual times together with the
+// given change of its package credit. It returns the package a consumed credit was
+// taken from, or nil. The lesson must carry its tutor and start time.
+func (r *PostgresRepository) UpdateAttendance(ctx context.Context, lesson repo.Lesson, credit repo.CreditAction) (*repo.LessonPackage, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	res, err := tx.Exec(ctx, `
+		UPDATE lessons
+		SET status = $1, actual_starts_at = $2, actual_ends_at = $3, edited_at = $4
+		WHERE id = $5
+	`,
+		lesson.Status,
+		lesson.ActualStartsAt,
+		lesson.ActualEndsAt,
+		lesson.EditedAt,
+		lesson.ID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update attendance: %w", err)
+	}
+	if res.RowsAffected() == 0 {
+		return nil, service.ErrLessonNotFound
+	}
+
+	var pkg *repo.LessonPackage
+	switch credit {
+	case repo.CreditRefund:
+		err = refundPackageCredits(ctx, tx, []string{lesson.ID})
+	case repo.CreditConsume:
+		pkg, err = consumePackageCredit(ctx, tx, lesson)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return pkg, nil
+}
+
 func (r *PostgresRepository) CancelLessonAndFreeSlot(ctx context.Context, lesson repo.Lesson, slotID string) error {
 	tx, err := r.pool.Begin(ctx)
 	if err != nil {
@@ -310,9 +448,10 @@ func (r *PostgresRepository) CancelLessonAndFreeSlot(ctx context.Context, lesson
 	defer func() { _ = tx.Rollback(ctx) }()
 
 	_, err = tx.Exec(ctx,
-		"UPDATE lessons SET status = $1, edited_at = $2 WHERE id = $3",
+		"UPDATE lessons SET status = $1, edited_at = $2, cancelled_by = $3 WHERE id = $4",
 		lesson.Status,
 		lesson.EditedAt,
+		lesson.CancelledBy,
 		lesson.ID,
 	)
 	if err != nil {
@@ -324,6 +463,10 @@ func (r *PostgresRepository) CancelLessonAndFreeSlot(ctx context.Context, lesson
 		return fmt.Errorf("failed to mark slot as available: %w", err)
 	}
 
+	if err := refundPackageCredits(ctx, tx, []string{lesson.ID}); err != nil {
+		return err
+	}
+
 	if err := tx.Commit(ctx); err != nil {
 		return fmt.Errorf("failed to commit transaction: %w", err)
 	}
@@ -333,7 +476,7 @@ func (r *PostgresRepository) CancelLessonAndFreeSlot(ctx context.Context, lesson
 
 func (r *PostgresRepository) ListLessonsByTutor(ctx context.Context, tutorID string, statusFilter []string) ([]repo.Lesson, error) {
 	query := `
-		SELECT l.id, l.slot_id, l.student_id, l.status, l.is_paid, l.connection_link, l.price_rub, l.payment_info, l.created_at, l.edited_at
+		SELECT ` + lessonColumns + `
 		FROM lessons l
 		JOIN slots s ON l.slot_id = s.id
 		WHERE s.tutor_id = $1
@@ -356,7 +499,7 @@ func (r *PostgresRepository) ListLessonsByTutor(ctx context.Context, tutorID str
 
 func (r *PostgresRepository) ListLessonsByStudent(ctx context.Context, studentID string, statusFilter []string) ([]repo.Lesson, error) {
 	query := `
-		SELECT l.id, l.slot_id, l.student_id, l.status, l.is_paid, l.connection_link, l.price_rub, l.payment_info, l.created_at, l.edited_at
+		SELECT ` + lessonColumns + `
 		FROM lessons l
 		JOIN slots s ON l.slot_id = s.id
 		WHERE l.student_id = $1
@@ -379,7 +522,7 @@ func (r *PostgresRepository) ListLessonsByStudent(ctx context.Context, studentID
 
 func (r *PostgresRepository) ListLessonsByPair(ctx context.Context, tutorID, studentID string, statusFilter []string) ([]repo.Lesson, error) {
 	query := `
-		SELECT l.id, l.slot_id, l.student_id, l.status, l.is_paid, l.connection_link, l.price_rub, l.payment_info, l.created_at, l.edited_at
+		SELECT ` + lessonColumns + `
 		FROM lessons l
 		JOIN slots s ON l.slot_id = s.id
 		WHERE s.tutor_id = $1 AND l.student_id = $2
@@ -406,19 +549,19 @@ func (r *PostgresRepository) ListCompletedUnpaidLessons(ctx context.Context, aft
 
 	if after != nil {
 		query = `
-			SELECT l.id, l.slot_id, l.student_id, l.status, l.is_paid, l.connection_link, l.price_rub, l.payment_info, l.created_at, l.edited_at
+			SELECT ` + lessonColumns + `
 			FROM lessons l
 			JOIN slots s ON l.slot_id = s.id
-			WHERE l.status = 'completed' AND l.is_paid = false AND s.ends_at > $1
+			WHERE l.status IN ` + billableStatuses + ` AND l.is_paid = false AND s.ends_at > $1
 			ORDER BY s.ends_at ASC
 		`
 		args = []interface{}{after}
 	} else {
 		query = `
-			SELECT l.id, l.slot_id, l.student_id, l.status, l.is_paid, l.connection_link, l.price_rub, l.payment_info, l.created_at, l.edited_at
+			SELECT ` + lessonColumns + `
 			FROM lessons l
 			JOIN slots s ON l.slot_id = s.id
-			WHERE l.status = 'completed' AND l.is_paid = false
+			WHERE l.status IN ` + billableStatuses + ` AND l.is_paid = false
 			ORDER BY s.ends_at ASC
 		`
 		args = []interface{}{}
@@ -427,22 +570,66 @@ func (r *PostgresRepository) ListCompletedUnpaidLessons(ctx context.Context, aft
 	return r.queryLessons(ctx, query, args...)
 }
 
-func (r *PostgresRepository) UpdateCompletedLessons(ctx context.Context) (int, error) {
-	query := `
-		UPDATE lessons
-		SET status = 'completed', edited_at = NOW()
-		FROM slots
-		WHERE lessons.slot_id = slots.id
-		AND lessons.status = 'booked'
-		AND slots.ends_at < NOW()
-	`
+// UpdateCompletedLessons completes booked lessons that ended before endedBefore in a
+// single transaction. Lessons marked as no-show are not booked and stay untouched.
+// Unpaid lessons consume a package credit, as when the tutor marks them completed.
+func (r *PostgresRepository) UpdateCompletedLessons(ctx context.Context, endedBefore time.Time) (*repo.CompletedLessonsResult, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
 
-	res, err := r.pool.Exec(ctx, query)
+	rows, err := tx.Query(ctx, `
+		UPDATE lessons l
+		SET status = 'completed', edited_at = NOW()
+		FROM slots s
+		WHERE l.slot_id = s.id
+		AND l.status = 'booked'
+		AND s.ends_at < $1
+		RETURNING `+lessonColumns+`, `+slotColumns+`
+	`, endedBefore)
 	if err != nil {
-		return 0, fmt.Errorf("failed to update completed lessons: %w", err)
+		return nil, fmt.Errorf("failed to update completed lessons: %w", err)
+	}
+
+	result := &repo.CompletedLessonsResult{}
+	for rows.Next() {
+		completed, err := scanLessonWithSlot(rows)
+		if err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("failed to scan lesson row: %w", err)
+		}
+
+		result.Completed = append(result.Completed, completed)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating lesson rows: %w", err)
 	}
 
-	return int(res.RowsAffected()), nil
+	for i := range result.Completed {
+		lesson := &result.Completed[i].Lesson
+		if lesson.IsPaid {
+			continue
+		}
+
+		pkg, err := consumePackageCredit(ctx, tx, *lesson)
+		if err != nil {
+			return nil, err
+		}
+		if pkg != nil {
+			lesson.IsPaid = true
+			lesson.PackageID = &pkg.ID
+			result.Packages = usePackage(result.Packages, *pkg)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return result, nil
 }
 
 func (r *PostgresRepository) queryLessons(ctx context.Context, query string, args ...interface{}) ([]repo.Lesson, error) {
@@ -454,61 +641,801 @@ func (r *PostgresRepository) queryLessons(ctx context.Context, query string, arg
 
 	var lessons []repo.Lesson
 	for rows.Next() {
-		var lesson repo.Lesson
-		var connectionLink, paymentInfo pgtype.Text
-		var priceRub pgtype.Int4
+		lesson, err := scanLesson(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan lesson row: %w", err)
+		}
 
-		err := rows.Scan(
-			&lesson.ID,
-			&lesson.SlotID,
-			&lesson.StudentID,
-			&lesson.Status,
-			&lesson.IsPaid,
-			&connectionLink,
-			&priceRub,
-			&paymentInfo,
-			&lesson.CreatedAt,
-			&lesson.EditedAt,
-		)
+		lessons = append(lessons, lesson)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating lesson rows: %w", err)
+	}
+
+	return lessons, nil
+}
+
+func (r *PostgresRepository) MarkAsPaid(ctx context.Context, lessonID string) error {
+	return markAsPaid(ctx, r.pool, lessonID, nil)
+}
+
+// execer is implemented by both the pool and a transaction.
+type execer interface {
+	Exec(ctx context.Context, sql string, arguments ...any) (pgconn.CommandTag, error)
+}
+
+// markAsPaid marks the lesson as paid. A lesson paid from a package keeps a reference
+// to it, so that the credit can be refunded.
+func markAsPaid(ctx context.Context, db execer, lessonID string, packageID *string) error {
+	query := `UPDATE lessons SET is_paid = TRUE, package_id = COALESCE($2::uuid, package_id) WHERE id = $1`
+
+	res, err := db.Exec(ctx, query, lessonID, packageID)
+
+	if err != nil {
+		return fmt.Errorf("failed to mark as paid: %w", err)
+	}
+
+	if res.RowsAffected() == 0 {
+		return service.ErrLessonNotFound
+	}
+	return nil
+}
+
+// BlockPeriod deletes free slots and cancels booked lessons overlapping [from, to)
+// in a single transaction. Slots that are still referenced by lessons cannot be
+// deleted, so they are closed (is_booked = true) instead of being offered to students.
+// Free slots that only keep cancelled lessons are reported in ClosedSlots.
+// With dryRun the transaction is rolled back and only the summary is returned.
+func (r *PostgresRepository) BlockPeriod(ctx context.Context, tutorID string, from, to time.Time, reason *string, dryRun bool) (*repo.BlockPeriodResult, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	deleteQuery := `
+		DELETE FROM slots s
+		WHERE s.tutor_id = $1 AND s.starts_at < $3 AND s.ends_at > $2
+		AND s.is_booked = false
+		AND NOT EXISTS (SELECT 1 FROM lessons l WHERE l.slot_id = s.id)
+		RETURNING s.id, s.tutor_id, s.starts_at, s.ends_at, s.is_booked, s.created_at, s.edited_at
+	`
+
+	result := &repo.BlockPeriodResult{}
+
+	rows, err := tx.Query(ctx, deleteQuery, tutorID, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete free slots: %w", err)
+	}
+	result.DeletedSlots, err = collectSlots(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	cancelQuery := `
+		UPDATE lessons l
+		SET status = 'cancelled', cancel_reason = $4, cancelled_by = 'tutor', edited_at = NOW()
+		FROM slots s
+		WHERE l.slot_id = s.id
+		AND s.tutor_id = $1 AND s.starts_at < $3 AND s.ends_at > $2
+		AND l.status = 'booked'
+		RETURNING ` + lessonColumns + `, ` + slotColumns + `
+	`
+
+	rows, err = tx.Query(ctx, cancelQuery, tutorID, from, to, reason)
+	if err != nil {
+		return nil, fmt.Errorf("failed to cancel lessons: %w", err)
+	}
+
+	for rows.Next() {
+		cancelled, err := scanLessonWithSlot(rows)
 		if err != nil {
+			rows.Close()
 			return nil, fmt.Errorf("failed to scan lesson row: %w", err)
 		}
 
-		if connectionLink.Valid {
-			lesson.ConnectionLink = &connectionLink.String
+		result.CancelledLessons = append(result.CancelledLessons, cancelled)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating lesson rows: %w", err)
+	}
+
+	if err := refundCancelledLessons(ctx, tx, result.CancelledLessons); err != nil {
+		return nil, err
+	}
+
+	// Slots left free at this point were freed by a cancellation: they are offered
+	// to students like any free slot, but their cancelled lessons keep them from
+	// being deleted.
+	rows, err = tx.Query(ctx, `
+		UPDATE slots s SET is_booked = true, edited_at = NOW()
+		WHERE s.tutor_id = $1 AND s.starts_at < $3 AND s.ends_at > $2 AND s.is_booked = false
+		RETURNING `+slotColumns+`
+	`, tutorID, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to close remaining slots: %w", err)
+	}
+	result.ClosedSlots, err = collectSlots(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	if dryRun {
+		return result, nil
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return result, nil
+}
+
+// collectSlots scans and closes rows selected with slotColumns.
+func collectSlots(rows pgx.Rows) ([]repo.Slot, error) {
+	defer rows.Close()
+
+	var slots []repo.Slot
+	for rows.Next() {
+		var slot repo.Slot
+		var editedAt pgtype.Timestamptz
+
+		if err := rows.Scan(
+			&slot.ID,
+			&slot.TutorID,
+			&slot.StartsAt,
+			&slot.EndsAt,
+			&slot.IsBooked,
+			&slot.CreatedAt,
+			&editedAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan slot row: %w", err)
 		}
 
-		if priceRub.Valid {
-			val := int32(priceRub.Int32)
-			lesson.PriceRub = &val
+		if editedAt.Valid {
+			slot.EditedAt = &editedAt.Time
 		}
 
-		if paymentInfo.Valid {
-			lesson.PaymentInfo = &paymentInfo.String
+		slots = append(slots, slot)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating slot rows: %w", err)
+	}
+
+	return slots, nil
+}
+
+// CreateLessonSeries books every occurrence of a series in one transaction.
+// Occurrences whose slot is taken, missing or (with createSlots) overlaps another
+// slot are reported in Failed instead of aborting the series. If nothing could be
+// booked the transaction is rolled back and the series is not stored.
+func (r *PostgresRepository) CreateLessonSeries(ctx context.Context, series repo.LessonSeries, occurrences []repo.SeriesOccurrence, createSlots bool) (*repo.LessonSeriesResult, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	_, err = tx.Exec(ctx, `
+		INSERT INTO lesson_series (id, tutor_id, student_id, created_at)
+		VALUES ($1, $2, $3, $4)
+	`, series.ID, series.TutorID, series.StudentID, series.CreatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create lesson series: %w", err)
+	}
+
+	result := &repo.LessonSeriesResult{}
+	for _, occurrence := range occurrences {
+		booked, pkg, err := bookSeriesOccurrence(ctx, tx, series, occurrence, createSlots)
+		if errors.Is(err, service.ErrSlotBooked) || errors.Is(err, service.ErrSlotNotFound) || errors.Is(err, service.ErrSlotConflict) {
+			result.Failed = append(result.Failed, repo.FailedOccurrence{Occurrence: occurrence, Err: err})
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		result.Booked = append(result.Booked, *booked)
+		if pkg != nil {
+			result.Packages = usePackage(result.Packages, *pkg)
 		}
+	}
 
-		lessons = append(lessons, lesson)
+	if len(result.Booked) == 0 {
+		return result, nil
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return result, nil
+}
+
+// usePackage records that pkg paid for one more lesson, keeping its latest state.
+func usePackage(usages []repo.PackageUsage, pkg repo.LessonPackage) []repo.PackageUsage {
+	for i := range usages {
+		if usages[i].Package.ID == pkg.ID {
+			usages[i].Package = pkg
+			usages[i].Consumed++
+			return usages
+		}
+	}
+	return append(usages, repo.PackageUsage{Package: pkg, Consumed: 1})
+}
+
+// bookSeriesOccurrence books a single lesson of the series and pays for it from the
+// pair's package, returning the package or nil.
+func bookSeriesOccurrence(ctx context.Context, tx pgx.Tx, series repo.LessonSeries, occurrence repo.SeriesOccurrence, createSlots bool) (*repo.LessonWithSlot, *repo.LessonPackage, error) {
+	slot := repo.Slot{
+		TutorID:  series.TutorID,
+		StartsAt: occurrence.StartsAt,
+		EndsAt:   occurrence.EndsAt,
+	}
+
+	var taken bool
+	err := tx.QueryRow(ctx, `
+		SELECT s.id, s.created_at, s.is_booked
+			OR EXISTS (SELECT 1 FROM lessons l WHERE l.slot_id = s.id AND l.status <> 'cancelled')
+		FROM slots s
+		WHERE s.tutor_id = $1 AND s.starts_at = $2 AND s.ends_at = $3
+		FOR UPDATE
+	`, series.TutorID, occurrence.StartsAt, occurrence.EndsAt).Scan(&slot.ID, &slot.CreatedAt, &taken)
+
+	switch {
+	case err == nil:
+		if taken {
+			return nil, nil, service.ErrSlotBooked
+		}
+		_, err = tx.Exec(ctx, "UPDATE slots SET is_booked = true, edited_at = NOW() WHERE id = $1", slot.ID)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to mark slot as booked: %w", err)
+		}
+	case errors.Is(err, pgx.ErrNoRows):
+		if !createSlots {
+			return nil, nil, service.ErrSlotNotFound
+		}
+
+		var overlaps bool
+		err = tx.QueryRow(ctx, `
+			SELECT EXISTS (SELECT 1 FROM slots WHERE tutor_id = $1 AND starts_at < $3 AND ends_at > $2)
+		`, series.TutorID, occurrence.StartsAt, occurrence.EndsAt).Scan(&overlaps)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to check slot overlap: %w", err)
+		}
+		if overlaps {
+			return nil, nil, service.ErrSlotConflict
+		}
+
+		slot.ID = uuid.New().String()
+		slot.CreatedAt = series.CreatedAt
+		_, err = tx.Exec(ctx, `
+			INSERT INTO slots (id, tutor_id, starts_at, ends_at, is_booked, created_at)
+			VALUES ($1, $2, $3, $4, true, $5)
+		`, slot.ID, slot.TutorID, slot.StartsAt, slot.EndsAt, slot.CreatedAt)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to create slot: %w", err)
+		}
+	default:
+		return nil, nil, fmt.Errorf("failed to check slot availability: %w", err)
+	}
+	slot.IsBooked = true
+
+	seriesID := series.ID
+	lesson := repo.Lesson{
+		ID:        uuid.New().String(),
+		SlotID:    slot.ID,
+		StudentID: series.StudentID,
+		Status:    "booked",
+		CreatedAt: series.CreatedAt,
+		EditedAt:  series.CreatedAt,
+		SeriesID:  &seriesID,
+		TutorID:   slot.TutorID,
+		StartsAt:  slot.StartsAt,
+		EndsAt:    slot.EndsAt,
 	}
 
+	_, err = tx.Exec(ctx, `
+		INSERT INTO lessons (id, slot_id, student_id, status, is_paid, created_at, edited_at, series_id)
+		VALUES ($1, $2, $3, $4, false, $5, $6, $7)
+	`, lesson.ID, lesson.SlotID, lesson.StudentID, lesson.Status, lesson.CreatedAt, lesson.EditedAt, lesson.SeriesID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create lesson: %w", err)
+	}
+
+	pkg, err := consumePackageCredit(ctx, tx, lesson)
+	if err != nil {
+		return nil, nil, err
+	}
+	if pkg != nil {
+		lesson.IsPaid = true
+		lesson.PackageID = &pkg.ID
+	}
+
+	return &repo.LessonWithSlot{Lesson: lesson, Slot: slot}, pkg, nil
+}
+
+func (r *PostgresRepository) GetLessonSeries(ctx context.Context, id string) (*repo.LessonSeries, error) {
+	var series repo.LessonSeries
+
+	err := r.pool.QueryRow(ctx, `
+		SELECT id, tutor_id, student_id, created_at
+		FROM lesson_series
+		WHERE id = $1
+	`, id).Scan(&series.ID, &series.TutorID, &series.StudentID, &series.CreatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, service.ErrSeriesNotFound
+		}
+		return nil, fmt.Errorf("failed to get lesson series: %w", err)
+	}
+
+	return &series, nil
+}
+
+// CancelLessonSeries cancels the booked lessons of a series starting after the
+// given moment and frees their slots. Past and already completed lessons are kept.
+func (r *PostgresRepository) CancelLessonSeries(ctx context.Context, seriesID string, after time.Time, reason *string, cancelledBy string) ([]repo.LessonWithSlot, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	rows, err := tx.Query(ctx, `
+		UPDATE lessons l
+		SET status = 'cancelled', cancel_reason = $3, cancelled_by = $4, edited_at = NOW()
+		FROM slots s
+		WHERE l.slot_id = s.id
+		AND l.series_id = $1 AND l.status = 'booked' AND s.starts_at > $2
+		RETURNING `+lessonColumns+`, `+slotColumns+`
+	`, seriesID, after, reason, cancelledBy)
+	if err != nil {
+		return nil, fmt.Errorf("failed to cancel series lessons: %w", err)
+	}
+
+	var cancelled []repo.LessonWithSlot
+	slotIDs := make([]string, 0)
+	for rows.Next() {
+		c, err := scanLessonWithSlot(rows)
+		if err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("failed to scan lesson row: %w", err)
+		}
+		c.Slot.IsBooked = false
+		cancelled = append(cancelled, c)
+		slotIDs = append(slotIDs, c.Slot.ID)
+	}
+	rows.Close()
 	if err := rows.Err(); err != nil {
 		return nil, fmt.Errorf("error iterating lesson rows: %w", err)
 	}
 
-	return lessons, nil
+	_, err = tx.Exec(ctx, "UPDATE slots SET is_booked = false, edited_at = NOW() WHERE id = ANY($1)", slotIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to free slots: %w", err)
+	}
+
+	if err := refundCancelledLessons(ctx, tx, cancelled); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return cancelled, nil
 }
 
-func (r *PostgresRepository) MarkAsPaid(ctx context.Context, lessonID string) error {
-	query := `UPDATE lessons SET is_paid = TRUE WHERE id = $1`
+func (r *PostgresRepository) GetLessonNotes(ctx context.Context, lessonID string) (*repo.LessonNotes, error) {
+	var notes repo.LessonNotes
+
+	err := r.pool.QueryRow(ctx, `
+		SELECT lesson_id, private_note, agenda, summary, attachment_file_ids, edited_by, edited_at
+		FROM lesson_notes
+		WHERE lesson_id = $1
+	`, lessonID).Scan(
+		&notes.LessonID,
+		&notes.PrivateNote,
+		&notes.Agenda,
+		&notes.Summary,
+		&notes.AttachmentFileIDs,
+		&notes.EditedBy,
+		&notes.EditedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, service.ErrNotesNotFound
+		}
+		return nil, fmt.Errorf("failed to get lesson notes: %w", err)
+	}
 
-	res, err := r.pool.Exec(ctx, query, lessonID)
+	return &notes, nil
+}
 
+// UpsertLessonNotes replaces the current notes of a lesson and appends the new
+// version to lesson_notes_history in the same transaction.
+func (r *PostgresRepository) UpsertLessonNotes(ctx context.Context, notes repo.LessonNotes) error {
+	tx, err := r.pool.Begin(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to mark as paid: %w", err)
+		return fmt.Errorf("failed to begin transaction: %w", err)
 	}
+	defer func() { _ = tx.Rollback(ctx) }()
 
-	if res.RowsAffected() == 0 {
-		return service.ErrLessonNotFound
+	attachments := notes.AttachmentFileIDs
+	if attachments == nil {
+		attachments = []string{}
+	}
+
+	_, err = tx.Exec(ctx, `
+		INSERT INTO lesson_notes (lesson_id, private_note, agenda, summary, attachment_file_ids, edited_by, edited_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (lesson_id) DO UPDATE
+		SET private_note = EXCLUDED.private_note,
+			agenda = EXCLUDED.agenda,
+			summary = EXCLUDED.summary,
+			attachment_file_ids = EXCLUDED.attachment_file_ids,
+			edited_by = EXCLUDED.edited_by,
+			edited_at = EXCLUDED.edited_at
+	`, notes.LessonID, notes.PrivateNote, notes.Agenda, notes.Summary, attachments, notes.EditedBy, notes.EditedAt)
+	if err != nil {
+		return fmt.Errorf("failed to upsert lesson notes: %w", err)
 	}
+
+	_, err = tx.Exec(ctx, `
+		INSERT INTO lesson_notes_history (id, lesson_id, private_note, agenda, summary, attachment_file_ids, edited_by, edited_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+	`, uuid.New().String(), notes.LessonID, notes.PrivateNote, notes.Agenda, notes.Summary, attachments, notes.EditedBy, notes.EditedAt)
+	if err != nil {
+		return fmt.Errorf("failed to save lesson notes history: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+func (r *PostgresRepository) ListLessonNotesHistory(ctx context.Context, lessonID string) ([]repo.LessonNotes, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT lesson_id, private_note, agenda, summary, attachment_file_ids, edited_by, edited_at
+		FROM lesson_notes_history
+		WHERE lesson_id = $1
+		ORDER BY edited_at DESC
+	`, lessonID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query lesson notes history: %w", err)
+	}
+	defer rows.Close()
+
+	var history []repo.LessonNotes
+	for rows.Next() {
+		var notes repo.LessonNotes
+		if err := rows.Scan(
+			&notes.LessonID,
+			&notes.PrivateNote,
+			&notes.Agenda,
+			&notes.Summary,
+			&notes.AttachmentFileIDs,
+			&notes.EditedBy,
+			&notes.EditedAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan lesson notes row: %w", err)
+		}
+		history = append(history, notes)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating lesson notes rows: %w", err)
+	}
+
+	return history, nil
+}
+
+// refundPackageCredits returns the package credits consumed by the given lessons
+// and marks the lessons unpaid again. Lessons paid otherwise are left untouched.
+func refundPackageCredits(ctx context.Context, tx pgx.Tx, lessonIDs []string) error {
+	_, err := tx.Exec(ctx, `
+		WITH used AS (
+			SELECT id, package_id FROM lessons
+			WHERE id = ANY($1) AND package_id IS NOT NULL
+		), refunded AS (
+			UPDATE lesson_packages p
+			SET lessons_remaining = p.lessons_remaining + u.cnt
+			FROM (SELECT package_id, COUNT(*) AS cnt FROM used GROUP BY package_id) u
+			WHERE p.id = u.package_id
+		)
+		UPDATE lessons l
+		SET package_id = NULL, is_paid = false
+		FROM used
+		WHERE l.id = used.id
+	`, lessonIDs)
+	if err != nil {
+		return fmt.Errorf("failed to refund package credits: %w", err)
+	}
+
+	return nil
+}
+
+// refundCancelledLessons refunds package credits of bulk-cancelled lessons and
+// updates the returned rows accordingly.
+func refundCancelledLessons(ctx context.Context, tx pgx.Tx, cancelled []repo.LessonWithSlot) error {
+	lessonIDs := make([]string, 0, len(cancelled))
+	for _, c := range cancelled {
+		if c.Lesson.PackageID != nil {
+			lessonIDs = append(lessonIDs, c.Lesson.ID)
+		}
+	}
+	if len(lessonIDs) == 0 {
+		return nil
+	}
+
+	if err := refundPackageCredits(ctx, tx, lessonIDs); err != nil {
+		return err
+	}
+
+	for i := range cancelled {
+		if cancelled[i].Lesson.PackageID != nil {
+			cancelled[i].Lesson.PackageID = nil
+			cancelled[i].Lesson.IsPaid = false
+		}
+	}
+
+	return nil
+}
+
+const packageColumns = `id, tutor_id, student_id, lessons_total, lessons_remaining, price_rub, valid_from, valid_until, created_at`
+
+func scanPackage(row pgx.Row) (repo.LessonPackage, error) {
+	var pkg repo.LessonPackage
+	err := row.Scan(
+		&pkg.ID,
+		&pkg.TutorID,
+		&pkg.StudentID,
+		&pkg.LessonsTotal,
+		&pkg.LessonsRemaining,
+		&pkg.PriceRub,
+		&pkg.ValidFrom,
+		&pkg.ValidUntil,
+		&pkg.CreatedAt,
+	)
+	return pkg, err
+}
+
+func (r *PostgresRepository) queryPackages(ctx context.Context, query string, args ...interface{}) ([]repo.LessonPackage, error) {
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query packages: %w", err)
+	}
+	defer rows.Close()
+
+	var packages []repo.LessonPackage
+	for rows.Next() {
+		pkg, err := scanPackage(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan package row: %w", err)
+		}
+		packages = append(packages, pkg)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating package rows: %w", err)
+	}
+
+	return packages, nil
+}
+
+func (r *PostgresRepository) CreateLessonPackage(ctx context.Context, pkg repo.LessonPackage) error {
+	_, err := r.pool.Exec(ctx, `
+		INSERT INTO lesson_packages (`+packageColumns+`)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+	`,
+		pkg.ID,
+		pkg.TutorID,
+		pkg.StudentID,
+		pkg.LessonsTotal,
+		pkg.LessonsRemaining,
+		pkg.PriceRub,
+		pkg.ValidFrom,
+		pkg.ValidUntil,
+		pkg.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create lesson package: %w", err)
+	}
+
 	return nil
+}
+
+// ListActivePackages returns the pair's packages that have not expired at the given moment,
+// including the ones that start later, ordered by expiry.
+func (r *PostgresRepository) ListActivePackages(ctx context.Context, tutorID, studentID string, at time.Time) ([]repo.LessonPackage, error) {
+	return r.queryPackages(ctx, `
+		SELECT `+packageColumns+`
+		FROM lesson_packages
+		WHERE tutor_id = $1 AND student_id = $2 AND valid_until > $3
+		ORDER BY valid_until ASC
+	`, tutorID, studentID, at)
+}
+
+// consumePackageCredit pays for the lesson with a credit from the pair's package that
+// is valid at the lesson time and expires first. It returns nil if there is no such
+// package or the lesson is already paid. The lesson must carry its tutor and start time.
+func consumePackageCredit(ctx context.Context, tx pgx.Tx, lesson repo.Lesson) (*repo.LessonPackage, error) {
+	var isPaid bool
+	err := tx.QueryRow(ctx, "SELECT is_paid FROM lessons WHERE id = $1 FOR UPDATE", lesson.ID).Scan(&isPaid)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, service.ErrLessonNotFound
+		}
+		return nil, fmt.Errorf("failed to check lesson payment: %w", err)
+	}
+	if isPaid {
+		return nil, nil
+	}
+
+	pkg, err := scanPackage(tx.QueryRow(ctx, `
+		UPDATE lesson_packages
+		SET lessons_remaining = lessons_remaining - 1
+		WHERE id = (
+			SELECT id FROM lesson_packages
+			WHERE tutor_id = $1 AND student_id = $2
+			AND valid_from <= $3 AND valid_until > $3
+			AND lessons_remaining > 0
+			ORDER BY valid_until ASC
+			LIMIT 1
+			FOR UPDATE
+		)
+		RETURNING `+packageColumns,
+		lesson.TutorID, lesson.StudentID, lesson.StartsAt,
+	))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to consume package credit: %w", err)
+	}
+
+	if err := markAsPaid(ctx, tx, lesson.ID, &pkg.ID); err != nil {
+		return nil, err
+	}
+
+	return &pkg, nil
+}
+
+// MarkExpiredPackagesNotified returns packages that expired with unused lessons and
+// have not been reported yet, marking them as reported.
+func (r *PostgresRepository) MarkExpiredPackagesNotified(ctx context.Context, now time.Time) ([]repo.LessonPackage, error) {
+	return r.queryPackages(ctx, `
+		UPDATE lesson_packages
+		SET expiry_notified = true
+		WHERE valid_until <= $1 AND expiry_notified = false AND lessons_remaining > 0
+		RETURNING `+packageColumns,
+		now,
+	)
+}
+
+// periodSlots selects the tutor's slots starting in [$2, $3), one row per slot. A slot
+// can carry several cancelled lessons, since a cancelled slot can be booked again, but
+// at most one lesson that is not cancelled (has_lesson).
+const periodSlots = `
+	SELECT s.id, s.starts_at, s.is_booked,
+		EXTRACT(EPOCH FROM s.ends_at - s.starts_at) / 3600 AS hours,
+		EXISTS (SELECT 1 FROM lessons l WHERE l.slot_id = s.id AND l.status <> 'cancelled') AS has_lesson
+	FROM slots s
+	WHERE s.tutor_id = $1 AND s.starts_at >= $2 AND s.starts_at < $3
+`
+
+// GetScheduleStats aggregates the tutor's slots starting in [from, to) together with
+// their lessons. Slots closed without a lesson (e.g. by BlockPeriod) are not counted
+// as available. The heat map is bucketed in the given time zone.
+func (r *PostgresRepository) GetScheduleStats(ctx context.Context, tutorID string, from, to time.Time, timezone string) (*repo.ScheduleStats, error) {
+	var stats repo.ScheduleStats
+
+	err := r.pool.QueryRow(ctx, `
+		WITH period_slots AS (`+periodSlots+`),
+		period AS (
+			SELECT ps.hours,
+				l.status, l.cancelled_by, l.price_rub, l.actual_starts_at, l.actual_ends_at
+			FROM period_slots ps
+			JOIN lessons l ON l.slot_id = ps.id
+		)
+		SELECT
+			(SELECT COALESCE(SUM(hours) FILTER (WHERE NOT is_booked OR has_lesson), 0) FROM period_slots),
+			COALESCE(SUM(hours) FILTER (WHERE status <> 'cancelled'), 0),
+			COALESCE(SUM(COALESCE(EXTRACT(EPOCH FROM actual_ends_at - actual_starts_at) / 3600, hours))
+				FILTER (WHERE status = 'completed'), 0),
+			COUNT(status),
+			COUNT(*) FILTER (WHERE status = 'completed'),
+			COUNT(*) FILTER (WHERE status = 'cancelled'),
+			COUNT(*) FILTER (WHERE status = 'cancelled' AND cancelled_by = 'tutor'),
+			COUNT(*) FILTER (WHERE status = 'cancelled' AND cancelled_by = 'student'),
+			COUNT(*) FILTER (WHERE status = 'no_show_student'),
+			COUNT(*) FILTER (WHERE status = 'no_show_tutor'),
+			COALESCE(SUM(price_rub) FILTER (WHERE status IN `+billableStatuses+`), 0),
+			COALESCE(SUM(price_rub) FILTER (WHERE status = 'booked'), 0)
+		FROM period
+	`, tutorID, from, to).Scan(
+		&stats.AvailableHours,
+		&stats.BookedHours,
+		&stats.TaughtHours,
+		&stats.LessonsTotal,
+		&stats.LessonsCompleted,
+		&stats.LessonsCancelled,
+		&stats.LessonsCancelledByTutor,
+		&stats.LessonsCancelledByStudent,
+		&stats.NoShowStudent,
+		&stats.NoShowTutor,
+		&stats.EarningsRub,
+		&stats.ExpectedEarningsRub,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get schedule totals: %w", err)
+	}
+
+	rows, err := r.pool.Query(ctx, `
+		SELECT l.student_id,
+			COUNT(*),
+			COUNT(*) FILTER (WHERE l.status = 'completed'),
+			COUNT(*) FILTER (WHERE l.status = 'cancelled'),
+			COALESCE(SUM(EXTRACT(EPOCH FROM s.ends_at - s.starts_at) / 3600) FILTER (WHERE l.status <> 'cancelled'), 0),
+			COALESCE(SUM(l.price_rub) FILTER (WHERE l.status IN `+billableStatuses+`), 0)
+		FROM lessons l
+		JOIN slots s ON s.id = l.slot_id
+		WHERE s.tutor_id = $1 AND s.starts_at >= $2 AND s.starts_at < $3
+		GROUP BY l.student_id
+		ORDER BY COUNT(*) DESC, l.student_id
+	`, tutorID, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get per-student stats: %w", err)
+	}
+	for rows.Next() {
+		var student repo.StudentLessonStats
+		if err := rows.Scan(
+			&student.StudentID,
+			&student.LessonsTotal,
+			&student.LessonsCompleted,
+			&student.LessonsCancelled,
+			&student.Hours,
+			&student.EarningsRub,
+		); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("failed to scan student stats row: %w", err)
+		}
+		stats.Students = append(stats.Students, student)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating student stats rows: %w", err)
+	}
+
+	rows, err = r.pool.Query(ctx, `
+		SELECT
+			EXTRACT(ISODOW FROM starts_at AT TIME ZONE $4)::int AS weekday,
+			EXTRACT(HOUR FROM starts_at AT TIME ZONE $4)::int AS hour,
+			COUNT(*) FILTER (WHERE NOT is_booked OR has_lesson),
+			COUNT(*) FILTER (WHERE has_lesson)
+		FROM (`+periodSlots+`) ps
+		GROUP BY weekday, hour
+		ORDER BY weekday, hour
+	`, tutorID, from, to, timezone)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get heat map: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var cell repo.HeatMapCell
+		if err := rows.Scan(&cell.Weekday, &cell.Hour, &cell.AvailableSlots, &cell.BookedLessons); err != nil {
+			return nil, fmt.Errorf("failed to scan heat map row: %w", err)
+		}
+		stats.HeatMap = append(stats.HeatMap, cell)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating heat map rows: %w", err)
+	}
 
+	return &stats, nil
 }
@@ -0,0 +1,176 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/golang-migrate/migrate/v4"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"schedule_service/internal/database/repo"
+)
+
+// newTestRepository connects to the database from SCHEDULE_TEST_POSTGRES_URL and
+// migrates it. The test is skipped when the variable is not set.
+func newTestRepository(t *testing.T) *PostgresRepository {
+	t.Helper()
+
+	url := os.Getenv("SCHEDULE_TEST_POSTGRES_URL")
+	if url == "" {
+		t.Skip("SCHEDULE_TEST_POSTGRES_URL is not set")
+	}
+
+	m, err := migrate.New("file://../../../migrations", url)
+	require.NoError(t, err)
+	if err := m.Up(); err != nil && !errors.Is(err, migrate.ErrNoChange) {
+		require.NoError(t, err)
+	}
+
+	pool, err := pgxpool.New(context.Background(), url)
+	require.NoError(t, err)
+	t.Cleanup(pool.Close)
+
+	return &PostgresRepository{pool: pool}
+}
+
+func addTestSlot(t *testing.T, r *PostgresRepository, tutorID string, startsAt time.Time, isBooked bool) string {
+	t.Helper()
+
+	id := uuid.NewString()
+	_, err := r.pool.Exec(context.Background(), `
+		INSERT INTO slots (id, tutor_id, starts_at, ends_at, is_booked, created_at)
+		VALUES ($1, $2, $3, $4, $5, NOW())
+	`, id, tutorID, startsAt, startsAt.Add(time.Hour), isBooked)
+	require.NoError(t, err)
+	return id
+}
+
+func addTestLesson(t *testing.T, r *PostgresRepository, slotID, status string) {
+	t.Helper()
+
+	_, err := r.pool.Exec(context.Background(), `
+		INSERT INTO lessons (id, slot_id, student_id, status, is_paid, created_at, edited_at)
+		VALUES ($1, $2, $3, $4, FALSE, NOW(), NOW())
+	`, uuid.NewString(), slotID, uuid.NewString(), status)
+	require.NoError(t, err)
+}
+
+func TestGetScheduleStats(t *testing.T) {
+	r := newTestRepository(t)
+	ctx := context.Background()
+
+	tutorID := uuid.NewString()
+	from := time.Date(2030, 3, 4, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 0, 7)
+
+	// Free slot booked and cancelled twice.
+	rebooked := addTestSlot(t, r, tutorID, from.Add(10*time.Hour), false)
+	addTestLesson(t, r, rebooked, "cancelled")
+	addTestLesson(t, r, rebooked, "cancelled")
+
+	// Slot booked again after a cancellation.
+	booked := addTestSlot(t, r, tutorID, from.Add(11*time.Hour), true)
+	addTestLesson(t, r, booked, "cancelled")
+	addTestLesson(t, r, booked, "booked")
+
+	// Slot closed by BlockPeriod after its lesson was cancelled.
+	blocked := addTestSlot(t, r, tutorID, from.Add(12*time.Hour), true)
+	addTestLesson(t, r, blocked, "cancelled")
+
+	stats, err := r.GetScheduleStats(ctx, tutorID, from, to, "UTC")
+	require.NoError(t, err)
+
+	assert.InDelta(t, 2, stats.AvailableHours, 0.001)
+	assert.InDelta(t, 1, stats.BookedHours, 0.001)
+	assert.Equal(t, int32(5), stats.LessonsTotal)
+	assert.Equal(t, int32(4), stats.LessonsCancelled)
+
+	var available, bookedLessons int32
+	for _, cell := range stats.HeatMap {
+		available += cell.AvailableSlots
+		bookedLessons += cell.BookedLessons
+	}
+	assert.Equal(t, int32(2), available)
+	assert.Equal(t, int32(1), bookedLessons)
+}
+
+func TestCreateLessonSeriesBooksFreedSlot(t *testing.T) {
+	r := newTestRepository(t)
+	ctx := context.Background()
+
+	tutorID := uuid.NewString()
+	startsAt := time.Date(2030, 4, 1, 10, 0, 0, 0, time.UTC)
+
+	slotID := addTestSlot(t, r, tutorID, startsAt, false)
+	addTestLesson(t, r, slotID, "cancelled")
+
+	result, err := r.CreateLessonSeries(ctx, repo.LessonSeries{
+		ID:        uuid.NewString(),
+		TutorID:   tutorID,
+		StudentID: uuid.NewString(),
+		CreatedAt: time.Now(),
+	}, []repo.SeriesOccurrence{{StartsAt: startsAt, EndsAt: startsAt.Add(time.Hour)}}, false)
+	require.NoError(t, err)
+
+	assert.Empty(t, result.Failed)
+	require.Len(t, result.Booked, 1)
+	assert.Equal(t, slotID, result.Booked[0].Slot.ID)
+}
+
+func TestBlockPeriod(t *testing.T) {
+	r := newTestRepository(t)
+	ctx := context.Background()
+
+	tutorID := uuid.NewString()
+	from := time.Date(2030, 5, 6, 0, 0, 0, 0, time.UTC)
+
+	free := addTestSlot(t, r, tutorID, from.Add(10*time.Hour), false)
+	freed := addTestSlot(t, r, tutorID, from.Add(11*time.Hour), false)
+	addTestLesson(t, r, freed, "cancelled")
+	booked := addTestSlot(t, r, tutorID, from.Add(12*time.Hour), true)
+	addTestLesson(t, r, booked, "booked")
+
+	result, err := r.BlockPeriod(ctx, tutorID, from, from.AddDate(0, 0, 1), nil, false)
+	require.NoError(t, err)
+
+	require.Len(t, result.DeletedSlots, 1)
+	assert.Equal(t, free, result.DeletedSlots[0].ID)
+	require.Len(t, result.ClosedSlots, 1)
+	assert.Equal(t, freed, result.ClosedSlots[0].ID)
+	assert.True(t, result.ClosedSlots[0].IsBooked)
+	require.Len(t, result.CancelledLessons, 1)
+	assert.Equal(t, booked, result.CancelledLessons[0].Slot.ID)
+}
+
+func TestUpdateCompletedLessons(t *testing.T) {
+	r := newTestRepository(t)
+	ctx := context.Background()
+
+	tutorID := uuid.NewString()
+	startsAt := time.Date(2020, 6, 1, 10, 0, 0, 0, time.UTC)
+
+	booked := addTestSlot(t, r, tutorID, startsAt, true)
+	addTestLesson(t, r, booked, "booked")
+	noShow := addTestSlot(t, r, tutorID, startsAt.Add(time.Hour), true)
+	addTestLesson(t, r, noShow, "no_show_student")
+
+	result, err := r.UpdateCompletedLessons(ctx, startsAt.Add(3*time.Hour))
+	require.NoError(t, err)
+
+	// The update is not scoped to a tutor, so other lessons in the database may
+	// be completed as well.
+	var completed []string
+	for _, lesson := range result.Completed {
+		if lesson.Slot.TutorID == tutorID {
+			completed = append(completed, lesson.Slot.ID)
+			assert.Equal(t, "completed", lesson.Lesson.Status)
+		}
+	}
+	assert.Equal(t, []string{booked}, completed)
+}
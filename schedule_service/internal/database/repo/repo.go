@@ -19,13 +19,143 @@ type Lesson struct {
 	ID             string
 	SlotID         string
 	StudentID      string
-	Status         string // "booked", "cancelled", "completed"
+	Status         string // "booked", "cancelled", "completed", "no_show_student", "no_show_tutor"
 	IsPaid         bool
 	ConnectionLink *string
 	PriceRub       *int32
 	PaymentInfo    *string
 	CreatedAt      time.Time
 	EditedAt       time.Time
+	CancelReason   *string
+	SeriesID       *string
+	ActualStartsAt *time.Time
+	ActualEndsAt   *time.Time
+	PackageID      *string
+	CancelledBy    *string // "tutor", "student"
+
+	// Read from the lesson's slot.
+	TutorID  string
+	StartsAt time.Time
+	EndsAt   time.Time
+}
+
+// LessonWithSlot is a lesson touched by a bulk operation together with its slot.
+type LessonWithSlot struct {
+	Lesson Lesson
+	Slot   Slot
+}
+
+type BlockPeriodResult struct {
+	DeletedSlots []Slot
+	// ClosedSlots are free slots that keep cancelled lessons and so are closed
+	// instead of deleted.
+	ClosedSlots      []Slot
+	CancelledLessons []LessonWithSlot
+}
+
+type LessonSeries struct {
+	ID        string
+	TutorID   string
+	StudentID string
+	CreatedAt time.Time
+}
+
+// SeriesOccurrence is a single planned lesson of a series.
+type SeriesOccurrence struct {
+	StartsAt time.Time
+	EndsAt   time.Time
+}
+
+type FailedOccurrence struct {
+	Occurrence SeriesOccurrence
+	Err        error
+}
+
+type LessonSeriesResult struct {
+	Booked []LessonWithSlot
+	Failed []FailedOccurrence
+	// Packages are the packages that paid for booked lessons.
+	Packages []PackageUsage
+}
+
+// PackageUsage is a package in its final state after paying for Consumed lessons
+// in a single operation.
+type PackageUsage struct {
+	Package  LessonPackage
+	Consumed int32
+}
+
+type CompletedLessonsResult struct {
+	Completed []LessonWithSlot
+	// Packages are the packages that paid for completed lessons.
+	Packages []PackageUsage
+}
+
+type LessonNotes struct {
+	LessonID          string
+	PrivateNote       string
+	Agenda            string
+	Summary           string
+	AttachmentFileIDs []string
+	EditedBy          string
+	EditedAt          time.Time
+}
+
+// CreditAction is what happens to the package credit of a lesson when its attendance is saved.
+type CreditAction int
+
+const (
+	CreditKeep CreditAction = iota
+	// CreditRefund returns the credit the lesson was paid with to its package.
+	CreditRefund
+	// CreditConsume pays for an unpaid lesson from the pair's package, if there is one.
+	CreditConsume
+)
+
+type LessonPackage struct {
+	ID               string
+	TutorID          string
+	StudentID        string
+	LessonsTotal     int32
+	LessonsRemaining int32
+	PriceRub         int32
+	ValidFrom        time.Time
+	ValidUntil       time.Time
+	CreatedAt        time.Time
+}
+
+type ScheduleStats struct {
+	AvailableHours            float64
+	BookedHours               float64
+	TaughtHours               float64
+	LessonsTotal              int32
+	LessonsCompleted          int32
+	LessonsCancelled          int32
+	LessonsCancelledByTutor   int32
+	LessonsCancelledByStudent int32
+	NoShowStudent             int32
+	NoShowTutor               int32
+	EarningsRub               int64
+	ExpectedEarningsRub       int64
+	Students                  []StudentLessonStats
+	HeatMap                   []HeatMapCell
+}
+
+type StudentLessonStats struct {
+	StudentID        string
+	LessonsTotal     int32
+	LessonsCompleted int32
+	LessonsCancelled int32
+	Hours            float64
+	EarningsRub      int64
+}
+
+// HeatMapCell aggregates slots starting at the given ISO weekday (1 = Monday) and hour.
+type HeatMapCell struct {
+	Weekday        int32
+	Hour           int32
+	AvailableSlots int32
+	BookedLessons  int32
 }
 
 type Repository interface {
@@ -38,15 +168,41 @@ type Repository interface {
 
 	// Lesson operations
 	GetLesson(ctx context.Context, id string) (*Lesson, error)
-	CreateLessonAndBookSlot(ctx context.Context, lesson Lesson, slotID string) error
+	// CreateLessonAndBookSlot pays for the new lesson from the pair's package in the same
+	// transaction and returns the package, or nil if the lesson stays unpaid.
+	CreateLessonAndBookSlot(ctx context.Context, lesson Lesson, slotID string) (*LessonPackage, error)
 	UpdateLesson(ctx context.Context, lesson Lesson) error
+	UpdateAttendance(ctx context.Context, lesson Lesson, credit CreditAction) (*LessonPackage, error)
 	CancelLessonAndFreeSlot(ctx context.Context, lesson Lesson, slotID string) error
 	ListLessonsByTutor(ctx context.Context, tutorID string, statusFilter []string) ([]Lesson, error)
 	ListLessonsByStudent(ctx context.Context, studentID string, statusFilter []string) ([]Lesson, error)
 	ListLessonsByPair(ctx context.Context, tutorID, studentID string, statusFilter []string) ([]Lesson, error)
 	ListCompletedUnpaidLessons(ctx context.Context, after *time.Time) ([]Lesson, error)
 
-	UpdateCompletedLessons(ctx context.Context) (int, error)
+	// UpdateCompletedLessons marks booked lessons that ended before endedBefore as
+	// completed and pays for unpaid ones from the pair's package.
+	UpdateCompletedLessons(ctx context.Context, endedBefore time.Time) (*CompletedLessonsResult, error)
+
+	// Lesson notes operations
+	GetLessonNotes(ctx context.Context, lessonID string) (*LessonNotes, error)
+	UpsertLessonNotes(ctx context.Context, notes LessonNotes) error
+	ListLessonNotesHistory(ctx context.Context, lessonID string) ([]LessonNotes, error)
+
+	// Bulk operations
+	BlockPeriod(ctx context.Context, tutorID string, from, to time.Time, reason *string, dryRun bool) (*BlockPeriodResult, error)
+
+	// Series operations
+	CreateLessonSeries(ctx context.Context, series LessonSeries, occurrences []SeriesOccurrence, createSlots bool) (*LessonSeriesResult, error)
+	GetLessonSeries(ctx context.Context, id string) (*LessonSeries, error)
+	CancelLessonSeries(ctx context.Context, seriesID string, after time.Time, reason *string, cancelledBy string) ([]LessonWithSlot, error)
 
 	MarkAsPaid(ctx context.Context, lessonID string) error
+
+	// Package operations
+	CreateLessonPackage(ctx context.Context, pkg LessonPackage) error
+	ListActivePackages(ctx context.Context, tutorID, studentID string, at time.Time) ([]LessonPackage, error)
+	MarkExpiredPackagesNotified(ctx context.Context, now time.Time) ([]LessonPackage, error)
+
+	// Stats
+	GetScheduleStats(ctx context.Context, tutorID string, from, to time.Time, timezone string) (*ScheduleStats, error)
 }
@@ -22,9 +22,20 @@ type ReminderEvent struct {
 	StudentID      string    `json:"student_id"`
 	StartsAt       time.Time `json:"starts_at"`
 	EndsAt         time.Time `json:"ends_at"`
-	EventType      string    `json:"event_type"`                // "booked", "cancelled"
-	ReminderType   string    `json:"reminder_type,omitempty"`   // "24h" or "1h" (set by reminder worker)
+	EventType      string    `json:"event_type"`              // "booked", "cancelled", "completed"
+	ReminderType   string    `json:"reminder_type,omitempty"` // "24h" or "1h" (set by reminder worker)
 	ConnectionLink string    `json:"connection_link,omitempty"`
+	Reason         string    `json:"reason,omitempty"` // cancellation reason (set by bulk operations)
+}
+
+// PackageEvent is sent to the same topic when a prepaid package runs low or expires.
+type PackageEvent struct {
+	PackageID        string    `json:"package_id"`
+	TutorID          string    `json:"tutor_id"`
+	StudentID        string    `json:"student_id"`
+	LessonsRemaining int32     `json:"lessons_remaining"`
+	ValidUntil       time.Time `json:"valid_until"`
+	EventType        string    `json:"event_type"` // "package_low_balance", "package_expired"
 }
 
 func NewEventSender(brokers []string, reminderTopic string) *EventSender {
@@ -64,3 +75,22 @@ func (s *EventSender) SendReminderEvent(ctx context.Context, event ReminderEvent
 
 	return nil
 }
+
+func (s *EventSender) SendPackageEvent(ctx context.Context, event PackageEvent) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to marshal package event: %w", err)
+	}
+
+	message := kafka.Message{
+		Key:   []byte(event.PackageID),
+		Value: data,
+		Time:  time.Now(),
+	}
+
+	if err := s.writer.WriteMessages(ctx, message); err != nil {
+		return fmt.Errorf("failed to send package event: %w", err)
+	}
+
+	return nil
+}
@@ -16,6 +16,9 @@ var (
 	ErrPastTime         = errors.New("time cannot be in the past")
 	ErrInvalidPair      = errors.New("tutor and student are not connected")
 	ErrNotTutor         = errors.New("user is not a tutor")
+	ErrSlotConflict     = errors.New("slot overlaps another slot")
+	ErrSeriesNotFound   = errors.New("lesson series not found")
+	ErrNotesNotFound    = errors.New("lesson notes not found")
 
 	StatusUnauthenticated  = status.Error(codes.Unauthenticated, "user not authenticated")
 	StatusPermissionDenied = status.Error(codes.PermissionDenied, "permission denied")
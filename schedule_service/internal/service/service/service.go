@@ -21,6 +21,7 @@ import (
 // EventSenderInterface allows mocking in tests.
 type EventSenderInterface interface {
 	SendReminderEvent(ctx context.Context, event kafka.ReminderEvent) error
+	SendPackageEvent(ctx context.Context, event kafka.PackageEvent) error
 }
 
 type ScheduleServer struct {
@@ -307,7 +308,17 @@ func (s *ScheduleServer) GetLesson(ctx context.Context, req *pb.GetLessonRequest
 		return nil, StatusPermissionDenied
 	}
 
-	return convertrepoLessonToProto(lesson), nil
+	notes, err := s.db.GetLessonNotes(ctx, lesson.ID)
+	if err != nil && !errors.Is(err, ErrNotesNotFound) {
+		return nil, status.Error(codes.Internal, "failed to get lesson notes")
+	}
+
+	protoLesson := convertrepoLessonToProto(lesson)
+	if notes != nil {
+		protoLesson.Notes = convertrepoNotesToProto(notes, userID == slot.TutorID)
+	}
+
+	return protoLesson, nil
 }
 
 func (s *ScheduleServer) CreateLesson(ctx context.Context, req *pb.CreateLessonRequest) (*pb.Lesson, error) {
@@ -366,11 +377,16 @@ func (s *ScheduleServer) CreateLesson(ctx context.Context, req *pb.CreateLessonR
 		IsPaid:    false,
 		CreatedAt: now,
 		EditedAt:  now,
+		TutorID:   slot.TutorID,
+		StartsAt:  slot.StartsAt,
+		EndsAt:    slot.EndsAt,
 	}
 
-	if err := s.db.CreateLessonAndBookSlot(ctx, lesson, req.SlotId); err != nil {
+	pkg, err := s.db.CreateLessonAndBookSlot(ctx, lesson, req.SlotId)
+	if err != nil {
 		return nil, status.Error(codes.Internal, "failed to create lesson")
 	}
+	s.applyPackageCredit(ctx, &lesson, pkg)
 
 	if s.eventSender != nil {
 		reminderEvent := kafka.ReminderEvent{
@@ -393,15 +409,7 @@ func (s *ScheduleServer) CreateLesson(ctx context.Context, req *pb.CreateLessonR
 		}
 	}
 
-	return &pb.Lesson{
-		Id:        lessonID,
-		SlotId:    req.SlotId,
-		StudentId: studentID,
-		Status:    "booked",
-		IsPaid:    false,
-		CreatedAt: timestamppb.New(now),
-		EditedAt:  timestamppb.New(now),
-	}, nil
+	return convertrepoLessonToProto(&lesson), nil
 }
 
 func (s *ScheduleServer) UpdateLesson(ctx context.Context, req *pb.UpdateLessonRequest) (*pb.Lesson, error) {
@@ -485,16 +493,256 @@ func (s *ScheduleServer) CancelLesson(ctx context.Context, req *pb.CancelLessonR
 	}
 
 	now := time.Now()
+	cancelledBy := cancellingParty(userID, slot.TutorID)
 	lesson.Status = "cancelled"
 	lesson.EditedAt = now
+	lesson.CancelledBy = &cancelledBy
 
 	if err := s.db.CancelLessonAndFreeSlot(ctx, *lesson, lesson.SlotID); err != nil {
 		return nil, status.Error(codes.Internal, "failed to cancel lesson")
 	}
+	if lesson.PackageID != nil {
+		// The package credit is refunded together with the cancellation.
+		lesson.PackageID = nil
+		lesson.IsPaid = false
+	}
 
 	return convertrepoLessonToProto(lesson), nil
 }
 
+// maxNoteAttachments limits the number of files attached to lesson notes.
+const maxNoteAttachments = 20
+
+func (s *ScheduleServer) UpsertLessonNotes(ctx context.Context, req *pb.UpsertLessonNotesRequest) (*pb.LessonNotes, error) {
+	userID, ok := ctxdata.GetUserID(ctx)
+	if !ok {
+		return nil, StatusUnauthenticated
+	}
+	if err := uuid.Validate(req.LessonId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid ID")
+	}
+	if len(req.AttachmentFileIds) > maxNoteAttachments {
+		return nil, status.Errorf(codes.InvalidArgument, "no more than %d attachments allowed", maxNoteAttachments)
+	}
+	for _, fileID := range req.AttachmentFileIds {
+		if err := uuid.Validate(fileID); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid attachment file ID")
+		}
+	}
+
+	lesson, err := s.db.GetLesson(ctx, req.LessonId)
+	if err != nil {
+		if errors.Is(err, ErrLessonNotFound) {
+			return nil, StatusNotFound
+		}
+		return nil, StatusInternalError
+	}
+
+	slot, err := s.db.GetSlot(ctx, lesson.SlotID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to get slot information")
+	}
+
+	if userID != slot.TutorID {
+		return nil, status.Error(codes.PermissionDenied, "only tutors can edit lesson notes")
+	}
+
+	notes := repo.LessonNotes{
+		LessonID:          lesson.ID,
+		PrivateNote:       req.PrivateNote,
+		Agenda:            req.Agenda,
+		Summary:           req.Summary,
+		AttachmentFileIDs: req.AttachmentFileIds,
+		EditedBy:          userID,
+		EditedAt:          time.Now(),
+	}
+
+	if err := s.db.UpsertLessonNotes(ctx, notes); err != nil {
+		return nil, status.Error(codes.Internal, "failed to save lesson notes")
+	}
+
+	return convertrepoNotesToProto(&notes, true), nil
+}
+
+func (s *ScheduleServer) ListLessonNotesHistory(ctx context.Context, req *pb.ListLessonNotesHistoryRequest) (*pb.ListLessonNotesHistoryResponse, error) {
+	userID, ok := ctxdata.GetUserID(ctx)
+	if !ok {
+		return nil, StatusUnauthenticated
+	}
+	if err := uuid.Validate(req.LessonId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid ID")
+	}
+
+	lesson, err := s.db.GetLesson(ctx, req.LessonId)
+	if err != nil {
+		if errors.Is(err, ErrLessonNotFound) {
+			return nil, StatusNotFound
+		}
+		return nil, StatusInternalError
+	}
+
+	slot, err := s.db.GetSlot(ctx, lesson.SlotID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to get slot information")
+	}
+
+	if userID != slot.TutorID && userID != lesson.StudentID {
+		return nil, StatusPermissionDenied
+	}
+
+	history, err := s.db.ListLessonNotesHistory(ctx, lesson.ID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to list lesson notes history")
+	}
+
+	versions := make([]*pb.LessonNotes, 0, len(history))
+	for i := range history {
+		versions = append(versions, convertrepoNotesToProto(&history[i], userID == slot.TutorID))
+	}
+
+	return &pb.ListLessonNotesHistoryResponse{Versions: versions}, nil
+}
+
+// attendanceStatuses are the statuses a tutor can set once a lesson has started.
+var attendanceStatuses = map[string]bool{
+	"completed":       true,
+	"no_show_student": true,
+	"no_show_tutor":   true,
+}
+
+func (s *ScheduleServer) UpdateAttendance(ctx context.Context, req *pb.UpdateAttendanceRequest) (*pb.Lesson, error) {
+	userID, ok := ctxdata.GetUserID(ctx)
+	if !ok {
+		return nil, StatusUnauthenticated
+	}
+	if err := uuid.Validate(req.Id); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid ID")
+	}
+	if req.Status != nil && !attendanceStatuses[req.GetStatus()] {
+		return nil, status.Error(codes.InvalidArgument, "invalid attendance status")
+	}
+
+	lesson, err := s.db.GetLesson(ctx, req.Id)
+	if err != nil {
+		if errors.Is(err, ErrLessonNotFound) {
+			return nil, StatusNotFound
+		}
+		return nil, StatusInternalError
+	}
+
+	slot, err := s.db.GetSlot(ctx, lesson.SlotID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to get slot information")
+	}
+
+	if userID != slot.TutorID {
+		return nil, status.Error(codes.PermissionDenied, "only tutors can update attendance")
+	}
+
+	if lesson.Status == "cancelled" {
+		return nil, status.Error(codes.FailedPrecondition, "cannot update attendance of a cancelled lesson")
+	}
+	if time.Now().Before(slot.StartsAt) {
+		return nil, status.Error(codes.FailedPrecondition, "lesson has not started yet")
+	}
+
+	completedNow := req.GetStatus() == "completed" && lesson.Status != "completed"
+	if req.Status != nil {
+		lesson.Status = req.GetStatus()
+	}
+	if req.ActualStartsAt != nil {
+		t := req.ActualStartsAt.AsTime()
+		lesson.ActualStartsAt = &t
+	}
+	if req.ActualEndsAt != nil {
+		t := req.ActualEndsAt.AsTime()
+		lesson.ActualEndsAt = &t
+	}
+
+	if lesson.Status == "no_show_student" || lesson.Status == "no_show_tutor" {
+		// The lesson did not take place, so there is nothing to time.
+		lesson.ActualStartsAt = nil
+		lesson.ActualEndsAt = nil
+	}
+	if lesson.ActualStartsAt != nil && lesson.ActualEndsAt != nil && !validateTimeRange(*lesson.ActualStartsAt, *lesson.ActualEndsAt) {
+		return nil, status.Error(codes.InvalidArgument, "invalid time range")
+	}
+	credit := repo.CreditKeep
+	switch {
+	case lesson.Status == "no_show_tutor" && lesson.IsPaid:
+		if lesson.PackageID == nil {
+			return nil, status.Error(codes.FailedPrecondition, "lesson is already paid")
+		}
+		credit = repo.CreditRefund
+	case completedNow && !lesson.IsPaid:
+		// The lesson may have been booked before the package was bought.
+		credit = repo.CreditConsume
+	}
+
+	lesson.EditedAt = time.Now()
+	pkg, err := s.db.UpdateAttendance(ctx, *lesson, credit)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to update attendance")
+	}
+	if credit == repo.CreditRefund {
+		lesson.PackageID = nil
+		lesson.IsPaid = false
+	}
+	s.applyPackageCredit(ctx, lesson, pkg)
+	if completedNow {
+		s.sendCompletedEvent(ctx, lesson, slot)
+	}
+
+	return convertrepoLessonToProto(lesson), nil
+}
+
+// sendCompletedEvent lets downstream services (e.g. homework follow-ups) react
+// to a lesson that has just been marked as held.
+func (s *ScheduleServer) sendCompletedEvent(ctx context.Context, lesson *repo.Lesson, slot *repo.Slot) {
+	if s.eventSender == nil {
+		return
+	}
+
+	event := kafka.ReminderEvent{
+		LessonID:  lesson.ID,
+		SlotID:    slot.ID,
+		TutorID:   slot.TutorID,
+		StudentID: lesson.StudentID,
+		StartsAt:  slot.StartsAt,
+		EndsAt:    slot.EndsAt,
+		EventType: "completed",
+	}
+	if err := s.eventSender.SendReminderEvent(context.WithoutCancel(ctx), event); err != nil {
+		if s.logger != nil {
+			s.logger.Error(ctx, "failed to send lesson completed event",
+				zap.String("lesson_id", lesson.ID), zap.Error(err))
+		}
+	}
+}
+
+// autoCompleteDelay is how long after its end a booked lesson is completed
+// automatically, leaving the tutor time to mark a no-show instead.
+const autoCompleteDelay = 24 * time.Hour
+
+// CompleteEndedLessons completes booked lessons that ended more than autoCompleteDelay
+// ago, as if the tutor had marked them, and sends a completed event for each of them.
+// It is run periodically from main.
+func (s *ScheduleServer) CompleteEndedLessons(ctx context.Context) error {
+	result, err := s.db.UpdateCompletedLessons(ctx, time.Now().Add(-autoCompleteDelay))
+	if err != nil {
+		return err
+	}
+
+	for i := range result.Completed {
+		s.sendCompletedEvent(ctx, &result.Completed[i].Lesson, &result.Completed[i].Slot)
+	}
+	for i := range result.Packages {
+		s.notifyLowBalance(ctx, &result.Packages[i].Package, result.Packages[i].Consumed)
+	}
+
+	return nil
+}
+
 func (s *ScheduleServer) ListLessonsByTutor(ctx context.Context, req *pb.ListLessonsByTutorRequest) (*pb.ListLessonsResponse, error) {
 	userID, ok := ctxdata.GetUserID(ctx)
 	if !ok {
@@ -517,6 +765,10 @@ func (s *ScheduleServer) ListLessonsByTutor(ctx context.Context, req *pb.ListLes
 			statusFilters = append(statusFilters, "cancelled")
 		case pb.LessonStatusFilter_COMPLETED:
 			statusFilters = append(statusFilters, "completed")
+		case pb.LessonStatusFilter_NO_SHOW_STUDENT:
+			statusFilters = append(statusFilters, "no_show_student")
+		case pb.LessonStatusFilter_NO_SHOW_TUTOR:
+			statusFilters = append(statusFilters, "no_show_tutor")
 		}
 	}
 
@@ -547,6 +799,10 @@ func (s *ScheduleServer) ListLessonsByStudent(ctx context.Context, req *pb.ListL
 			statusFilters = append(statusFilters, "cancelled")
 		case pb.LessonStatusFilter_COMPLETED:
 			statusFilters = append(statusFilters, "completed")
+		case pb.LessonStatusFilter_NO_SHOW_STUDENT:
+			statusFilters = append(statusFilters, "no_show_student")
+		case pb.LessonStatusFilter_NO_SHOW_TUTOR:
+			statusFilters = append(statusFilters, "no_show_tutor")
 		}
 	}
 	if err := uuid.Validate(req.StudentId); err != nil {
@@ -588,6 +844,10 @@ func (s *ScheduleServer) ListLessonsByPair(ctx context.Context, req *pb.ListLess
 			statusFilters = append(statusFilters, "cancelled")
 		case pb.LessonStatusFilter_COMPLETED:
 			statusFilters = append(statusFilters, "completed")
+		case pb.LessonStatusFilter_NO_SHOW_STUDENT:
+			statusFilters = append(statusFilters, "no_show_student")
+		case pb.LessonStatusFilter_NO_SHOW_TUTOR:
+			statusFilters = append(statusFilters, "no_show_tutor")
 		}
 	}
 	if err := uuid.Validate(req.TutorId); err != nil {
@@ -659,3 +919,481 @@ func (s *ScheduleServer) MarkAsPaid(ctx context.Context, req *pb.MarkAsPaidReque
 	return convertrepoLessonToProto(lesson), nil
 
 }
+
+func (s *ScheduleServer) BlockPeriod(ctx context.Context, req *pb.BlockPeriodRequest) (*pb.BlockPeriodResponse, error) {
+	userID, ok := ctxdata.GetUserID(ctx)
+	if !ok {
+		return nil, StatusUnauthenticated
+	}
+	if err := uuid.Validate(req.TutorId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid ID")
+	}
+
+	isTutor, err := IsTutor(ctx, userID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to verify tutor status")
+	}
+	if !isTutor || req.TutorId != userID {
+		return nil, StatusPermissionDenied
+	}
+
+	if req.From == nil || req.To == nil {
+		return nil, status.Error(codes.InvalidArgument, "from and to are required")
+	}
+	from := req.From.AsTime()
+	to := req.To.AsTime()
+	if !validateTimeRange(from, to) {
+		return nil, status.Error(codes.InvalidArgument, "invalid time range")
+	}
+
+	result, err := s.db.BlockPeriod(ctx, req.TutorId, from, to, req.Reason, req.DryRun)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to block period")
+	}
+
+	if !req.DryRun {
+		s.sendCancellationEvents(ctx, result.CancelledLessons)
+	}
+
+	resp := &pb.BlockPeriodResponse{
+		DryRun:           req.DryRun,
+		DeletedSlots:     make([]*pb.Slot, 0, len(result.DeletedSlots)),
+		ClosedSlots:      make([]*pb.Slot, 0, len(result.ClosedSlots)),
+		CancelledLessons: make([]*pb.Lesson, 0, len(result.CancelledLessons)),
+	}
+	for i := range result.DeletedSlots {
+		resp.DeletedSlots = append(resp.DeletedSlots, convertrepoSlotToProto(&result.DeletedSlots[i]))
+	}
+	for i := range result.ClosedSlots {
+		resp.ClosedSlots = append(resp.ClosedSlots, convertrepoSlotToProto(&result.ClosedSlots[i]))
+	}
+	for i := range result.CancelledLessons {
+		resp.CancelledLessons = append(resp.CancelledLessons, convertrepoLessonToProto(&result.CancelledLessons[i].Lesson))
+	}
+
+	return resp, nil
+}
+
+func (s *ScheduleServer) sendCancellationEvents(ctx context.Context, cancelled []repo.LessonWithSlot) {
+	if s.eventSender == nil {
+		return
+	}
+
+	sendCtx := context.WithoutCancel(ctx)
+	for _, c := range cancelled {
+		event := kafka.ReminderEvent{
+			LessonID:  c.Lesson.ID,
+			SlotID:    c.Slot.ID,
+			TutorID:   c.Slot.TutorID,
+			StudentID: c.Lesson.StudentID,
+			StartsAt:  c.Slot.StartsAt,
+			EndsAt:    c.Slot.EndsAt,
+			EventType: "cancelled",
+		}
+		if c.Lesson.CancelReason != nil {
+			event.Reason = *c.Lesson.CancelReason
+		}
+		if err := s.eventSender.SendReminderEvent(sendCtx, event); err != nil {
+			if s.logger != nil {
+				s.logger.Error(ctx, "failed to send lesson cancellation event",
+					zap.String("lesson_id", c.Lesson.ID), zap.Error(err))
+			}
+		}
+	}
+}
+
+// maxSeriesOccurrences limits a single series to roughly one school year of weekly lessons.
+const maxSeriesOccurrences = 52
+
+func (s *ScheduleServer) CreateLessonSeries(ctx context.Context, req *pb.CreateLessonSeriesRequest) (*pb.CreateLessonSeriesResponse, error) {
+	userID, ok := ctxdata.GetUserID(ctx)
+	if !ok {
+		return nil, StatusUnauthenticated
+	}
+	if err := uuid.Validate(req.TutorId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid TutorID")
+	}
+	if err := uuid.Validate(req.StudentId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid StudentID")
+	}
+	if userID != req.TutorId && userID != req.StudentId {
+		return nil, StatusPermissionDenied
+	}
+
+	if req.StartsAt == nil || req.EndsAt == nil {
+		return nil, status.Error(codes.InvalidArgument, "starts_at and ends_at are required")
+	}
+	startsAt := req.StartsAt.AsTime()
+	endsAt := req.EndsAt.AsTime()
+	if !validateTimeRange(startsAt, endsAt) {
+		return nil, status.Error(codes.InvalidArgument, "invalid time range")
+	}
+	if time.Now().After(startsAt) {
+		return nil, status.Error(codes.InvalidArgument, "series must start in the future")
+	}
+
+	if req.Count == nil && req.Until == nil {
+		return nil, status.Error(codes.InvalidArgument, "count or until is required")
+	}
+	limit := maxSeriesOccurrences + 1
+	if req.Count != nil {
+		if req.GetCount() <= 0 || req.GetCount() > maxSeriesOccurrences {
+			return nil, status.Errorf(codes.InvalidArgument, "count must be between 1 and %d", maxSeriesOccurrences)
+		}
+		limit = int(req.GetCount())
+	}
+	var until *time.Time
+	if req.Until != nil {
+		t := req.Until.AsTime()
+		if t.Before(startsAt) {
+			return nil, status.Error(codes.InvalidArgument, "until must not be before starts_at")
+		}
+		until = &t
+	}
+
+	loc := time.UTC
+	if req.GetTimezone() != "" {
+		l, err := time.LoadLocation(req.GetTimezone())
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid timezone")
+		}
+		loc = l
+	}
+
+	weekdays := make([]time.Weekday, 0, len(req.Weekdays))
+	for _, wd := range req.Weekdays {
+		if wd < 1 || wd > 7 {
+			return nil, status.Error(codes.InvalidArgument, "weekdays must be between 1 and 7")
+		}
+		weekdays = append(weekdays, time.Weekday(wd%7))
+	}
+
+	occurrences := buildSeriesOccurrences(startsAt, endsAt, loc, weekdays, until, limit)
+	if len(occurrences) > maxSeriesOccurrences {
+		return nil, status.Errorf(codes.InvalidArgument, "series cannot have more than %d lessons", maxSeriesOccurrences)
+	}
+	if len(occurrences) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "pattern has no lessons in the given range")
+	}
+
+	isValidPair, err := s.ValidateTutorStudentPair(ctx, req.TutorId, req.StudentId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to validate tutor-student relationship: "+err.Error())
+	}
+	if !isValidPair {
+		return nil, status.Error(codes.FailedPrecondition, "tutor and student are not connected")
+	}
+
+	series := repo.LessonSeries{
+		ID:        uuid.New().String(),
+		TutorID:   req.TutorId,
+		StudentID: req.StudentId,
+		CreatedAt: time.Now(),
+	}
+
+	// Only the tutor may open new slots; a student can book existing free ones.
+	result, err := s.db.CreateLessonSeries(ctx, series, occurrences, userID == req.TutorId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to create lesson series")
+	}
+
+	resp := &pb.CreateLessonSeriesResponse{
+		Lessons: make([]*pb.Lesson, 0, len(result.Booked)),
+		Failed:  make([]*pb.SeriesOccurrenceFailure, 0, len(result.Failed)),
+	}
+	if len(result.Booked) > 0 {
+		resp.SeriesId = series.ID
+	}
+	for i := range result.Booked {
+		booked := result.Booked[i]
+		resp.Lessons = append(resp.Lessons, convertrepoLessonToProto(&booked.Lesson))
+		s.sendBookedEvent(ctx, booked)
+	}
+	for i := range result.Packages {
+		s.notifyLowBalance(ctx, &result.Packages[i].Package, result.Packages[i].Consumed)
+	}
+	for _, failed := range result.Failed {
+		resp.Failed = append(resp.Failed, &pb.SeriesOccurrenceFailure{
+			StartsAt: timestamppb.New(failed.Occurrence.StartsAt),
+			EndsAt:   timestamppb.New(failed.Occurrence.EndsAt),
+			Reason:   seriesFailureReason(failed.Err),
+		})
+	}
+
+	return resp, nil
+}
+
+func (s *ScheduleServer) CancelLessonSeries(ctx context.Context, req *pb.CancelLessonSeriesRequest) (*pb.ListLessonsResponse, error) {
+	userID, ok := ctxdata.GetUserID(ctx)
+	if !ok {
+		return nil, StatusUnauthenticated
+	}
+	if err := uuid.Validate(req.SeriesId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid ID")
+	}
+
+	series, err := s.db.GetLessonSeries(ctx, req.SeriesId)
+	if err != nil {
+		if errors.Is(err, ErrSeriesNotFound) {
+			return nil, status.Error(codes.NotFound, "lesson series not found")
+		}
+		return nil, StatusInternalError
+	}
+
+	if userID != series.TutorID && userID != series.StudentID {
+		return nil, StatusPermissionDenied
+	}
+
+	cancelled, err := s.db.CancelLessonSeries(ctx, series.ID, time.Now(), req.Reason, cancellingParty(userID, series.TutorID))
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to cancel lesson series")
+	}
+
+	s.sendCancellationEvents(ctx, cancelled)
+
+	lessons := make([]repo.Lesson, 0, len(cancelled))
+	for _, c := range cancelled {
+		lessons = append(lessons, c.Lesson)
+	}
+
+	return createListLessonsResponse(lessons), nil
+}
+
+func (s *ScheduleServer) sendBookedEvent(ctx context.Context, booked repo.LessonWithSlot) {
+	if s.eventSender == nil {
+		return
+	}
+
+	event := kafka.ReminderEvent{
+		LessonID:  booked.Lesson.ID,
+		SlotID:    booked.Slot.ID,
+		TutorID:   booked.Slot.TutorID,
+		StudentID: booked.Lesson.StudentID,
+		StartsAt:  booked.Slot.StartsAt,
+		EndsAt:    booked.Slot.EndsAt,
+		EventType: "booked",
+	}
+	if err := s.eventSender.SendReminderEvent(context.WithoutCancel(ctx), event); err != nil {
+		if s.logger != nil {
+			s.logger.Error(ctx, "failed to send lesson reminder event",
+				zap.String("lesson_id", booked.Lesson.ID), zap.Error(err))
+		}
+	}
+}
+
+// lowPackageBalance is the number of remaining credits at which a low-balance event is sent.
+const lowPackageBalance = 1
+
+const maxPackageLessons = 100
+
+func (s *ScheduleServer) CreateLessonPackage(ctx context.Context, req *pb.CreateLessonPackageRequest) (*pb.LessonPackage, error) {
+	userID, ok := ctxdata.GetUserID(ctx)
+	if !ok {
+		return nil, StatusUnauthenticated
+	}
+	if err := uuid.Validate(req.TutorId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid TutorID")
+	}
+	if err := uuid.Validate(req.StudentId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid StudentID")
+	}
+
+	isTutor, err := IsTutor(ctx, userID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to verify tutor status")
+	}
+	if !isTutor || req.TutorId != userID {
+		return nil, StatusPermissionDenied
+	}
+
+	if req.LessonsCount <= 0 || req.LessonsCount > maxPackageLessons {
+		return nil, status.Errorf(codes.InvalidArgument, "lessons_count must be between 1 and %d", maxPackageLessons)
+	}
+	if req.PriceRub < 0 {
+		return nil, status.Error(codes.InvalidArgument, "price_rub cannot be negative")
+	}
+	if req.ValidUntil == nil {
+		return nil, status.Error(codes.InvalidArgument, "valid_until is required")
+	}
+
+	now := time.Now()
+	validFrom := now
+	if req.ValidFrom != nil {
+		validFrom = req.ValidFrom.AsTime()
+	}
+	validUntil := req.ValidUntil.AsTime()
+	if !validateTimeRange(validFrom, validUntil) || validUntil.Before(now) {
+		return nil, status.Error(codes.InvalidArgument, "invalid validity period")
+	}
+
+	isValidPair, err := s.ValidateTutorStudentPair(ctx, req.TutorId, req.StudentId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to validate tutor-student relationship: "+err.Error())
+	}
+	if !isValidPair {
+		return nil, status.Error(codes.FailedPrecondition, "tutor and student are not connected")
+	}
+
+	pkg := repo.LessonPackage{
+		ID:               uuid.New().String(),
+		TutorID:          req.TutorId,
+		StudentID:        req.StudentId,
+		LessonsTotal:     req.LessonsCount,
+		LessonsRemaining: req.LessonsCount,
+		PriceRub:         req.PriceRub,
+		ValidFrom:        validFrom,
+		ValidUntil:       validUntil,
+		CreatedAt:        now,
+	}
+
+	if err := s.db.CreateLessonPackage(ctx, pkg); err != nil {
+		return nil, status.Error(codes.Internal, "failed to create lesson package")
+	}
+
+	return convertrepoPackageToProto(&pkg), nil
+}
+
+func (s *ScheduleServer) GetPackageBalance(ctx context.Context, req *pb.GetPackageBalanceRequest) (*pb.PackageBalance, error) {
+	userID, ok := ctxdata.GetUserID(ctx)
+	if !ok {
+		return nil, StatusUnauthenticated
+	}
+	if err := uuid.Validate(req.TutorId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid TutorID")
+	}
+	if err := uuid.Validate(req.StudentId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid StudentID")
+	}
+
+	if req.TutorId != userID && req.StudentId != userID {
+		return nil, StatusPermissionDenied
+	}
+
+	isValidPair, err := s.ValidateTutorStudentPair(ctx, req.TutorId, req.StudentId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to validate tutor-student relationship")
+	}
+	if !isValidPair {
+		return nil, status.Error(codes.PermissionDenied, "tutor and student are not connected")
+	}
+
+	now := time.Now()
+	packages, err := s.db.ListActivePackages(ctx, req.TutorId, req.StudentId, now)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to get package balance")
+	}
+
+	balance := &pb.PackageBalance{Packages: make([]*pb.LessonPackage, 0, len(packages))}
+	for i := range packages {
+		if !packages[i].ValidFrom.After(now) {
+			balance.LessonsRemaining += packages[i].LessonsRemaining
+		}
+		balance.Packages = append(balance.Packages, convertrepoPackageToProto(&packages[i]))
+	}
+
+	return balance, nil
+}
+
+// maxStatsPeriod limits the period covered by GetScheduleStats.
+const maxStatsPeriod = 366 * 24 * time.Hour
+
+func (s *ScheduleServer) GetScheduleStats(ctx context.Context, req *pb.GetScheduleStatsRequest) (*pb.ScheduleStats, error) {
+	userID, ok := ctxdata.GetUserID(ctx)
+	if !ok {
+		return nil, StatusUnauthenticated
+	}
+	if err := uuid.Validate(req.TutorId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid ID")
+	}
+
+	isTutor, err := IsTutor(ctx, userID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to verify tutor status")
+	}
+	if !isTutor || req.TutorId != userID {
+		return nil, StatusPermissionDenied
+	}
+
+	if req.From == nil || req.To == nil {
+		return nil, status.Error(codes.InvalidArgument, "from and to are required")
+	}
+	from := req.From.AsTime()
+	to := req.To.AsTime()
+	if !validateTimeRange(from, to) {
+		return nil, status.Error(codes.InvalidArgument, "invalid time range")
+	}
+	if to.Sub(from) > maxStatsPeriod {
+		return nil, status.Error(codes.InvalidArgument, "period is too long")
+	}
+
+	timezone := "UTC"
+	if req.Timezone != nil && *req.Timezone != "" {
+		if _, err := time.LoadLocation(*req.Timezone); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid timezone")
+		}
+		timezone = *req.Timezone
+	}
+
+	stats, err := s.db.GetScheduleStats(ctx, req.TutorId, from, to, timezone)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to get schedule stats")
+	}
+
+	return convertrepoStatsToProto(stats), nil
+}
+
+// NotifyExpiredPackages sends an event for every package that expired with unused
+// lessons. It is run periodically from main; each package is reported once.
+func (s *ScheduleServer) NotifyExpiredPackages(ctx context.Context) error {
+	expired, err := s.db.MarkExpiredPackagesNotified(ctx, time.Now())
+	if err != nil {
+		return err
+	}
+
+	for i := range expired {
+		s.sendPackageEvent(ctx, &expired[i], "package_expired")
+	}
+
+	return nil
+}
+
+// applyPackageCredit records that the lesson was paid from the package, if any,
+// and warns the pair when the package is running out.
+func (s *ScheduleServer) applyPackageCredit(ctx context.Context, lesson *repo.Lesson, pkg *repo.LessonPackage) {
+	if pkg == nil {
+		return
+	}
+
+	lesson.IsPaid = true
+	lesson.PackageID = &pkg.ID
+	s.notifyLowBalance(ctx, pkg, 1)
+}
+
+// notifyLowBalance warns the pair once per package: only when paying for consumed
+// lessons brought the balance down to lowPackageBalance or below.
+func (s *ScheduleServer) notifyLowBalance(ctx context.Context, pkg *repo.LessonPackage, consumed int32) {
+	if pkg.LessonsRemaining <= lowPackageBalance && pkg.LessonsRemaining+consumed > lowPackageBalance {
+		s.sendPackageEvent(ctx, pkg, "package_low_balance")
+	}
+}
+
+func (s *ScheduleServer) sendPackageEvent(ctx context.Context, pkg *repo.LessonPackage, eventType string) {
+	if s.eventSender == nil {
+		return
+	}
+
+	event := kafka.PackageEvent{
+		PackageID:        pkg.ID,
+		TutorID:          pkg.TutorID,
+		StudentID:        pkg.StudentID,
+		LessonsRemaining: pkg.LessonsRemaining,
+		ValidUntil:       pkg.ValidUntil,
+		EventType:        eventType,
+	}
+	if err := s.eventSender.SendPackageEvent(context.WithoutCancel(ctx), event); err != nil {
+		if s.logger != nil {
+			s.logger.Error(ctx, "failed to send package event",
+				zap.String("package_id", pkg.ID), zap.Error(err))
+		}
+	}
+}
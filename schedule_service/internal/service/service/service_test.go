@@ -3,6 +3,7 @@ package service_test
 import (
 	"common_library/ctxdata"
 	"context"
+	"errors"
 	"testing"
 	"time"
 	userpb "userservice/pkg/api"
@@ -15,6 +16,7 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"schedule_service/internal/database/repo"
+	"schedule_service/internal/kafka"
 	"schedule_service/internal/service/service"
 	pb "schedule_service/pkg/api"
 	"schedule_service/pkg/mocks"
@@ -416,6 +418,7 @@ func TestGetLesson(t *testing.T) {
 
 		mockRepo.EXPECT().GetLesson(gomock.Any(), lessonID).Return(lesson, nil)
 		mockRepo.EXPECT().GetSlot(gomock.Any(), slotID).Return(slot, nil)
+		mockRepo.EXPECT().GetLessonNotes(gomock.Any(), lessonID).Return(nil, service.ErrNotesNotFound)
 
 		resp, err := srv.GetLesson(ctx, &pb.GetLessonRequest{Id: lessonID})
 		require.NoError(t, err)
@@ -424,6 +427,7 @@ func TestGetLesson(t *testing.T) {
 		require.Equal(t, studentID, resp.StudentId)
 		require.Equal(t, "booked", resp.Status)
 		require.False(t, resp.IsPaid)
+		require.Nil(t, resp.Notes)
 	})
 
 	t.Run("Success - Student", func(t *testing.T) {
@@ -456,6 +460,13 @@ func TestGetLesson(t *testing.T) {
 
 		mockRepo.EXPECT().GetLesson(gomock.Any(), lessonID).Return(lesson, nil)
 		mockRepo.EXPECT().GetSlot(gomock.Any(), slotID).Return(slot, nil)
+		mockRepo.EXPECT().GetLessonNotes(gomock.Any(), lessonID).Return(&repo.LessonNotes{
+			LessonID:    lessonID,
+			PrivateNote: "struggles with past tenses",
+			Agenda:      "irregular verbs",
+			EditedBy:    tutorID,
+			EditedAt:    now,
+		}, nil)
 
 		resp, err := srv.GetLesson(ctx, &pb.GetLessonRequest{Id: lessonID})
 		require.NoError(t, err)
@@ -464,6 +475,8 @@ func TestGetLesson(t *testing.T) {
 		require.Equal(t, studentID, resp.StudentId)
 		require.Equal(t, "booked", resp.Status)
 		require.False(t, resp.IsPaid)
+		require.Equal(t, "irregular verbs", resp.Notes.GetAgenda())
+		require.Empty(t, resp.Notes.GetPrivateNote())
 	})
 
 	t.Run("Lesson Not Found", func(t *testing.T) {
@@ -503,7 +516,7 @@ func TestCreateLesson(t *testing.T) {
 
 		mockRepo.EXPECT().GetSlot(gomock.Any(), slotID).Return(slot, nil)
 		mockUserClient.EXPECT().GetTutorStudent(gomock.Any(), tutorID, studentID).Return(&userpb.TutorStudent{Status: "active"}, nil)
-		mockRepo.EXPECT().CreateLessonAndBookSlot(gomock.Any(), gomock.Any(), slotID).Return(nil)
+		mockRepo.EXPECT().CreateLessonAndBookSlot(gomock.Any(), gomock.Any(), slotID).Return(nil, nil)
 
 		resp, err := srv.CreateLesson(ctx, &pb.CreateLessonRequest{
 			SlotId:    slotID,
@@ -514,6 +527,8 @@ func TestCreateLesson(t *testing.T) {
 		require.Equal(t, studentID, resp.StudentId)
 		require.Equal(t, "booked", resp.Status)
 		require.False(t, resp.IsPaid)
+		require.Equal(t, tutorID, resp.GetTutorId())
+		require.True(t, resp.StartsAt.AsTime().Equal(slot.StartsAt))
 	})
 
 	t.Run("Already Booked", func(t *testing.T) {
@@ -679,12 +694,14 @@ func TestCancelLesson(t *testing.T) {
 			func(_ context.Context, cancelledLesson repo.Lesson, slotID string) error {
 				require.Equal(t, lessonID, cancelledLesson.ID)
 				require.Equal(t, "cancelled", cancelledLesson.Status)
+				require.Equal(t, "tutor", *cancelledLesson.CancelledBy)
 				return nil
 			},
 		)
 
-		_, err := srv.CancelLesson(ctx, &pb.CancelLessonRequest{Id: lesson.ID})
+		resp, err := srv.CancelLesson(ctx, &pb.CancelLessonRequest{Id: lesson.ID})
 		assert.NoError(t, err)
+		assert.Equal(t, "tutor", resp.GetCancelledBy())
 
 	})
 }
@@ -1104,3 +1121,1060 @@ func TestMarkAsPaid(t *testing.T) {
 		require.Equal(t, codes.NotFound, st.Code())
 	})
 }
+
+type fakeEventSender struct {
+	events        []kafka.ReminderEvent
+	packageEvents []kafka.PackageEvent
+}
+
+func (f *fakeEventSender) SendReminderEvent(_ context.Context, event kafka.ReminderEvent) error {
+	f.events = append(f.events, event)
+	return nil
+}
+
+func (f *fakeEventSender) SendPackageEvent(_ context.Context, event kafka.PackageEvent) error {
+	f.packageEvents = append(f.packageEvents, event)
+	return nil
+}
+
+func TestBlockPeriod(t *testing.T) {
+	tutorID := "de305d54-75b4-431b-adb2-eb6b9e546014"
+	studentID := "de305d54-75b4-431b-adb2-eb6b9e546015"
+	reason := "vacation"
+	from := time.Now().Add(24 * time.Hour)
+	to := from.Add(7 * 24 * time.Hour)
+
+	blockResult := func() *repo.BlockPeriodResult {
+		return &repo.BlockPeriodResult{
+			DeletedSlots: []repo.Slot{
+				{
+					ID:       "de305d54-75b4-431b-adb2-eb6b9e546017",
+					TutorID:  tutorID,
+					StartsAt: from.Add(time.Hour),
+					EndsAt:   from.Add(2 * time.Hour),
+				},
+			},
+			ClosedSlots: []repo.Slot{
+				{
+					ID:       "de305d54-75b4-431b-adb2-eb6b9e546019",
+					TutorID:  tutorID,
+					StartsAt: from.Add(5 * time.Hour),
+					EndsAt:   from.Add(6 * time.Hour),
+					IsBooked: true,
+				},
+			},
+			CancelledLessons: []repo.LessonWithSlot{
+				{
+					Lesson: repo.Lesson{
+						ID:           "de305d54-75b4-431b-adb2-eb6b9e546016",
+						SlotID:       "de305d54-75b4-431b-adb2-eb6b9e546018",
+						StudentID:    studentID,
+						Status:       "cancelled",
+						CancelReason: &reason,
+					},
+					Slot: repo.Slot{
+						ID:       "de305d54-75b4-431b-adb2-eb6b9e546018",
+						TutorID:  tutorID,
+						StartsAt: from.Add(3 * time.Hour),
+						EndsAt:   from.Add(4 * time.Hour),
+						IsBooked: true,
+					},
+				},
+			},
+		}
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockRepo := mocks.NewMockRepository(ctrl)
+		sender := &fakeEventSender{}
+		srv := service.NewScheduleServer(mockRepo, mocks.NewMockIUserClient(ctrl), sender, nil)
+
+		ctx := ctxdata.WithUserID(context.Background(), tutorID)
+		ctx = ctxdata.WithUserRole(ctx, "tutor")
+
+		mockRepo.EXPECT().BlockPeriod(gomock.Any(), tutorID, gomock.Any(), gomock.Any(), &reason, false).Return(blockResult(), nil)
+
+		resp, err := srv.BlockPeriod(ctx, &pb.BlockPeriodRequest{
+			TutorId: tutorID,
+			From:    timestamppb.New(from),
+			To:      timestamppb.New(to),
+			Reason:  &reason,
+		})
+		require.NoError(t, err)
+		require.False(t, resp.DryRun)
+		require.Len(t, resp.DeletedSlots, 1)
+		require.Len(t, resp.ClosedSlots, 1)
+		require.Len(t, resp.CancelledLessons, 1)
+		require.Equal(t, reason, resp.CancelledLessons[0].GetCancelReason())
+
+		require.Len(t, sender.events, 1)
+		require.Equal(t, "cancelled", sender.events[0].EventType)
+		require.Equal(t, studentID, sender.events[0].StudentID)
+		require.Equal(t, reason, sender.events[0].Reason)
+	})
+
+	t.Run("Dry Run Sends No Events", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockRepo := mocks.NewMockRepository(ctrl)
+		sender := &fakeEventSender{}
+		srv := service.NewScheduleServer(mockRepo, mocks.NewMockIUserClient(ctrl), sender, nil)
+
+		ctx := ctxdata.WithUserID(context.Background(), tutorID)
+		ctx = ctxdata.WithUserRole(ctx, "tutor")
+
+		mockRepo.EXPECT().BlockPeriod(gomock.Any(), tutorID, gomock.Any(), gomock.Any(), (*string)(nil), true).Return(blockResult(), nil)
+
+		resp, err := srv.BlockPeriod(ctx, &pb.BlockPeriodRequest{
+			TutorId: tutorID,
+			From:    timestamppb.New(from),
+			To:      timestamppb.New(to),
+			DryRun:  true,
+		})
+		require.NoError(t, err)
+		require.True(t, resp.DryRun)
+		require.Len(t, resp.CancelledLessons, 1)
+		require.Empty(t, sender.events)
+	})
+
+	t.Run("Permission Denied - Not A Tutor", func(t *testing.T) {
+		srv, _, _, _ := setup(t)
+		ctx := ctxdata.WithUserID(context.Background(), studentID)
+		ctx = ctxdata.WithUserRole(ctx, "student")
+
+		_, err := srv.BlockPeriod(ctx, &pb.BlockPeriodRequest{
+			TutorId: studentID,
+			From:    timestamppb.New(from),
+			To:      timestamppb.New(to),
+		})
+		require.Error(t, err)
+		st, _ := status.FromError(err)
+		require.Equal(t, codes.PermissionDenied, st.Code())
+	})
+
+	t.Run("Invalid Time Range", func(t *testing.T) {
+		srv, _, _, _ := setup(t)
+		ctx := ctxdata.WithUserID(context.Background(), tutorID)
+		ctx = ctxdata.WithUserRole(ctx, "tutor")
+
+		_, err := srv.BlockPeriod(ctx, &pb.BlockPeriodRequest{
+			TutorId: tutorID,
+			From:    timestamppb.New(to),
+			To:      timestamppb.New(from),
+		})
+		require.Error(t, err)
+		st, _ := status.FromError(err)
+		require.Equal(t, codes.InvalidArgument, st.Code())
+	})
+}
+
+func TestCreateLessonSeries(t *testing.T) {
+	tutorID := "de305d54-75b4-431b-adb2-eb6b9e546014"
+	studentID := "de305d54-75b4-431b-adb2-eb6b9e546015"
+	startsAt := time.Now().Add(24 * time.Hour).Truncate(time.Minute)
+	endsAt := startsAt.Add(time.Hour)
+
+	t.Run("Success - Weekly Count", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockRepo := mocks.NewMockRepository(ctrl)
+		mockUserClient := mocks.NewMockIUserClient(ctrl)
+		sender := &fakeEventSender{}
+		srv := service.NewScheduleServer(mockRepo, mockUserClient, sender, nil)
+
+		ctx := ctxdata.WithUserID(context.Background(), tutorID)
+		ctx = ctxdata.WithUserRole(ctx, "tutor")
+
+		count := int32(3)
+		mockUserClient.EXPECT().GetTutorStudent(gomock.Any(), tutorID, studentID).Return(&userpb.TutorStudent{Status: "active"}, nil)
+		mockRepo.EXPECT().CreateLessonSeries(gomock.Any(), gomock.Any(), gomock.Any(), true).DoAndReturn(
+			func(_ context.Context, series repo.LessonSeries, occurrences []repo.SeriesOccurrence, _ bool) (*repo.LessonSeriesResult, error) {
+				require.Equal(t, tutorID, series.TutorID)
+				require.Equal(t, studentID, series.StudentID)
+				require.Len(t, occurrences, 3)
+				for i, o := range occurrences {
+					require.True(t, startsAt.AddDate(0, 0, 7*i).Equal(o.StartsAt))
+					require.Equal(t, time.Hour, o.EndsAt.Sub(o.StartsAt))
+				}
+
+				result := &repo.LessonSeriesResult{}
+				for _, o := range occurrences[:2] {
+					result.Booked = append(result.Booked, repo.LessonWithSlot{
+						Lesson: repo.Lesson{ID: "de305d54-75b4-431b-adb2-eb6b9e546016", StudentID: studentID, Status: "booked", SeriesID: &series.ID},
+						Slot:   repo.Slot{ID: "de305d54-75b4-431b-adb2-eb6b9e546017", TutorID: tutorID, StartsAt: o.StartsAt, EndsAt: o.EndsAt, IsBooked: true},
+					})
+				}
+				result.Failed = []repo.FailedOccurrence{{Occurrence: occurrences[2], Err: service.ErrSlotConflict}}
+				result.Packages = []repo.PackageUsage{{
+					Package:  repo.LessonPackage{ID: "de305d54-75b4-431b-adb2-eb6b9e546020", TutorID: tutorID, StudentID: studentID, LessonsRemaining: 0},
+					Consumed: 2,
+				}}
+				return result, nil
+			},
+		)
+
+		resp, err := srv.CreateLessonSeries(ctx, &pb.CreateLessonSeriesRequest{
+			TutorId:   tutorID,
+			StudentId: studentID,
+			StartsAt:  timestamppb.New(startsAt),
+			EndsAt:    timestamppb.New(endsAt),
+			Count:     &count,
+		})
+		require.NoError(t, err)
+		require.NotEmpty(t, resp.SeriesId)
+		require.False(t, resp.Lessons[0].IsPaid)
+		require.Len(t, resp.Lessons, 2)
+		require.Equal(t, resp.SeriesId, resp.Lessons[0].GetSeriesId())
+		require.Len(t, resp.Failed, 1)
+		require.Equal(t, "overlaps another slot", resp.Failed[0].Reason)
+		require.Len(t, sender.events, 2)
+		require.Equal(t, "booked", sender.events[0].EventType)
+		require.Len(t, sender.packageEvents, 1)
+		require.Equal(t, "package_low_balance", sender.packageEvents[0].EventType)
+	})
+
+	t.Run("Student Books Only Existing Slots", func(t *testing.T) {
+		srv, mockRepo, mockUserClient, _ := setup(t)
+		ctx := ctxdata.WithUserID(context.Background(), studentID)
+		ctx = ctxdata.WithUserRole(ctx, "student")
+
+		until := startsAt.AddDate(0, 0, 14)
+		mockUserClient.EXPECT().GetTutorStudent(gomock.Any(), tutorID, studentID).Return(&userpb.TutorStudent{Status: "active"}, nil)
+		mockRepo.EXPECT().CreateLessonSeries(gomock.Any(), gomock.Any(), gomock.Any(), false).DoAndReturn(
+			func(_ context.Context, _ repo.LessonSeries, occurrences []repo.SeriesOccurrence, _ bool) (*repo.LessonSeriesResult, error) {
+				require.Len(t, occurrences, 3)
+				failed := make([]repo.FailedOccurrence, 0, len(occurrences))
+				for _, o := range occurrences {
+					failed = append(failed, repo.FailedOccurrence{Occurrence: o, Err: service.ErrSlotNotFound})
+				}
+				return &repo.LessonSeriesResult{Failed: failed}, nil
+			},
+		)
+
+		resp, err := srv.CreateLessonSeries(ctx, &pb.CreateLessonSeriesRequest{
+			TutorId:   tutorID,
+			StudentId: studentID,
+			StartsAt:  timestamppb.New(startsAt),
+			EndsAt:    timestamppb.New(endsAt),
+			Until:     timestamppb.New(until),
+		})
+		require.NoError(t, err)
+		require.Empty(t, resp.SeriesId)
+		require.Empty(t, resp.Lessons)
+		require.Len(t, resp.Failed, 3)
+	})
+
+	t.Run("Several Weekdays", func(t *testing.T) {
+		srv, mockRepo, mockUserClient, _ := setup(t)
+		ctx := ctxdata.WithUserID(context.Background(), tutorID)
+		ctx = ctxdata.WithUserRole(ctx, "tutor")
+
+		count := int32(4)
+		mockUserClient.EXPECT().GetTutorStudent(gomock.Any(), tutorID, studentID).Return(&userpb.TutorStudent{Status: "active"}, nil)
+		mockRepo.EXPECT().CreateLessonSeries(gomock.Any(), gomock.Any(), gomock.Any(), true).DoAndReturn(
+			func(_ context.Context, _ repo.LessonSeries, occurrences []repo.SeriesOccurrence, _ bool) (*repo.LessonSeriesResult, error) {
+				require.Len(t, occurrences, 4)
+				for _, o := range occurrences {
+					wd := o.StartsAt.Weekday()
+					require.True(t, wd == time.Monday || wd == time.Thursday)
+				}
+				return &repo.LessonSeriesResult{}, nil
+			},
+		)
+
+		_, err := srv.CreateLessonSeries(ctx, &pb.CreateLessonSeriesRequest{
+			TutorId:   tutorID,
+			StudentId: studentID,
+			StartsAt:  timestamppb.New(startsAt),
+			EndsAt:    timestamppb.New(endsAt),
+			Weekdays:  []int32{1, 4},
+			Count:     &count,
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("Missing Count And Until", func(t *testing.T) {
+		srv, _, _, _ := setup(t)
+		ctx := ctxdata.WithUserID(context.Background(), tutorID)
+
+		_, err := srv.CreateLessonSeries(ctx, &pb.CreateLessonSeriesRequest{
+			TutorId:   tutorID,
+			StudentId: studentID,
+			StartsAt:  timestamppb.New(startsAt),
+			EndsAt:    timestamppb.New(endsAt),
+		})
+		require.Error(t, err)
+		st, _ := status.FromError(err)
+		require.Equal(t, codes.InvalidArgument, st.Code())
+	})
+
+	t.Run("Permission Denied - Unrelated User", func(t *testing.T) {
+		srv, _, _, _ := setup(t)
+		ctx := ctxdata.WithUserID(context.Background(), "de305d54-75b4-431b-adb2-eb6b9e546019")
+
+		count := int32(2)
+		_, err := srv.CreateLessonSeries(ctx, &pb.CreateLessonSeriesRequest{
+			TutorId:   tutorID,
+			StudentId: studentID,
+			StartsAt:  timestamppb.New(startsAt),
+			EndsAt:    timestamppb.New(endsAt),
+			Count:     &count,
+		})
+		require.Error(t, err)
+		st, _ := status.FromError(err)
+		require.Equal(t, codes.PermissionDenied, st.Code())
+	})
+}
+
+func TestCancelLessonSeries(t *testing.T) {
+	tutorID := "de305d54-75b4-431b-adb2-eb6b9e546014"
+	studentID := "de305d54-75b4-431b-adb2-eb6b9e546015"
+	seriesID := "de305d54-75b4-431b-adb2-eb6b9e546020"
+	series := &repo.LessonSeries{ID: seriesID, TutorID: tutorID, StudentID: studentID}
+
+	t.Run("Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockRepo := mocks.NewMockRepository(ctrl)
+		sender := &fakeEventSender{}
+		srv := service.NewScheduleServer(mockRepo, mocks.NewMockIUserClient(ctrl), sender, nil)
+
+		ctx := ctxdata.WithUserID(context.Background(), studentID)
+		startsAt := time.Now().Add(48 * time.Hour)
+
+		mockRepo.EXPECT().GetLessonSeries(gomock.Any(), seriesID).Return(series, nil)
+		mockRepo.EXPECT().CancelLessonSeries(gomock.Any(), seriesID, gomock.Any(), (*string)(nil), "student").Return([]repo.LessonWithSlot{
+			{
+				Lesson: repo.Lesson{ID: "de305d54-75b4-431b-adb2-eb6b9e546016", StudentID: studentID, Status: "cancelled"},
+				Slot:   repo.Slot{ID: "de305d54-75b4-431b-adb2-eb6b9e546017", TutorID: tutorID, StartsAt: startsAt, EndsAt: startsAt.Add(time.Hour)},
+			},
+		}, nil)
+
+		resp, err := srv.CancelLessonSeries(ctx, &pb.CancelLessonSeriesRequest{SeriesId: seriesID})
+		require.NoError(t, err)
+		require.Len(t, resp.Lessons, 1)
+		require.Equal(t, "cancelled", resp.Lessons[0].Status)
+		require.Len(t, sender.events, 1)
+		require.Equal(t, "cancelled", sender.events[0].EventType)
+	})
+
+	t.Run("Series Not Found", func(t *testing.T) {
+		srv, mockRepo, _, _ := setup(t)
+		ctx := ctxdata.WithUserID(context.Background(), tutorID)
+
+		mockRepo.EXPECT().GetLessonSeries(gomock.Any(), seriesID).Return(nil, service.ErrSeriesNotFound)
+
+		_, err := srv.CancelLessonSeries(ctx, &pb.CancelLessonSeriesRequest{SeriesId: seriesID})
+		require.Error(t, err)
+		st, _ := status.FromError(err)
+		require.Equal(t, codes.NotFound, st.Code())
+	})
+
+	t.Run("Permission Denied - Unrelated User", func(t *testing.T) {
+		srv, mockRepo, _, _ := setup(t)
+		ctx := ctxdata.WithUserID(context.Background(), "de305d54-75b4-431b-adb2-eb6b9e546019")
+
+		mockRepo.EXPECT().GetLessonSeries(gomock.Any(), seriesID).Return(series, nil)
+
+		_, err := srv.CancelLessonSeries(ctx, &pb.CancelLessonSeriesRequest{SeriesId: seriesID})
+		require.Error(t, err)
+		st, _ := status.FromError(err)
+		require.Equal(t, codes.PermissionDenied, st.Code())
+	})
+}
+
+func TestUpdateAttendance(t *testing.T) {
+	tutorID := "de305d54-75b4-431b-adb2-eb6b9e546014"
+	studentID := "de305d54-75b4-431b-adb2-eb6b9e546015"
+	lessonID := "de305d54-75b4-431b-adb2-eb6b9e546016"
+	slotID := "de305d54-75b4-431b-adb2-eb6b9e546017"
+
+	pastSlot := func() *repo.Slot {
+		startsAt := time.Now().Add(-2 * time.Hour)
+		return &repo.Slot{ID: slotID, TutorID: tutorID, StartsAt: startsAt, EndsAt: startsAt.Add(time.Hour), IsBooked: true}
+	}
+	lesson := func(status string) *repo.Lesson {
+		return &repo.Lesson{ID: lessonID, SlotID: slotID, StudentID: studentID, Status: status}
+	}
+
+	t.Run("Success - Actual Times", func(t *testing.T) {
+		srv, mockRepo, _, _ := setup(t)
+		ctx := ctxdata.WithUserID(context.Background(), tutorID)
+
+		slot := pastSlot()
+		actualStart := slot.StartsAt.Add(10 * time.Minute)
+		actualEnd := slot.EndsAt.Add(5 * time.Minute)
+		completed := "completed"
+
+		mockRepo.EXPECT().GetLesson(gomock.Any(), lessonID).Return(lesson("booked"), nil)
+		mockRepo.EXPECT().GetSlot(gomock.Any(), slotID).Return(slot, nil)
+		mockRepo.EXPECT().UpdateAttendance(gomock.Any(), gomock.Any(), repo.CreditConsume).DoAndReturn(
+			func(_ context.Context, l repo.Lesson, _ repo.CreditAction) (*repo.LessonPackage, error) {
+				require.Equal(t, "completed", l.Status)
+				require.True(t, actualStart.Equal(*l.ActualStartsAt))
+				require.True(t, actualEnd.Equal(*l.ActualEndsAt))
+				return nil, nil
+			},
+		)
+
+		resp, err := srv.UpdateAttendance(ctx, &pb.UpdateAttendanceRequest{
+			Id:             lessonID,
+			Status:         &completed,
+			ActualStartsAt: timestamppb.New(actualStart),
+			ActualEndsAt:   timestamppb.New(actualEnd),
+		})
+		require.NoError(t, err)
+		require.Equal(t, "completed", resp.Status)
+		require.NotNil(t, resp.ActualStartsAt)
+	})
+
+	t.Run("Completion Emits Event Once", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockRepo := mocks.NewMockRepository(ctrl)
+		sender := &fakeEventSender{}
+		srv := service.NewScheduleServer(mockRepo, mocks.NewMockIUserClient(ctrl), sender, nil)
+		ctx := ctxdata.WithUserID(context.Background(), tutorID)
+		completed := "completed"
+
+		mockRepo.EXPECT().GetLesson(gomock.Any(), lessonID).Return(lesson("booked"), nil)
+		mockRepo.EXPECT().GetSlot(gomock.Any(), slotID).Return(pastSlot(), nil).Times(2)
+		mockRepo.EXPECT().UpdateAttendance(gomock.Any(), gomock.Any(), repo.CreditConsume).Return(nil, nil)
+		mockRepo.EXPECT().UpdateAttendance(gomock.Any(), gomock.Any(), repo.CreditKeep).Return(nil, nil)
+
+		_, err := srv.UpdateAttendance(ctx, &pb.UpdateAttendanceRequest{Id: lessonID, Status: &completed})
+		require.NoError(t, err)
+		require.Len(t, sender.events, 1)
+		require.Equal(t, "completed", sender.events[0].EventType)
+		require.Equal(t, lessonID, sender.events[0].LessonID)
+		require.Equal(t, tutorID, sender.events[0].TutorID)
+		require.Equal(t, studentID, sender.events[0].StudentID)
+
+		// Re-submitting the same status must not announce the lesson again.
+		mockRepo.EXPECT().GetLesson(gomock.Any(), lessonID).Return(lesson("completed"), nil)
+		_, err = srv.UpdateAttendance(ctx, &pb.UpdateAttendanceRequest{Id: lessonID, Status: &completed})
+		require.NoError(t, err)
+		require.Len(t, sender.events, 1)
+	})
+
+	t.Run("Success - Student No-Show Clears Times", func(t *testing.T) {
+		srv, mockRepo, _, _ := setup(t)
+		ctx := ctxdata.WithUserID(context.Background(), tutorID)
+
+		noShow := "no_show_student"
+		existing := lesson("completed")
+		startedAt := time.Now().Add(-time.Hour)
+		existing.ActualStartsAt = &startedAt
+
+		mockRepo.EXPECT().GetLesson(gomock.Any(), lessonID).Return(existing, nil)
+		mockRepo.EXPECT().GetSlot(gomock.Any(), slotID).Return(pastSlot(), nil)
+		mockRepo.EXPECT().UpdateAttendance(gomock.Any(), gomock.Any(), repo.CreditKeep).DoAndReturn(
+			func(_ context.Context, l repo.Lesson, _ repo.CreditAction) (*repo.LessonPackage, error) {
+				require.Equal(t, "no_show_student", l.Status)
+				require.Nil(t, l.ActualStartsAt)
+				return nil, nil
+			},
+		)
+
+		resp, err := srv.UpdateAttendance(ctx, &pb.UpdateAttendanceRequest{Id: lessonID, Status: &noShow})
+		require.NoError(t, err)
+		require.Equal(t, "no_show_student", resp.Status)
+		require.Nil(t, resp.ActualStartsAt)
+	})
+
+	t.Run("Permission Denied - Student", func(t *testing.T) {
+		srv, mockRepo, _, _ := setup(t)
+		ctx := ctxdata.WithUserID(context.Background(), studentID)
+
+		noShow := "no_show_tutor"
+		mockRepo.EXPECT().GetLesson(gomock.Any(), lessonID).Return(lesson("completed"), nil)
+		mockRepo.EXPECT().GetSlot(gomock.Any(), slotID).Return(pastSlot(), nil)
+
+		_, err := srv.UpdateAttendance(ctx, &pb.UpdateAttendanceRequest{Id: lessonID, Status: &noShow})
+		require.Error(t, err)
+		st, _ := status.FromError(err)
+		require.Equal(t, codes.PermissionDenied, st.Code())
+	})
+
+	t.Run("Lesson Not Started", func(t *testing.T) {
+		srv, mockRepo, _, _ := setup(t)
+		ctx := ctxdata.WithUserID(context.Background(), tutorID)
+
+		slot := pastSlot()
+		slot.StartsAt = time.Now().Add(time.Hour)
+		noShow := "no_show_student"
+		mockRepo.EXPECT().GetLesson(gomock.Any(), lessonID).Return(lesson("booked"), nil)
+		mockRepo.EXPECT().GetSlot(gomock.Any(), slotID).Return(slot, nil)
+
+		_, err := srv.UpdateAttendance(ctx, &pb.UpdateAttendanceRequest{Id: lessonID, Status: &noShow})
+		require.Error(t, err)
+		st, _ := status.FromError(err)
+		require.Equal(t, codes.FailedPrecondition, st.Code())
+	})
+
+	t.Run("Cancelled Lesson", func(t *testing.T) {
+		srv, mockRepo, _, _ := setup(t)
+		ctx := ctxdata.WithUserID(context.Background(), tutorID)
+
+		completed := "completed"
+		mockRepo.EXPECT().GetLesson(gomock.Any(), lessonID).Return(lesson("cancelled"), nil)
+		mockRepo.EXPECT().GetSlot(gomock.Any(), slotID).Return(pastSlot(), nil)
+
+		_, err := srv.UpdateAttendance(ctx, &pb.UpdateAttendanceRequest{Id: lessonID, Status: &completed})
+		require.Error(t, err)
+		st, _ := status.FromError(err)
+		require.Equal(t, codes.FailedPrecondition, st.Code())
+	})
+
+	t.Run("Invalid Status", func(t *testing.T) {
+		srv, _, _, _ := setup(t)
+		ctx := ctxdata.WithUserID(context.Background(), tutorID)
+
+		booked := "booked"
+		_, err := srv.UpdateAttendance(ctx, &pb.UpdateAttendanceRequest{Id: lessonID, Status: &booked})
+		require.Error(t, err)
+		st, _ := status.FromError(err)
+		require.Equal(t, codes.InvalidArgument, st.Code())
+	})
+}
+
+func TestCompleteEndedLessons(t *testing.T) {
+	tutorID := "de305d54-75b4-431b-adb2-eb6b9e546014"
+	studentID := "de305d54-75b4-431b-adb2-eb6b9e546015"
+	slotID := "de305d54-75b4-431b-adb2-eb6b9e546016"
+	lessonID := "de305d54-75b4-431b-adb2-eb6b9e546017"
+	packageID := "de305d54-75b4-431b-adb2-eb6b9e546020"
+
+	t.Run("Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockRepo := mocks.NewMockRepository(ctrl)
+		sender := &fakeEventSender{}
+		srv := service.NewScheduleServer(mockRepo, mocks.NewMockIUserClient(ctrl), sender, nil)
+
+		endsAt := time.Now().Add(-25 * time.Hour)
+		pkgID := packageID
+		mockRepo.EXPECT().UpdateCompletedLessons(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, endedBefore time.Time) (*repo.CompletedLessonsResult, error) {
+				// The tutor has a day to mark a no-show before the lesson is completed.
+				require.WithinDuration(t, time.Now().Add(-24*time.Hour), endedBefore, time.Minute)
+				return &repo.CompletedLessonsResult{
+					Completed: []repo.LessonWithSlot{
+						{
+							Lesson: repo.Lesson{ID: lessonID, SlotID: slotID, StudentID: studentID, Status: "completed", IsPaid: true, PackageID: &pkgID},
+							Slot:   repo.Slot{ID: slotID, TutorID: tutorID, StartsAt: endsAt.Add(-time.Hour), EndsAt: endsAt, IsBooked: true},
+						},
+					},
+					Packages: []repo.PackageUsage{
+						{
+							Package:  repo.LessonPackage{ID: packageID, TutorID: tutorID, StudentID: studentID, LessonsTotal: 8, LessonsRemaining: 1},
+							Consumed: 1,
+						},
+					},
+				}, nil
+			},
+		)
+
+		err := srv.CompleteEndedLessons(context.Background())
+		require.NoError(t, err)
+		require.Len(t, sender.events, 1)
+		require.Equal(t, "completed", sender.events[0].EventType)
+		require.Equal(t, lessonID, sender.events[0].LessonID)
+		require.Equal(t, tutorID, sender.events[0].TutorID)
+		require.Len(t, sender.packageEvents, 1)
+		require.Equal(t, "package_low_balance", sender.packageEvents[0].EventType)
+	})
+
+	t.Run("Repository Error", func(t *testing.T) {
+		srv, mockRepo, _, _ := setup(t)
+
+		mockRepo.EXPECT().UpdateCompletedLessons(gomock.Any(), gomock.Any()).Return(nil, errors.New("db down"))
+
+		err := srv.CompleteEndedLessons(context.Background())
+		require.Error(t, err)
+	})
+}
+
+func TestUpsertLessonNotes(t *testing.T) {
+	tutorID := "de305d54-75b4-431b-adb2-eb6b9e546014"
+	studentID := "de305d54-75b4-431b-adb2-eb6b9e546015"
+	lessonID := "de305d54-75b4-431b-adb2-eb6b9e546016"
+	slotID := "de305d54-75b4-431b-adb2-eb6b9e546017"
+	fileID := "de305d54-75b4-431b-adb2-eb6b9e546018"
+
+	lesson := &repo.Lesson{ID: lessonID, SlotID: slotID, StudentID: studentID, Status: "completed"}
+	slot := &repo.Slot{ID: slotID, TutorID: tutorID}
+
+	t.Run("Success", func(t *testing.T) {
+		srv, mockRepo, _, _ := setup(t)
+		ctx := ctxdata.WithUserID(context.Background(), tutorID)
+
+		mockRepo.EXPECT().GetLesson(gomock.Any(), lessonID).Return(lesson, nil)
+		mockRepo.EXPECT().GetSlot(gomock.Any(), slotID).Return(slot, nil)
+		mockRepo.EXPECT().UpsertLessonNotes(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, notes repo.LessonNotes) error {
+				require.Equal(t, lessonID, notes.LessonID)
+				require.Equal(t, "private", notes.PrivateNote)
+				require.Equal(t, []string{fileID}, notes.AttachmentFileIDs)
+				require.Equal(t, tutorID, notes.EditedBy)
+				return nil
+			},
+		)
+
+		resp, err := srv.UpsertLessonNotes(ctx, &pb.UpsertLessonNotesRequest{
+			LessonId:          lessonID,
+			PrivateNote:       "private",
+			Agenda:            "agenda",
+			Summary:           "summary",
+			AttachmentFileIds: []string{fileID},
+		})
+		require.NoError(t, err)
+		require.Equal(t, "private", resp.PrivateNote)
+		require.Equal(t, "summary", resp.Summary)
+	})
+
+	t.Run("Permission Denied - Student", func(t *testing.T) {
+		srv, mockRepo, _, _ := setup(t)
+		ctx := ctxdata.WithUserID(context.Background(), studentID)
+
+		mockRepo.EXPECT().GetLesson(gomock.Any(), lessonID).Return(lesson, nil)
+		mockRepo.EXPECT().GetSlot(gomock.Any(), slotID).Return(slot, nil)
+
+		_, err := srv.UpsertLessonNotes(ctx, &pb.UpsertLessonNotesRequest{LessonId: lessonID, Agenda: "agenda"})
+		require.Error(t, err)
+		st, _ := status.FromError(err)
+		require.Equal(t, codes.PermissionDenied, st.Code())
+	})
+
+	t.Run("Invalid Attachment ID", func(t *testing.T) {
+		srv, _, _, _ := setup(t)
+		ctx := ctxdata.WithUserID(context.Background(), tutorID)
+
+		_, err := srv.UpsertLessonNotes(ctx, &pb.UpsertLessonNotesRequest{
+			LessonId:          lessonID,
+			AttachmentFileIds: []string{"not-a-uuid"},
+		})
+		require.Error(t, err)
+		st, _ := status.FromError(err)
+		require.Equal(t, codes.InvalidArgument, st.Code())
+	})
+}
+
+func TestListLessonNotesHistory(t *testing.T) {
+	tutorID := "de305d54-75b4-431b-adb2-eb6b9e546014"
+	studentID := "de305d54-75b4-431b-adb2-eb6b9e546015"
+	lessonID := "de305d54-75b4-431b-adb2-eb6b9e546016"
+	slotID := "de305d54-75b4-431b-adb2-eb6b9e546017"
+
+	lesson := &repo.Lesson{ID: lessonID, SlotID: slotID, StudentID: studentID, Status: "completed"}
+	slot := &repo.Slot{ID: slotID, TutorID: tutorID}
+	now := time.Now()
+	history := []repo.LessonNotes{
+		{LessonID: lessonID, PrivateNote: "v2", Summary: "second", EditedBy: tutorID, EditedAt: now},
+		{LessonID: lessonID, PrivateNote: "v1", Summary: "first", EditedBy: tutorID, EditedAt: now.Add(-time.Hour)},
+	}
+
+	t.Run("Success - Tutor", func(t *testing.T) {
+		srv, mockRepo, _, _ := setup(t)
+		ctx := ctxdata.WithUserID(context.Background(), tutorID)
+
+		mockRepo.EXPECT().GetLesson(gomock.Any(), lessonID).Return(lesson, nil)
+		mockRepo.EXPECT().GetSlot(gomock.Any(), slotID).Return(slot, nil)
+		mockRepo.EXPECT().ListLessonNotesHistory(gomock.Any(), lessonID).Return(history, nil)
+
+		resp, err := srv.ListLessonNotesHistory(ctx, &pb.ListLessonNotesHistoryRequest{LessonId: lessonID})
+		require.NoError(t, err)
+		require.Len(t, resp.Versions, 2)
+		require.Equal(t, "v2", resp.Versions[0].PrivateNote)
+	})
+
+	t.Run("Success - Student Sees No Private Notes", func(t *testing.T) {
+		srv, mockRepo, _, _ := setup(t)
+		ctx := ctxdata.WithUserID(context.Background(), studentID)
+
+		mockRepo.EXPECT().GetLesson(gomock.Any(), lessonID).Return(lesson, nil)
+		mockRepo.EXPECT().GetSlot(gomock.Any(), slotID).Return(slot, nil)
+		mockRepo.EXPECT().ListLessonNotesHistory(gomock.Any(), lessonID).Return(history, nil)
+
+		resp, err := srv.ListLessonNotesHistory(ctx, &pb.ListLessonNotesHistoryRequest{LessonId: lessonID})
+		require.NoError(t, err)
+		require.Len(t, resp.Versions, 2)
+		for _, v := range resp.Versions {
+			require.Empty(t, v.PrivateNote)
+		}
+		require.Equal(t, "first", resp.Versions[1].Summary)
+	})
+
+	t.Run("Permission Denied - Unrelated User", func(t *testing.T) {
+		srv, mockRepo, _, _ := setup(t)
+		ctx := ctxdata.WithUserID(context.Background(), "de305d54-75b4-431b-adb2-eb6b9e546019")
+
+		mockRepo.EXPECT().GetLesson(gomock.Any(), lessonID).Return(lesson, nil)
+		mockRepo.EXPECT().GetSlot(gomock.Any(), slotID).Return(slot, nil)
+
+		_, err := srv.ListLessonNotesHistory(ctx, &pb.ListLessonNotesHistoryRequest{LessonId: lessonID})
+		require.Error(t, err)
+		st, _ := status.FromError(err)
+		require.Equal(t, codes.PermissionDenied, st.Code())
+	})
+}
+
+func TestCreateLessonPackage(t *testing.T) {
+	tutorID := "de305d54-75b4-431b-adb2-eb6b9e546014"
+	studentID := "de305d54-75b4-431b-adb2-eb6b9e546015"
+	validUntil := time.Now().AddDate(0, 1, 0)
+
+	t.Run("Success", func(t *testing.T) {
+		srv, mockRepo, mockUserClient, _ := setup(t)
+		ctx := ctxdata.WithUserID(context.Background(), tutorID)
+		ctx = ctxdata.WithUserRole(ctx, "tutor")
+
+		mockUserClient.EXPECT().GetTutorStudent(gomock.Any(), tutorID, studentID).Return(&userpb.TutorStudent{Status: "active"}, nil)
+		mockRepo.EXPECT().CreateLessonPackage(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, pkg repo.LessonPackage) error {
+				require.Equal(t, int32(8), pkg.LessonsTotal)
+				require.Equal(t, int32(8), pkg.LessonsRemaining)
+				require.Equal(t, int32(12000), pkg.PriceRub)
+				return nil
+			},
+		)
+
+		resp, err := srv.CreateLessonPackage(ctx, &pb.CreateLessonPackageRequest{
+			TutorId:      tutorID,
+			StudentId:    studentID,
+			LessonsCount: 8,
+			PriceRub:     12000,
+			ValidUntil:   timestamppb.New(validUntil),
+		})
+		require.NoError(t, err)
+		require.NotEmpty(t, resp.Id)
+		require.Equal(t, int32(8), resp.LessonsRemaining)
+	})
+
+	t.Run("Permission Denied - Student", func(t *testing.T) {
+		srv, _, _, _ := setup(t)
+		ctx := ctxdata.WithUserID(context.Background(), studentID)
+		ctx = ctxdata.WithUserRole(ctx, "student")
+
+		_, err := srv.CreateLessonPackage(ctx, &pb.CreateLessonPackageRequest{
+			TutorId:      tutorID,
+			StudentId:    studentID,
+			LessonsCount: 8,
+			ValidUntil:   timestamppb.New(validUntil),
+		})
+		require.Error(t, err)
+		st, _ := status.FromError(err)
+		require.Equal(t, codes.PermissionDenied, st.Code())
+	})
+
+	t.Run("Invalid Lessons Count", func(t *testing.T) {
+		srv, _, _, _ := setup(t)
+		ctx := ctxdata.WithUserID(context.Background(), tutorID)
+		ctx = ctxdata.WithUserRole(ctx, "tutor")
+
+		_, err := srv.CreateLessonPackage(ctx, &pb.CreateLessonPackageRequest{
+			TutorId:    tutorID,
+			StudentId:  studentID,
+			ValidUntil: timestamppb.New(validUntil),
+		})
+		require.Error(t, err)
+		st, _ := status.FromError(err)
+		require.Equal(t, codes.InvalidArgument, st.Code())
+	})
+}
+
+func TestGetPackageBalance(t *testing.T) {
+	tutorID := "de305d54-75b4-431b-adb2-eb6b9e546014"
+	studentID := "de305d54-75b4-431b-adb2-eb6b9e546015"
+	now := time.Now()
+
+	t.Run("Success - Student", func(t *testing.T) {
+		srv, mockRepo, mockUserClient, _ := setup(t)
+		ctx := ctxdata.WithUserID(context.Background(), studentID)
+		ctx = ctxdata.WithUserRole(ctx, "student")
+
+		mockUserClient.EXPECT().GetTutorStudent(gomock.Any(), tutorID, studentID).Return(&userpb.TutorStudent{Status: "active"}, nil)
+		mockRepo.EXPECT().ListActivePackages(gomock.Any(), tutorID, studentID, gomock.Any()).Return([]repo.LessonPackage{
+			{ID: "p1", TutorID: tutorID, StudentID: studentID, LessonsTotal: 8, LessonsRemaining: 3, ValidFrom: now.AddDate(0, 0, -10), ValidUntil: now.AddDate(0, 0, 20)},
+			{ID: "p2", TutorID: tutorID, StudentID: studentID, LessonsTotal: 8, LessonsRemaining: 8, ValidFrom: now.AddDate(0, 0, 20), ValidUntil: now.AddDate(0, 0, 50)},
+		}, nil)
+
+		resp, err := srv.GetPackageBalance(ctx, &pb.GetPackageBalanceRequest{TutorId: tutorID, StudentId: studentID})
+		require.NoError(t, err)
+		require.Equal(t, int32(3), resp.LessonsRemaining)
+		require.Len(t, resp.Packages, 2)
+	})
+
+	t.Run("Permission Denied - Unrelated User", func(t *testing.T) {
+		srv, _, _, _ := setup(t)
+		ctx := ctxdata.WithUserID(context.Background(), "de305d54-75b4-431b-adb2-eb6b9e546019")
+
+		_, err := srv.GetPackageBalance(ctx, &pb.GetPackageBalanceRequest{TutorId: tutorID, StudentId: studentID})
+		require.Error(t, err)
+		st, _ := status.FromError(err)
+		require.Equal(t, codes.PermissionDenied, st.Code())
+	})
+}
+
+func TestGetScheduleStats(t *testing.T) {
+	tutorID := "de305d54-75b4-431b-adb2-eb6b9e546014"
+	studentID := "de305d54-75b4-431b-adb2-eb6b9e546015"
+	from := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 1, 0)
+
+	t.Run("Success", func(t *testing.T) {
+		srv, mockRepo, _, _ := setup(t)
+		ctx := ctxdata.WithUserID(context.Background(), tutorID)
+		ctx = ctxdata.WithUserRole(ctx, "tutor")
+		timezone := "Europe/Moscow"
+
+		mockRepo.EXPECT().GetScheduleStats(gomock.Any(), tutorID, from, to, timezone).Return(&repo.ScheduleStats{
+			AvailableHours:            20,
+			BookedHours:               15,
+			TaughtHours:               12,
+			LessonsTotal:              20,
+			LessonsCompleted:          12,
+			LessonsCancelled:          5,
+			LessonsCancelledByTutor:   1,
+			LessonsCancelledByStudent: 4,
+			EarningsRub:               24000,
+			Students: []repo.StudentLessonStats{
+				{StudentID: studentID, LessonsTotal: 20, LessonsCompleted: 12, LessonsCancelled: 5, Hours: 15, EarningsRub: 24000},
+			},
+			HeatMap: []repo.HeatMapCell{
+				{Weekday: 1, Hour: 18, AvailableSlots: 4, BookedLessons: 3},
+			},
+		}, nil)
+
+		resp, err := srv.GetScheduleStats(ctx, &pb.GetScheduleStatsRequest{
+			TutorId:  tutorID,
+			From:     timestamppb.New(from),
+			To:       timestamppb.New(to),
+			Timezone: &timezone,
+		})
+		require.NoError(t, err)
+		require.InDelta(t, 0.75, resp.Utilization, 1e-9)
+		require.InDelta(t, 0.05, resp.TutorCancellationRate, 1e-9)
+		require.InDelta(t, 0.2, resp.StudentCancellationRate, 1e-9)
+		require.Equal(t, int64(24000), resp.EarningsRub)
+		require.Len(t, resp.Students, 1)
+		require.Equal(t, studentID, resp.Students[0].StudentId)
+		require.Len(t, resp.HeatMap, 1)
+		require.Equal(t, int32(3), resp.HeatMap[0].BookedLessons)
+	})
+
+	t.Run("Empty Period", func(t *testing.T) {
+		srv, mockRepo, _, _ := setup(t)
+		ctx := ctxdata.WithUserID(context.Background(), tutorID)
+		ctx = ctxdata.WithUserRole(ctx, "tutor")
+
+		mockRepo.EXPECT().GetScheduleStats(gomock.Any(), tutorID, from, to, "UTC").Return(&repo.ScheduleStats{}, nil)
+
+		resp, err := srv.GetScheduleStats(ctx, &pb.GetScheduleStatsRequest{
+			TutorId: tutorID,
+			From:    timestamppb.New(from),
+			To:      timestamppb.New(to),
+		})
+		require.NoError(t, err)
+		require.Zero(t, resp.Utilization)
+		require.Zero(t, resp.TutorCancellationRate)
+		require.Empty(t, resp.Students)
+	})
+
+	t.Run("Invalid Timezone", func(t *testing.T) {
+		srv, _, _, _ := setup(t)
+		ctx := ctxdata.WithUserID(context.Background(), tutorID)
+		ctx = ctxdata.WithUserRole(ctx, "tutor")
+		timezone := "Mars/Olympus"
+
+		_, err := srv.GetScheduleStats(ctx, &pb.GetScheduleStatsRequest{
+			TutorId:  tutorID,
+			From:     timestamppb.New(from),
+			To:       timestamppb.New(to),
+			Timezone: &timezone,
+		})
+		require.Error(t, err)
+		st, _ := status.FromError(err)
+		require.Equal(t, codes.InvalidArgument, st.Code())
+	})
+
+	t.Run("Period Too Long", func(t *testing.T) {
+		srv, _, _, _ := setup(t)
+		ctx := ctxdata.WithUserID(context.Background(), tutorID)
+		ctx = ctxdata.WithUserRole(ctx, "tutor")
+
+		_, err := srv.GetScheduleStats(ctx, &pb.GetScheduleStatsRequest{
+			TutorId: tutorID,
+			From:    timestamppb.New(from),
+			To:      timestamppb.New(from.AddDate(2, 0, 0)),
+		})
+		require.Error(t, err)
+		st, _ := status.FromError(err)
+		require.Equal(t, codes.InvalidArgument, st.Code())
+	})
+
+	t.Run("Permission Denied - Student", func(t *testing.T) {
+		srv, _, _, _ := setup(t)
+		ctx := ctxdata.WithUserID(context.Background(), studentID)
+		ctx = ctxdata.WithUserRole(ctx, "student")
+
+		_, err := srv.GetScheduleStats(ctx, &pb.GetScheduleStatsRequest{
+			TutorId: tutorID,
+			From:    timestamppb.New(from),
+			To:      timestamppb.New(to),
+		})
+		require.Error(t, err)
+		st, _ := status.FromError(err)
+		require.Equal(t, codes.PermissionDenied, st.Code())
+	})
+}
+
+func TestPackageCredits(t *testing.T) {
+	tutorID := "de305d54-75b4-431b-adb2-eb6b9e546014"
+	studentID := "de305d54-75b4-431b-adb2-eb6b9e546015"
+	slotID := "de305d54-75b4-431b-adb2-eb6b9e546016"
+	packageID := "de305d54-75b4-431b-adb2-eb6b9e546020"
+
+	t.Run("Booking Consumes Credit And Reports Low Balance", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockRepo := mocks.NewMockRepository(ctrl)
+		mockUserClient := mocks.NewMockIUserClient(ctrl)
+		sender := &fakeEventSender{}
+		srv := service.NewScheduleServer(mockRepo, mockUserClient, sender, nil)
+
+		ctx := ctxdata.WithUserID(context.Background(), studentID)
+		ctx = ctxdata.WithUserRole(ctx, "student")
+
+		now := time.Now()
+		slot := &repo.Slot{ID: slotID, TutorID: tutorID, StartsAt: now.Add(time.Hour), EndsAt: now.Add(2 * time.Hour)}
+
+		mockRepo.EXPECT().GetSlot(gomock.Any(), slotID).Return(slot, nil)
+		mockUserClient.EXPECT().GetTutorStudent(gomock.Any(), tutorID, studentID).Return(&userpb.TutorStudent{Status: "active"}, nil)
+		mockRepo.EXPECT().CreateLessonAndBookSlot(gomock.Any(), gomock.Any(), slotID).Return(&repo.LessonPackage{
+			ID: packageID, TutorID: tutorID, StudentID: studentID, LessonsTotal: 8, LessonsRemaining: 1,
+		}, nil)
+
+		resp, err := srv.CreateLesson(ctx, &pb.CreateLessonRequest{SlotId: slotID, StudentId: studentID})
+		require.NoError(t, err)
+		require.True(t, resp.IsPaid)
+		require.Equal(t, packageID, resp.GetPackageId())
+		require.Len(t, sender.packageEvents, 1)
+		require.Equal(t, "package_low_balance", sender.packageEvents[0].EventType)
+		require.Equal(t, int32(1), sender.packageEvents[0].LessonsRemaining)
+	})
+
+	t.Run("Low Balance Is Reported Once", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockRepo := mocks.NewMockRepository(ctrl)
+		mockUserClient := mocks.NewMockIUserClient(ctrl)
+		sender := &fakeEventSender{}
+		srv := service.NewScheduleServer(mockRepo, mockUserClient, sender, nil)
+
+		ctx := ctxdata.WithUserID(context.Background(), studentID)
+		ctx = ctxdata.WithUserRole(ctx, "student")
+
+		now := time.Now()
+		slot := &repo.Slot{ID: slotID, TutorID: tutorID, StartsAt: now.Add(time.Hour), EndsAt: now.Add(2 * time.Hour)}
+
+		mockRepo.EXPECT().GetSlot(gomock.Any(), slotID).Return(slot, nil).Times(2)
+		mockUserClient.EXPECT().GetTutorStudent(gomock.Any(), tutorID, studentID).Return(&userpb.TutorStudent{Status: "active"}, nil).Times(2)
+		gomock.InOrder(
+			mockRepo.EXPECT().CreateLessonAndBookSlot(gomock.Any(), gomock.Any(), slotID).Return(&repo.LessonPackage{
+				ID: packageID, TutorID: tutorID, StudentID: studentID, LessonsTotal: 8, LessonsRemaining: 1,
+			}, nil),
+			mockRepo.EXPECT().CreateLessonAndBookSlot(gomock.Any(), gomock.Any(), slotID).Return(&repo.LessonPackage{
+				ID: packageID, TutorID: tutorID, StudentID: studentID, LessonsTotal: 8, LessonsRemaining: 0,
+			}, nil),
+		)
+
+		for range 2 {
+			_, err := srv.CreateLesson(ctx, &pb.CreateLessonRequest{SlotId: slotID, StudentId: studentID})
+			require.NoError(t, err)
+		}
+		require.Len(t, sender.packageEvents, 1)
+		require.Equal(t, int32(1), sender.packageEvents[0].LessonsRemaining)
+	})
+
+	t.Run("Tutor No-Show Refunds Credit", func(t *testing.T) {
+		srv, mockRepo, _, _ := setup(t)
+		ctx := ctxdata.WithUserID(context.Background(), tutorID)
+
+		lessonID := "de305d54-75b4-431b-adb2-eb6b9e546017"
+		startsAt := time.Now().Add(-2 * time.Hour)
+		pkgID := packageID
+		noShow := "no_show_tutor"
+
+		mockRepo.EXPECT().GetLesson(gomock.Any(), lessonID).Return(&repo.Lesson{
+			ID: lessonID, SlotID: slotID, StudentID: studentID, Status: "completed", IsPaid: true, PackageID: &pkgID,
+		}, nil)
+		mockRepo.EXPECT().GetSlot(gomock.Any(), slotID).Return(&repo.Slot{ID: slotID, TutorID: tutorID, StartsAt: startsAt, EndsAt: startsAt.Add(time.Hour)}, nil)
+		mockRepo.EXPECT().UpdateAttendance(gomock.Any(), gomock.Any(), repo.CreditRefund).DoAndReturn(
+			func(_ context.Context, l repo.Lesson, _ repo.CreditAction) (*repo.LessonPackage, error) {
+				require.Equal(t, "no_show_tutor", l.Status)
+				return nil, nil
+			},
+		)
+
+		resp, err := srv.UpdateAttendance(ctx, &pb.UpdateAttendanceRequest{Id: lessonID, Status: &noShow})
+		require.NoError(t, err)
+		require.False(t, resp.IsPaid)
+		require.Empty(t, resp.GetPackageId())
+	})
+
+	t.Run("Failed Refund Keeps Lesson Paid", func(t *testing.T) {
+		srv, mockRepo, _, _ := setup(t)
+		ctx := ctxdata.WithUserID(context.Background(), tutorID)
+
+		lessonID := "de305d54-75b4-431b-adb2-eb6b9e546017"
+		startsAt := time.Now().Add(-2 * time.Hour)
+		pkgID := packageID
+		noShow := "no_show_tutor"
+
+		mockRepo.EXPECT().GetLesson(gomock.Any(), lessonID).Return(&repo.Lesson{
+			ID: lessonID, SlotID: slotID, StudentID: studentID, Status: "completed", IsPaid: true, PackageID: &pkgID,
+		}, nil)
+		mockRepo.EXPECT().GetSlot(gomock.Any(), slotID).Return(&repo.Slot{ID: slotID, TutorID: tutorID, StartsAt: startsAt, EndsAt: startsAt.Add(time.Hour)}, nil)
+		mockRepo.EXPECT().UpdateAttendance(gomock.Any(), gomock.Any(), repo.CreditRefund).Return(nil, errors.New("db down"))
+
+		_, err := srv.UpdateAttendance(ctx, &pb.UpdateAttendanceRequest{Id: lessonID, Status: &noShow})
+		require.Equal(t, codes.Internal, status.Code(err))
+	})
+
+	t.Run("Completion Consumes Credit Of Unpaid Lesson", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockRepo := mocks.NewMockRepository(ctrl)
+		sender := &fakeEventSender{}
+		srv := service.NewScheduleServer(mockRepo, mocks.NewMockIUserClient(ctrl), sender, nil)
+		ctx := ctxdata.WithUserID(context.Background(), tutorID)
+
+		lessonID := "de305d54-75b4-431b-adb2-eb6b9e546017"
+		startsAt := time.Now().Add(-2 * time.Hour)
+		completed := "completed"
+
+		mockRepo.EXPECT().GetLesson(gomock.Any(), lessonID).Return(&repo.Lesson{
+			ID: lessonID, SlotID: slotID, StudentID: studentID, Status: "booked", TutorID: tutorID, StartsAt: startsAt,
+		}, nil)
+		mockRepo.EXPECT().GetSlot(gomock.Any(), slotID).Return(&repo.Slot{ID: slotID, TutorID: tutorID, StartsAt: startsAt, EndsAt: startsAt.Add(time.Hour)}, nil)
+		mockRepo.EXPECT().UpdateAttendance(gomock.Any(), gomock.Any(), repo.CreditConsume).Return(&repo.LessonPackage{
+			ID: packageID, TutorID: tutorID, StudentID: studentID, LessonsTotal: 8, LessonsRemaining: 3,
+		}, nil)
+
+		resp, err := srv.UpdateAttendance(ctx, &pb.UpdateAttendanceRequest{Id: lessonID, Status: &completed})
+		require.NoError(t, err)
+		require.True(t, resp.IsPaid)
+		require.Equal(t, packageID, resp.GetPackageId())
+		require.Empty(t, sender.packageEvents)
+	})
+
+	t.Run("Expired Packages Are Reported", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockRepo := mocks.NewMockRepository(ctrl)
+		sender := &fakeEventSender{}
+		srv := service.NewScheduleServer(mockRepo, mocks.NewMockIUserClient(ctrl), sender, nil)
+
+		mockRepo.EXPECT().MarkExpiredPackagesNotified(gomock.Any(), gomock.Any()).Return([]repo.LessonPackage{
+			{ID: packageID, TutorID: tutorID, StudentID: studentID, LessonsRemaining: 2},
+		}, nil)
+
+		err := srv.NotifyExpiredPackages(context.Background())
+		require.NoError(t, err)
+		require.Len(t, sender.packageEvents, 1)
+		require.Equal(t, "package_expired", sender.packageEvents[0].EventType)
+	})
+}
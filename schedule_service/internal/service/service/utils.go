@@ -78,9 +78,69 @@ func convertrepoLessonToProto(lesson *repo.Lesson) *pb.Lesson {
 		protoLesson.PaymentInfo = lesson.PaymentInfo
 	}
 
+	if lesson.CancelReason != nil {
+		protoLesson.CancelReason = lesson.CancelReason
+	}
+
+	if lesson.SeriesID != nil {
+		protoLesson.SeriesId = lesson.SeriesID
+	}
+
+	if lesson.ActualStartsAt != nil {
+		protoLesson.ActualStartsAt = timestamppb.New(*lesson.ActualStartsAt)
+	}
+
+	if lesson.ActualEndsAt != nil {
+		protoLesson.ActualEndsAt = timestamppb.New(*lesson.ActualEndsAt)
+	}
+
+	if lesson.PackageID != nil {
+		protoLesson.PackageId = lesson.PackageID
+	}
+
+	if lesson.CancelledBy != nil {
+		protoLesson.CancelledBy = lesson.CancelledBy
+	}
+
+	if lesson.TutorID != "" {
+		protoLesson.TutorId = &lesson.TutorID
+	}
+
+	if !lesson.StartsAt.IsZero() {
+		protoLesson.StartsAt = timestamppb.New(lesson.StartsAt)
+		protoLesson.EndsAt = timestamppb.New(lesson.EndsAt)
+	}
+
 	return protoLesson
 }
 
+// cancellingParty tells whether a cancellation by userID comes from the tutor or the student.
+func cancellingParty(userID, tutorID string) string {
+	if userID == tutorID {
+		return "tutor"
+	}
+	return "student"
+}
+
+// convertrepoNotesToProto converts lesson notes; the private note is only
+// included for the tutor.
+func convertrepoNotesToProto(notes *repo.LessonNotes, withPrivate bool) *pb.LessonNotes {
+	protoNotes := &pb.LessonNotes{
+		LessonId:          notes.LessonID,
+		Agenda:            notes.Agenda,
+		Summary:           notes.Summary,
+		AttachmentFileIds: notes.AttachmentFileIDs,
+		EditedBy:          notes.EditedBy,
+		EditedAt:          timestamppb.New(notes.EditedAt),
+	}
+
+	if withPrivate {
+		protoNotes.PrivateNote = notes.PrivateNote
+	}
+
+	return protoNotes
+}
+
 func createListLessonsResponse(lessons []repo.Lesson) *pb.ListLessonsResponse {
 	protoLessons := make([]*pb.Lesson, 0, len(lessons))
 
@@ -95,6 +155,86 @@ func createListLessonsResponse(lessons []repo.Lesson) *pb.ListLessonsResponse {
 	}
 }
 
+func convertrepoSlotToProto(slot *repo.Slot) *pb.Slot {
+	protoSlot := &pb.Slot{
+		Id:        slot.ID,
+		TutorId:   slot.TutorID,
+		StartsAt:  timestamppb.New(slot.StartsAt),
+		EndsAt:    timestamppb.New(slot.EndsAt),
+		IsBooked:  slot.IsBooked,
+		CreatedAt: timestamppb.New(slot.CreatedAt),
+	}
+
+	if slot.EditedAt != nil {
+		protoSlot.EditedAt = timestamppb.New(*slot.EditedAt)
+	}
+
+	return protoSlot
+}
+
+func convertrepoPackageToProto(pkg *repo.LessonPackage) *pb.LessonPackage {
+	return &pb.LessonPackage{
+		Id:               pkg.ID,
+		TutorId:          pkg.TutorID,
+		StudentId:        pkg.StudentID,
+		LessonsTotal:     pkg.LessonsTotal,
+		LessonsRemaining: pkg.LessonsRemaining,
+		PriceRub:         pkg.PriceRub,
+		ValidFrom:        timestamppb.New(pkg.ValidFrom),
+		ValidUntil:       timestamppb.New(pkg.ValidUntil),
+		CreatedAt:        timestamppb.New(pkg.CreatedAt),
+	}
+}
+
+func convertrepoStatsToProto(stats *repo.ScheduleStats) *pb.ScheduleStats {
+	resp := &pb.ScheduleStats{
+		AvailableHours:            stats.AvailableHours,
+		BookedHours:               stats.BookedHours,
+		TaughtHours:               stats.TaughtHours,
+		LessonsTotal:              stats.LessonsTotal,
+		LessonsCompleted:          stats.LessonsCompleted,
+		LessonsCancelled:          stats.LessonsCancelled,
+		LessonsCancelledByTutor:   stats.LessonsCancelledByTutor,
+		LessonsCancelledByStudent: stats.LessonsCancelledByStudent,
+		NoShowStudent:             stats.NoShowStudent,
+		NoShowTutor:               stats.NoShowTutor,
+		EarningsRub:               stats.EarningsRub,
+		ExpectedEarningsRub:       stats.ExpectedEarningsRub,
+		Students:                  make([]*pb.StudentLessonStats, 0, len(stats.Students)),
+		HeatMap:                   make([]*pb.ScheduleHeatMapCell, 0, len(stats.HeatMap)),
+	}
+
+	if stats.AvailableHours > 0 {
+		resp.Utilization = stats.BookedHours / stats.AvailableHours
+	}
+	if stats.LessonsTotal > 0 {
+		resp.TutorCancellationRate = float64(stats.LessonsCancelledByTutor) / float64(stats.LessonsTotal)
+		resp.StudentCancellationRate = float64(stats.LessonsCancelledByStudent) / float64(stats.LessonsTotal)
+	}
+
+	for _, student := range stats.Students {
+		resp.Students = append(resp.Students, &pb.StudentLessonStats{
+			StudentId:        student.StudentID,
+			LessonsTotal:     student.LessonsTotal,
+			LessonsCompleted: student.LessonsCompleted,
+			LessonsCancelled: student.LessonsCancelled,
+			Hours:            student.Hours,
+			EarningsRub:      student.EarningsRub,
+		})
+	}
+
+	for _, cell := range stats.HeatMap {
+		resp.HeatMap = append(resp.HeatMap, &pb.ScheduleHeatMapCell{
+			Weekday:        cell.Weekday,
+			Hour:           cell.Hour,
+			AvailableSlots: cell.AvailableSlots,
+			BookedLessons:  cell.BookedLessons,
+		})
+	}
+
+	return resp
+}
+
 func validateTimeRange(start, end time.Time) bool {
 	return start.Before(end)
 }
@@ -149,3 +289,52 @@ func IsTutor(ctx context.Context, userID string) (bool, error) {
 
 	return role == "tutor", nil
 }
+
+// buildSeriesOccurrences expands a weekly pattern starting with the first lesson
+// [startsAt, endsAt). Wall-clock time is kept in loc, so lessons do not shift
+// across DST changes. At most limit occurrences are returned.
+func buildSeriesOccurrences(startsAt, endsAt time.Time, loc *time.Location, weekdays []time.Weekday, until *time.Time, limit int) []repo.SeriesOccurrence {
+	duration := endsAt.Sub(startsAt)
+	first := startsAt.In(loc)
+
+	days := make(map[time.Weekday]bool, len(weekdays))
+	for _, wd := range weekdays {
+		days[wd] = true
+	}
+	if len(days) == 0 {
+		days[first.Weekday()] = true
+	}
+
+	occurrences := make([]repo.SeriesOccurrence, 0)
+	for i := 0; len(occurrences) < limit; i++ {
+		candidate := time.Date(first.Year(), first.Month(), first.Day()+i,
+			first.Hour(), first.Minute(), first.Second(), first.Nanosecond(), loc)
+
+		if until != nil && candidate.After(*until) {
+			break
+		}
+		if !days[candidate.Weekday()] || candidate.Before(startsAt) {
+			continue
+		}
+
+		occurrences = append(occurrences, repo.SeriesOccurrence{
+			StartsAt: candidate,
+			EndsAt:   candidate.Add(duration),
+		})
+	}
+
+	return occurrences
+}
+
+func seriesFailureReason(err error) string {
+	switch {
+	case errors.Is(err, ErrSlotBooked):
+		return "slot is already booked"
+	case errors.Is(err, ErrSlotNotFound):
+		return "no free slot at this time"
+	case errors.Is(err, ErrSlotConflict):
+		return "overlaps another slot"
+	default:
+		return "failed to book lesson"
+	}
+}
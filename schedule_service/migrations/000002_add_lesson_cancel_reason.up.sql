@@ -0,0 +1 @@
+ALTER TABLE lessons ADD COLUMN IF NOT EXISTS cancel_reason TEXT;
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_lessons_series;
+ALTER TABLE lessons DROP COLUMN IF EXISTS series_id;
+DROP TABLE IF EXISTS lesson_series;
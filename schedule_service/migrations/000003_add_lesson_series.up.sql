@@ -0,0 +1,11 @@
+-- Серии регулярных занятий
+CREATE TABLE IF NOT EXISTS lesson_series (
+    id UUID PRIMARY KEY,
+    tutor_id UUID NOT NULL,
+    student_id UUID NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+ALTER TABLE lessons ADD COLUMN IF NOT EXISTS series_id UUID REFERENCES lesson_series(id);
+
+CREATE INDEX IF NOT EXISTS idx_lessons_series ON lessons(series_id) WHERE series_id IS NOT NULL;
@@ -0,0 +1,9 @@
+ALTER TABLE lessons DROP COLUMN IF EXISTS actual_ends_at;
+ALTER TABLE lessons DROP COLUMN IF EXISTS actual_starts_at;
+
+UPDATE lessons SET status = 'completed' WHERE status = 'no_show_student';
+UPDATE lessons SET status = 'cancelled' WHERE status = 'no_show_tutor';
+
+ALTER TABLE lessons DROP CONSTRAINT IF EXISTS lessons_status_check;
+ALTER TABLE lessons ADD CONSTRAINT lessons_status_check
+    CHECK (status IN ('booked', 'cancelled', 'completed'));
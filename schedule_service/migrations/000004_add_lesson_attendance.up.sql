@@ -0,0 +1,6 @@
+ALTER TABLE lessons DROP CONSTRAINT IF EXISTS lessons_status_check;
+ALTER TABLE lessons ADD CONSTRAINT lessons_status_check
+    CHECK (status IN ('booked', 'cancelled', 'completed', 'no_show_student', 'no_show_tutor'));
+
+ALTER TABLE lessons ADD COLUMN IF NOT EXISTS actual_starts_at TIMESTAMP WITH TIME ZONE;
+ALTER TABLE lessons ADD COLUMN IF NOT EXISTS actual_ends_at TIMESTAMP WITH TIME ZONE;
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS lesson_notes_history;
+DROP TABLE IF EXISTS lesson_notes;
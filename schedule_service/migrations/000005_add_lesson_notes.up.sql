@@ -0,0 +1,24 @@
+-- Заметки к уроку: текущая версия
+CREATE TABLE IF NOT EXISTS lesson_notes (
+    lesson_id UUID PRIMARY KEY REFERENCES lessons(id),
+    private_note TEXT NOT NULL DEFAULT '',
+    agenda TEXT NOT NULL DEFAULT '',
+    summary TEXT NOT NULL DEFAULT '',
+    attachment_file_ids UUID[] NOT NULL DEFAULT '{}',
+    edited_by UUID NOT NULL,
+    edited_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+-- История правок заметок, каждая сохранённая версия
+CREATE TABLE IF NOT EXISTS lesson_notes_history (
+    id UUID PRIMARY KEY,
+    lesson_id UUID NOT NULL REFERENCES lessons(id),
+    private_note TEXT NOT NULL,
+    agenda TEXT NOT NULL,
+    summary TEXT NOT NULL,
+    attachment_file_ids UUID[] NOT NULL,
+    edited_by UUID NOT NULL,
+    edited_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_lesson_notes_history_lesson ON lesson_notes_history(lesson_id, edited_at);
@@ -0,0 +1,2 @@
+ALTER TABLE lessons DROP COLUMN IF EXISTS package_id;
+DROP TABLE IF EXISTS lesson_packages;
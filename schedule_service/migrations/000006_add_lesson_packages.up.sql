@@ -0,0 +1,21 @@
+-- Пакеты предоплаченных занятий
+CREATE TABLE IF NOT EXISTS lesson_packages (
+    id UUID PRIMARY KEY,
+    tutor_id UUID NOT NULL,
+    student_id UUID NOT NULL,
+    lessons_total INTEGER NOT NULL CHECK (lessons_total > 0),
+    lessons_remaining INTEGER NOT NULL CHECK (lessons_remaining >= 0),
+    price_rub INTEGER NOT NULL CHECK (price_rub >= 0),
+    valid_from TIMESTAMP WITH TIME ZONE NOT NULL,
+    valid_until TIMESTAMP WITH TIME ZONE NOT NULL,
+    expiry_notified BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+
+    CONSTRAINT lesson_packages_validity CHECK (valid_from < valid_until)
+);
+
+CREATE INDEX IF NOT EXISTS idx_lesson_packages_pair ON lesson_packages(tutor_id, student_id, valid_until);
+CREATE INDEX IF NOT EXISTS idx_lesson_packages_expiry ON lesson_packages(valid_until) WHERE expiry_notified = false;
+
+-- Пакет, из которого оплачен урок
+ALTER TABLE lessons ADD COLUMN IF NOT EXISTS package_id UUID REFERENCES lesson_packages(id);
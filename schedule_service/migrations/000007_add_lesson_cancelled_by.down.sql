@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_slots_tutor_starts_at;
+ALTER TABLE lessons DROP COLUMN IF EXISTS cancelled_by;
@@ -0,0 +1,6 @@
+-- Кто отменил урок: репетитор или ученик
+ALTER TABLE lessons ADD COLUMN IF NOT EXISTS cancelled_by TEXT
+    CHECK (cancelled_by IN ('tutor', 'student'));
+
+-- Статистика репетитора выбирает слоты за период
+CREATE INDEX IF NOT EXISTS idx_slots_tutor_starts_at ON slots(tutor_id, starts_at);
@@ -0,0 +1,7 @@
+-- Миграция необратима намеренно: после неё на слоте может быть несколько
+-- отменённых уроков, и вернуть UNIQUE (slot_id) без удаления истории уроков нельзя
+DO $$
+BEGIN
+    RAISE EXCEPTION 'migration 000008_allow_slot_rebooking is irreversible';
+END
+$$;
@@ -0,0 +1,5 @@
+-- Освобождённый отменой слот можно забронировать снова: на слоте может быть
+-- несколько отменённых уроков, но не больше одного действующего
+ALTER TABLE lessons DROP CONSTRAINT IF EXISTS unique_slot_lesson;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_lessons_slot_active ON lessons(slot_id)
+    WHERE status <> 'cancelled';
@@ -1,6 +1,6 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.36.5
+// 	protoc-gen-go v1.36.6
 // 	protoc        v3.21.12
 // source: schedule_service.proto
 
@@ -25,9 +25,11 @@ const (
 type LessonStatusFilter int32
 
 const (
-	LessonStatusFilter_BOOKED    LessonStatusFilter = 0
-	LessonStatusFilter_CANCELLED LessonStatusFilter = 1
-	LessonStatusFilter_COMPLETED LessonStatusFilter = 2
+	LessonStatusFilter_BOOKED          LessonStatusFilter = 0
+	LessonStatusFilter_CANCELLED       LessonStatusFilter = 1
+	LessonStatusFilter_COMPLETED       LessonStatusFilter = 2
+	LessonStatusFilter_NO_SHOW_STUDENT LessonStatusFilter = 3
+	LessonStatusFilter_NO_SHOW_TUTOR   LessonStatusFilter = 4
 )
 
 // Enum value maps for LessonStatusFilter.
@@ -36,11 +38,15 @@ var (
 		0: "BOOKED",
 		1: "CANCELLED",
 		2: "COMPLETED",
+		3: "NO_SHOW_STUDENT",
+		4: "NO_SHOW_TUTOR",
 	}
 	LessonStatusFilter_value = map[string]int32{
-		"BOOKED":    0,
-		"CANCELLED": 1,
-		"COMPLETED": 2,
+		"BOOKED":          0,
+		"CANCELLED":       1,
+		"COMPLETED":       2,
+		"NO_SHOW_STUDENT": 3,
+		"NO_SHOW_TUTOR":   4,
 	}
 )
 
@@ -675,6 +681,238 @@ func (x *CancelLessonRequest) GetId() string {
 	return ""
 }
 
+type UpdateAttendanceRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status         *string                `protobuf:"bytes,2,opt,name=status,proto3,oneof" json:"status,omitempty"` // completed / no_show_student / no_show_tutor
+	ActualStartsAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=actual_starts_at,json=actualStartsAt,proto3,oneof" json:"actual_starts_at,omitempty"`
+	ActualEndsAt   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=actual_ends_at,json=actualEndsAt,proto3,oneof" json:"actual_ends_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UpdateAttendanceRequest) Reset() {
+	*x = UpdateAttendanceRequest{}
+	mi := &file_schedule_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAttendanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAttendanceRequest) ProtoMessage() {}
+
+func (x *UpdateAttendanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_schedule_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAttendanceRequest.ProtoReflect.Descriptor instead.
+func (*UpdateAttendanceRequest) Descriptor() ([]byte, []int) {
+	return file_schedule_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *UpdateAttendanceRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateAttendanceRequest) GetStatus() string {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return ""
+}
+
+func (x *UpdateAttendanceRequest) GetActualStartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ActualStartsAt
+	}
+	return nil
+}
+
+func (x *UpdateAttendanceRequest) GetActualEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ActualEndsAt
+	}
+	return nil
+}
+
+type UpsertLessonNotesRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	LessonId          string                 `protobuf:"bytes,1,opt,name=lesson_id,json=lessonId,proto3" json:"lesson_id,omitempty"`
+	PrivateNote       string                 `protobuf:"bytes,2,opt,name=private_note,json=privateNote,proto3" json:"private_note,omitempty"`
+	Agenda            string                 `protobuf:"bytes,3,opt,name=agenda,proto3" json:"agenda,omitempty"`
+	Summary           string                 `protobuf:"bytes,4,opt,name=summary,proto3" json:"summary,omitempty"`
+	AttachmentFileIds []string               `protobuf:"bytes,5,rep,name=attachment_file_ids,json=attachmentFileIds,proto3" json:"attachment_file_ids,omitempty"` // id файлов из file_service
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *UpsertLessonNotesRequest) Reset() {
+	*x = UpsertLessonNotesRequest{}
+	mi := &file_schedule_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpsertLessonNotesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpsertLessonNotesRequest) ProtoMessage() {}
+
+func (x *UpsertLessonNotesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_schedule_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpsertLessonNotesRequest.ProtoReflect.Descriptor instead.
+func (*UpsertLessonNotesRequest) Descriptor() ([]byte, []int) {
+	return file_schedule_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *UpsertLessonNotesRequest) GetLessonId() string {
+	if x != nil {
+		return x.LessonId
+	}
+	return ""
+}
+
+func (x *UpsertLessonNotesRequest) GetPrivateNote() string {
+	if x != nil {
+		return x.PrivateNote
+	}
+	return ""
+}
+
+func (x *UpsertLessonNotesRequest) GetAgenda() string {
+	if x != nil {
+		return x.Agenda
+	}
+	return ""
+}
+
+func (x *UpsertLessonNotesRequest) GetSummary() string {
+	if x != nil {
+		return x.Summary
+	}
+	return ""
+}
+
+func (x *UpsertLessonNotesRequest) GetAttachmentFileIds() []string {
+	if x != nil {
+		return x.AttachmentFileIds
+	}
+	return nil
+}
+
+type ListLessonNotesHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LessonId      string                 `protobuf:"bytes,1,opt,name=lesson_id,json=lessonId,proto3" json:"lesson_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLessonNotesHistoryRequest) Reset() {
+	*x = ListLessonNotesHistoryRequest{}
+	mi := &file_schedule_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLessonNotesHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLessonNotesHistoryRequest) ProtoMessage() {}
+
+func (x *ListLessonNotesHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_schedule_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLessonNotesHistoryRequest.ProtoReflect.Descriptor instead.
+func (*ListLessonNotesHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_schedule_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListLessonNotesHistoryRequest) GetLessonId() string {
+	if x != nil {
+		return x.LessonId
+	}
+	return ""
+}
+
+type ListLessonNotesHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Versions      []*LessonNotes         `protobuf:"bytes,1,rep,name=versions,proto3" json:"versions,omitempty"` // от новых к старым
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLessonNotesHistoryResponse) Reset() {
+	*x = ListLessonNotesHistoryResponse{}
+	mi := &file_schedule_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLessonNotesHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLessonNotesHistoryResponse) ProtoMessage() {}
+
+func (x *ListLessonNotesHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_schedule_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLessonNotesHistoryResponse.ProtoReflect.Descriptor instead.
+func (*ListLessonNotesHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_schedule_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListLessonNotesHistoryResponse) GetVersions() []*LessonNotes {
+	if x != nil {
+		return x.Versions
+	}
+	return nil
+}
+
 type MarkAsPaidRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -684,7 +922,7 @@ type MarkAsPaidRequest struct {
 
 func (x *MarkAsPaidRequest) Reset() {
 	*x = MarkAsPaidRequest{}
-	mi := &file_schedule_service_proto_msgTypes[11]
+	mi := &file_schedule_service_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -696,7 +934,7 @@ func (x *MarkAsPaidRequest) String() string {
 func (*MarkAsPaidRequest) ProtoMessage() {}
 
 func (x *MarkAsPaidRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_schedule_service_proto_msgTypes[11]
+	mi := &file_schedule_service_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -709,7 +947,7 @@ func (x *MarkAsPaidRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MarkAsPaidRequest.ProtoReflect.Descriptor instead.
 func (*MarkAsPaidRequest) Descriptor() ([]byte, []int) {
-	return file_schedule_service_proto_rawDescGZIP(), []int{11}
+	return file_schedule_service_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *MarkAsPaidRequest) GetId() string {
@@ -729,7 +967,7 @@ type ListLessonsByTutorRequest struct {
 
 func (x *ListLessonsByTutorRequest) Reset() {
 	*x = ListLessonsByTutorRequest{}
-	mi := &file_schedule_service_proto_msgTypes[12]
+	mi := &file_schedule_service_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -741,7 +979,7 @@ func (x *ListLessonsByTutorRequest) String() string {
 func (*ListLessonsByTutorRequest) ProtoMessage() {}
 
 func (x *ListLessonsByTutorRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_schedule_service_proto_msgTypes[12]
+	mi := &file_schedule_service_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -754,7 +992,7 @@ func (x *ListLessonsByTutorRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListLessonsByTutorRequest.ProtoReflect.Descriptor instead.
 func (*ListLessonsByTutorRequest) Descriptor() ([]byte, []int) {
-	return file_schedule_service_proto_rawDescGZIP(), []int{12}
+	return file_schedule_service_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *ListLessonsByTutorRequest) GetTutorId() string {
@@ -781,7 +1019,7 @@ type ListLessonsByStudentRequest struct {
 
 func (x *ListLessonsByStudentRequest) Reset() {
 	*x = ListLessonsByStudentRequest{}
-	mi := &file_schedule_service_proto_msgTypes[13]
+	mi := &file_schedule_service_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -793,7 +1031,7 @@ func (x *ListLessonsByStudentRequest) String() string {
 func (*ListLessonsByStudentRequest) ProtoMessage() {}
 
 func (x *ListLessonsByStudentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_schedule_service_proto_msgTypes[13]
+	mi := &file_schedule_service_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -806,7 +1044,7 @@ func (x *ListLessonsByStudentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListLessonsByStudentRequest.ProtoReflect.Descriptor instead.
 func (*ListLessonsByStudentRequest) Descriptor() ([]byte, []int) {
-	return file_schedule_service_proto_rawDescGZIP(), []int{13}
+	return file_schedule_service_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *ListLessonsByStudentRequest) GetStudentId() string {
@@ -834,7 +1072,7 @@ type ListLessonsByPairRequest struct {
 
 func (x *ListLessonsByPairRequest) Reset() {
 	*x = ListLessonsByPairRequest{}
-	mi := &file_schedule_service_proto_msgTypes[14]
+	mi := &file_schedule_service_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -846,7 +1084,7 @@ func (x *ListLessonsByPairRequest) String() string {
 func (*ListLessonsByPairRequest) ProtoMessage() {}
 
 func (x *ListLessonsByPairRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_schedule_service_proto_msgTypes[14]
+	mi := &file_schedule_service_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -859,7 +1097,7 @@ func (x *ListLessonsByPairRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListLessonsByPairRequest.ProtoReflect.Descriptor instead.
 func (*ListLessonsByPairRequest) Descriptor() ([]byte, []int) {
-	return file_schedule_service_proto_rawDescGZIP(), []int{14}
+	return file_schedule_service_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *ListLessonsByPairRequest) GetTutorId() string {
@@ -892,7 +1130,7 @@ type ListCompletedUnpaidLessonsRequest struct {
 
 func (x *ListCompletedUnpaidLessonsRequest) Reset() {
 	*x = ListCompletedUnpaidLessonsRequest{}
-	mi := &file_schedule_service_proto_msgTypes[15]
+	mi := &file_schedule_service_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -904,7 +1142,7 @@ func (x *ListCompletedUnpaidLessonsRequest) String() string {
 func (*ListCompletedUnpaidLessonsRequest) ProtoMessage() {}
 
 func (x *ListCompletedUnpaidLessonsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_schedule_service_proto_msgTypes[15]
+	mi := &file_schedule_service_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -917,7 +1155,7 @@ func (x *ListCompletedUnpaidLessonsRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use ListCompletedUnpaidLessonsRequest.ProtoReflect.Descriptor instead.
 func (*ListCompletedUnpaidLessonsRequest) Descriptor() ([]byte, []int) {
-	return file_schedule_service_proto_rawDescGZIP(), []int{15}
+	return file_schedule_service_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *ListCompletedUnpaidLessonsRequest) GetAfter() *timestamppb.Timestamp {
@@ -927,28 +1165,32 @@ func (x *ListCompletedUnpaidLessonsRequest) GetAfter() *timestamppb.Timestamp {
 	return nil
 }
 
-type ListLessonsResponse struct {
+type BlockPeriodRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Lessons       []*Lesson              `protobuf:"bytes,1,rep,name=lessons,proto3" json:"lessons,omitempty"`
+	TutorId       string                 `protobuf:"bytes,1,opt,name=tutor_id,json=tutorId,proto3" json:"tutor_id,omitempty"`
+	From          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To            *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	Reason        *string                `protobuf:"bytes,4,opt,name=reason,proto3,oneof" json:"reason,omitempty"`
+	DryRun        bool                   `protobuf:"varint,5,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"` // если true, ничего не меняет и возвращает только сводку
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListLessonsResponse) Reset() {
-	*x = ListLessonsResponse{}
-	mi := &file_schedule_service_proto_msgTypes[16]
+func (x *BlockPeriodRequest) Reset() {
+	*x = BlockPeriodRequest{}
+	mi := &file_schedule_service_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListLessonsResponse) String() string {
+func (x *BlockPeriodRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListLessonsResponse) ProtoMessage() {}
+func (*BlockPeriodRequest) ProtoMessage() {}
 
-func (x *ListLessonsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_schedule_service_proto_msgTypes[16]
+func (x *BlockPeriodRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_schedule_service_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -959,49 +1201,71 @@ func (x *ListLessonsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListLessonsResponse.ProtoReflect.Descriptor instead.
-func (*ListLessonsResponse) Descriptor() ([]byte, []int) {
-	return file_schedule_service_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use BlockPeriodRequest.ProtoReflect.Descriptor instead.
+func (*BlockPeriodRequest) Descriptor() ([]byte, []int) {
+	return file_schedule_service_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *ListLessonsResponse) GetLessons() []*Lesson {
+func (x *BlockPeriodRequest) GetTutorId() string {
 	if x != nil {
-		return x.Lessons
+		return x.TutorId
+	}
+	return ""
+}
+
+func (x *BlockPeriodRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
 	}
 	return nil
 }
 
-type Lesson struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	SlotId         string                 `protobuf:"bytes,2,opt,name=slot_id,json=slotId,proto3" json:"slot_id,omitempty"`
-	StudentId      string                 `protobuf:"bytes,3,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
-	Status         string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"` // booked / cancelled / completed
-	IsPaid         bool                   `protobuf:"varint,5,opt,name=is_paid,json=isPaid,proto3" json:"is_paid,omitempty"`
-	ConnectionLink *string                `protobuf:"bytes,6,opt,name=connection_link,json=connectionLink,proto3,oneof" json:"connection_link,omitempty"`
-	PriceRub       *int32                 `protobuf:"varint,7,opt,name=price_rub,json=priceRub,proto3,oneof" json:"price_rub,omitempty"`
-	PaymentInfo    *string                `protobuf:"bytes,8,opt,name=payment_info,json=paymentInfo,proto3,oneof" json:"payment_info,omitempty"`
-	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	EditedAt       *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=edited_at,json=editedAt,proto3" json:"edited_at,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *BlockPeriodRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
 }
 
-func (x *Lesson) Reset() {
-	*x = Lesson{}
-	mi := &file_schedule_service_proto_msgTypes[17]
+func (x *BlockPeriodRequest) GetReason() string {
+	if x != nil && x.Reason != nil {
+		return *x.Reason
+	}
+	return ""
+}
+
+func (x *BlockPeriodRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type BlockPeriodResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	DryRun           bool                   `protobuf:"varint,1,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	DeletedSlots     []*Slot                `protobuf:"bytes,2,rep,name=deleted_slots,json=deletedSlots,proto3" json:"deleted_slots,omitempty"`
+	CancelledLessons []*Lesson              `protobuf:"bytes,3,rep,name=cancelled_lessons,json=cancelledLessons,proto3" json:"cancelled_lessons,omitempty"`
+	ClosedSlots      []*Slot                `protobuf:"bytes,4,rep,name=closed_slots,json=closedSlots,proto3" json:"closed_slots,omitempty"` // свободные слоты с отменёнными уроками: закрыты, а не удалены
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *BlockPeriodResponse) Reset() {
+	*x = BlockPeriodResponse{}
+	mi := &file_schedule_service_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Lesson) String() string {
+func (x *BlockPeriodResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Lesson) ProtoMessage() {}
+func (*BlockPeriodResponse) ProtoMessage() {}
 
-func (x *Lesson) ProtoReflect() protoreflect.Message {
-	mi := &file_schedule_service_proto_msgTypes[17]
+func (x *BlockPeriodResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_schedule_service_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1012,21 +1276,1109 @@ func (x *Lesson) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Lesson.ProtoReflect.Descriptor instead.
-func (*Lesson) Descriptor() ([]byte, []int) {
-	return file_schedule_service_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use BlockPeriodResponse.ProtoReflect.Descriptor instead.
+func (*BlockPeriodResponse) Descriptor() ([]byte, []int) {
+	return file_schedule_service_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *Lesson) GetId() string {
+func (x *BlockPeriodResponse) GetDryRun() bool {
 	if x != nil {
-		return x.Id
+		return x.DryRun
 	}
-	return ""
+	return false
 }
 
-func (x *Lesson) GetSlotId() string {
+func (x *BlockPeriodResponse) GetDeletedSlots() []*Slot {
 	if x != nil {
-		return x.SlotId
+		return x.DeletedSlots
+	}
+	return nil
+}
+
+func (x *BlockPeriodResponse) GetCancelledLessons() []*Lesson {
+	if x != nil {
+		return x.CancelledLessons
+	}
+	return nil
+}
+
+func (x *BlockPeriodResponse) GetClosedSlots() []*Slot {
+	if x != nil {
+		return x.ClosedSlots
+	}
+	return nil
+}
+
+type CreateLessonSeriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TutorId       string                 `protobuf:"bytes,1,opt,name=tutor_id,json=tutorId,proto3" json:"tutor_id,omitempty"`
+	StudentId     string                 `protobuf:"bytes,2,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	StartsAt      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"` // первое занятие серии, его время и длительность повторяются
+	EndsAt        *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`
+	Weekdays      []int32                `protobuf:"varint,5,rep,packed,name=weekdays,proto3" json:"weekdays,omitempty"` // 1 — понедельник ... 7 — воскресенье; если пусто, день недели первого занятия
+	Count         *int32                 `protobuf:"varint,6,opt,name=count,proto3,oneof" json:"count,omitempty"`        // количество занятий
+	Until         *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=until,proto3,oneof" json:"until,omitempty"`         // или дата окончания серии
+	Timezone      *string                `protobuf:"bytes,8,opt,name=timezone,proto3,oneof" json:"timezone,omitempty"`   // IANA, например Europe/Moscow; по умолчанию UTC
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateLessonSeriesRequest) Reset() {
+	*x = CreateLessonSeriesRequest{}
+	mi := &file_schedule_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateLessonSeriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateLessonSeriesRequest) ProtoMessage() {}
+
+func (x *CreateLessonSeriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_schedule_service_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateLessonSeriesRequest.ProtoReflect.Descriptor instead.
+func (*CreateLessonSeriesRequest) Descriptor() ([]byte, []int) {
+	return file_schedule_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *CreateLessonSeriesRequest) GetTutorId() string {
+	if x != nil {
+		return x.TutorId
+	}
+	return ""
+}
+
+func (x *CreateLessonSeriesRequest) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *CreateLessonSeriesRequest) GetStartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartsAt
+	}
+	return nil
+}
+
+func (x *CreateLessonSeriesRequest) GetEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndsAt
+	}
+	return nil
+}
+
+func (x *CreateLessonSeriesRequest) GetWeekdays() []int32 {
+	if x != nil {
+		return x.Weekdays
+	}
+	return nil
+}
+
+func (x *CreateLessonSeriesRequest) GetCount() int32 {
+	if x != nil && x.Count != nil {
+		return *x.Count
+	}
+	return 0
+}
+
+func (x *CreateLessonSeriesRequest) GetUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Until
+	}
+	return nil
+}
+
+func (x *CreateLessonSeriesRequest) GetTimezone() string {
+	if x != nil && x.Timezone != nil {
+		return *x.Timezone
+	}
+	return ""
+}
+
+type SeriesOccurrenceFailure struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StartsAt      *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`
+	EndsAt        *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SeriesOccurrenceFailure) Reset() {
+	*x = SeriesOccurrenceFailure{}
+	mi := &file_schedule_service_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SeriesOccurrenceFailure) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeriesOccurrenceFailure) ProtoMessage() {}
+
+func (x *SeriesOccurrenceFailure) ProtoReflect() protoreflect.Message {
+	mi := &file_schedule_service_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeriesOccurrenceFailure.ProtoReflect.Descriptor instead.
+func (*SeriesOccurrenceFailure) Descriptor() ([]byte, []int) {
+	return file_schedule_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *SeriesOccurrenceFailure) GetStartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartsAt
+	}
+	return nil
+}
+
+func (x *SeriesOccurrenceFailure) GetEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndsAt
+	}
+	return nil
+}
+
+func (x *SeriesOccurrenceFailure) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type CreateLessonSeriesResponse struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	SeriesId      string                     `protobuf:"bytes,1,opt,name=series_id,json=seriesId,proto3" json:"series_id,omitempty"` // пусто, если не удалось забронировать ни одного занятия
+	Lessons       []*Lesson                  `protobuf:"bytes,2,rep,name=lessons,proto3" json:"lessons,omitempty"`
+	Failed        []*SeriesOccurrenceFailure `protobuf:"bytes,3,rep,name=failed,proto3" json:"failed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateLessonSeriesResponse) Reset() {
+	*x = CreateLessonSeriesResponse{}
+	mi := &file_schedule_service_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateLessonSeriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateLessonSeriesResponse) ProtoMessage() {}
+
+func (x *CreateLessonSeriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_schedule_service_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateLessonSeriesResponse.ProtoReflect.Descriptor instead.
+func (*CreateLessonSeriesResponse) Descriptor() ([]byte, []int) {
+	return file_schedule_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *CreateLessonSeriesResponse) GetSeriesId() string {
+	if x != nil {
+		return x.SeriesId
+	}
+	return ""
+}
+
+func (x *CreateLessonSeriesResponse) GetLessons() []*Lesson {
+	if x != nil {
+		return x.Lessons
+	}
+	return nil
+}
+
+func (x *CreateLessonSeriesResponse) GetFailed() []*SeriesOccurrenceFailure {
+	if x != nil {
+		return x.Failed
+	}
+	return nil
+}
+
+type CancelLessonSeriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SeriesId      string                 `protobuf:"bytes,1,opt,name=series_id,json=seriesId,proto3" json:"series_id,omitempty"`
+	Reason        *string                `protobuf:"bytes,2,opt,name=reason,proto3,oneof" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelLessonSeriesRequest) Reset() {
+	*x = CancelLessonSeriesRequest{}
+	mi := &file_schedule_service_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelLessonSeriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelLessonSeriesRequest) ProtoMessage() {}
+
+func (x *CancelLessonSeriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_schedule_service_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelLessonSeriesRequest.ProtoReflect.Descriptor instead.
+func (*CancelLessonSeriesRequest) Descriptor() ([]byte, []int) {
+	return file_schedule_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *CancelLessonSeriesRequest) GetSeriesId() string {
+	if x != nil {
+		return x.SeriesId
+	}
+	return ""
+}
+
+func (x *CancelLessonSeriesRequest) GetReason() string {
+	if x != nil && x.Reason != nil {
+		return *x.Reason
+	}
+	return ""
+}
+
+type CreateLessonPackageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TutorId       string                 `protobuf:"bytes,1,opt,name=tutor_id,json=tutorId,proto3" json:"tutor_id,omitempty"`
+	StudentId     string                 `protobuf:"bytes,2,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	LessonsCount  int32                  `protobuf:"varint,3,opt,name=lessons_count,json=lessonsCount,proto3" json:"lessons_count,omitempty"`
+	PriceRub      int32                  `protobuf:"varint,4,opt,name=price_rub,json=priceRub,proto3" json:"price_rub,omitempty"`         // цена всего пакета
+	ValidFrom     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=valid_from,json=validFrom,proto3,oneof" json:"valid_from,omitempty"` // по умолчанию сейчас
+	ValidUntil    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=valid_until,json=validUntil,proto3" json:"valid_until,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateLessonPackageRequest) Reset() {
+	*x = CreateLessonPackageRequest{}
+	mi := &file_schedule_service_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateLessonPackageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateLessonPackageRequest) ProtoMessage() {}
+
+func (x *CreateLessonPackageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_schedule_service_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateLessonPackageRequest.ProtoReflect.Descriptor instead.
+func (*CreateLessonPackageRequest) Descriptor() ([]byte, []int) {
+	return file_schedule_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *CreateLessonPackageRequest) GetTutorId() string {
+	if x != nil {
+		return x.TutorId
+	}
+	return ""
+}
+
+func (x *CreateLessonPackageRequest) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *CreateLessonPackageRequest) GetLessonsCount() int32 {
+	if x != nil {
+		return x.LessonsCount
+	}
+	return 0
+}
+
+func (x *CreateLessonPackageRequest) GetPriceRub() int32 {
+	if x != nil {
+		return x.PriceRub
+	}
+	return 0
+}
+
+func (x *CreateLessonPackageRequest) GetValidFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidFrom
+	}
+	return nil
+}
+
+func (x *CreateLessonPackageRequest) GetValidUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidUntil
+	}
+	return nil
+}
+
+type GetPackageBalanceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TutorId       string                 `protobuf:"bytes,1,opt,name=tutor_id,json=tutorId,proto3" json:"tutor_id,omitempty"`
+	StudentId     string                 `protobuf:"bytes,2,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPackageBalanceRequest) Reset() {
+	*x = GetPackageBalanceRequest{}
+	mi := &file_schedule_service_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPackageBalanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPackageBalanceRequest) ProtoMessage() {}
+
+func (x *GetPackageBalanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_schedule_service_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPackageBalanceRequest.ProtoReflect.Descriptor instead.
+func (*GetPackageBalanceRequest) Descriptor() ([]byte, []int) {
+	return file_schedule_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetPackageBalanceRequest) GetTutorId() string {
+	if x != nil {
+		return x.TutorId
+	}
+	return ""
+}
+
+func (x *GetPackageBalanceRequest) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+type PackageBalance struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	LessonsRemaining int32                  `protobuf:"varint,1,opt,name=lessons_remaining,json=lessonsRemaining,proto3" json:"lessons_remaining,omitempty"` // сумма по всем действующим пакетам
+	Packages         []*LessonPackage       `protobuf:"bytes,2,rep,name=packages,proto3" json:"packages,omitempty"`                                          // действующие и будущие пакеты
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *PackageBalance) Reset() {
+	*x = PackageBalance{}
+	mi := &file_schedule_service_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PackageBalance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PackageBalance) ProtoMessage() {}
+
+func (x *PackageBalance) ProtoReflect() protoreflect.Message {
+	mi := &file_schedule_service_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PackageBalance.ProtoReflect.Descriptor instead.
+func (*PackageBalance) Descriptor() ([]byte, []int) {
+	return file_schedule_service_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *PackageBalance) GetLessonsRemaining() int32 {
+	if x != nil {
+		return x.LessonsRemaining
+	}
+	return 0
+}
+
+func (x *PackageBalance) GetPackages() []*LessonPackage {
+	if x != nil {
+		return x.Packages
+	}
+	return nil
+}
+
+type LessonPackage struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TutorId          string                 `protobuf:"bytes,2,opt,name=tutor_id,json=tutorId,proto3" json:"tutor_id,omitempty"`
+	StudentId        string                 `protobuf:"bytes,3,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	LessonsTotal     int32                  `protobuf:"varint,4,opt,name=lessons_total,json=lessonsTotal,proto3" json:"lessons_total,omitempty"`
+	LessonsRemaining int32                  `protobuf:"varint,5,opt,name=lessons_remaining,json=lessonsRemaining,proto3" json:"lessons_remaining,omitempty"`
+	PriceRub         int32                  `protobuf:"varint,6,opt,name=price_rub,json=priceRub,proto3" json:"price_rub,omitempty"`
+	ValidFrom        *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=valid_from,json=validFrom,proto3" json:"valid_from,omitempty"`
+	ValidUntil       *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=valid_until,json=validUntil,proto3" json:"valid_until,omitempty"`
+	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *LessonPackage) Reset() {
+	*x = LessonPackage{}
+	mi := &file_schedule_service_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LessonPackage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LessonPackage) ProtoMessage() {}
+
+func (x *LessonPackage) ProtoReflect() protoreflect.Message {
+	mi := &file_schedule_service_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LessonPackage.ProtoReflect.Descriptor instead.
+func (*LessonPackage) Descriptor() ([]byte, []int) {
+	return file_schedule_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *LessonPackage) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *LessonPackage) GetTutorId() string {
+	if x != nil {
+		return x.TutorId
+	}
+	return ""
+}
+
+func (x *LessonPackage) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *LessonPackage) GetLessonsTotal() int32 {
+	if x != nil {
+		return x.LessonsTotal
+	}
+	return 0
+}
+
+func (x *LessonPackage) GetLessonsRemaining() int32 {
+	if x != nil {
+		return x.LessonsRemaining
+	}
+	return 0
+}
+
+func (x *LessonPackage) GetPriceRub() int32 {
+	if x != nil {
+		return x.PriceRub
+	}
+	return 0
+}
+
+func (x *LessonPackage) GetValidFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidFrom
+	}
+	return nil
+}
+
+func (x *LessonPackage) GetValidUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidUntil
+	}
+	return nil
+}
+
+func (x *LessonPackage) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type GetScheduleStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TutorId       string                 `protobuf:"bytes,1,opt,name=tutor_id,json=tutorId,proto3" json:"tutor_id,omitempty"`
+	From          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To            *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	Timezone      *string                `protobuf:"bytes,4,opt,name=timezone,proto3,oneof" json:"timezone,omitempty"` // IANA, для тепловой карты; по умолчанию UTC
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetScheduleStatsRequest) Reset() {
+	*x = GetScheduleStatsRequest{}
+	mi := &file_schedule_service_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetScheduleStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetScheduleStatsRequest) ProtoMessage() {}
+
+func (x *GetScheduleStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_schedule_service_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetScheduleStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetScheduleStatsRequest) Descriptor() ([]byte, []int) {
+	return file_schedule_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetScheduleStatsRequest) GetTutorId() string {
+	if x != nil {
+		return x.TutorId
+	}
+	return ""
+}
+
+func (x *GetScheduleStatsRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *GetScheduleStatsRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *GetScheduleStatsRequest) GetTimezone() string {
+	if x != nil && x.Timezone != nil {
+		return *x.Timezone
+	}
+	return ""
+}
+
+type ScheduleStats struct {
+	state                     protoimpl.MessageState `protogen:"open.v1"`
+	AvailableHours            float64                `protobuf:"fixed64,1,opt,name=available_hours,json=availableHours,proto3" json:"available_hours,omitempty"` // открытые слоты и слоты с неотменёнными уроками
+	BookedHours               float64                `protobuf:"fixed64,2,opt,name=booked_hours,json=bookedHours,proto3" json:"booked_hours,omitempty"`          // слоты с неотменёнными уроками
+	TaughtHours               float64                `protobuf:"fixed64,3,opt,name=taught_hours,json=taughtHours,proto3" json:"taught_hours,omitempty"`          // проведённые уроки, по фактическому времени если оно указано
+	Utilization               float64                `protobuf:"fixed64,4,opt,name=utilization,proto3" json:"utilization,omitempty"`                             // booked_hours / available_hours
+	LessonsTotal              int32                  `protobuf:"varint,5,opt,name=lessons_total,json=lessonsTotal,proto3" json:"lessons_total,omitempty"`
+	LessonsCompleted          int32                  `protobuf:"varint,6,opt,name=lessons_completed,json=lessonsCompleted,proto3" json:"lessons_completed,omitempty"`
+	LessonsCancelled          int32                  `protobuf:"varint,7,opt,name=lessons_cancelled,json=lessonsCancelled,proto3" json:"lessons_cancelled,omitempty"`
+	LessonsCancelledByTutor   int32                  `protobuf:"varint,8,opt,name=lessons_cancelled_by_tutor,json=lessonsCancelledByTutor,proto3" json:"lessons_cancelled_by_tutor,omitempty"`
+	LessonsCancelledByStudent int32                  `protobuf:"varint,9,opt,name=lessons_cancelled_by_student,json=lessonsCancelledByStudent,proto3" json:"lessons_cancelled_by_student,omitempty"`
+	NoShowStudent             int32                  `protobuf:"varint,10,opt,name=no_show_student,json=noShowStudent,proto3" json:"no_show_student,omitempty"`
+	NoShowTutor               int32                  `protobuf:"varint,11,opt,name=no_show_tutor,json=noShowTutor,proto3" json:"no_show_tutor,omitempty"`
+	TutorCancellationRate     float64                `protobuf:"fixed64,12,opt,name=tutor_cancellation_rate,json=tutorCancellationRate,proto3" json:"tutor_cancellation_rate,omitempty"` // доля от lessons_total
+	StudentCancellationRate   float64                `protobuf:"fixed64,13,opt,name=student_cancellation_rate,json=studentCancellationRate,proto3" json:"student_cancellation_rate,omitempty"`
+	EarningsRub               int64                  `protobuf:"varint,14,opt,name=earnings_rub,json=earningsRub,proto3" json:"earnings_rub,omitempty"`                           // по урокам, за которые берётся оплата
+	ExpectedEarningsRub       int64                  `protobuf:"varint,15,opt,name=expected_earnings_rub,json=expectedEarningsRub,proto3" json:"expected_earnings_rub,omitempty"` // по ещё не проведённым урокам
+	Students                  []*StudentLessonStats  `protobuf:"bytes,16,rep,name=students,proto3" json:"students,omitempty"`
+	HeatMap                   []*ScheduleHeatMapCell `protobuf:"bytes,17,rep,name=heat_map,json=heatMap,proto3" json:"heat_map,omitempty"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *ScheduleStats) Reset() {
+	*x = ScheduleStats{}
+	mi := &file_schedule_service_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleStats) ProtoMessage() {}
+
+func (x *ScheduleStats) ProtoReflect() protoreflect.Message {
+	mi := &file_schedule_service_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleStats.ProtoReflect.Descriptor instead.
+func (*ScheduleStats) Descriptor() ([]byte, []int) {
+	return file_schedule_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ScheduleStats) GetAvailableHours() float64 {
+	if x != nil {
+		return x.AvailableHours
+	}
+	return 0
+}
+
+func (x *ScheduleStats) GetBookedHours() float64 {
+	if x != nil {
+		return x.BookedHours
+	}
+	return 0
+}
+
+func (x *ScheduleStats) GetTaughtHours() float64 {
+	if x != nil {
+		return x.TaughtHours
+	}
+	return 0
+}
+
+func (x *ScheduleStats) GetUtilization() float64 {
+	if x != nil {
+		return x.Utilization
+	}
+	return 0
+}
+
+func (x *ScheduleStats) GetLessonsTotal() int32 {
+	if x != nil {
+		return x.LessonsTotal
+	}
+	return 0
+}
+
+func (x *ScheduleStats) GetLessonsCompleted() int32 {
+	if x != nil {
+		return x.LessonsCompleted
+	}
+	return 0
+}
+
+func (x *ScheduleStats) GetLessonsCancelled() int32 {
+	if x != nil {
+		return x.LessonsCancelled
+	}
+	return 0
+}
+
+func (x *ScheduleStats) GetLessonsCancelledByTutor() int32 {
+	if x != nil {
+		return x.LessonsCancelledByTutor
+	}
+	return 0
+}
+
+func (x *ScheduleStats) GetLessonsCancelledByStudent() int32 {
+	if x != nil {
+		return x.LessonsCancelledByStudent
+	}
+	return 0
+}
+
+func (x *ScheduleStats) GetNoShowStudent() int32 {
+	if x != nil {
+		return x.NoShowStudent
+	}
+	return 0
+}
+
+func (x *ScheduleStats) GetNoShowTutor() int32 {
+	if x != nil {
+		return x.NoShowTutor
+	}
+	return 0
+}
+
+func (x *ScheduleStats) GetTutorCancellationRate() float64 {
+	if x != nil {
+		return x.TutorCancellationRate
+	}
+	return 0
+}
+
+func (x *ScheduleStats) GetStudentCancellationRate() float64 {
+	if x != nil {
+		return x.StudentCancellationRate
+	}
+	return 0
+}
+
+func (x *ScheduleStats) GetEarningsRub() int64 {
+	if x != nil {
+		return x.EarningsRub
+	}
+	return 0
+}
+
+func (x *ScheduleStats) GetExpectedEarningsRub() int64 {
+	if x != nil {
+		return x.ExpectedEarningsRub
+	}
+	return 0
+}
+
+func (x *ScheduleStats) GetStudents() []*StudentLessonStats {
+	if x != nil {
+		return x.Students
+	}
+	return nil
+}
+
+func (x *ScheduleStats) GetHeatMap() []*ScheduleHeatMapCell {
+	if x != nil {
+		return x.HeatMap
+	}
+	return nil
+}
+
+type StudentLessonStats struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	StudentId        string                 `protobuf:"bytes,1,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	LessonsTotal     int32                  `protobuf:"varint,2,opt,name=lessons_total,json=lessonsTotal,proto3" json:"lessons_total,omitempty"`
+	LessonsCompleted int32                  `protobuf:"varint,3,opt,name=lessons_completed,json=lessonsCompleted,proto3" json:"lessons_completed,omitempty"`
+	LessonsCancelled int32                  `protobuf:"varint,4,opt,name=lessons_cancelled,json=lessonsCancelled,proto3" json:"lessons_cancelled,omitempty"`
+	Hours            float64                `protobuf:"fixed64,5,opt,name=hours,proto3" json:"hours,omitempty"`
+	EarningsRub      int64                  `protobuf:"varint,6,opt,name=earnings_rub,json=earningsRub,proto3" json:"earnings_rub,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *StudentLessonStats) Reset() {
+	*x = StudentLessonStats{}
+	mi := &file_schedule_service_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StudentLessonStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StudentLessonStats) ProtoMessage() {}
+
+func (x *StudentLessonStats) ProtoReflect() protoreflect.Message {
+	mi := &file_schedule_service_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StudentLessonStats.ProtoReflect.Descriptor instead.
+func (*StudentLessonStats) Descriptor() ([]byte, []int) {
+	return file_schedule_service_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *StudentLessonStats) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *StudentLessonStats) GetLessonsTotal() int32 {
+	if x != nil {
+		return x.LessonsTotal
+	}
+	return 0
+}
+
+func (x *StudentLessonStats) GetLessonsCompleted() int32 {
+	if x != nil {
+		return x.LessonsCompleted
+	}
+	return 0
+}
+
+func (x *StudentLessonStats) GetLessonsCancelled() int32 {
+	if x != nil {
+		return x.LessonsCancelled
+	}
+	return 0
+}
+
+func (x *StudentLessonStats) GetHours() float64 {
+	if x != nil {
+		return x.Hours
+	}
+	return 0
+}
+
+func (x *StudentLessonStats) GetEarningsRub() int64 {
+	if x != nil {
+		return x.EarningsRub
+	}
+	return 0
+}
+
+type ScheduleHeatMapCell struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Weekday        int32                  `protobuf:"varint,1,opt,name=weekday,proto3" json:"weekday,omitempty"` // 1 - понедельник, 7 - воскресенье
+	Hour           int32                  `protobuf:"varint,2,opt,name=hour,proto3" json:"hour,omitempty"`       // 0..23 в запрошенном часовом поясе
+	AvailableSlots int32                  `protobuf:"varint,3,opt,name=available_slots,json=availableSlots,proto3" json:"available_slots,omitempty"`
+	BookedLessons  int32                  `protobuf:"varint,4,opt,name=booked_lessons,json=bookedLessons,proto3" json:"booked_lessons,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ScheduleHeatMapCell) Reset() {
+	*x = ScheduleHeatMapCell{}
+	mi := &file_schedule_service_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleHeatMapCell) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleHeatMapCell) ProtoMessage() {}
+
+func (x *ScheduleHeatMapCell) ProtoReflect() protoreflect.Message {
+	mi := &file_schedule_service_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleHeatMapCell.ProtoReflect.Descriptor instead.
+func (*ScheduleHeatMapCell) Descriptor() ([]byte, []int) {
+	return file_schedule_service_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ScheduleHeatMapCell) GetWeekday() int32 {
+	if x != nil {
+		return x.Weekday
+	}
+	return 0
+}
+
+func (x *ScheduleHeatMapCell) GetHour() int32 {
+	if x != nil {
+		return x.Hour
+	}
+	return 0
+}
+
+func (x *ScheduleHeatMapCell) GetAvailableSlots() int32 {
+	if x != nil {
+		return x.AvailableSlots
+	}
+	return 0
+}
+
+func (x *ScheduleHeatMapCell) GetBookedLessons() int32 {
+	if x != nil {
+		return x.BookedLessons
+	}
+	return 0
+}
+
+type ListLessonsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Lessons       []*Lesson              `protobuf:"bytes,1,rep,name=lessons,proto3" json:"lessons,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLessonsResponse) Reset() {
+	*x = ListLessonsResponse{}
+	mi := &file_schedule_service_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLessonsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLessonsResponse) ProtoMessage() {}
+
+func (x *ListLessonsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_schedule_service_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLessonsResponse.ProtoReflect.Descriptor instead.
+func (*ListLessonsResponse) Descriptor() ([]byte, []int) {
+	return file_schedule_service_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ListLessonsResponse) GetLessons() []*Lesson {
+	if x != nil {
+		return x.Lessons
+	}
+	return nil
+}
+
+type Lesson struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	SlotId         string                 `protobuf:"bytes,2,opt,name=slot_id,json=slotId,proto3" json:"slot_id,omitempty"`
+	StudentId      string                 `protobuf:"bytes,3,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	Status         string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"` // booked / cancelled / completed / no_show_student / no_show_tutor
+	IsPaid         bool                   `protobuf:"varint,5,opt,name=is_paid,json=isPaid,proto3" json:"is_paid,omitempty"`
+	ConnectionLink *string                `protobuf:"bytes,6,opt,name=connection_link,json=connectionLink,proto3,oneof" json:"connection_link,omitempty"`
+	PriceRub       *int32                 `protobuf:"varint,7,opt,name=price_rub,json=priceRub,proto3,oneof" json:"price_rub,omitempty"`
+	PaymentInfo    *string                `protobuf:"bytes,8,opt,name=payment_info,json=paymentInfo,proto3,oneof" json:"payment_info,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	EditedAt       *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=edited_at,json=editedAt,proto3" json:"edited_at,omitempty"`
+	CancelReason   *string                `protobuf:"bytes,11,opt,name=cancel_reason,json=cancelReason,proto3,oneof" json:"cancel_reason,omitempty"`
+	SeriesId       *string                `protobuf:"bytes,12,opt,name=series_id,json=seriesId,proto3,oneof" json:"series_id,omitempty"`
+	ActualStartsAt *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=actual_starts_at,json=actualStartsAt,proto3,oneof" json:"actual_starts_at,omitempty"`
+	ActualEndsAt   *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=actual_ends_at,json=actualEndsAt,proto3,oneof" json:"actual_ends_at,omitempty"`
+	Notes          *LessonNotes           `protobuf:"bytes,15,opt,name=notes,proto3,oneof" json:"notes,omitempty"`                                // заполняется только в GetLesson
+	PackageId      *string                `protobuf:"bytes,16,opt,name=package_id,json=packageId,proto3,oneof" json:"package_id,omitempty"`       // пакет, из которого оплачено занятие
+	CancelledBy    *string                `protobuf:"bytes,17,opt,name=cancelled_by,json=cancelledBy,proto3,oneof" json:"cancelled_by,omitempty"` // tutor / student
+	TutorId        *string                `protobuf:"bytes,18,opt,name=tutor_id,json=tutorId,proto3,oneof" json:"tutor_id,omitempty"`             // из слота урока
+	StartsAt       *timestamppb.Timestamp `protobuf:"bytes,19,opt,name=starts_at,json=startsAt,proto3,oneof" json:"starts_at,omitempty"`          // из слота урока
+	EndsAt         *timestamppb.Timestamp `protobuf:"bytes,20,opt,name=ends_at,json=endsAt,proto3,oneof" json:"ends_at,omitempty"`                // из слота урока
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Lesson) Reset() {
+	*x = Lesson{}
+	mi := &file_schedule_service_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Lesson) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Lesson) ProtoMessage() {}
+
+func (x *Lesson) ProtoReflect() protoreflect.Message {
+	mi := &file_schedule_service_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Lesson.ProtoReflect.Descriptor instead.
+func (*Lesson) Descriptor() ([]byte, []int) {
+	return file_schedule_service_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *Lesson) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Lesson) GetSlotId() string {
+	if x != nil {
+		return x.SlotId
 	}
 	return ""
 }
@@ -1087,6 +2439,168 @@ func (x *Lesson) GetEditedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Lesson) GetCancelReason() string {
+	if x != nil && x.CancelReason != nil {
+		return *x.CancelReason
+	}
+	return ""
+}
+
+func (x *Lesson) GetSeriesId() string {
+	if x != nil && x.SeriesId != nil {
+		return *x.SeriesId
+	}
+	return ""
+}
+
+func (x *Lesson) GetActualStartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ActualStartsAt
+	}
+	return nil
+}
+
+func (x *Lesson) GetActualEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ActualEndsAt
+	}
+	return nil
+}
+
+func (x *Lesson) GetNotes() *LessonNotes {
+	if x != nil {
+		return x.Notes
+	}
+	return nil
+}
+
+func (x *Lesson) GetPackageId() string {
+	if x != nil && x.PackageId != nil {
+		return *x.PackageId
+	}
+	return ""
+}
+
+func (x *Lesson) GetCancelledBy() string {
+	if x != nil && x.CancelledBy != nil {
+		return *x.CancelledBy
+	}
+	return ""
+}
+
+func (x *Lesson) GetTutorId() string {
+	if x != nil && x.TutorId != nil {
+		return *x.TutorId
+	}
+	return ""
+}
+
+func (x *Lesson) GetStartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartsAt
+	}
+	return nil
+}
+
+func (x *Lesson) GetEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndsAt
+	}
+	return nil
+}
+
+type LessonNotes struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	LessonId          string                 `protobuf:"bytes,1,opt,name=lesson_id,json=lessonId,proto3" json:"lesson_id,omitempty"`
+	PrivateNote       string                 `protobuf:"bytes,2,opt,name=private_note,json=privateNote,proto3" json:"private_note,omitempty"` // видна только репетитору, ученику приходит пустой
+	Agenda            string                 `protobuf:"bytes,3,opt,name=agenda,proto3" json:"agenda,omitempty"`
+	Summary           string                 `protobuf:"bytes,4,opt,name=summary,proto3" json:"summary,omitempty"`
+	AttachmentFileIds []string               `protobuf:"bytes,5,rep,name=attachment_file_ids,json=attachmentFileIds,proto3" json:"attachment_file_ids,omitempty"`
+	EditedBy          string                 `protobuf:"bytes,6,opt,name=edited_by,json=editedBy,proto3" json:"edited_by,omitempty"`
+	EditedAt          *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=edited_at,json=editedAt,proto3" json:"edited_at,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *LessonNotes) Reset() {
+	*x = LessonNotes{}
+	mi := &file_schedule_service_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LessonNotes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LessonNotes) ProtoMessage() {}
+
+func (x *LessonNotes) ProtoReflect() protoreflect.Message {
+	mi := &file_schedule_service_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LessonNotes.ProtoReflect.Descriptor instead.
+func (*LessonNotes) Descriptor() ([]byte, []int) {
+	return file_schedule_service_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *LessonNotes) GetLessonId() string {
+	if x != nil {
+		return x.LessonId
+	}
+	return ""
+}
+
+func (x *LessonNotes) GetPrivateNote() string {
+	if x != nil {
+		return x.PrivateNote
+	}
+	return ""
+}
+
+func (x *LessonNotes) GetAgenda() string {
+	if x != nil {
+		return x.Agenda
+	}
+	return ""
+}
+
+func (x *LessonNotes) GetSummary() string {
+	if x != nil {
+		return x.Summary
+	}
+	return ""
+}
+
+func (x *LessonNotes) GetAttachmentFileIds() []string {
+	if x != nil {
+		return x.AttachmentFileIds
+	}
+	return nil
+}
+
+func (x *LessonNotes) GetEditedBy() string {
+	if x != nil {
+		return x.EditedBy
+	}
+	return ""
+}
+
+func (x *LessonNotes) GetEditedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EditedAt
+	}
+	return nil
+}
+
 type Empty struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -1095,7 +2609,7 @@ type Empty struct {
 
 func (x *Empty) Reset() {
 	*x = Empty{}
-	mi := &file_schedule_service_proto_msgTypes[18]
+	mi := &file_schedule_service_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1107,7 +2621,7 @@ func (x *Empty) String() string {
 func (*Empty) ProtoMessage() {}
 
 func (x *Empty) ProtoReflect() protoreflect.Message {
-	mi := &file_schedule_service_proto_msgTypes[18]
+	mi := &file_schedule_service_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1120,236 +2634,291 @@ func (x *Empty) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Empty.ProtoReflect.Descriptor instead.
 func (*Empty) Descriptor() ([]byte, []int) {
-	return file_schedule_service_proto_rawDescGZIP(), []int{18}
+	return file_schedule_service_proto_rawDescGZIP(), []int{37}
 }
 
 var File_schedule_service_proto protoreflect.FileDescriptor
 
-var file_schedule_service_proto_rawDesc = string([]byte{
-	0x0a, 0x16, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0b, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75,
-	0x6c, 0x65, 0x2e, 0x76, 0x31, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x20, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x53, 0x6c, 0x6f,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x9c, 0x01, 0x0a, 0x11, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19,
-	0x0a, 0x08, 0x74, 0x75, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x74, 0x75, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x37, 0x0a, 0x09, 0x73, 0x74, 0x61,
-	0x72, 0x74, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x73, 0x74, 0x61, 0x72, 0x74, 0x73,
-	0x41, 0x74, 0x12, 0x33, 0x0a, 0x07, 0x65, 0x6e, 0x64, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
-	0x06, 0x65, 0x6e, 0x64, 0x73, 0x41, 0x74, 0x22, 0x91, 0x01, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x37, 0x0a,
-	0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x73, 0x74,
-	0x61, 0x72, 0x74, 0x73, 0x41, 0x74, 0x12, 0x33, 0x0a, 0x07, 0x65, 0x6e, 0x64, 0x73, 0x5f, 0x61,
-	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x52, 0x06, 0x65, 0x6e, 0x64, 0x73, 0x41, 0x74, 0x22, 0x23, 0x0a, 0x11, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
-	0x22, 0x73, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x6c, 0x6f, 0x74, 0x73, 0x42, 0x79, 0x54,
-	0x75, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x74,
-	0x75, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74,
-	0x75, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x0e, 0x6f, 0x6e, 0x6c, 0x79, 0x5f, 0x61,
-	0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00,
-	0x52, 0x0d, 0x6f, 0x6e, 0x6c, 0x79, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x88,
-	0x01, 0x01, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x5f, 0x61, 0x76, 0x61, 0x69,
-	0x6c, 0x61, 0x62, 0x6c, 0x65, 0x22, 0x3c, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x6c, 0x6f,
-	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x05, 0x73, 0x6c,
-	0x6f, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x63, 0x68, 0x65,
-	0x64, 0x75, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x05, 0x73, 0x6c,
-	0x6f, 0x74, 0x73, 0x22, 0xc3, 0x02, 0x0a, 0x04, 0x53, 0x6c, 0x6f, 0x74, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x08,
-	0x74, 0x75, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x74, 0x75, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x37, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74,
-	0x73, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x73, 0x74, 0x61, 0x72, 0x74, 0x73, 0x41, 0x74,
-	0x12, 0x33, 0x0a, 0x07, 0x65, 0x6e, 0x64, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x06, 0x65,
-	0x6e, 0x64, 0x73, 0x41, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x62, 0x6f, 0x6f, 0x6b,
-	0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x42, 0x6f, 0x6f, 0x6b,
-	0x65, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x3c, 0x0a,
-	0x09, 0x65, 0x64, 0x69, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x00, 0x52, 0x08,
-	0x65, 0x64, 0x69, 0x74, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f,
-	0x65, 0x64, 0x69, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x22, 0x22, 0x0a, 0x10, 0x47, 0x65, 0x74,
-	0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x4d, 0x0a,
-	0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x6c, 0x6f, 0x74, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6c, 0x6f, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a,
-	0x0a, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0xd0, 0x01, 0x0a,
-	0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x02, 0x69, 0x64, 0x12, 0x2c, 0x0a, 0x0f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x5f, 0x6c, 0x69, 0x6e, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52,
-	0x0e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x6e, 0x6b, 0x88,
-	0x01, 0x01, 0x12, 0x20, 0x0a, 0x09, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x72, 0x75, 0x62, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x05, 0x48, 0x01, 0x52, 0x08, 0x70, 0x72, 0x69, 0x63, 0x65, 0x52, 0x75,
-	0x62, 0x88, 0x01, 0x01, 0x12, 0x26, 0x0a, 0x0c, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f,
-	0x69, 0x6e, 0x66, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x0b, 0x70, 0x61,
-	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x88, 0x01, 0x01, 0x42, 0x12, 0x0a, 0x10,
-	0x5f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6c, 0x69, 0x6e, 0x6b,
-	0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x72, 0x75, 0x62, 0x42, 0x0f,
-	0x0a, 0x0d, 0x5f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x22,
-	0x25, 0x0a, 0x13, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x23, 0x0a, 0x11, 0x4d, 0x61, 0x72, 0x6b, 0x41, 0x73,
-	0x50, 0x61, 0x69, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x7c, 0x0a, 0x19, 0x4c,
-	0x69, 0x73, 0x74, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x73, 0x42, 0x79, 0x54, 0x75, 0x74, 0x6f,
-	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x75, 0x74, 0x6f,
-	0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x75, 0x74, 0x6f,
-	0x72, 0x49, 0x64, 0x12, 0x44, 0x0a, 0x0d, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x66, 0x69,
-	0x6c, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x73, 0x63, 0x68,
-	0x65, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x0c, 0x73, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0x82, 0x01, 0x0a, 0x1b, 0x4c, 0x69,
-	0x73, 0x74, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x73, 0x42, 0x79, 0x53, 0x74, 0x75, 0x64, 0x65,
-	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x75,
-	0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73,
-	0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x44, 0x0a, 0x0d, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0e, 0x32,
-	0x1f, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65,
-	0x73, 0x73, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
-	0x52, 0x0c, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0x9a,
-	0x01, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x73, 0x42, 0x79,
-	0x50, 0x61, 0x69, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x74,
-	0x75, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74,
-	0x75, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e,
-	0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x75, 0x64,
-	0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x44, 0x0a, 0x0d, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f,
-	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x73,
-	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x73, 0x73, 0x6f,
-	0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x0c, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0x64, 0x0a, 0x21, 0x4c,
-	0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x55, 0x6e, 0x70, 0x61,
-	0x69, 0x64, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x35, 0x0a, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x00, 0x52, 0x05, 0x61,
-	0x66, 0x74, 0x65, 0x72, 0x88, 0x01, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x61, 0x66, 0x74, 0x65,
-	0x72, 0x22, 0x44, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x07, 0x6c, 0x65, 0x73, 0x73,
-	0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x63, 0x68, 0x65,
-	0x64, 0x75, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x52, 0x07,
-	0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x73, 0x22, 0xa0, 0x03, 0x0a, 0x06, 0x4c, 0x65, 0x73, 0x73,
-	0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
-	0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x6c, 0x6f, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6c, 0x6f, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73,
-	0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x73, 0x5f, 0x70, 0x61, 0x69, 0x64, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x06, 0x69, 0x73, 0x50, 0x61, 0x69, 0x64, 0x12, 0x2c, 0x0a, 0x0f, 0x63,
-	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6c, 0x69, 0x6e, 0x6b, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x4c, 0x69, 0x6e, 0x6b, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x09, 0x70, 0x72, 0x69,
-	0x63, 0x65, 0x5f, 0x72, 0x75, 0x62, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x48, 0x01, 0x52, 0x08,
-	0x70, 0x72, 0x69, 0x63, 0x65, 0x52, 0x75, 0x62, 0x88, 0x01, 0x01, 0x12, 0x26, 0x0a, 0x0c, 0x70,
-	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x08, 0x20, 0x01, 0x28,
-	0x09, 0x48, 0x02, 0x52, 0x0b, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f,
-	0x88, 0x01, 0x01, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
-	0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x37,
-	0x0a, 0x09, 0x65, 0x64, 0x69, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x65,
-	0x64, 0x69, 0x74, 0x65, 0x64, 0x41, 0x74, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x63, 0x6f, 0x6e, 0x6e,
-	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6c, 0x69, 0x6e, 0x6b, 0x42, 0x0c, 0x0a, 0x0a, 0x5f,
-	0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x72, 0x75, 0x62, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x70, 0x61,
-	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x22, 0x07, 0x0a, 0x05, 0x45, 0x6d,
-	0x70, 0x74, 0x79, 0x2a, 0x3e, 0x0a, 0x12, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x0a, 0x0a, 0x06, 0x42, 0x4f, 0x4f,
-	0x4b, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c,
-	0x45, 0x44, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54, 0x45,
-	0x44, 0x10, 0x02, 0x32, 0xd5, 0x08, 0x0a, 0x0f, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
-	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x39, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x53, 0x6c,
-	0x6f, 0x74, 0x12, 0x1b, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x47, 0x65, 0x74, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x11, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6c,
-	0x6f, 0x74, 0x12, 0x3f, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x6c, 0x6f, 0x74,
-	0x12, 0x1e, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x11, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53,
-	0x6c, 0x6f, 0x74, 0x12, 0x3f, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x6c, 0x6f,
-	0x74, 0x12, 0x1e, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x11, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e,
-	0x53, 0x6c, 0x6f, 0x74, 0x12, 0x40, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x6c,
-	0x6f, 0x74, 0x12, 0x1e, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x12, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x58, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x6c,
-	0x6f, 0x74, 0x73, 0x42, 0x79, 0x54, 0x75, 0x74, 0x6f, 0x72, 0x12, 0x24, 0x2e, 0x73, 0x63, 0x68,
-	0x65, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x6c, 0x6f,
-	0x74, 0x73, 0x42, 0x79, 0x54, 0x75, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1e, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
-	0x69, 0x73, 0x74, 0x53, 0x6c, 0x6f, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x3f, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x12, 0x1d, 0x2e,
-	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4c,
-	0x65, 0x73, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x73,
-	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x73, 0x73, 0x6f,
-	0x6e, 0x12, 0x45, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x65, 0x73, 0x73, 0x6f,
-	0x6e, 0x12, 0x20, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x76,
-	0x31, 0x2e, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x12, 0x45, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x12, 0x20, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64,
-	0x75, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x65, 0x73,
-	0x73, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x73, 0x63, 0x68,
-	0x65, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x12,
-	0x45, 0x0a, 0x0c, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x12,
-	0x20, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61,
-	0x6e, 0x63, 0x65, 0x6c, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x13, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e,
-	0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x12, 0x41, 0x0a, 0x0a, 0x4d, 0x61, 0x72, 0x6b, 0x41, 0x73,
-	0x50, 0x61, 0x69, 0x64, 0x12, 0x1e, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x2e,
-	0x76, 0x31, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x41, 0x73, 0x50, 0x61, 0x69, 0x64, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x2e,
-	0x76, 0x31, 0x2e, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x12, 0x5e, 0x0a, 0x12, 0x4c, 0x69, 0x73,
-	0x74, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x73, 0x42, 0x79, 0x54, 0x75, 0x74, 0x6f, 0x72, 0x12,
-	0x26, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69,
-	0x73, 0x74, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x73, 0x42, 0x79, 0x54, 0x75, 0x74, 0x6f, 0x72,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75,
-	0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62, 0x0a, 0x14, 0x4c, 0x69, 0x73,
-	0x74, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x73, 0x42, 0x79, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e,
-	0x74, 0x12, 0x28, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e,
-	0x4c, 0x69, 0x73, 0x74, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x73, 0x42, 0x79, 0x53, 0x74, 0x75,
-	0x64, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x73, 0x63,
-	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x65,
-	0x73, 0x73, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a,
-	0x11, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x73, 0x42, 0x79, 0x50, 0x61,
-	0x69, 0x72, 0x12, 0x25, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x73, 0x42, 0x79, 0x50, 0x61,
-	0x69, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x73, 0x63, 0x68, 0x65,
-	0x64, 0x75, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x65, 0x73, 0x73,
-	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6e, 0x0a, 0x1a, 0x4c,
-	0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x55, 0x6e, 0x70, 0x61,
-	0x69, 0x64, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x73, 0x12, 0x2e, 0x2e, 0x73, 0x63, 0x68, 0x65,
-	0x64, 0x75, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x70,
-	0x6c, 0x65, 0x74, 0x65, 0x64, 0x55, 0x6e, 0x70, 0x61, 0x69, 0x64, 0x4c, 0x65, 0x73, 0x73, 0x6f,
-	0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x73, 0x63, 0x68, 0x65,
-	0x64, 0x75, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x65, 0x73, 0x73,
-	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x0b, 0x5a, 0x09, 0x2e,
-	0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x6b, 0x67, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
-})
+const file_schedule_service_proto_rawDesc = "" +
+	"\n" +
+	"\x16schedule_service.proto\x12\vschedule.v1\x1a\x1fgoogle/protobuf/timestamp.proto\" \n" +
+	"\x0eGetSlotRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x9c\x01\n" +
+	"\x11CreateSlotRequest\x12\x19\n" +
+	"\btutor_id\x18\x01 \x01(\tR\atutorId\x127\n" +
+	"\tstarts_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\bstartsAt\x123\n" +
+	"\aends_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x06endsAt\"\x91\x01\n" +
+	"\x11UpdateSlotRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x127\n" +
+	"\tstarts_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\bstartsAt\x123\n" +
+	"\aends_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x06endsAt\"#\n" +
+	"\x11DeleteSlotRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"s\n" +
+	"\x17ListSlotsByTutorRequest\x12\x19\n" +
+	"\btutor_id\x18\x01 \x01(\tR\atutorId\x12*\n" +
+	"\x0eonly_available\x18\x02 \x01(\bH\x00R\ronlyAvailable\x88\x01\x01B\x11\n" +
+	"\x0f_only_available\"<\n" +
+	"\x11ListSlotsResponse\x12'\n" +
+	"\x05slots\x18\x01 \x03(\v2\x11.schedule.v1.SlotR\x05slots\"\xc3\x02\n" +
+	"\x04Slot\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\btutor_id\x18\x02 \x01(\tR\atutorId\x127\n" +
+	"\tstarts_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\bstartsAt\x123\n" +
+	"\aends_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x06endsAt\x12\x1b\n" +
+	"\tis_booked\x18\x05 \x01(\bR\bisBooked\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12<\n" +
+	"\tedited_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampH\x00R\beditedAt\x88\x01\x01B\f\n" +
+	"\n" +
+	"_edited_at\"\"\n" +
+	"\x10GetLessonRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"M\n" +
+	"\x13CreateLessonRequest\x12\x17\n" +
+	"\aslot_id\x18\x01 \x01(\tR\x06slotId\x12\x1d\n" +
+	"\n" +
+	"student_id\x18\x02 \x01(\tR\tstudentId\"\xd0\x01\n" +
+	"\x13UpdateLessonRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12,\n" +
+	"\x0fconnection_link\x18\x02 \x01(\tH\x00R\x0econnectionLink\x88\x01\x01\x12 \n" +
+	"\tprice_rub\x18\x03 \x01(\x05H\x01R\bpriceRub\x88\x01\x01\x12&\n" +
+	"\fpayment_info\x18\x04 \x01(\tH\x02R\vpaymentInfo\x88\x01\x01B\x12\n" +
+	"\x10_connection_linkB\f\n" +
+	"\n" +
+	"_price_rubB\x0f\n" +
+	"\r_payment_info\"%\n" +
+	"\x13CancelLessonRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x8b\x02\n" +
+	"\x17UpdateAttendanceRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\x06status\x18\x02 \x01(\tH\x00R\x06status\x88\x01\x01\x12I\n" +
+	"\x10actual_starts_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampH\x01R\x0eactualStartsAt\x88\x01\x01\x12E\n" +
+	"\x0eactual_ends_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampH\x02R\factualEndsAt\x88\x01\x01B\t\n" +
+	"\a_statusB\x13\n" +
+	"\x11_actual_starts_atB\x11\n" +
+	"\x0f_actual_ends_at\"\xbc\x01\n" +
+	"\x18UpsertLessonNotesRequest\x12\x1b\n" +
+	"\tlesson_id\x18\x01 \x01(\tR\blessonId\x12!\n" +
+	"\fprivate_note\x18\x02 \x01(\tR\vprivateNote\x12\x16\n" +
+	"\x06agenda\x18\x03 \x01(\tR\x06agenda\x12\x18\n" +
+	"\asummary\x18\x04 \x01(\tR\asummary\x12.\n" +
+	"\x13attachment_file_ids\x18\x05 \x03(\tR\x11attachmentFileIds\"<\n" +
+	"\x1dListLessonNotesHistoryRequest\x12\x1b\n" +
+	"\tlesson_id\x18\x01 \x01(\tR\blessonId\"V\n" +
+	"\x1eListLessonNotesHistoryResponse\x124\n" +
+	"\bversions\x18\x01 \x03(\v2\x18.schedule.v1.LessonNotesR\bversions\"#\n" +
+	"\x11MarkAsPaidRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"|\n" +
+	"\x19ListLessonsByTutorRequest\x12\x19\n" +
+	"\btutor_id\x18\x01 \x01(\tR\atutorId\x12D\n" +
+	"\rstatus_filter\x18\x02 \x03(\x0e2\x1f.schedule.v1.LessonStatusFilterR\fstatusFilter\"\x82\x01\n" +
+	"\x1bListLessonsByStudentRequest\x12\x1d\n" +
+	"\n" +
+	"student_id\x18\x01 \x01(\tR\tstudentId\x12D\n" +
+	"\rstatus_filter\x18\x02 \x03(\x0e2\x1f.schedule.v1.LessonStatusFilterR\fstatusFilter\"\x9a\x01\n" +
+	"\x18ListLessonsByPairRequest\x12\x19\n" +
+	"\btutor_id\x18\x01 \x01(\tR\atutorId\x12\x1d\n" +
+	"\n" +
+	"student_id\x18\x02 \x01(\tR\tstudentId\x12D\n" +
+	"\rstatus_filter\x18\x03 \x03(\x0e2\x1f.schedule.v1.LessonStatusFilterR\fstatusFilter\"d\n" +
+	"!ListCompletedUnpaidLessonsRequest\x125\n" +
+	"\x05after\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\x05after\x88\x01\x01B\b\n" +
+	"\x06_after\"\xcc\x01\n" +
+	"\x12BlockPeriodRequest\x12\x19\n" +
+	"\btutor_id\x18\x01 \x01(\tR\atutorId\x12.\n" +
+	"\x04from\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\x12\x1b\n" +
+	"\x06reason\x18\x04 \x01(\tH\x00R\x06reason\x88\x01\x01\x12\x17\n" +
+	"\adry_run\x18\x05 \x01(\bR\x06dryRunB\t\n" +
+	"\a_reason\"\xde\x01\n" +
+	"\x13BlockPeriodResponse\x12\x17\n" +
+	"\adry_run\x18\x01 \x01(\bR\x06dryRun\x126\n" +
+	"\rdeleted_slots\x18\x02 \x03(\v2\x11.schedule.v1.SlotR\fdeletedSlots\x12@\n" +
+	"\x11cancelled_lessons\x18\x03 \x03(\v2\x13.schedule.v1.LessonR\x10cancelledLessons\x124\n" +
+	"\fclosed_slots\x18\x04 \x03(\v2\x11.schedule.v1.SlotR\vclosedSlots\"\xf3\x02\n" +
+	"\x19CreateLessonSeriesRequest\x12\x19\n" +
+	"\btutor_id\x18\x01 \x01(\tR\atutorId\x12\x1d\n" +
+	"\n" +
+	"student_id\x18\x02 \x01(\tR\tstudentId\x127\n" +
+	"\tstarts_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\bstartsAt\x123\n" +
+	"\aends_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x06endsAt\x12\x1a\n" +
+	"\bweekdays\x18\x05 \x03(\x05R\bweekdays\x12\x19\n" +
+	"\x05count\x18\x06 \x01(\x05H\x00R\x05count\x88\x01\x01\x125\n" +
+	"\x05until\x18\a \x01(\v2\x1a.google.protobuf.TimestampH\x01R\x05until\x88\x01\x01\x12\x1f\n" +
+	"\btimezone\x18\b \x01(\tH\x02R\btimezone\x88\x01\x01B\b\n" +
+	"\x06_countB\b\n" +
+	"\x06_untilB\v\n" +
+	"\t_timezone\"\x9f\x01\n" +
+	"\x17SeriesOccurrenceFailure\x127\n" +
+	"\tstarts_at\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\bstartsAt\x123\n" +
+	"\aends_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x06endsAt\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"\xa6\x01\n" +
+	"\x1aCreateLessonSeriesResponse\x12\x1b\n" +
+	"\tseries_id\x18\x01 \x01(\tR\bseriesId\x12-\n" +
+	"\alessons\x18\x02 \x03(\v2\x13.schedule.v1.LessonR\alessons\x12<\n" +
+	"\x06failed\x18\x03 \x03(\v2$.schedule.v1.SeriesOccurrenceFailureR\x06failed\"`\n" +
+	"\x19CancelLessonSeriesRequest\x12\x1b\n" +
+	"\tseries_id\x18\x01 \x01(\tR\bseriesId\x12\x1b\n" +
+	"\x06reason\x18\x02 \x01(\tH\x00R\x06reason\x88\x01\x01B\t\n" +
+	"\a_reason\"\xa4\x02\n" +
+	"\x1aCreateLessonPackageRequest\x12\x19\n" +
+	"\btutor_id\x18\x01 \x01(\tR\atutorId\x12\x1d\n" +
+	"\n" +
+	"student_id\x18\x02 \x01(\tR\tstudentId\x12#\n" +
+	"\rlessons_count\x18\x03 \x01(\x05R\flessonsCount\x12\x1b\n" +
+	"\tprice_rub\x18\x04 \x01(\x05R\bpriceRub\x12>\n" +
+	"\n" +
+	"valid_from\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\tvalidFrom\x88\x01\x01\x12;\n" +
+	"\vvalid_until\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"validUntilB\r\n" +
+	"\v_valid_from\"T\n" +
+	"\x18GetPackageBalanceRequest\x12\x19\n" +
+	"\btutor_id\x18\x01 \x01(\tR\atutorId\x12\x1d\n" +
+	"\n" +
+	"student_id\x18\x02 \x01(\tR\tstudentId\"u\n" +
+	"\x0ePackageBalance\x12+\n" +
+	"\x11lessons_remaining\x18\x01 \x01(\x05R\x10lessonsRemaining\x126\n" +
+	"\bpackages\x18\x02 \x03(\v2\x1a.schedule.v1.LessonPackageR\bpackages\"\xfb\x02\n" +
+	"\rLessonPackage\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\btutor_id\x18\x02 \x01(\tR\atutorId\x12\x1d\n" +
+	"\n" +
+	"student_id\x18\x03 \x01(\tR\tstudentId\x12#\n" +
+	"\rlessons_total\x18\x04 \x01(\x05R\flessonsTotal\x12+\n" +
+	"\x11lessons_remaining\x18\x05 \x01(\x05R\x10lessonsRemaining\x12\x1b\n" +
+	"\tprice_rub\x18\x06 \x01(\x05R\bpriceRub\x129\n" +
+	"\n" +
+	"valid_from\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tvalidFrom\x12;\n" +
+	"\vvalid_until\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"validUntil\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xbe\x01\n" +
+	"\x17GetScheduleStatsRequest\x12\x19\n" +
+	"\btutor_id\x18\x01 \x01(\tR\atutorId\x12.\n" +
+	"\x04from\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\x12\x1f\n" +
+	"\btimezone\x18\x04 \x01(\tH\x00R\btimezone\x88\x01\x01B\v\n" +
+	"\t_timezone\"\xae\x06\n" +
+	"\rScheduleStats\x12'\n" +
+	"\x0favailable_hours\x18\x01 \x01(\x01R\x0eavailableHours\x12!\n" +
+	"\fbooked_hours\x18\x02 \x01(\x01R\vbookedHours\x12!\n" +
+	"\ftaught_hours\x18\x03 \x01(\x01R\vtaughtHours\x12 \n" +
+	"\vutilization\x18\x04 \x01(\x01R\vutilization\x12#\n" +
+	"\rlessons_total\x18\x05 \x01(\x05R\flessonsTotal\x12+\n" +
+	"\x11lessons_completed\x18\x06 \x01(\x05R\x10lessonsCompleted\x12+\n" +
+	"\x11lessons_cancelled\x18\a \x01(\x05R\x10lessonsCancelled\x12;\n" +
+	"\x1alessons_cancelled_by_tutor\x18\b \x01(\x05R\x17lessonsCancelledByTutor\x12?\n" +
+	"\x1clessons_cancelled_by_student\x18\t \x01(\x05R\x19lessonsCancelledByStudent\x12&\n" +
+	"\x0fno_show_student\x18\n" +
+	" \x01(\x05R\rnoShowStudent\x12\"\n" +
+	"\rno_show_tutor\x18\v \x01(\x05R\vnoShowTutor\x126\n" +
+	"\x17tutor_cancellation_rate\x18\f \x01(\x01R\x15tutorCancellationRate\x12:\n" +
+	"\x19student_cancellation_rate\x18\r \x01(\x01R\x17studentCancellationRate\x12!\n" +
+	"\fearnings_rub\x18\x0e \x01(\x03R\vearningsRub\x122\n" +
+	"\x15expected_earnings_rub\x18\x0f \x01(\x03R\x13expectedEarningsRub\x12;\n" +
+	"\bstudents\x18\x10 \x03(\v2\x1f.schedule.v1.StudentLessonStatsR\bstudents\x12;\n" +
+	"\bheat_map\x18\x11 \x03(\v2 .schedule.v1.ScheduleHeatMapCellR\aheatMap\"\xeb\x01\n" +
+	"\x12StudentLessonStats\x12\x1d\n" +
+	"\n" +
+	"student_id\x18\x01 \x01(\tR\tstudentId\x12#\n" +
+	"\rlessons_total\x18\x02 \x01(\x05R\flessonsTotal\x12+\n" +
+	"\x11lessons_completed\x18\x03 \x01(\x05R\x10lessonsCompleted\x12+\n" +
+	"\x11lessons_cancelled\x18\x04 \x01(\x05R\x10lessonsCancelled\x12\x14\n" +
+	"\x05hours\x18\x05 \x01(\x01R\x05hours\x12!\n" +
+	"\fearnings_rub\x18\x06 \x01(\x03R\vearningsRub\"\x93\x01\n" +
+	"\x13ScheduleHeatMapCell\x12\x18\n" +
+	"\aweekday\x18\x01 \x01(\x05R\aweekday\x12\x12\n" +
+	"\x04hour\x18\x02 \x01(\x05R\x04hour\x12'\n" +
+	"\x0favailable_slots\x18\x03 \x01(\x05R\x0eavailableSlots\x12%\n" +
+	"\x0ebooked_lessons\x18\x04 \x01(\x05R\rbookedLessons\"D\n" +
+	"\x13ListLessonsResponse\x12-\n" +
+	"\alessons\x18\x01 \x03(\v2\x13.schedule.v1.LessonR\alessons\"\xb0\b\n" +
+	"\x06Lesson\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\aslot_id\x18\x02 \x01(\tR\x06slotId\x12\x1d\n" +
+	"\n" +
+	"student_id\x18\x03 \x01(\tR\tstudentId\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12\x17\n" +
+	"\ais_paid\x18\x05 \x01(\bR\x06isPaid\x12,\n" +
+	"\x0fconnection_link\x18\x06 \x01(\tH\x00R\x0econnectionLink\x88\x01\x01\x12 \n" +
+	"\tprice_rub\x18\a \x01(\x05H\x01R\bpriceRub\x88\x01\x01\x12&\n" +
+	"\fpayment_info\x18\b \x01(\tH\x02R\vpaymentInfo\x88\x01\x01\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x127\n" +
+	"\tedited_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\beditedAt\x12(\n" +
+	"\rcancel_reason\x18\v \x01(\tH\x03R\fcancelReason\x88\x01\x01\x12 \n" +
+	"\tseries_id\x18\f \x01(\tH\x04R\bseriesId\x88\x01\x01\x12I\n" +
+	"\x10actual_starts_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampH\x05R\x0eactualStartsAt\x88\x01\x01\x12E\n" +
+	"\x0eactual_ends_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampH\x06R\factualEndsAt\x88\x01\x01\x123\n" +
+	"\x05notes\x18\x0f \x01(\v2\x18.schedule.v1.LessonNotesH\aR\x05notes\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"package_id\x18\x10 \x01(\tH\bR\tpackageId\x88\x01\x01\x12&\n" +
+	"\fcancelled_by\x18\x11 \x01(\tH\tR\vcancelledBy\x88\x01\x01\x12\x1e\n" +
+	"\btutor_id\x18\x12 \x01(\tH\n" +
+	"R\atutorId\x88\x01\x01\x12<\n" +
+	"\tstarts_at\x18\x13 \x01(\v2\x1a.google.protobuf.TimestampH\vR\bstartsAt\x88\x01\x01\x128\n" +
+	"\aends_at\x18\x14 \x01(\v2\x1a.google.protobuf.TimestampH\fR\x06endsAt\x88\x01\x01B\x12\n" +
+	"\x10_connection_linkB\f\n" +
+	"\n" +
+	"_price_rubB\x0f\n" +
+	"\r_payment_infoB\x10\n" +
+	"\x0e_cancel_reasonB\f\n" +
+	"\n" +
+	"_series_idB\x13\n" +
+	"\x11_actual_starts_atB\x11\n" +
+	"\x0f_actual_ends_atB\b\n" +
+	"\x06_notesB\r\n" +
+	"\v_package_idB\x0f\n" +
+	"\r_cancelled_byB\v\n" +
+	"\t_tutor_idB\f\n" +
+	"\n" +
+	"_starts_atB\n" +
+	"\n" +
+	"\b_ends_at\"\x85\x02\n" +
+	"\vLessonNotes\x12\x1b\n" +
+	"\tlesson_id\x18\x01 \x01(\tR\blessonId\x12!\n" +
+	"\fprivate_note\x18\x02 \x01(\tR\vprivateNote\x12\x16\n" +
+	"\x06agenda\x18\x03 \x01(\tR\x06agenda\x12\x18\n" +
+	"\asummary\x18\x04 \x01(\tR\asummary\x12.\n" +
+	"\x13attachment_file_ids\x18\x05 \x03(\tR\x11attachmentFileIds\x12\x1b\n" +
+	"\tedited_by\x18\x06 \x01(\tR\beditedBy\x127\n" +
+	"\tedited_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\beditedAt\"\a\n" +
+	"\x05Empty*f\n" +
+	"\x12LessonStatusFilter\x12\n" +
+	"\n" +
+	"\x06BOOKED\x10\x00\x12\r\n" +
+	"\tCANCELLED\x10\x01\x12\r\n" +
+	"\tCOMPLETED\x10\x02\x12\x13\n" +
+	"\x0fNO_SHOW_STUDENT\x10\x03\x12\x11\n" +
+	"\rNO_SHOW_TUTOR\x10\x042\x91\x0f\n" +
+	"\x0fScheduleService\x129\n" +
+	"\aGetSlot\x12\x1b.schedule.v1.GetSlotRequest\x1a\x11.schedule.v1.Slot\x12?\n" +
+	"\n" +
+	"CreateSlot\x12\x1e.schedule.v1.CreateSlotRequest\x1a\x11.schedule.v1.Slot\x12?\n" +
+	"\n" +
+	"UpdateSlot\x12\x1e.schedule.v1.UpdateSlotRequest\x1a\x11.schedule.v1.Slot\x12@\n" +
+	"\n" +
+	"DeleteSlot\x12\x1e.schedule.v1.DeleteSlotRequest\x1a\x12.schedule.v1.Empty\x12X\n" +
+	"\x10ListSlotsByTutor\x12$.schedule.v1.ListSlotsByTutorRequest\x1a\x1e.schedule.v1.ListSlotsResponse\x12?\n" +
+	"\tGetLesson\x12\x1d.schedule.v1.GetLessonRequest\x1a\x13.schedule.v1.Lesson\x12E\n" +
+	"\fCreateLesson\x12 .schedule.v1.CreateLessonRequest\x1a\x13.schedule.v1.Lesson\x12E\n" +
+	"\fUpdateLesson\x12 .schedule.v1.UpdateLessonRequest\x1a\x13.schedule.v1.Lesson\x12E\n" +
+	"\fCancelLesson\x12 .schedule.v1.CancelLessonRequest\x1a\x13.schedule.v1.Lesson\x12M\n" +
+	"\x10UpdateAttendance\x12$.schedule.v1.UpdateAttendanceRequest\x1a\x13.schedule.v1.Lesson\x12T\n" +
+	"\x11UpsertLessonNotes\x12%.schedule.v1.UpsertLessonNotesRequest\x1a\x18.schedule.v1.LessonNotes\x12q\n" +
+	"\x16ListLessonNotesHistory\x12*.schedule.v1.ListLessonNotesHistoryRequest\x1a+.schedule.v1.ListLessonNotesHistoryResponse\x12A\n" +
+	"\n" +
+	"MarkAsPaid\x12\x1e.schedule.v1.MarkAsPaidRequest\x1a\x13.schedule.v1.Lesson\x12^\n" +
+	"\x12ListLessonsByTutor\x12&.schedule.v1.ListLessonsByTutorRequest\x1a .schedule.v1.ListLessonsResponse\x12b\n" +
+	"\x14ListLessonsByStudent\x12(.schedule.v1.ListLessonsByStudentRequest\x1a .schedule.v1.ListLessonsResponse\x12\\\n" +
+	"\x11ListLessonsByPair\x12%.schedule.v1.ListLessonsByPairRequest\x1a .schedule.v1.ListLessonsResponse\x12P\n" +
+	"\vBlockPeriod\x12\x1f.schedule.v1.BlockPeriodRequest\x1a .schedule.v1.BlockPeriodResponse\x12e\n" +
+	"\x12CreateLessonSeries\x12&.schedule.v1.CreateLessonSeriesRequest\x1a'.schedule.v1.CreateLessonSeriesResponse\x12^\n" +
+	"\x12CancelLessonSeries\x12&.schedule.v1.CancelLessonSeriesRequest\x1a .schedule.v1.ListLessonsResponse\x12Z\n" +
+	"\x13CreateLessonPackage\x12'.schedule.v1.CreateLessonPackageRequest\x1a\x1a.schedule.v1.LessonPackage\x12W\n" +
+	"\x11GetPackageBalance\x12%.schedule.v1.GetPackageBalanceRequest\x1a\x1b.schedule.v1.PackageBalance\x12T\n" +
+	"\x10GetScheduleStats\x12$.schedule.v1.GetScheduleStatsRequest\x1a\x1a.schedule.v1.ScheduleStats\x12n\n" +
+	"\x1aListCompletedUnpaidLessons\x12..schedule.v1.ListCompletedUnpaidLessonsRequest\x1a .schedule.v1.ListLessonsResponseB\vZ\t./pkg/pkgb\x06proto3"
 
 var (
 	file_schedule_service_proto_rawDescOnce sync.Once
@@ -1364,7 +2933,7 @@ func file_schedule_service_proto_rawDescGZIP() []byte {
 }
 
 var file_schedule_service_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_schedule_service_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
+var file_schedule_service_proto_msgTypes = make([]protoimpl.MessageInfo, 38)
 var file_schedule_service_proto_goTypes = []any{
 	(LessonStatusFilter)(0),                   // 0: schedule.v1.LessonStatusFilter
 	(*GetSlotRequest)(nil),                    // 1: schedule.v1.GetSlotRequest
@@ -1378,66 +2947,134 @@ var file_schedule_service_proto_goTypes = []any{
 	(*CreateLessonRequest)(nil),               // 9: schedule.v1.CreateLessonRequest
 	(*UpdateLessonRequest)(nil),               // 10: schedule.v1.UpdateLessonRequest
 	(*CancelLessonRequest)(nil),               // 11: schedule.v1.CancelLessonRequest
-	(*MarkAsPaidRequest)(nil),                 // 12: schedule.v1.MarkAsPaidRequest
-	(*ListLessonsByTutorRequest)(nil),         // 13: schedule.v1.ListLessonsByTutorRequest
-	(*ListLessonsByStudentRequest)(nil),       // 14: schedule.v1.ListLessonsByStudentRequest
-	(*ListLessonsByPairRequest)(nil),          // 15: schedule.v1.ListLessonsByPairRequest
-	(*ListCompletedUnpaidLessonsRequest)(nil), // 16: schedule.v1.ListCompletedUnpaidLessonsRequest
-	(*ListLessonsResponse)(nil),               // 17: schedule.v1.ListLessonsResponse
-	(*Lesson)(nil),                            // 18: schedule.v1.Lesson
-	(*Empty)(nil),                             // 19: schedule.v1.Empty
-	(*timestamppb.Timestamp)(nil),             // 20: google.protobuf.Timestamp
+	(*UpdateAttendanceRequest)(nil),           // 12: schedule.v1.UpdateAttendanceRequest
+	(*UpsertLessonNotesRequest)(nil),          // 13: schedule.v1.UpsertLessonNotesRequest
+	(*ListLessonNotesHistoryRequest)(nil),     // 14: schedule.v1.ListLessonNotesHistoryRequest
+	(*ListLessonNotesHistoryResponse)(nil),    // 15: schedule.v1.ListLessonNotesHistoryResponse
+	(*MarkAsPaidRequest)(nil),                 // 16: schedule.v1.MarkAsPaidRequest
+	(*ListLessonsByTutorRequest)(nil),         // 17: schedule.v1.ListLessonsByTutorRequest
+	(*ListLessonsByStudentRequest)(nil),       // 18: schedule.v1.ListLessonsByStudentRequest
+	(*ListLessonsByPairRequest)(nil),          // 19: schedule.v1.ListLessonsByPairRequest
+	(*ListCompletedUnpaidLessonsRequest)(nil), // 20: schedule.v1.ListCompletedUnpaidLessonsRequest
+	(*BlockPeriodRequest)(nil),                // 21: schedule.v1.BlockPeriodRequest
+	(*BlockPeriodResponse)(nil),               // 22: schedule.v1.BlockPeriodResponse
+	(*CreateLessonSeriesRequest)(nil),         // 23: schedule.v1.CreateLessonSeriesRequest
+	(*SeriesOccurrenceFailure)(nil),           // 24: schedule.v1.SeriesOccurrenceFailure
+	(*CreateLessonSeriesResponse)(nil),        // 25: schedule.v1.CreateLessonSeriesResponse
+	(*CancelLessonSeriesRequest)(nil),         // 26: schedule.v1.CancelLessonSeriesRequest
+	(*CreateLessonPackageRequest)(nil),        // 27: schedule.v1.CreateLessonPackageRequest
+	(*GetPackageBalanceRequest)(nil),          // 28: schedule.v1.GetPackageBalanceRequest
+	(*PackageBalance)(nil),                    // 29: schedule.v1.PackageBalance
+	(*LessonPackage)(nil),                     // 30: schedule.v1.LessonPackage
+	(*GetScheduleStatsRequest)(nil),           // 31: schedule.v1.GetScheduleStatsRequest
+	(*ScheduleStats)(nil),                     // 32: schedule.v1.ScheduleStats
+	(*StudentLessonStats)(nil),                // 33: schedule.v1.StudentLessonStats
+	(*ScheduleHeatMapCell)(nil),               // 34: schedule.v1.ScheduleHeatMapCell
+	(*ListLessonsResponse)(nil),               // 35: schedule.v1.ListLessonsResponse
+	(*Lesson)(nil),                            // 36: schedule.v1.Lesson
+	(*LessonNotes)(nil),                       // 37: schedule.v1.LessonNotes
+	(*Empty)(nil),                             // 38: schedule.v1.Empty
+	(*timestamppb.Timestamp)(nil),             // 39: google.protobuf.Timestamp
 }
 var file_schedule_service_proto_depIdxs = []int32{
-	20, // 0: schedule.v1.CreateSlotRequest.starts_at:type_name -> google.protobuf.Timestamp
-	20, // 1: schedule.v1.CreateSlotRequest.ends_at:type_name -> google.protobuf.Timestamp
-	20, // 2: schedule.v1.UpdateSlotRequest.starts_at:type_name -> google.protobuf.Timestamp
-	20, // 3: schedule.v1.UpdateSlotRequest.ends_at:type_name -> google.protobuf.Timestamp
+	39, // 0: schedule.v1.CreateSlotRequest.starts_at:type_name -> google.protobuf.Timestamp
+	39, // 1: schedule.v1.CreateSlotRequest.ends_at:type_name -> google.protobuf.Timestamp
+	39, // 2: schedule.v1.UpdateSlotRequest.starts_at:type_name -> google.protobuf.Timestamp
+	39, // 3: schedule.v1.UpdateSlotRequest.ends_at:type_name -> google.protobuf.Timestamp
 	7,  // 4: schedule.v1.ListSlotsResponse.slots:type_name -> schedule.v1.Slot
-	20, // 5: schedule.v1.Slot.starts_at:type_name -> google.protobuf.Timestamp
-	20, // 6: schedule.v1.Slot.ends_at:type_name -> google.protobuf.Timestamp
-	20, // 7: schedule.v1.Slot.created_at:type_name -> google.protobuf.Timestamp
-	20, // 8: schedule.v1.Slot.edited_at:type_name -> google.protobuf.Timestamp
-	0,  // 9: schedule.v1.ListLessonsByTutorRequest.status_filter:type_name -> schedule.v1.LessonStatusFilter
-	0,  // 10: schedule.v1.ListLessonsByStudentRequest.status_filter:type_name -> schedule.v1.LessonStatusFilter
-	0,  // 11: schedule.v1.ListLessonsByPairRequest.status_filter:type_name -> schedule.v1.LessonStatusFilter
-	20, // 12: schedule.v1.ListCompletedUnpaidLessonsRequest.after:type_name -> google.protobuf.Timestamp
-	18, // 13: schedule.v1.ListLessonsResponse.lessons:type_name -> schedule.v1.Lesson
-	20, // 14: schedule.v1.Lesson.created_at:type_name -> google.protobuf.Timestamp
-	20, // 15: schedule.v1.Lesson.edited_at:type_name -> google.protobuf.Timestamp
-	1,  // 16: schedule.v1.ScheduleService.GetSlot:input_type -> schedule.v1.GetSlotRequest
-	2,  // 17: schedule.v1.ScheduleService.CreateSlot:input_type -> schedule.v1.CreateSlotRequest
-	3,  // 18: schedule.v1.ScheduleService.UpdateSlot:input_type -> schedule.v1.UpdateSlotRequest
-	4,  // 19: schedule.v1.ScheduleService.DeleteSlot:input_type -> schedule.v1.DeleteSlotRequest
-	5,  // 20: schedule.v1.ScheduleService.ListSlotsByTutor:input_type -> schedule.v1.ListSlotsByTutorRequest
-	8,  // 21: schedule.v1.ScheduleService.GetLesson:input_type -> schedule.v1.GetLessonRequest
-	9,  // 22: schedule.v1.ScheduleService.CreateLesson:input_type -> schedule.v1.CreateLessonRequest
-	10, // 23: schedule.v1.ScheduleService.UpdateLesson:input_type -> schedule.v1.UpdateLessonRequest
-	11, // 24: schedule.v1.ScheduleService.CancelLesson:input_type -> schedule.v1.CancelLessonRequest
-	12, // 25: schedule.v1.ScheduleService.MarkAsPaid:input_type -> schedule.v1.MarkAsPaidRequest
-	13, // 26: schedule.v1.ScheduleService.ListLessonsByTutor:input_type -> schedule.v1.ListLessonsByTutorRequest
-	14, // 27: schedule.v1.ScheduleService.ListLessonsByStudent:input_type -> schedule.v1.ListLessonsByStudentRequest
-	15, // 28: schedule.v1.ScheduleService.ListLessonsByPair:input_type -> schedule.v1.ListLessonsByPairRequest
-	16, // 29: schedule.v1.ScheduleService.ListCompletedUnpaidLessons:input_type -> schedule.v1.ListCompletedUnpaidLessonsRequest
-	7,  // 30: schedule.v1.ScheduleService.GetSlot:output_type -> schedule.v1.Slot
-	7,  // 31: schedule.v1.ScheduleService.CreateSlot:output_type -> schedule.v1.Slot
-	7,  // 32: schedule.v1.ScheduleService.UpdateSlot:output_type -> schedule.v1.Slot
-	19, // 33: schedule.v1.ScheduleService.DeleteSlot:output_type -> schedule.v1.Empty
-	6,  // 34: schedule.v1.ScheduleService.ListSlotsByTutor:output_type -> schedule.v1.ListSlotsResponse
-	18, // 35: schedule.v1.ScheduleService.GetLesson:output_type -> schedule.v1.Lesson
-	18, // 36: schedule.v1.ScheduleService.CreateLesson:output_type -> schedule.v1.Lesson
-	18, // 37: schedule.v1.ScheduleService.UpdateLesson:output_type -> schedule.v1.Lesson
-	18, // 38: schedule.v1.ScheduleService.CancelLesson:output_type -> schedule.v1.Lesson
-	18, // 39: schedule.v1.ScheduleService.MarkAsPaid:output_type -> schedule.v1.Lesson
-	17, // 40: schedule.v1.ScheduleService.ListLessonsByTutor:output_type -> schedule.v1.ListLessonsResponse
-	17, // 41: schedule.v1.ScheduleService.ListLessonsByStudent:output_type -> schedule.v1.ListLessonsResponse
-	17, // 42: schedule.v1.ScheduleService.ListLessonsByPair:output_type -> schedule.v1.ListLessonsResponse
-	17, // 43: schedule.v1.ScheduleService.ListCompletedUnpaidLessons:output_type -> schedule.v1.ListLessonsResponse
-	30, // [30:44] is the sub-list for method output_type
-	16, // [16:30] is the sub-list for method input_type
-	16, // [16:16] is the sub-list for extension type_name
-	16, // [16:16] is the sub-list for extension extendee
-	0,  // [0:16] is the sub-list for field type_name
+	39, // 5: schedule.v1.Slot.starts_at:type_name -> google.protobuf.Timestamp
+	39, // 6: schedule.v1.Slot.ends_at:type_name -> google.protobuf.Timestamp
+	39, // 7: schedule.v1.Slot.created_at:type_name -> google.protobuf.Timestamp
+	39, // 8: schedule.v1.Slot.edited_at:type_name -> google.protobuf.Timestamp
+	39, // 9: schedule.v1.UpdateAttendanceRequest.actual_starts_at:type_name -> google.protobuf.Timestamp
+	39, // 10: schedule.v1.UpdateAttendanceRequest.actual_ends_at:type_name -> google.protobuf.Timestamp
+	37, // 11: schedule.v1.ListLessonNotesHistoryResponse.versions:type_name -> schedule.v1.LessonNotes
+	0,  // 12: schedule.v1.ListLessonsByTutorRequest.status_filter:type_name -> schedule.v1.LessonStatusFilter
+	0,  // 13: schedule.v1.ListLessonsByStudentRequest.status_filter:type_name -> schedule.v1.LessonStatusFilter
+	0,  // 14: schedule.v1.ListLessonsByPairRequest.status_filter:type_name -> schedule.v1.LessonStatusFilter
+	39, // 15: schedule.v1.ListCompletedUnpaidLessonsRequest.after:type_name -> google.protobuf.Timestamp
+	39, // 16: schedule.v1.BlockPeriodRequest.from:type_name -> google.protobuf.Timestamp
+	39, // 17: schedule.v1.BlockPeriodRequest.to:type_name -> google.protobuf.Timestamp
+	7,  // 18: schedule.v1.BlockPeriodResponse.deleted_slots:type_name -> schedule.v1.Slot
+	36, // 19: schedule.v1.BlockPeriodResponse.cancelled_lessons:type_name -> schedule.v1.Lesson
+	7,  // 20: schedule.v1.BlockPeriodResponse.closed_slots:type_name -> schedule.v1.Slot
+	39, // 21: schedule.v1.CreateLessonSeriesRequest.starts_at:type_name -> google.protobuf.Timestamp
+	39, // 22: schedule.v1.CreateLessonSeriesRequest.ends_at:type_name -> google.protobuf.Timestamp
+	39, // 23: schedule.v1.CreateLessonSeriesRequest.until:type_name -> google.protobuf.Timestamp
+	39, // 24: schedule.v1.SeriesOccurrenceFailure.starts_at:type_name -> google.protobuf.Timestamp
+	39, // 25: schedule.v1.SeriesOccurrenceFailure.ends_at:type_name -> google.protobuf.Timestamp
+	36, // 26: schedule.v1.CreateLessonSeriesResponse.lessons:type_name -> schedule.v1.Lesson
+	24, // 27: schedule.v1.CreateLessonSeriesResponse.failed:type_name -> schedule.v1.SeriesOccurrenceFailure
+	39, // 28: schedule.v1.CreateLessonPackageRequest.valid_from:type_name -> google.protobuf.Timestamp
+	39, // 29: schedule.v1.CreateLessonPackageRequest.valid_until:type_name -> google.protobuf.Timestamp
+	30, // 30: schedule.v1.PackageBalance.packages:type_name -> schedule.v1.LessonPackage
+	39, // 31: schedule.v1.LessonPackage.valid_from:type_name -> google.protobuf.Timestamp
+	39, // 32: schedule.v1.LessonPackage.valid_until:type_name -> google.protobuf.Timestamp
+	39, // 33: schedule.v1.LessonPackage.created_at:type_name -> google.protobuf.Timestamp
+	39, // 34: schedule.v1.GetScheduleStatsRequest.from:type_name -> google.protobuf.Timestamp
+	39, // 35: schedule.v1.GetScheduleStatsRequest.to:type_name -> google.protobuf.Timestamp
+	33, // 36: schedule.v1.ScheduleStats.students:type_name -> schedule.v1.StudentLessonStats
+	34, // 37: schedule.v1.ScheduleStats.heat_map:type_name -> schedule.v1.ScheduleHeatMapCell
+	36, // 38: schedule.v1.ListLessonsResponse.lessons:type_name -> schedule.v1.Lesson
+	39, // 39: schedule.v1.Lesson.created_at:type_name -> google.protobuf.Timestamp
+	39, // 40: schedule.v1.Lesson.edited_at:type_name -> google.protobuf.Timestamp
+	39, // 41: schedule.v1.Lesson.actual_starts_at:type_name -> google.protobuf.Timestamp
+	39, // 42: schedule.v1.Lesson.actual_ends_at:type_name -> google.protobuf.Timestamp
+	37, // 43: schedule.v1.Lesson.notes:type_name -> schedule.v1.LessonNotes
+	39, // 44: schedule.v1.Lesson.starts_at:type_name -> google.protobuf.Timestamp
+	39, // 45: schedule.v1.Lesson.ends_at:type_name -> google.protobuf.Timestamp
+	39, // 46: schedule.v1.LessonNotes.edited_at:type_name -> google.protobuf.Timestamp
+	1,  // 47: schedule.v1.ScheduleService.GetSlot:input_type -> schedule.v1.GetSlotRequest
+	2,  // 48: schedule.v1.ScheduleService.CreateSlot:input_type -> schedule.v1.CreateSlotRequest
+	3,  // 49: schedule.v1.ScheduleService.UpdateSlot:input_type -> schedule.v1.UpdateSlotRequest
+	4,  // 50: schedule.v1.ScheduleService.DeleteSlot:input_type -> schedule.v1.DeleteSlotRequest
+	5,  // 51: schedule.v1.ScheduleService.ListSlotsByTutor:input_type -> schedule.v1.ListSlotsByTutorRequest
+	8,  // 52: schedule.v1.ScheduleService.GetLesson:input_type -> schedule.v1.GetLessonRequest
+	9,  // 53: schedule.v1.ScheduleService.CreateLesson:input_type -> schedule.v1.CreateLessonRequest
+	10, // 54: schedule.v1.ScheduleService.UpdateLesson:input_type -> schedule.v1.UpdateLessonRequest
+	11, // 55: schedule.v1.ScheduleService.CancelLesson:input_type -> schedule.v1.CancelLessonRequest
+	12, // 56: schedule.v1.ScheduleService.UpdateAttendance:input_type -> schedule.v1.UpdateAttendanceRequest
+	13, // 57: schedule.v1.ScheduleService.UpsertLessonNotes:input_type -> schedule.v1.UpsertLessonNotesRequest
+	14, // 58: schedule.v1.ScheduleService.ListLessonNotesHistory:input_type -> schedule.v1.ListLessonNotesHistoryRequest
+	16, // 59: schedule.v1.ScheduleService.MarkAsPaid:input_type -> schedule.v1.MarkAsPaidRequest
+	17, // 60: schedule.v1.ScheduleService.ListLessonsByTutor:input_type -> schedule.v1.ListLessonsByTutorRequest
+	18, // 61: schedule.v1.ScheduleService.ListLessonsByStudent:input_type -> schedule.v1.ListLessonsByStudentRequest
+	19, // 62: schedule.v1.ScheduleService.ListLessonsByPair:input_type -> schedule.v1.ListLessonsByPairRequest
+	21, // 63: schedule.v1.ScheduleService.BlockPeriod:input_type -> schedule.v1.BlockPeriodRequest
+	23, // 64: schedule.v1.ScheduleService.CreateLessonSeries:input_type -> schedule.v1.CreateLessonSeriesRequest
+	26, // 65: schedule.v1.ScheduleService.CancelLessonSeries:input_type -> schedule.v1.CancelLessonSeriesRequest
+	27, // 66: schedule.v1.ScheduleService.CreateLessonPackage:input_type -> schedule.v1.CreateLessonPackageRequest
+	28, // 67: schedule.v1.ScheduleService.GetPackageBalance:input_type -> schedule.v1.GetPackageBalanceRequest
+	31, // 68: schedule.v1.ScheduleService.GetScheduleStats:input_type -> schedule.v1.GetScheduleStatsRequest
+	20, // 69: schedule.v1.ScheduleService.ListCompletedUnpaidLessons:input_type -> schedule.v1.ListCompletedUnpaidLessonsRequest
+	7,  // 70: schedule.v1.ScheduleService.GetSlot:output_type -> schedule.v1.Slot
+	7,  // 71: schedule.v1.ScheduleService.CreateSlot:output_type -> schedule.v1.Slot
+	7,  // 72: schedule.v1.ScheduleService.UpdateSlot:output_type -> schedule.v1.Slot
+	38, // 73: schedule.v1.ScheduleService.DeleteSlot:output_type -> schedule.v1.Empty
+	6,  // 74: schedule.v1.ScheduleService.ListSlotsByTutor:output_type -> schedule.v1.ListSlotsResponse
+	36, // 75: schedule.v1.ScheduleService.GetLesson:output_type -> schedule.v1.Lesson
+	36, // 76: schedule.v1.ScheduleService.CreateLesson:output_type -> schedule.v1.Lesson
+	36, // 77: schedule.v1.ScheduleService.UpdateLesson:output_type -> schedule.v1.Lesson
+	36, // 78: schedule.v1.ScheduleService.CancelLesson:output_type -> schedule.v1.Lesson
+	36, // 79: schedule.v1.ScheduleService.UpdateAttendance:output_type -> schedule.v1.Lesson
+	37, // 80: schedule.v1.ScheduleService.UpsertLessonNotes:output_type -> schedule.v1.LessonNotes
+	15, // 81: schedule.v1.ScheduleService.ListLessonNotesHistory:output_type -> schedule.v1.ListLessonNotesHistoryResponse
+	36, // 82: schedule.v1.ScheduleService.MarkAsPaid:output_type -> schedule.v1.Lesson
+	35, // 83: schedule.v1.ScheduleService.ListLessonsByTutor:output_type -> schedule.v1.ListLessonsResponse
+	35, // 84: schedule.v1.ScheduleService.ListLessonsByStudent:output_type -> schedule.v1.ListLessonsResponse
+	35, // 85: schedule.v1.ScheduleService.ListLessonsByPair:output_type -> schedule.v1.ListLessonsResponse
+	22, // 86: schedule.v1.ScheduleService.BlockPeriod:output_type -> schedule.v1.BlockPeriodResponse
+	25, // 87: schedule.v1.ScheduleService.CreateLessonSeries:output_type -> schedule.v1.CreateLessonSeriesResponse
+	35, // 88: schedule.v1.ScheduleService.CancelLessonSeries:output_type -> schedule.v1.ListLessonsResponse
+	30, // 89: schedule.v1.ScheduleService.CreateLessonPackage:output_type -> schedule.v1.LessonPackage
+	29, // 90: schedule.v1.ScheduleService.GetPackageBalance:output_type -> schedule.v1.PackageBalance
+	32, // 91: schedule.v1.ScheduleService.GetScheduleStats:output_type -> schedule.v1.ScheduleStats
+	35, // 92: schedule.v1.ScheduleService.ListCompletedUnpaidLessons:output_type -> schedule.v1.ListLessonsResponse
+	70, // [70:93] is the sub-list for method output_type
+	47, // [47:70] is the sub-list for method input_type
+	47, // [47:47] is the sub-list for extension type_name
+	47, // [47:47] is the sub-list for extension extendee
+	0,  // [0:47] is the sub-list for field type_name
 }
 
 func init() { file_schedule_service_proto_init() }
@@ -1448,15 +3085,21 @@ func file_schedule_service_proto_init() {
 	file_schedule_service_proto_msgTypes[4].OneofWrappers = []any{}
 	file_schedule_service_proto_msgTypes[6].OneofWrappers = []any{}
 	file_schedule_service_proto_msgTypes[9].OneofWrappers = []any{}
-	file_schedule_service_proto_msgTypes[15].OneofWrappers = []any{}
-	file_schedule_service_proto_msgTypes[17].OneofWrappers = []any{}
+	file_schedule_service_proto_msgTypes[11].OneofWrappers = []any{}
+	file_schedule_service_proto_msgTypes[19].OneofWrappers = []any{}
+	file_schedule_service_proto_msgTypes[20].OneofWrappers = []any{}
+	file_schedule_service_proto_msgTypes[22].OneofWrappers = []any{}
+	file_schedule_service_proto_msgTypes[25].OneofWrappers = []any{}
+	file_schedule_service_proto_msgTypes[26].OneofWrappers = []any{}
+	file_schedule_service_proto_msgTypes[30].OneofWrappers = []any{}
+	file_schedule_service_proto_msgTypes[35].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_schedule_service_proto_rawDesc), len(file_schedule_service_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   19,
+			NumMessages:   38,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
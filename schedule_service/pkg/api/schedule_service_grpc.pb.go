@@ -28,10 +28,19 @@ const (
 	ScheduleService_CreateLesson_FullMethodName               = "/schedule.v1.ScheduleService/CreateLesson"
 	ScheduleService_UpdateLesson_FullMethodName               = "/schedule.v1.ScheduleService/UpdateLesson"
 	ScheduleService_CancelLesson_FullMethodName               = "/schedule.v1.ScheduleService/CancelLesson"
+	ScheduleService_UpdateAttendance_FullMethodName           = "/schedule.v1.ScheduleService/UpdateAttendance"
+	ScheduleService_UpsertLessonNotes_FullMethodName          = "/schedule.v1.ScheduleService/UpsertLessonNotes"
+	ScheduleService_ListLessonNotesHistory_FullMethodName     = "/schedule.v1.ScheduleService/ListLessonNotesHistory"
 	ScheduleService_MarkAsPaid_FullMethodName                 = "/schedule.v1.ScheduleService/MarkAsPaid"
 	ScheduleService_ListLessonsByTutor_FullMethodName         = "/schedule.v1.ScheduleService/ListLessonsByTutor"
 	ScheduleService_ListLessonsByStudent_FullMethodName       = "/schedule.v1.ScheduleService/ListLessonsByStudent"
 	ScheduleService_ListLessonsByPair_FullMethodName          = "/schedule.v1.ScheduleService/ListLessonsByPair"
+	ScheduleService_BlockPeriod_FullMethodName                = "/schedule.v1.ScheduleService/BlockPeriod"
+	ScheduleService_CreateLessonSeries_FullMethodName         = "/schedule.v1.ScheduleService/CreateLessonSeries"
+	ScheduleService_CancelLessonSeries_FullMethodName         = "/schedule.v1.ScheduleService/CancelLessonSeries"
+	ScheduleService_CreateLessonPackage_FullMethodName        = "/schedule.v1.ScheduleService/CreateLessonPackage"
+	ScheduleService_GetPackageBalance_FullMethodName          = "/schedule.v1.ScheduleService/GetPackageBalance"
+	ScheduleService_GetScheduleStats_FullMethodName           = "/schedule.v1.ScheduleService/GetScheduleStats"
 	ScheduleService_ListCompletedUnpaidLessons_FullMethodName = "/schedule.v1.ScheduleService/ListCompletedUnpaidLessons"
 )
 
@@ -50,10 +59,23 @@ type ScheduleServiceClient interface {
 	CreateLesson(ctx context.Context, in *CreateLessonRequest, opts ...grpc.CallOption) (*Lesson, error)
 	UpdateLesson(ctx context.Context, in *UpdateLessonRequest, opts ...grpc.CallOption) (*Lesson, error)
 	CancelLesson(ctx context.Context, in *CancelLessonRequest, opts ...grpc.CallOption) (*Lesson, error)
+	UpdateAttendance(ctx context.Context, in *UpdateAttendanceRequest, opts ...grpc.CallOption) (*Lesson, error)
+	UpsertLessonNotes(ctx context.Context, in *UpsertLessonNotesRequest, opts ...grpc.CallOption) (*LessonNotes, error)
+	ListLessonNotesHistory(ctx context.Context, in *ListLessonNotesHistoryRequest, opts ...grpc.CallOption) (*ListLessonNotesHistoryResponse, error)
 	MarkAsPaid(ctx context.Context, in *MarkAsPaidRequest, opts ...grpc.CallOption) (*Lesson, error)
 	ListLessonsByTutor(ctx context.Context, in *ListLessonsByTutorRequest, opts ...grpc.CallOption) (*ListLessonsResponse, error)
 	ListLessonsByStudent(ctx context.Context, in *ListLessonsByStudentRequest, opts ...grpc.CallOption) (*ListLessonsResponse, error)
 	ListLessonsByPair(ctx context.Context, in *ListLessonsByPairRequest, opts ...grpc.CallOption) (*ListLessonsResponse, error)
+	// --- BULK ---
+	BlockPeriod(ctx context.Context, in *BlockPeriodRequest, opts ...grpc.CallOption) (*BlockPeriodResponse, error)
+	// --- SERIES ---
+	CreateLessonSeries(ctx context.Context, in *CreateLessonSeriesRequest, opts ...grpc.CallOption) (*CreateLessonSeriesResponse, error)
+	CancelLessonSeries(ctx context.Context, in *CancelLessonSeriesRequest, opts ...grpc.CallOption) (*ListLessonsResponse, error)
+	// --- PACKAGES ---
+	CreateLessonPackage(ctx context.Context, in *CreateLessonPackageRequest, opts ...grpc.CallOption) (*LessonPackage, error)
+	GetPackageBalance(ctx context.Context, in *GetPackageBalanceRequest, opts ...grpc.CallOption) (*PackageBalance, error)
+	// --- STATS ---
+	GetScheduleStats(ctx context.Context, in *GetScheduleStatsRequest, opts ...grpc.CallOption) (*ScheduleStats, error)
 	// --- INTERNAL ---
 	ListCompletedUnpaidLessons(ctx context.Context, in *ListCompletedUnpaidLessonsRequest, opts ...grpc.CallOption) (*ListLessonsResponse, error)
 }
@@ -156,6 +178,36 @@ func (c *scheduleServiceClient) CancelLesson(ctx context.Context, in *CancelLess
 	return out, nil
 }
 
+func (c *scheduleServiceClient) UpdateAttendance(ctx context.Context, in *UpdateAttendanceRequest, opts ...grpc.CallOption) (*Lesson, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Lesson)
+	err := c.cc.Invoke(ctx, ScheduleService_UpdateAttendance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scheduleServiceClient) UpsertLessonNotes(ctx context.Context, in *UpsertLessonNotesRequest, opts ...grpc.CallOption) (*LessonNotes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LessonNotes)
+	err := c.cc.Invoke(ctx, ScheduleService_UpsertLessonNotes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scheduleServiceClient) ListLessonNotesHistory(ctx context.Context, in *ListLessonNotesHistoryRequest, opts ...grpc.CallOption) (*ListLessonNotesHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListLessonNotesHistoryResponse)
+	err := c.cc.Invoke(ctx, ScheduleService_ListLessonNotesHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *scheduleServiceClient) MarkAsPaid(ctx context.Context, in *MarkAsPaidRequest, opts ...grpc.CallOption) (*Lesson, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(Lesson)
@@ -196,6 +248,66 @@ func (c *scheduleServiceClient) ListLessonsByPair(ctx context.Context, in *ListL
 	return out, nil
 }
 
+func (c *scheduleServiceClient) BlockPeriod(ctx context.Context, in *BlockPeriodRequest, opts ...grpc.CallOption) (*BlockPeriodResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BlockPeriodResponse)
+	err := c.cc.Invoke(ctx, ScheduleService_BlockPeriod_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scheduleServiceClient) CreateLessonSeries(ctx context.Context, in *CreateLessonSeriesRequest, opts ...grpc.CallOption) (*CreateLessonSeriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateLessonSeriesResponse)
+	err := c.cc.Invoke(ctx, ScheduleService_CreateLessonSeries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scheduleServiceClient) CancelLessonSeries(ctx context.Context, in *CancelLessonSeriesRequest, opts ...grpc.CallOption) (*ListLessonsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListLessonsResponse)
+	err := c.cc.Invoke(ctx, ScheduleService_CancelLessonSeries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scheduleServiceClient) CreateLessonPackage(ctx context.Context, in *CreateLessonPackageRequest, opts ...grpc.CallOption) (*LessonPackage, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LessonPackage)
+	err := c.cc.Invoke(ctx, ScheduleService_CreateLessonPackage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scheduleServiceClient) GetPackageBalance(ctx context.Context, in *GetPackageBalanceRequest, opts ...grpc.CallOption) (*PackageBalance, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PackageBalance)
+	err := c.cc.Invoke(ctx, ScheduleService_GetPackageBalance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scheduleServiceClient) GetScheduleStats(ctx context.Context, in *GetScheduleStatsRequest, opts ...grpc.CallOption) (*ScheduleStats, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ScheduleStats)
+	err := c.cc.Invoke(ctx, ScheduleService_GetScheduleStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *scheduleServiceClient) ListCompletedUnpaidLessons(ctx context.Context, in *ListCompletedUnpaidLessonsRequest, opts ...grpc.CallOption) (*ListLessonsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListLessonsResponse)
@@ -221,10 +333,23 @@ type ScheduleServiceServer interface {
 	CreateLesson(context.Context, *CreateLessonRequest) (*Lesson, error)
 	UpdateLesson(context.Context, *UpdateLessonRequest) (*Lesson, error)
 	CancelLesson(context.Context, *CancelLessonRequest) (*Lesson, error)
+	UpdateAttendance(context.Context, *UpdateAttendanceRequest) (*Lesson, error)
+	UpsertLessonNotes(context.Context, *UpsertLessonNotesRequest) (*LessonNotes, error)
+	ListLessonNotesHistory(context.Context, *ListLessonNotesHistoryRequest) (*ListLessonNotesHistoryResponse, error)
 	MarkAsPaid(context.Context, *MarkAsPaidRequest) (*Lesson, error)
 	ListLessonsByTutor(context.Context, *ListLessonsByTutorRequest) (*ListLessonsResponse, error)
 	ListLessonsByStudent(context.Context, *ListLessonsByStudentRequest) (*ListLessonsResponse, error)
 	ListLessonsByPair(context.Context, *ListLessonsByPairRequest) (*ListLessonsResponse, error)
+	// --- BULK ---
+	BlockPeriod(context.Context, *BlockPeriodRequest) (*BlockPeriodResponse, error)
+	// --- SERIES ---
+	CreateLessonSeries(context.Context, *CreateLessonSeriesRequest) (*CreateLessonSeriesResponse, error)
+	CancelLessonSeries(context.Context, *CancelLessonSeriesRequest) (*ListLessonsResponse, error)
+	// --- PACKAGES ---
+	CreateLessonPackage(context.Context, *CreateLessonPackageRequest) (*LessonPackage, error)
+	GetPackageBalance(context.Context, *GetPackageBalanceRequest) (*PackageBalance, error)
+	// --- STATS ---
+	GetScheduleStats(context.Context, *GetScheduleStatsRequest) (*ScheduleStats, error)
 	// --- INTERNAL ---
 	ListCompletedUnpaidLessons(context.Context, *ListCompletedUnpaidLessonsRequest) (*ListLessonsResponse, error)
 	mustEmbedUnimplementedScheduleServiceServer()
@@ -264,6 +389,15 @@ func (UnimplementedScheduleServiceServer) UpdateLesson(context.Context, *UpdateL
 func (UnimplementedScheduleServiceServer) CancelLesson(context.Context, *CancelLessonRequest) (*Lesson, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelLesson not implemented")
 }
+func (UnimplementedScheduleServiceServer) UpdateAttendance(context.Context, *UpdateAttendanceRequest) (*Lesson, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAttendance not implemented")
+}
+func (UnimplementedScheduleServiceServer) UpsertLessonNotes(context.Context, *UpsertLessonNotesRequest) (*LessonNotes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpsertLessonNotes not implemented")
+}
+func (UnimplementedScheduleServiceServer) ListLessonNotesHistory(context.Context, *ListLessonNotesHistoryRequest) (*ListLessonNotesHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLessonNotesHistory not implemented")
+}
 func (UnimplementedScheduleServiceServer) MarkAsPaid(context.Context, *MarkAsPaidRequest) (*Lesson, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MarkAsPaid not implemented")
 }
@@ -276,6 +410,24 @@ func (UnimplementedScheduleServiceServer) ListLessonsByStudent(context.Context,
 func (UnimplementedScheduleServiceServer) ListLessonsByPair(context.Context, *ListLessonsByPairRequest) (*ListLessonsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListLessonsByPair not implemented")
 }
+func (UnimplementedScheduleServiceServer) BlockPeriod(context.Context, *BlockPeriodRequest) (*BlockPeriodResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockPeriod not implemented")
+}
+func (UnimplementedScheduleServiceServer) CreateLessonSeries(context.Context, *CreateLessonSeriesRequest) (*CreateLessonSeriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateLessonSeries not implemented")
+}
+func (UnimplementedScheduleServiceServer) CancelLessonSeries(context.Context, *CancelLessonSeriesRequest) (*ListLessonsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelLessonSeries not implemented")
+}
+func (UnimplementedScheduleServiceServer) CreateLessonPackage(context.Context, *CreateLessonPackageRequest) (*LessonPackage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateLessonPackage not implemented")
+}
+func (UnimplementedScheduleServiceServer) GetPackageBalance(context.Context, *GetPackageBalanceRequest) (*PackageBalance, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPackageBalance not implemented")
+}
+func (UnimplementedScheduleServiceServer) GetScheduleStats(context.Context, *GetScheduleStatsRequest) (*ScheduleStats, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetScheduleStats not implemented")
+}
 func (UnimplementedScheduleServiceServer) ListCompletedUnpaidLessons(context.Context, *ListCompletedUnpaidLessonsRequest) (*ListLessonsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListCompletedUnpaidLessons not implemented")
 }
@@ -462,6 +614,60 @@ func _ScheduleService_CancelLesson_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ScheduleService_UpdateAttendance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAttendanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScheduleServiceServer).UpdateAttendance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScheduleService_UpdateAttendance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScheduleServiceServer).UpdateAttendance(ctx, req.(*UpdateAttendanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScheduleService_UpsertLessonNotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpsertLessonNotesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScheduleServiceServer).UpsertLessonNotes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScheduleService_UpsertLessonNotes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScheduleServiceServer).UpsertLessonNotes(ctx, req.(*UpsertLessonNotesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScheduleService_ListLessonNotesHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLessonNotesHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScheduleServiceServer).ListLessonNotesHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScheduleService_ListLessonNotesHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScheduleServiceServer).ListLessonNotesHistory(ctx, req.(*ListLessonNotesHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ScheduleService_MarkAsPaid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MarkAsPaidRequest)
 	if err := dec(in); err != nil {
@@ -534,6 +740,114 @@ func _ScheduleService_ListLessonsByPair_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ScheduleService_BlockPeriod_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockPeriodRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScheduleServiceServer).BlockPeriod(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScheduleService_BlockPeriod_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScheduleServiceServer).BlockPeriod(ctx, req.(*BlockPeriodRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScheduleService_CreateLessonSeries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateLessonSeriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScheduleServiceServer).CreateLessonSeries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScheduleService_CreateLessonSeries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScheduleServiceServer).CreateLessonSeries(ctx, req.(*CreateLessonSeriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScheduleService_CancelLessonSeries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelLessonSeriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScheduleServiceServer).CancelLessonSeries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScheduleService_CancelLessonSeries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScheduleServiceServer).CancelLessonSeries(ctx, req.(*CancelLessonSeriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScheduleService_CreateLessonPackage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateLessonPackageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScheduleServiceServer).CreateLessonPackage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScheduleService_CreateLessonPackage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScheduleServiceServer).CreateLessonPackage(ctx, req.(*CreateLessonPackageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScheduleService_GetPackageBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPackageBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScheduleServiceServer).GetPackageBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScheduleService_GetPackageBalance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScheduleServiceServer).GetPackageBalance(ctx, req.(*GetPackageBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScheduleService_GetScheduleStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetScheduleStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScheduleServiceServer).GetScheduleStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScheduleService_GetScheduleStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScheduleServiceServer).GetScheduleStats(ctx, req.(*GetScheduleStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ScheduleService_ListCompletedUnpaidLessons_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListCompletedUnpaidLessonsRequest)
 	if err := dec(in); err != nil {
@@ -595,6 +909,18 @@ var ScheduleService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CancelLesson",
 			Handler:    _ScheduleService_CancelLesson_Handler,
 		},
+		{
+			MethodName: "UpdateAttendance",
+			Handler:    _ScheduleService_UpdateAttendance_Handler,
+		},
+		{
+			MethodName: "UpsertLessonNotes",
+			Handler:    _ScheduleService_UpsertLessonNotes_Handler,
+		},
+		{
+			MethodName: "ListLessonNotesHistory",
+			Handler:    _ScheduleService_ListLessonNotesHistory_Handler,
+		},
 		{
 			MethodName: "MarkAsPaid",
 			Handler:    _ScheduleService_MarkAsPaid_Handler,
@@ -611,6 +937,30 @@ var ScheduleService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListLessonsByPair",
 			Handler:    _ScheduleService_ListLessonsByPair_Handler,
 		},
+		{
+			MethodName: "BlockPeriod",
+			Handler:    _ScheduleService_BlockPeriod_Handler,
+		},
+		{
+			MethodName: "CreateLessonSeries",
+			Handler:    _ScheduleService_CreateLessonSeries_Handler,
+		},
+		{
+			MethodName: "CancelLessonSeries",
+			Handler:    _ScheduleService_CancelLessonSeries_Handler,
+		},
+		{
+			MethodName: "CreateLessonPackage",
+			Handler:    _ScheduleService_CreateLessonPackage_Handler,
+		},
+		{
+			MethodName: "GetPackageBalance",
+			Handler:    _ScheduleService_GetPackageBalance_Handler,
+		},
+		{
+			MethodName: "GetScheduleStats",
+			Handler:    _ScheduleService_GetScheduleStats_Handler,
+		},
 		{
 			MethodName: "ListCompletedUnpaidLessons",
 			Handler:    _ScheduleService_ListCompletedUnpaidLessons_Handler,
@@ -42,6 +42,21 @@ func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
 	return m.recorder
 }
 
+// BlockPeriod mocks base method.
+func (m *MockRepository) BlockPeriod(ctx context.Context, tutorID string, from, to time.Time, reason *string, dryRun bool) (*repo.BlockPeriodResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BlockPeriod", ctx, tutorID, from, to, reason, dryRun)
+	ret0, _ := ret[0].(*repo.BlockPeriodResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BlockPeriod indicates an expected call of BlockPeriod.
+func (mr *MockRepositoryMockRecorder) BlockPeriod(ctx, tutorID, from, to, reason, dryRun any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockPeriod", reflect.TypeOf((*MockRepository)(nil).BlockPeriod), ctx, tutorID, from, to, reason, dryRun)
+}
+
 // CancelLessonAndFreeSlot mocks base method.
 func (m *MockRepository) CancelLessonAndFreeSlot(ctx context.Context, lesson repo.Lesson, slotID string) error {
 	m.ctrl.T.Helper()
@@ -56,12 +71,28 @@ func (mr *MockRepositoryMockRecorder) CancelLessonAndFreeSlot(ctx, lesson, slotI
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelLessonAndFreeSlot", reflect.TypeOf((*MockRepository)(nil).CancelLessonAndFreeSlot), ctx, lesson, slotID)
 }
 
+// CancelLessonSeries mocks base method.
+func (m *MockRepository) CancelLessonSeries(ctx context.Context, seriesID string, after time.Time, reason *string, cancelledBy string) ([]repo.LessonWithSlot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelLessonSeries", ctx, seriesID, after, reason, cancelledBy)
+	ret0, _ := ret[0].([]repo.LessonWithSlot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelLessonSeries indicates an expected call of CancelLessonSeries.
+func (mr *MockRepositoryMockRecorder) CancelLessonSeries(ctx, seriesID, after, reason, cancelledBy any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelLessonSeries", reflect.TypeOf((*MockRepository)(nil).CancelLessonSeries), ctx, seriesID, after, reason, cancelledBy)
+}
+
 // CreateLessonAndBookSlot mocks base method.
-func (m *MockRepository) CreateLessonAndBookSlot(ctx context.Context, lesson repo.Lesson, slotID string) error {
+func (m *MockRepository) CreateLessonAndBookSlot(ctx context.Context, lesson repo.Lesson, slotID string) (*repo.LessonPackage, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "CreateLessonAndBookSlot", ctx, lesson, slotID)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret0, _ := ret[0].(*repo.LessonPackage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // CreateLessonAndBookSlot indicates an expected call of CreateLessonAndBookSlot.
@@ -70,6 +101,35 @@ func (mr *MockRepositoryMockRecorder) CreateLessonAndBookSlot(ctx, lesson, slotI
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLessonAndBookSlot", reflect.TypeOf((*MockRepository)(nil).CreateLessonAndBookSlot), ctx, lesson, slotID)
 }
 
+// CreateLessonPackage mocks base method.
+func (m *MockRepository) CreateLessonPackage(ctx context.Context, pkg repo.LessonPackage) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateLessonPackage", ctx, pkg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateLessonPackage indicates an expected call of CreateLessonPackage.
+func (mr *MockRepositoryMockRecorder) CreateLessonPackage(ctx, pkg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLessonPackage", reflect.TypeOf((*MockRepository)(nil).CreateLessonPackage), ctx, pkg)
+}
+
+// CreateLessonSeries mocks base method.
+func (m *MockRepository) CreateLessonSeries(ctx context.Context, series repo.LessonSeries, occurrences []repo.SeriesOccurrence, createSlots bool) (*repo.LessonSeriesResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateLessonSeries", ctx, series, occurrences, createSlots)
+	ret0, _ := ret[0].(*repo.LessonSeriesResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateLessonSeries indicates an expected call of CreateLessonSeries.
+func (mr *MockRepositoryMockRecorder) CreateLessonSeries(ctx, series, occurrences, createSlots any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLessonSeries", reflect.TypeOf((*MockRepository)(nil).CreateLessonSeries), ctx, series, occurrences, createSlots)
+}
+
 // CreateSlot mocks base method.
 func (m *MockRepository) CreateSlot(ctx context.Context, slot repo.Slot) error {
 	m.ctrl.T.Helper()
@@ -113,6 +173,51 @@ func (mr *MockRepositoryMockRecorder) GetLesson(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLesson", reflect.TypeOf((*MockRepository)(nil).GetLesson), ctx, id)
 }
 
+// GetLessonNotes mocks base method.
+func (m *MockRepository) GetLessonNotes(ctx context.Context, lessonID string) (*repo.LessonNotes, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLessonNotes", ctx, lessonID)
+	ret0, _ := ret[0].(*repo.LessonNotes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLessonNotes indicates an expected call of GetLessonNotes.
+func (mr *MockRepositoryMockRecorder) GetLessonNotes(ctx, lessonID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLessonNotes", reflect.TypeOf((*MockRepository)(nil).GetLessonNotes), ctx, lessonID)
+}
+
+// GetLessonSeries mocks base method.
+func (m *MockRepository) GetLessonSeries(ctx context.Context, id string) (*repo.LessonSeries, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLessonSeries", ctx, id)
+	ret0, _ := ret[0].(*repo.LessonSeries)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLessonSeries indicates an expected call of GetLessonSeries.
+func (mr *MockRepositoryMockRecorder) GetLessonSeries(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLessonSeries", reflect.TypeOf((*MockRepository)(nil).GetLessonSeries), ctx, id)
+}
+
+// GetScheduleStats mocks base method.
+func (m *MockRepository) GetScheduleStats(ctx context.Context, tutorID string, from, to time.Time, timezone string) (*repo.ScheduleStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetScheduleStats", ctx, tutorID, from, to, timezone)
+	ret0, _ := ret[0].(*repo.ScheduleStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetScheduleStats indicates an expected call of GetScheduleStats.
+func (mr *MockRepositoryMockRecorder) GetScheduleStats(ctx, tutorID, from, to, timezone any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetScheduleStats", reflect.TypeOf((*MockRepository)(nil).GetScheduleStats), ctx, tutorID, from, to, timezone)
+}
+
 // GetSlot mocks base method.
 func (m *MockRepository) GetSlot(ctx context.Context, id string) (*repo.Slot, error) {
 	m.ctrl.T.Helper()
@@ -128,6 +233,21 @@ func (mr *MockRepositoryMockRecorder) GetSlot(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSlot", reflect.TypeOf((*MockRepository)(nil).GetSlot), ctx, id)
 }
 
+// ListActivePackages mocks base method.
+func (m *MockRepository) ListActivePackages(ctx context.Context, tutorID, studentID string, at time.Time) ([]repo.LessonPackage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListActivePackages", ctx, tutorID, studentID, at)
+	ret0, _ := ret[0].([]repo.LessonPackage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListActivePackages indicates an expected call of ListActivePackages.
+func (mr *MockRepositoryMockRecorder) ListActivePackages(ctx, tutorID, studentID, at any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListActivePackages", reflect.TypeOf((*MockRepository)(nil).ListActivePackages), ctx, tutorID, studentID, at)
+}
+
 // ListCompletedUnpaidLessons mocks base method.
 func (m *MockRepository) ListCompletedUnpaidLessons(ctx context.Context, after *time.Time) ([]repo.Lesson, error) {
 	m.ctrl.T.Helper()
@@ -143,6 +263,21 @@ func (mr *MockRepositoryMockRecorder) ListCompletedUnpaidLessons(ctx, after any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCompletedUnpaidLessons", reflect.TypeOf((*MockRepository)(nil).ListCompletedUnpaidLessons), ctx, after)
 }
 
+// ListLessonNotesHistory mocks base method.
+func (m *MockRepository) ListLessonNotesHistory(ctx context.Context, lessonID string) ([]repo.LessonNotes, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListLessonNotesHistory", ctx, lessonID)
+	ret0, _ := ret[0].([]repo.LessonNotes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLessonNotesHistory indicates an expected call of ListLessonNotesHistory.
+func (mr *MockRepositoryMockRecorder) ListLessonNotesHistory(ctx, lessonID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLessonNotesHistory", reflect.TypeOf((*MockRepository)(nil).ListLessonNotesHistory), ctx, lessonID)
+}
+
 // ListLessonsByPair mocks base method.
 func (m *MockRepository) ListLessonsByPair(ctx context.Context, tutorID, studentID string, statusFilter []string) ([]repo.Lesson, error) {
 	m.ctrl.T.Helper()
@@ -217,19 +352,49 @@ func (mr *MockRepositoryMockRecorder) MarkAsPaid(ctx, lessonID any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkAsPaid", reflect.TypeOf((*MockRepository)(nil).MarkAsPaid), ctx, lessonID)
 }
 
+// MarkExpiredPackagesNotified mocks base method.
+func (m *MockRepository) MarkExpiredPackagesNotified(ctx context.Context, now time.Time) ([]repo.LessonPackage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkExpiredPackagesNotified", ctx, now)
+	ret0, _ := ret[0].([]repo.LessonPackage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkExpiredPackagesNotified indicates an expected call of MarkExpiredPackagesNotified.
+func (mr *MockRepositoryMockRecorder) MarkExpiredPackagesNotified(ctx, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkExpiredPackagesNotified", reflect.TypeOf((*MockRepository)(nil).MarkExpiredPackagesNotified), ctx, now)
+}
+
+// UpdateAttendance mocks base method.
+func (m *MockRepository) UpdateAttendance(ctx context.Context, lesson repo.Lesson, credit repo.CreditAction) (*repo.LessonPackage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAttendance", ctx, lesson, credit)
+	ret0, _ := ret[0].(*repo.LessonPackage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateAttendance indicates an expected call of UpdateAttendance.
+func (mr *MockRepositoryMockRecorder) UpdateAttendance(ctx, lesson, credit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAttendance", reflect.TypeOf((*MockRepository)(nil).UpdateAttendance), ctx, lesson, credit)
+}
+
 // UpdateCompletedLessons mocks base method.
-func (m *MockRepository) UpdateCompletedLessons(ctx context.Context) (int, error) {
+func (m *MockRepository) UpdateCompletedLessons(ctx context.Context, endedBefore time.Time) (*repo.CompletedLessonsResult, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateCompletedLessons", ctx)
-	ret0, _ := ret[0].(int)
+	ret := m.ctrl.Call(m, "UpdateCompletedLessons", ctx, endedBefore)
+	ret0, _ := ret[0].(*repo.CompletedLessonsResult)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdateCompletedLessons indicates an expected call of UpdateCompletedLessons.
-func (mr *MockRepositoryMockRecorder) UpdateCompletedLessons(ctx any) *gomock.Call {
+func (mr *MockRepositoryMockRecorder) UpdateCompletedLessons(ctx, endedBefore any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCompletedLessons", reflect.TypeOf((*MockRepository)(nil).UpdateCompletedLessons), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCompletedLessons", reflect.TypeOf((*MockRepository)(nil).UpdateCompletedLessons), ctx, endedBefore)
 }
 
 // UpdateLesson mocks base method.
@@ -259,3 +424,17 @@ func (mr *MockRepositoryMockRecorder) UpdateSlot(ctx, slot any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSlot", reflect.TypeOf((*MockRepository)(nil).UpdateSlot), ctx, slot)
 }
+
+// UpsertLessonNotes mocks base method.
+func (m *MockRepository) UpsertLessonNotes(ctx context.Context, notes repo.LessonNotes) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertLessonNotes", ctx, notes)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertLessonNotes indicates an expected call of UpsertLessonNotes.
+func (mr *MockRepositoryMockRecorder) UpsertLessonNotes(ctx, notes any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertLessonNotes", reflect.TypeOf((*MockRepository)(nil).UpsertLessonNotes), ctx, notes)
+}
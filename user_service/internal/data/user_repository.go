@@ -44,6 +44,20 @@ WHERE id = $1
 	return &user, nil
 }
 
+func (r *UserRepository) GetTimezones(ctx context.Context, ids []uuid.UUID) ([]*model.UserTimezone, error) {
+	query := `
+SELECT id, timezone
+FROM users
+WHERE id = ANY($1) AND timezone IS NOT NULL
+`
+	var rows []*model.UserTimezone
+	err := pgxscan.Select(ctx, r.db, &rows, query, ids)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return rows, nil
+}
+
 func (r *UserRepository) UpdateUser(ctx context.Context, id uuid.UUID, input *model.UpdateUserInput) (*model.User, error) {
 	query, args, err := buildUserUpdateQuery(input)
 	if err != nil {
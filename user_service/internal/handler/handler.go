@@ -20,6 +20,7 @@ type UserService interface {
 	Authorize(ctx context.Context, input *model.AuthorizeInput) (*model.User, error)
 	GetMe(ctx context.Context) (*model.User, error)
 	GetUserPublic(ctx context.Context, id uuid.UUID) (*model.UserPublic, error)
+	GetUserTimezones(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]string, error)
 	UpdateUser(ctx context.Context, id uuid.UUID, input *model.UpdateUserInput) (*model.User, error)
 	GetTutorProfile(ctx context.Context, userId uuid.UUID) (*model.TutorProfile, error)
 	UpdateTutorProfile(ctx context.Context, userId uuid.UUID, input *model.UpdateTutorProfileInput) (*model.TutorProfile, error)
@@ -106,6 +107,29 @@ func (h *UserServiceServer) GetUser(ctx context.Context, req *pb.GetUserRequest)
 	return userPb, nil
 }
 
+func (h *UserServiceServer) GetUserTimezones(ctx context.Context, req *pb.GetUserTimezonesRequest) (*pb.GetUserTimezonesResponse, error) {
+	ids := make([]uuid.UUID, len(req.Ids))
+	for i, rawId := range req.Ids {
+		id, err := uuid.Parse(rawId)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		}
+		ids[i] = id
+	}
+
+	timezones, err := h.service.GetUserTimezones(ctx, ids)
+	if err != nil {
+		return nil, mapError(err, errdefs.ErrValidation)
+	}
+
+	resp := &pb.GetUserTimezonesResponse{Timezones: make(map[string]string, len(timezones))}
+	for id, timezone := range timezones {
+		resp.Timezones[id.String()] = timezone
+	}
+
+	return resp, nil
+}
+
 func (h *UserServiceServer) UpdateUser(ctx context.Context, req *pb.UpdateUserRequest) (*pb.User, error) {
 	id, err := uuid.Parse(req.Id)
 	if err != nil {
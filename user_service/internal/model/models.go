@@ -125,3 +125,8 @@ type UserPublic struct {
 	FirstName *string
 	LastName  *string
 }
+
+type UserTimezone struct {
+	Id       uuid.UUID `db:"id"`
+	Timezone string    `db:"timezone"`
+}
@@ -73,6 +73,21 @@ func (mr *MockUserRepositoryMockRecorder) GetTelegramAccountByTelegramId(ctx, te
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTelegramAccountByTelegramId", reflect.TypeOf((*MockUserRepository)(nil).GetTelegramAccountByTelegramId), ctx, telegramId)
 }
 
+// GetTimezones mocks base method.
+func (m *MockUserRepository) GetTimezones(ctx context.Context, ids []uuid.UUID) ([]*model.UserTimezone, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTimezones", ctx, ids)
+	ret0, _ := ret[0].([]*model.UserTimezone)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTimezones indicates an expected call of GetTimezones.
+func (mr *MockUserRepositoryMockRecorder) GetTimezones(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTimezones", reflect.TypeOf((*MockUserRepository)(nil).GetTimezones), ctx, ids)
+}
+
 // GetTutorProfile mocks base method.
 func (m *MockUserRepository) GetTutorProfile(ctx context.Context, userId uuid.UUID) (*model.TutorProfile, error) {
 	m.ctrl.T.Helper()
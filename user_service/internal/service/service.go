@@ -17,6 +17,7 @@ type UserRepository interface {
 	NewUserCreationRepositoryTx(ctx context.Context) (UserCreationRepositoryTx, error)
 
 	GetUser(ctx context.Context, id uuid.UUID) (*model.User, error)
+	GetTimezones(ctx context.Context, ids []uuid.UUID) ([]*model.UserTimezone, error)
 	UpdateUser(ctx context.Context, id uuid.UUID, input *model.UpdateUserInput) (*model.User, error)
 
 	GetTutorProfile(ctx context.Context, userId uuid.UUID) (*model.TutorProfile, error)
@@ -200,6 +201,31 @@ func (s *UserService) GetUserPublic(ctx context.Context, id uuid.UUID) (*model.U
 	return resp, nil
 }
 
+// maxTimezoneBatch limits the number of users in one GetUserTimezones call.
+const maxTimezoneBatch = 500
+
+// GetUserTimezones returns the time zones of the given users. Users without a zone
+// and unknown users are omitted.
+func (s *UserService) GetUserTimezones(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]string, error) {
+	if len(ids) > maxTimezoneBatch {
+		return nil, errdefs.ErrValidation
+	}
+
+	timezones := make(map[uuid.UUID]string, len(ids))
+	if len(ids) == 0 {
+		return timezones, nil
+	}
+
+	rows, err := s.userRepository.GetTimezones(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		timezones[row.Id] = row.Timezone
+	}
+	return timezones, nil
+}
+
 func (s *UserService) UpdateUser(ctx context.Context, id uuid.UUID, input *model.UpdateUserInput) (*model.User, error) {
 	if err := ensureCurrentUserIs(ctx, id); err != nil {
 		return nil, err
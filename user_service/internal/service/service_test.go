@@ -117,6 +117,39 @@ func TestGetUserPublic(t *testing.T) {
 	})
 }
 
+// ── GetUserTimezones ────────────────────────────────────────────────
+
+func TestGetUserTimezones(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		svc, mockUserRepo, _, _ := setup(t)
+		tutorID, studentID := uuid.New(), uuid.New()
+
+		mockUserRepo.EXPECT().GetTimezones(gomock.Any(), []uuid.UUID{tutorID, studentID}).Return([]*model.UserTimezone{
+			{Id: tutorID, Timezone: "Europe/Moscow"},
+		}, nil)
+
+		result, err := svc.GetUserTimezones(context.Background(), []uuid.UUID{tutorID, studentID})
+		require.NoError(t, err)
+		assert.Equal(t, map[uuid.UUID]string{tutorID: "Europe/Moscow"}, result)
+	})
+
+	t.Run("Empty", func(t *testing.T) {
+		svc, _, _, _ := setup(t)
+
+		result, err := svc.GetUserTimezones(context.Background(), nil)
+		require.NoError(t, err)
+		assert.Empty(t, result)
+	})
+
+	t.Run("TooMany", func(t *testing.T) {
+		svc, _, _, _ := setup(t)
+
+		ids := make([]uuid.UUID, 501)
+		_, err := svc.GetUserTimezones(context.Background(), ids)
+		assert.ErrorIs(t, err, errdefs.ErrValidation)
+	})
+}
+
 // ── UpdateUser ──────────────────────────────────────────────────────
 
 func TestUpdateUser(t *testing.T) {
@@ -194,6 +194,94 @@ func (x *GetUserRequest) GetId() string {
 	return ""
 }
 
+type GetUserTimezonesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ids           []string               `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserTimezonesRequest) Reset() {
+	*x = GetUserTimezonesRequest{}
+	mi := &file_user_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserTimezonesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserTimezonesRequest) ProtoMessage() {}
+
+func (x *GetUserTimezonesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserTimezonesRequest.ProtoReflect.Descriptor instead.
+func (*GetUserTimezonesRequest) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetUserTimezonesRequest) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type GetUserTimezonesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Timezones     map[string]string      `protobuf:"bytes,1,rep,name=timezones,proto3" json:"timezones,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // user_id => IANA zone, users without a zone are omitted
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserTimezonesResponse) Reset() {
+	*x = GetUserTimezonesResponse{}
+	mi := &file_user_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserTimezonesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserTimezonesResponse) ProtoMessage() {}
+
+func (x *GetUserTimezonesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserTimezonesResponse.ProtoReflect.Descriptor instead.
+func (*GetUserTimezonesResponse) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetUserTimezonesResponse) GetTimezones() map[string]string {
+	if x != nil {
+		return x.Timezones
+	}
+	return nil
+}
+
 type UpdateUserRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -206,7 +294,7 @@ type UpdateUserRequest struct {
 
 func (x *UpdateUserRequest) Reset() {
 	*x = UpdateUserRequest{}
-	mi := &file_user_service_proto_msgTypes[3]
+	mi := &file_user_service_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -218,7 +306,7 @@ func (x *UpdateUserRequest) String() string {
 func (*UpdateUserRequest) ProtoMessage() {}
 
 func (x *UpdateUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_service_proto_msgTypes[3]
+	mi := &file_user_service_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -231,7 +319,7 @@ func (x *UpdateUserRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateUserRequest.ProtoReflect.Descriptor instead.
 func (*UpdateUserRequest) Descriptor() ([]byte, []int) {
-	return file_user_service_proto_rawDescGZIP(), []int{3}
+	return file_user_service_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *UpdateUserRequest) GetId() string {
@@ -271,7 +359,7 @@ type GetTutorProfileByUserIdRequest struct {
 
 func (x *GetTutorProfileByUserIdRequest) Reset() {
 	*x = GetTutorProfileByUserIdRequest{}
-	mi := &file_user_service_proto_msgTypes[4]
+	mi := &file_user_service_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -283,7 +371,7 @@ func (x *GetTutorProfileByUserIdRequest) String() string {
 func (*GetTutorProfileByUserIdRequest) ProtoMessage() {}
 
 func (x *GetTutorProfileByUserIdRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_service_proto_msgTypes[4]
+	mi := &file_user_service_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -296,7 +384,7 @@ func (x *GetTutorProfileByUserIdRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTutorProfileByUserIdRequest.ProtoReflect.Descriptor instead.
 func (*GetTutorProfileByUserIdRequest) Descriptor() ([]byte, []int) {
-	return file_user_service_proto_rawDescGZIP(), []int{4}
+	return file_user_service_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *GetTutorProfileByUserIdRequest) GetUserId() string {
@@ -318,7 +406,7 @@ type UpdateTutorProfileRequest struct {
 
 func (x *UpdateTutorProfileRequest) Reset() {
 	*x = UpdateTutorProfileRequest{}
-	mi := &file_user_service_proto_msgTypes[5]
+	mi := &file_user_service_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -330,7 +418,7 @@ func (x *UpdateTutorProfileRequest) String() string {
 func (*UpdateTutorProfileRequest) ProtoMessage() {}
 
 func (x *UpdateTutorProfileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_service_proto_msgTypes[5]
+	mi := &file_user_service_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -343,7 +431,7 @@ func (x *UpdateTutorProfileRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateTutorProfileRequest.ProtoReflect.Descriptor instead.
 func (*UpdateTutorProfileRequest) Descriptor() ([]byte, []int) {
-	return file_user_service_proto_rawDescGZIP(), []int{5}
+	return file_user_service_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *UpdateTutorProfileRequest) GetUserId() string {
@@ -384,7 +472,7 @@ type GetTutorStudentRequest struct {
 
 func (x *GetTutorStudentRequest) Reset() {
 	*x = GetTutorStudentRequest{}
-	mi := &file_user_service_proto_msgTypes[6]
+	mi := &file_user_service_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -396,7 +484,7 @@ func (x *GetTutorStudentRequest) String() string {
 func (*GetTutorStudentRequest) ProtoMessage() {}
 
 func (x *GetTutorStudentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_service_proto_msgTypes[6]
+	mi := &file_user_service_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -409,7 +497,7 @@ func (x *GetTutorStudentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTutorStudentRequest.ProtoReflect.Descriptor instead.
 func (*GetTutorStudentRequest) Descriptor() ([]byte, []int) {
-	return file_user_service_proto_rawDescGZIP(), []int{6}
+	return file_user_service_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *GetTutorStudentRequest) GetTutorId() string {
@@ -438,7 +526,7 @@ type CreateTutorStudentRequest struct {
 
 func (x *CreateTutorStudentRequest) Reset() {
 	*x = CreateTutorStudentRequest{}
-	mi := &file_user_service_proto_msgTypes[7]
+	mi := &file_user_service_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -450,7 +538,7 @@ func (x *CreateTutorStudentRequest) String() string {
 func (*CreateTutorStudentRequest) ProtoMessage() {}
 
 func (x *CreateTutorStudentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_service_proto_msgTypes[7]
+	mi := &file_user_service_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -463,7 +551,7 @@ func (x *CreateTutorStudentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateTutorStudentRequest.ProtoReflect.Descriptor instead.
 func (*CreateTutorStudentRequest) Descriptor() ([]byte, []int) {
-	return file_user_service_proto_rawDescGZIP(), []int{7}
+	return file_user_service_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *CreateTutorStudentRequest) GetTutorId() string {
@@ -507,7 +595,7 @@ type UpdateTutorStudentRequest struct {
 
 func (x *UpdateTutorStudentRequest) Reset() {
 	*x = UpdateTutorStudentRequest{}
-	mi := &file_user_service_proto_msgTypes[8]
+	mi := &file_user_service_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -519,7 +607,7 @@ func (x *UpdateTutorStudentRequest) String() string {
 func (*UpdateTutorStudentRequest) ProtoMessage() {}
 
 func (x *UpdateTutorStudentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_service_proto_msgTypes[8]
+	mi := &file_user_service_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -532,7 +620,7 @@ func (x *UpdateTutorStudentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateTutorStudentRequest.ProtoReflect.Descriptor instead.
 func (*UpdateTutorStudentRequest) Descriptor() ([]byte, []int) {
-	return file_user_service_proto_rawDescGZIP(), []int{8}
+	return file_user_service_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *UpdateTutorStudentRequest) GetTutorId() string {
@@ -580,7 +668,7 @@ type DeleteTutorStudentRequest struct {
 
 func (x *DeleteTutorStudentRequest) Reset() {
 	*x = DeleteTutorStudentRequest{}
-	mi := &file_user_service_proto_msgTypes[9]
+	mi := &file_user_service_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -592,7 +680,7 @@ func (x *DeleteTutorStudentRequest) String() string {
 func (*DeleteTutorStudentRequest) ProtoMessage() {}
 
 func (x *DeleteTutorStudentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_service_proto_msgTypes[9]
+	mi := &file_user_service_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -605,7 +693,7 @@ func (x *DeleteTutorStudentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteTutorStudentRequest.ProtoReflect.Descriptor instead.
 func (*DeleteTutorStudentRequest) Descriptor() ([]byte, []int) {
-	return file_user_service_proto_rawDescGZIP(), []int{9}
+	return file_user_service_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *DeleteTutorStudentRequest) GetTutorId() string {
@@ -631,7 +719,7 @@ type ListTutorStudentsRequest struct {
 
 func (x *ListTutorStudentsRequest) Reset() {
 	*x = ListTutorStudentsRequest{}
-	mi := &file_user_service_proto_msgTypes[10]
+	mi := &file_user_service_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -643,7 +731,7 @@ func (x *ListTutorStudentsRequest) String() string {
 func (*ListTutorStudentsRequest) ProtoMessage() {}
 
 func (x *ListTutorStudentsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_service_proto_msgTypes[10]
+	mi := &file_user_service_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -656,7 +744,7 @@ func (x *ListTutorStudentsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListTutorStudentsRequest.ProtoReflect.Descriptor instead.
 func (*ListTutorStudentsRequest) Descriptor() ([]byte, []int) {
-	return file_user_service_proto_rawDescGZIP(), []int{10}
+	return file_user_service_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *ListTutorStudentsRequest) GetTutorId() string {
@@ -675,7 +763,7 @@ type ListTutorStudentsResponse struct {
 
 func (x *ListTutorStudentsResponse) Reset() {
 	*x = ListTutorStudentsResponse{}
-	mi := &file_user_service_proto_msgTypes[11]
+	mi := &file_user_service_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -687,7 +775,7 @@ func (x *ListTutorStudentsResponse) String() string {
 func (*ListTutorStudentsResponse) ProtoMessage() {}
 
 func (x *ListTutorStudentsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_service_proto_msgTypes[11]
+	mi := &file_user_service_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -700,7 +788,7 @@ func (x *ListTutorStudentsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListTutorStudentsResponse.ProtoReflect.Descriptor instead.
 func (*ListTutorStudentsResponse) Descriptor() ([]byte, []int) {
-	return file_user_service_proto_rawDescGZIP(), []int{11}
+	return file_user_service_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *ListTutorStudentsResponse) GetStudents() []*TutorStudent {
@@ -719,7 +807,7 @@ type ListTutorsForStudentRequest struct {
 
 func (x *ListTutorsForStudentRequest) Reset() {
 	*x = ListTutorsForStudentRequest{}
-	mi := &file_user_service_proto_msgTypes[12]
+	mi := &file_user_service_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -731,7 +819,7 @@ func (x *ListTutorsForStudentRequest) String() string {
 func (*ListTutorsForStudentRequest) ProtoMessage() {}
 
 func (x *ListTutorsForStudentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_service_proto_msgTypes[12]
+	mi := &file_user_service_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -744,7 +832,7 @@ func (x *ListTutorsForStudentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListTutorsForStudentRequest.ProtoReflect.Descriptor instead.
 func (*ListTutorsForStudentRequest) Descriptor() ([]byte, []int) {
-	return file_user_service_proto_rawDescGZIP(), []int{12}
+	return file_user_service_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *ListTutorsForStudentRequest) GetStudentId() string {
@@ -763,7 +851,7 @@ type ListTutorsForStudentResponse struct {
 
 func (x *ListTutorsForStudentResponse) Reset() {
 	*x = ListTutorsForStudentResponse{}
-	mi := &file_user_service_proto_msgTypes[13]
+	mi := &file_user_service_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -775,7 +863,7 @@ func (x *ListTutorsForStudentResponse) String() string {
 func (*ListTutorsForStudentResponse) ProtoMessage() {}
 
 func (x *ListTutorsForStudentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_service_proto_msgTypes[13]
+	mi := &file_user_service_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -788,7 +876,7 @@ func (x *ListTutorsForStudentResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListTutorsForStudentResponse.ProtoReflect.Descriptor instead.
 func (*ListTutorsForStudentResponse) Descriptor() ([]byte, []int) {
-	return file_user_service_proto_rawDescGZIP(), []int{13}
+	return file_user_service_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *ListTutorsForStudentResponse) GetTutors() []*TutorStudent {
@@ -808,7 +896,7 @@ type ResolveTutorStudentContextRequest struct {
 
 func (x *ResolveTutorStudentContextRequest) Reset() {
 	*x = ResolveTutorStudentContextRequest{}
-	mi := &file_user_service_proto_msgTypes[14]
+	mi := &file_user_service_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -820,7 +908,7 @@ func (x *ResolveTutorStudentContextRequest) String() string {
 func (*ResolveTutorStudentContextRequest) ProtoMessage() {}
 
 func (x *ResolveTutorStudentContextRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_service_proto_msgTypes[14]
+	mi := &file_user_service_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -833,7 +921,7 @@ func (x *ResolveTutorStudentContextRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use ResolveTutorStudentContextRequest.ProtoReflect.Descriptor instead.
 func (*ResolveTutorStudentContextRequest) Descriptor() ([]byte, []int) {
-	return file_user_service_proto_rawDescGZIP(), []int{14}
+	return file_user_service_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *ResolveTutorStudentContextRequest) GetTutorId() string {
@@ -862,7 +950,7 @@ type ResolvedTutorStudentContext struct {
 
 func (x *ResolvedTutorStudentContext) Reset() {
 	*x = ResolvedTutorStudentContext{}
-	mi := &file_user_service_proto_msgTypes[15]
+	mi := &file_user_service_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -874,7 +962,7 @@ func (x *ResolvedTutorStudentContext) String() string {
 func (*ResolvedTutorStudentContext) ProtoMessage() {}
 
 func (x *ResolvedTutorStudentContext) ProtoReflect() protoreflect.Message {
-	mi := &file_user_service_proto_msgTypes[15]
+	mi := &file_user_service_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -887,7 +975,7 @@ func (x *ResolvedTutorStudentContext) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResolvedTutorStudentContext.ProtoReflect.Descriptor instead.
 func (*ResolvedTutorStudentContext) Descriptor() ([]byte, []int) {
-	return file_user_service_proto_rawDescGZIP(), []int{15}
+	return file_user_service_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *ResolvedTutorStudentContext) GetRelationshipStatus() string {
@@ -927,7 +1015,7 @@ type AcceptInvitationFromTutorRequest struct {
 
 func (x *AcceptInvitationFromTutorRequest) Reset() {
 	*x = AcceptInvitationFromTutorRequest{}
-	mi := &file_user_service_proto_msgTypes[16]
+	mi := &file_user_service_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -939,7 +1027,7 @@ func (x *AcceptInvitationFromTutorRequest) String() string {
 func (*AcceptInvitationFromTutorRequest) ProtoMessage() {}
 
 func (x *AcceptInvitationFromTutorRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_service_proto_msgTypes[16]
+	mi := &file_user_service_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -952,7 +1040,7 @@ func (x *AcceptInvitationFromTutorRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AcceptInvitationFromTutorRequest.ProtoReflect.Descriptor instead.
 func (*AcceptInvitationFromTutorRequest) Descriptor() ([]byte, []int) {
-	return file_user_service_proto_rawDescGZIP(), []int{16}
+	return file_user_service_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *AcceptInvitationFromTutorRequest) GetTutorId() string {
@@ -970,7 +1058,7 @@ type Empty struct {
 
 func (x *Empty) Reset() {
 	*x = Empty{}
-	mi := &file_user_service_proto_msgTypes[17]
+	mi := &file_user_service_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -982,7 +1070,7 @@ func (x *Empty) String() string {
 func (*Empty) ProtoMessage() {}
 
 func (x *Empty) ProtoReflect() protoreflect.Message {
-	mi := &file_user_service_proto_msgTypes[17]
+	mi := &file_user_service_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -995,7 +1083,7 @@ func (x *Empty) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Empty.ProtoReflect.Descriptor instead.
 func (*Empty) Descriptor() ([]byte, []int) {
-	return file_user_service_proto_rawDescGZIP(), []int{17}
+	return file_user_service_proto_rawDescGZIP(), []int{19}
 }
 
 type User struct {
@@ -1015,7 +1103,7 @@ type User struct {
 
 func (x *User) Reset() {
 	*x = User{}
-	mi := &file_user_service_proto_msgTypes[18]
+	mi := &file_user_service_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1027,7 +1115,7 @@ func (x *User) String() string {
 func (*User) ProtoMessage() {}
 
 func (x *User) ProtoReflect() protoreflect.Message {
-	mi := &file_user_service_proto_msgTypes[18]
+	mi := &file_user_service_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1040,7 +1128,7 @@ func (x *User) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use User.ProtoReflect.Descriptor instead.
 func (*User) Descriptor() ([]byte, []int) {
-	return file_user_service_proto_rawDescGZIP(), []int{18}
+	return file_user_service_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *User) GetId() string {
@@ -1118,7 +1206,7 @@ type UserPublic struct {
 
 func (x *UserPublic) Reset() {
 	*x = UserPublic{}
-	mi := &file_user_service_proto_msgTypes[19]
+	mi := &file_user_service_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1130,7 +1218,7 @@ func (x *UserPublic) String() string {
 func (*UserPublic) ProtoMessage() {}
 
 func (x *UserPublic) ProtoReflect() protoreflect.Message {
-	mi := &file_user_service_proto_msgTypes[19]
+	mi := &file_user_service_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1143,7 +1231,7 @@ func (x *UserPublic) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserPublic.ProtoReflect.Descriptor instead.
 func (*UserPublic) Descriptor() ([]byte, []int) {
-	return file_user_service_proto_rawDescGZIP(), []int{19}
+	return file_user_service_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *UserPublic) GetId() string {
@@ -1189,7 +1277,7 @@ type TutorProfile struct {
 
 func (x *TutorProfile) Reset() {
 	*x = TutorProfile{}
-	mi := &file_user_service_proto_msgTypes[20]
+	mi := &file_user_service_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1201,7 +1289,7 @@ func (x *TutorProfile) String() string {
 func (*TutorProfile) ProtoMessage() {}
 
 func (x *TutorProfile) ProtoReflect() protoreflect.Message {
-	mi := &file_user_service_proto_msgTypes[20]
+	mi := &file_user_service_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1214,7 +1302,7 @@ func (x *TutorProfile) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TutorProfile.ProtoReflect.Descriptor instead.
 func (*TutorProfile) Descriptor() ([]byte, []int) {
-	return file_user_service_proto_rawDescGZIP(), []int{20}
+	return file_user_service_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *TutorProfile) GetId() string {
@@ -1282,7 +1370,7 @@ type TutorStudent struct {
 
 func (x *TutorStudent) Reset() {
 	*x = TutorStudent{}
-	mi := &file_user_service_proto_msgTypes[21]
+	mi := &file_user_service_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1294,7 +1382,7 @@ func (x *TutorStudent) String() string {
 func (*TutorStudent) ProtoMessage() {}
 
 func (x *TutorStudent) ProtoReflect() protoreflect.Message {
-	mi := &file_user_service_proto_msgTypes[21]
+	mi := &file_user_service_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1307,7 +1395,7 @@ func (x *TutorStudent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TutorStudent.ProtoReflect.Descriptor instead.
 func (*TutorStudent) Descriptor() ([]byte, []int) {
-	return file_user_service_proto_rawDescGZIP(), []int{21}
+	return file_user_service_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *TutorStudent) GetId() string {
@@ -1388,7 +1476,14 @@ const file_user_service_proto_rawDesc = "" +
 	"\x1cAuthorizeByAuthHeaderRequest\x121\n" +
 	"\x14authorization_header\x18\x01 \x01(\tR\x13authorizationHeader\" \n" +
 	"\x0eGetUserRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"\xb4\x01\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"+\n" +
+	"\x17GetUserTimezonesRequest\x12\x10\n" +
+	"\x03ids\x18\x01 \x03(\tR\x03ids\"\xa8\x01\n" +
+	"\x18GetUserTimezonesResponse\x12N\n" +
+	"\ttimezones\x18\x01 \x03(\v20.user.v1.GetUserTimezonesResponse.TimezonesEntryR\ttimezones\x1a<\n" +
+	"\x0eTimezonesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xb4\x01\n" +
 	"\x11UpdateUserRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\"\n" +
 	"\n" +
@@ -1509,12 +1604,14 @@ const file_user_service_proto_rawDesc = "" +
 	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x127\n" +
 	"\tedited_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\beditedAtB\x13\n" +
 	"\x11_lesson_price_rubB\x19\n" +
-	"\x17_lesson_connection_link2\xad\t\n" +
+	"\x17_lesson_connection_link2\x86\n" +
+	"\n" +
 	"\vUserService\x12I\n" +
 	"\x13RegisterViaTelegram\x12#.user.v1.RegisterViaTelegramRequest\x1a\r.user.v1.User\x12M\n" +
 	"\x15AuthorizeByAuthHeader\x12%.user.v1.AuthorizeByAuthHeaderRequest\x1a\r.user.v1.User\x12&\n" +
 	"\x05GetMe\x12\x0e.user.v1.Empty\x1a\r.user.v1.User\x127\n" +
-	"\aGetUser\x12\x17.user.v1.GetUserRequest\x1a\x13.user.v1.UserPublic\x127\n" +
+	"\aGetUser\x12\x17.user.v1.GetUserRequest\x1a\x13.user.v1.UserPublic\x12W\n" +
+	"\x10GetUserTimezones\x12 .user.v1.GetUserTimezonesRequest\x1a!.user.v1.GetUserTimezonesResponse\x127\n" +
 	"\n" +
 	"UpdateUser\x12\x1a.user.v1.UpdateUserRequest\x1a\r.user.v1.User\x12O\n" +
 	"\x12UpdateTutorProfile\x12\".user.v1.UpdateTutorProfileRequest\x1a\x15.user.v1.TutorProfile\x12Y\n" +
@@ -1540,76 +1637,82 @@ func file_user_service_proto_rawDescGZIP() []byte {
 	return file_user_service_proto_rawDescData
 }
 
-var file_user_service_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
+var file_user_service_proto_msgTypes = make([]protoimpl.MessageInfo, 25)
 var file_user_service_proto_goTypes = []any{
 	(*RegisterViaTelegramRequest)(nil),        // 0: user.v1.RegisterViaTelegramRequest
 	(*AuthorizeByAuthHeaderRequest)(nil),      // 1: user.v1.AuthorizeByAuthHeaderRequest
 	(*GetUserRequest)(nil),                    // 2: user.v1.GetUserRequest
-	(*UpdateUserRequest)(nil),                 // 3: user.v1.UpdateUserRequest
-	(*GetTutorProfileByUserIdRequest)(nil),    // 4: user.v1.GetTutorProfileByUserIdRequest
-	(*UpdateTutorProfileRequest)(nil),         // 5: user.v1.UpdateTutorProfileRequest
-	(*GetTutorStudentRequest)(nil),            // 6: user.v1.GetTutorStudentRequest
-	(*CreateTutorStudentRequest)(nil),         // 7: user.v1.CreateTutorStudentRequest
-	(*UpdateTutorStudentRequest)(nil),         // 8: user.v1.UpdateTutorStudentRequest
-	(*DeleteTutorStudentRequest)(nil),         // 9: user.v1.DeleteTutorStudentRequest
-	(*ListTutorStudentsRequest)(nil),          // 10: user.v1.ListTutorStudentsRequest
-	(*ListTutorStudentsResponse)(nil),         // 11: user.v1.ListTutorStudentsResponse
-	(*ListTutorsForStudentRequest)(nil),       // 12: user.v1.ListTutorsForStudentRequest
-	(*ListTutorsForStudentResponse)(nil),      // 13: user.v1.ListTutorsForStudentResponse
-	(*ResolveTutorStudentContextRequest)(nil), // 14: user.v1.ResolveTutorStudentContextRequest
-	(*ResolvedTutorStudentContext)(nil),       // 15: user.v1.ResolvedTutorStudentContext
-	(*AcceptInvitationFromTutorRequest)(nil),  // 16: user.v1.AcceptInvitationFromTutorRequest
-	(*Empty)(nil),                             // 17: user.v1.Empty
-	(*User)(nil),                              // 18: user.v1.User
-	(*UserPublic)(nil),                        // 19: user.v1.UserPublic
-	(*TutorProfile)(nil),                      // 20: user.v1.TutorProfile
-	(*TutorStudent)(nil),                      // 21: user.v1.TutorStudent
-	(*timestamppb.Timestamp)(nil),             // 22: google.protobuf.Timestamp
+	(*GetUserTimezonesRequest)(nil),           // 3: user.v1.GetUserTimezonesRequest
+	(*GetUserTimezonesResponse)(nil),          // 4: user.v1.GetUserTimezonesResponse
+	(*UpdateUserRequest)(nil),                 // 5: user.v1.UpdateUserRequest
+	(*GetTutorProfileByUserIdRequest)(nil),    // 6: user.v1.GetTutorProfileByUserIdRequest
+	(*UpdateTutorProfileRequest)(nil),         // 7: user.v1.UpdateTutorProfileRequest
+	(*GetTutorStudentRequest)(nil),            // 8: user.v1.GetTutorStudentRequest
+	(*CreateTutorStudentRequest)(nil),         // 9: user.v1.CreateTutorStudentRequest
+	(*UpdateTutorStudentRequest)(nil),         // 10: user.v1.UpdateTutorStudentRequest
+	(*DeleteTutorStudentRequest)(nil),         // 11: user.v1.DeleteTutorStudentRequest
+	(*ListTutorStudentsRequest)(nil),          // 12: user.v1.ListTutorStudentsRequest
+	(*ListTutorStudentsResponse)(nil),         // 13: user.v1.ListTutorStudentsResponse
+	(*ListTutorsForStudentRequest)(nil),       // 14: user.v1.ListTutorsForStudentRequest
+	(*ListTutorsForStudentResponse)(nil),      // 15: user.v1.ListTutorsForStudentResponse
+	(*ResolveTutorStudentContextRequest)(nil), // 16: user.v1.ResolveTutorStudentContextRequest
+	(*ResolvedTutorStudentContext)(nil),       // 17: user.v1.ResolvedTutorStudentContext
+	(*AcceptInvitationFromTutorRequest)(nil),  // 18: user.v1.AcceptInvitationFromTutorRequest
+	(*Empty)(nil),                             // 19: user.v1.Empty
+	(*User)(nil),                              // 20: user.v1.User
+	(*UserPublic)(nil),                        // 21: user.v1.UserPublic
+	(*TutorProfile)(nil),                      // 22: user.v1.TutorProfile
+	(*TutorStudent)(nil),                      // 23: user.v1.TutorStudent
+	nil,                                       // 24: user.v1.GetUserTimezonesResponse.TimezonesEntry
+	(*timestamppb.Timestamp)(nil),             // 25: google.protobuf.Timestamp
 }
 var file_user_service_proto_depIdxs = []int32{
-	21, // 0: user.v1.ListTutorStudentsResponse.students:type_name -> user.v1.TutorStudent
-	21, // 1: user.v1.ListTutorsForStudentResponse.tutors:type_name -> user.v1.TutorStudent
-	22, // 2: user.v1.User.created_at:type_name -> google.protobuf.Timestamp
-	22, // 3: user.v1.User.edited_at:type_name -> google.protobuf.Timestamp
-	22, // 4: user.v1.TutorProfile.created_at:type_name -> google.protobuf.Timestamp
-	22, // 5: user.v1.TutorProfile.edited_at:type_name -> google.protobuf.Timestamp
-	22, // 6: user.v1.TutorStudent.created_at:type_name -> google.protobuf.Timestamp
-	22, // 7: user.v1.TutorStudent.edited_at:type_name -> google.protobuf.Timestamp
-	0,  // 8: user.v1.UserService.RegisterViaTelegram:input_type -> user.v1.RegisterViaTelegramRequest
-	1,  // 9: user.v1.UserService.AuthorizeByAuthHeader:input_type -> user.v1.AuthorizeByAuthHeaderRequest
-	17, // 10: user.v1.UserService.GetMe:input_type -> user.v1.Empty
-	2,  // 11: user.v1.UserService.GetUser:input_type -> user.v1.GetUserRequest
-	3,  // 12: user.v1.UserService.UpdateUser:input_type -> user.v1.UpdateUserRequest
-	5,  // 13: user.v1.UserService.UpdateTutorProfile:input_type -> user.v1.UpdateTutorProfileRequest
-	4,  // 14: user.v1.UserService.GetTutorProfileByUserId:input_type -> user.v1.GetTutorProfileByUserIdRequest
-	6,  // 15: user.v1.UserService.GetTutorStudent:input_type -> user.v1.GetTutorStudentRequest
-	7,  // 16: user.v1.UserService.CreateTutorStudent:input_type -> user.v1.CreateTutorStudentRequest
-	8,  // 17: user.v1.UserService.UpdateTutorStudent:input_type -> user.v1.UpdateTutorStudentRequest
-	9,  // 18: user.v1.UserService.DeleteTutorStudent:input_type -> user.v1.DeleteTutorStudentRequest
-	10, // 19: user.v1.UserService.ListTutorStudents:input_type -> user.v1.ListTutorStudentsRequest
-	12, // 20: user.v1.UserService.ListTutorsForStudent:input_type -> user.v1.ListTutorsForStudentRequest
-	14, // 21: user.v1.UserService.ResolveTutorStudentContext:input_type -> user.v1.ResolveTutorStudentContextRequest
-	16, // 22: user.v1.UserService.AcceptInvitationFromTutor:input_type -> user.v1.AcceptInvitationFromTutorRequest
-	18, // 23: user.v1.UserService.RegisterViaTelegram:output_type -> user.v1.User
-	18, // 24: user.v1.UserService.AuthorizeByAuthHeader:output_type -> user.v1.User
-	18, // 25: user.v1.UserService.GetMe:output_type -> user.v1.User
-	19, // 26: user.v1.UserService.GetUser:output_type -> user.v1.UserPublic
-	18, // 27: user.v1.UserService.UpdateUser:output_type -> user.v1.User
-	20, // 28: user.v1.UserService.UpdateTutorProfile:output_type -> user.v1.TutorProfile
-	20, // 29: user.v1.UserService.GetTutorProfileByUserId:output_type -> user.v1.TutorProfile
-	21, // 30: user.v1.UserService.GetTutorStudent:output_type -> user.v1.TutorStudent
-	21, // 31: user.v1.UserService.CreateTutorStudent:output_type -> user.v1.TutorStudent
-	21, // 32: user.v1.UserService.UpdateTutorStudent:output_type -> user.v1.TutorStudent
-	17, // 33: user.v1.UserService.DeleteTutorStudent:output_type -> user.v1.Empty
-	11, // 34: user.v1.UserService.ListTutorStudents:output_type -> user.v1.ListTutorStudentsResponse
-	13, // 35: user.v1.UserService.ListTutorsForStudent:output_type -> user.v1.ListTutorsForStudentResponse
-	15, // 36: user.v1.UserService.ResolveTutorStudentContext:output_type -> user.v1.ResolvedTutorStudentContext
-	17, // 37: user.v1.UserService.AcceptInvitationFromTutor:output_type -> user.v1.Empty
-	23, // [23:38] is the sub-list for method output_type
-	8,  // [8:23] is the sub-list for method input_type
-	8,  // [8:8] is the sub-list for extension type_name
-	8,  // [8:8] is the sub-list for extension extendee
-	0,  // [0:8] is the sub-list for field type_name
+	24, // 0: user.v1.GetUserTimezonesResponse.timezones:type_name -> user.v1.GetUserTimezonesResponse.TimezonesEntry
+	23, // 1: user.v1.ListTutorStudentsResponse.students:type_name -> user.v1.TutorStudent
+	23, // 2: user.v1.ListTutorsForStudentResponse.tutors:type_name -> user.v1.TutorStudent
+	25, // 3: user.v1.User.created_at:type_name -> google.protobuf.Timestamp
+	25, // 4: user.v1.User.edited_at:type_name -> google.protobuf.Timestamp
+	25, // 5: user.v1.TutorProfile.created_at:type_name -> google.protobuf.Timestamp
+	25, // 6: user.v1.TutorProfile.edited_at:type_name -> google.protobuf.Timestamp
+	25, // 7: user.v1.TutorStudent.created_at:type_name -> google.protobuf.Timestamp
+	25, // 8: user.v1.TutorStudent.edited_at:type_name -> google.protobuf.Timestamp
+	0,  // 9: user.v1.UserService.RegisterViaTelegram:input_type -> user.v1.RegisterViaTelegramRequest
+	1,  // 10: user.v1.UserService.AuthorizeByAuthHeader:input_type -> user.v1.AuthorizeByAuthHeaderRequest
+	19, // 11: user.v1.UserService.GetMe:input_type -> user.v1.Empty
+	2,  // 12: user.v1.UserService.GetUser:input_type -> user.v1.GetUserRequest
+	3,  // 13: user.v1.UserService.GetUserTimezones:input_type -> user.v1.GetUserTimezonesRequest
+	5,  // 14: user.v1.UserService.UpdateUser:input_type -> user.v1.UpdateUserRequest
+	7,  // 15: user.v1.UserService.UpdateTutorProfile:input_type -> user.v1.UpdateTutorProfileRequest
+	6,  // 16: user.v1.UserService.GetTutorProfileByUserId:input_type -> user.v1.GetTutorProfileByUserIdRequest
+	8,  // 17: user.v1.UserService.GetTutorStudent:input_type -> user.v1.GetTutorStudentRequest
+	9,  // 18: user.v1.UserService.CreateTutorStudent:input_type -> user.v1.CreateTutorStudentRequest
+	10, // 19: user.v1.UserService.UpdateTutorStudent:input_type -> user.v1.UpdateTutorStudentRequest
+	11, // 20: user.v1.UserService.DeleteTutorStudent:input_type -> user.v1.DeleteTutorStudentRequest
+	12, // 21: user.v1.UserService.ListTutorStudents:input_type -> user.v1.ListTutorStudentsRequest
+	14, // 22: user.v1.UserService.ListTutorsForStudent:input_type -> user.v1.ListTutorsForStudentRequest
+	16, // 23: user.v1.UserService.ResolveTutorStudentContext:input_type -> user.v1.ResolveTutorStudentContextRequest
+	18, // 24: user.v1.UserService.AcceptInvitationFromTutor:input_type -> user.v1.AcceptInvitationFromTutorRequest
+	20, // 25: user.v1.UserService.RegisterViaTelegram:output_type -> user.v1.User
+	20, // 26: user.v1.UserService.AuthorizeByAuthHeader:output_type -> user.v1.User
+	20, // 27: user.v1.UserService.GetMe:output_type -> user.v1.User
+	21, // 28: user.v1.UserService.GetUser:output_type -> user.v1.UserPublic
+	4,  // 29: user.v1.UserService.GetUserTimezones:output_type -> user.v1.GetUserTimezonesResponse
+	20, // 30: user.v1.UserService.UpdateUser:output_type -> user.v1.User
+	22, // 31: user.v1.UserService.UpdateTutorProfile:output_type -> user.v1.TutorProfile
+	22, // 32: user.v1.UserService.GetTutorProfileByUserId:output_type -> user.v1.TutorProfile
+	23, // 33: user.v1.UserService.GetTutorStudent:output_type -> user.v1.TutorStudent
+	23, // 34: user.v1.UserService.CreateTutorStudent:output_type -> user.v1.TutorStudent
+	23, // 35: user.v1.UserService.UpdateTutorStudent:output_type -> user.v1.TutorStudent
+	19, // 36: user.v1.UserService.DeleteTutorStudent:output_type -> user.v1.Empty
+	13, // 37: user.v1.UserService.ListTutorStudents:output_type -> user.v1.ListTutorStudentsResponse
+	15, // 38: user.v1.UserService.ListTutorsForStudent:output_type -> user.v1.ListTutorsForStudentResponse
+	17, // 39: user.v1.UserService.ResolveTutorStudentContext:output_type -> user.v1.ResolvedTutorStudentContext
+	19, // 40: user.v1.UserService.AcceptInvitationFromTutor:output_type -> user.v1.Empty
+	25, // [25:41] is the sub-list for method output_type
+	9,  // [9:25] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
 }
 
 func init() { file_user_service_proto_init() }
@@ -1618,22 +1721,22 @@ func file_user_service_proto_init() {
 		return
 	}
 	file_user_service_proto_msgTypes[0].OneofWrappers = []any{}
-	file_user_service_proto_msgTypes[3].OneofWrappers = []any{}
 	file_user_service_proto_msgTypes[5].OneofWrappers = []any{}
 	file_user_service_proto_msgTypes[7].OneofWrappers = []any{}
-	file_user_service_proto_msgTypes[8].OneofWrappers = []any{}
-	file_user_service_proto_msgTypes[15].OneofWrappers = []any{}
-	file_user_service_proto_msgTypes[18].OneofWrappers = []any{}
-	file_user_service_proto_msgTypes[19].OneofWrappers = []any{}
+	file_user_service_proto_msgTypes[9].OneofWrappers = []any{}
+	file_user_service_proto_msgTypes[10].OneofWrappers = []any{}
+	file_user_service_proto_msgTypes[17].OneofWrappers = []any{}
 	file_user_service_proto_msgTypes[20].OneofWrappers = []any{}
 	file_user_service_proto_msgTypes[21].OneofWrappers = []any{}
+	file_user_service_proto_msgTypes[22].OneofWrappers = []any{}
+	file_user_service_proto_msgTypes[23].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_user_service_proto_rawDesc), len(file_user_service_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   22,
+			NumMessages:   25,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
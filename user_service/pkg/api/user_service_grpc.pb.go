@@ -23,6 +23,7 @@ const (
 	UserService_AuthorizeByAuthHeader_FullMethodName      = "/user.v1.UserService/AuthorizeByAuthHeader"
 	UserService_GetMe_FullMethodName                      = "/user.v1.UserService/GetMe"
 	UserService_GetUser_FullMethodName                    = "/user.v1.UserService/GetUser"
+	UserService_GetUserTimezones_FullMethodName           = "/user.v1.UserService/GetUserTimezones"
 	UserService_UpdateUser_FullMethodName                 = "/user.v1.UserService/UpdateUser"
 	UserService_UpdateTutorProfile_FullMethodName         = "/user.v1.UserService/UpdateTutorProfile"
 	UserService_GetTutorProfileByUserId_FullMethodName    = "/user.v1.UserService/GetTutorProfileByUserId"
@@ -44,6 +45,7 @@ type UserServiceClient interface {
 	AuthorizeByAuthHeader(ctx context.Context, in *AuthorizeByAuthHeaderRequest, opts ...grpc.CallOption) (*User, error)
 	GetMe(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*User, error)
 	GetUser(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*UserPublic, error)
+	GetUserTimezones(ctx context.Context, in *GetUserTimezonesRequest, opts ...grpc.CallOption) (*GetUserTimezonesResponse, error)
 	UpdateUser(ctx context.Context, in *UpdateUserRequest, opts ...grpc.CallOption) (*User, error)
 	UpdateTutorProfile(ctx context.Context, in *UpdateTutorProfileRequest, opts ...grpc.CallOption) (*TutorProfile, error)
 	GetTutorProfileByUserId(ctx context.Context, in *GetTutorProfileByUserIdRequest, opts ...grpc.CallOption) (*TutorProfile, error)
@@ -105,6 +107,16 @@ func (c *userServiceClient) GetUser(ctx context.Context, in *GetUserRequest, opt
 	return out, nil
 }
 
+func (c *userServiceClient) GetUserTimezones(ctx context.Context, in *GetUserTimezonesRequest, opts ...grpc.CallOption) (*GetUserTimezonesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUserTimezonesResponse)
+	err := c.cc.Invoke(ctx, UserService_GetUserTimezones_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userServiceClient) UpdateUser(ctx context.Context, in *UpdateUserRequest, opts ...grpc.CallOption) (*User, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(User)
@@ -223,6 +235,7 @@ type UserServiceServer interface {
 	AuthorizeByAuthHeader(context.Context, *AuthorizeByAuthHeaderRequest) (*User, error)
 	GetMe(context.Context, *Empty) (*User, error)
 	GetUser(context.Context, *GetUserRequest) (*UserPublic, error)
+	GetUserTimezones(context.Context, *GetUserTimezonesRequest) (*GetUserTimezonesResponse, error)
 	UpdateUser(context.Context, *UpdateUserRequest) (*User, error)
 	UpdateTutorProfile(context.Context, *UpdateTutorProfileRequest) (*TutorProfile, error)
 	GetTutorProfileByUserId(context.Context, *GetTutorProfileByUserIdRequest) (*TutorProfile, error)
@@ -256,6 +269,9 @@ func (UnimplementedUserServiceServer) GetMe(context.Context, *Empty) (*User, err
 func (UnimplementedUserServiceServer) GetUser(context.Context, *GetUserRequest) (*UserPublic, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetUser not implemented")
 }
+func (UnimplementedUserServiceServer) GetUserTimezones(context.Context, *GetUserTimezonesRequest) (*GetUserTimezonesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserTimezones not implemented")
+}
 func (UnimplementedUserServiceServer) UpdateUser(context.Context, *UpdateUserRequest) (*User, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateUser not implemented")
 }
@@ -382,6 +398,24 @@ func _UserService_GetUser_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_GetUserTimezones_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserTimezonesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetUserTimezones(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetUserTimezones_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetUserTimezones(ctx, req.(*GetUserTimezonesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UserService_UpdateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdateUserRequest)
 	if err := dec(in); err != nil {
@@ -603,6 +637,10 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetUser",
 			Handler:    _UserService_GetUser_Handler,
 		},
+		{
+			MethodName: "GetUserTimezones",
+			Handler:    _UserService_GetUserTimezones_Handler,
+		},
 		{
 			MethodName: "UpdateUser",
 			Handler:    _UserService_UpdateUser_Handler,